@@ -83,30 +83,383 @@ func (m *ClusterInfo) GetDeploymentID() string {
 	return ""
 }
 
+// Migration is a schema migration of pachd's database.
+type Migration struct {
+	ID   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// start_time and end_time are unset if the migration hasn't been applied.
+	StartTime *types.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *types.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Applied   bool             `protobuf:"varint,5,opt,name=applied,proto3" json:"applied,omitempty"`
+	// reversible is true if the migration can be rolled back.
+	Reversible           bool     `protobuf:"varint,6,opt,name=reversible,proto3" json:"reversible,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Migration) Reset()         { *m = Migration{} }
+func (m *Migration) String() string { return proto.CompactTextString(m) }
+func (*Migration) ProtoMessage()    {}
+func (*Migration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8595c8dce2486799, []int{1}
+}
+func (m *Migration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Migration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Migration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Migration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Migration.Merge(m, src)
+}
+func (m *Migration) XXX_Size() int {
+	return m.Size()
+}
+func (m *Migration) XXX_DiscardUnknown() {
+	xxx_messageInfo_Migration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Migration proto.InternalMessageInfo
+
+func (m *Migration) GetID() int64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *Migration) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Migration) GetStartTime() *types.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *Migration) GetEndTime() *types.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *Migration) GetApplied() bool {
+	if m != nil {
+		return m.Applied
+	}
+	return false
+}
+
+func (m *Migration) GetReversible() bool {
+	if m != nil {
+		return m.Reversible
+	}
+	return false
+}
+
+type MigrationStatusResponse struct {
+	Migrations           []*Migration `protobuf:"bytes,1,rep,name=migrations,proto3" json:"migrations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *MigrationStatusResponse) Reset()         { *m = MigrationStatusResponse{} }
+func (m *MigrationStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*MigrationStatusResponse) ProtoMessage()    {}
+func (*MigrationStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8595c8dce2486799, []int{2}
+}
+func (m *MigrationStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigrationStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrationStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MigrationStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrationStatusResponse.Merge(m, src)
+}
+func (m *MigrationStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigrationStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrationStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrationStatusResponse proto.InternalMessageInfo
+
+func (m *MigrationStatusResponse) GetMigrations() []*Migration {
+	if m != nil {
+		return m.Migrations
+	}
+	return nil
+}
+
+type PlanMigrationsRequest struct {
+	// dry_run runs the pending migrations in a transaction that's rolled back,
+	// to check that they would succeed.
+	DryRun               bool     `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlanMigrationsRequest) Reset()         { *m = PlanMigrationsRequest{} }
+func (m *PlanMigrationsRequest) String() string { return proto.CompactTextString(m) }
+func (*PlanMigrationsRequest) ProtoMessage()    {}
+func (*PlanMigrationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8595c8dce2486799, []int{3}
+}
+func (m *PlanMigrationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlanMigrationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlanMigrationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlanMigrationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanMigrationsRequest.Merge(m, src)
+}
+func (m *PlanMigrationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlanMigrationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanMigrationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanMigrationsRequest proto.InternalMessageInfo
+
+func (m *PlanMigrationsRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type PlanMigrationsResponse struct {
+	Pending              []*Migration `protobuf:"bytes,1,rep,name=pending,proto3" json:"pending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *PlanMigrationsResponse) Reset()         { *m = PlanMigrationsResponse{} }
+func (m *PlanMigrationsResponse) String() string { return proto.CompactTextString(m) }
+func (*PlanMigrationsResponse) ProtoMessage()    {}
+func (*PlanMigrationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8595c8dce2486799, []int{4}
+}
+func (m *PlanMigrationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlanMigrationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlanMigrationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlanMigrationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanMigrationsResponse.Merge(m, src)
+}
+func (m *PlanMigrationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlanMigrationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanMigrationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanMigrationsResponse proto.InternalMessageInfo
+
+func (m *PlanMigrationsResponse) GetPending() []*Migration {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+type RollbackMigrationsRequest struct {
+	// to is the id of the last migration to keep.
+	To                   int64    `protobuf:"varint,1,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RollbackMigrationsRequest) Reset()         { *m = RollbackMigrationsRequest{} }
+func (m *RollbackMigrationsRequest) String() string { return proto.CompactTextString(m) }
+func (*RollbackMigrationsRequest) ProtoMessage()    {}
+func (*RollbackMigrationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8595c8dce2486799, []int{5}
+}
+func (m *RollbackMigrationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollbackMigrationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollbackMigrationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollbackMigrationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackMigrationsRequest.Merge(m, src)
+}
+func (m *RollbackMigrationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollbackMigrationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackMigrationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackMigrationsRequest proto.InternalMessageInfo
+
+func (m *RollbackMigrationsRequest) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+type RollbackMigrationsResponse struct {
+	RolledBack           []*Migration `protobuf:"bytes,1,rep,name=rolled_back,json=rolledBack,proto3" json:"rolled_back,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *RollbackMigrationsResponse) Reset()         { *m = RollbackMigrationsResponse{} }
+func (m *RollbackMigrationsResponse) String() string { return proto.CompactTextString(m) }
+func (*RollbackMigrationsResponse) ProtoMessage()    {}
+func (*RollbackMigrationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8595c8dce2486799, []int{6}
+}
+func (m *RollbackMigrationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollbackMigrationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollbackMigrationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollbackMigrationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackMigrationsResponse.Merge(m, src)
+}
+func (m *RollbackMigrationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollbackMigrationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackMigrationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackMigrationsResponse proto.InternalMessageInfo
+
+func (m *RollbackMigrationsResponse) GetRolledBack() []*Migration {
+	if m != nil {
+		return m.RolledBack
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ClusterInfo)(nil), "admin.ClusterInfo")
+	proto.RegisterType((*Migration)(nil), "admin.Migration")
+	proto.RegisterType((*MigrationStatusResponse)(nil), "admin.MigrationStatusResponse")
+	proto.RegisterType((*PlanMigrationsRequest)(nil), "admin.PlanMigrationsRequest")
+	proto.RegisterType((*PlanMigrationsResponse)(nil), "admin.PlanMigrationsResponse")
+	proto.RegisterType((*RollbackMigrationsRequest)(nil), "admin.RollbackMigrationsRequest")
+	proto.RegisterType((*RollbackMigrationsResponse)(nil), "admin.RollbackMigrationsResponse")
 }
 
 func init() { proto.RegisterFile("admin/admin.proto", fileDescriptor_8595c8dce2486799) }
 
 var fileDescriptor_8595c8dce2486799 = []byte{
-	// 248 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4c, 0x4c, 0xc9, 0xcd,
-	0xcc, 0xd3, 0x07, 0x93, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xac, 0x60, 0x8e, 0x94, 0x74,
-	0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x3e, 0x58, 0x30, 0xa9, 0x34, 0x4d, 0x3f, 0x35, 0xb7, 0xa0,
-	0xa4, 0x12, 0xa2, 0x46, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1, 0x20, 0xa2,
-	0x4a, 0x31, 0x5c, 0xdc, 0xce, 0x39, 0xa5, 0xc5, 0x25, 0xa9, 0x45, 0x9e, 0x79, 0x69, 0xf9, 0x42,
-	0x62, 0x5c, 0x4c, 0x99, 0x29, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0x6c, 0x8f, 0xee, 0xc9,
-	0x33, 0x79, 0xba, 0x04, 0x31, 0x65, 0xa6, 0x08, 0x99, 0x72, 0xf1, 0xa6, 0xa4, 0x16, 0xe4, 0xe4,
-	0x57, 0xe6, 0xa6, 0xe6, 0x95, 0xc4, 0x67, 0xa6, 0x48, 0x30, 0x81, 0x95, 0x08, 0x3c, 0xba, 0x27,
-	0xcf, 0xe3, 0x02, 0x97, 0xf0, 0x74, 0x09, 0xe2, 0x41, 0x28, 0xf3, 0x4c, 0x31, 0x72, 0xe5, 0x62,
-	0x76, 0x0c, 0xf0, 0x14, 0xb2, 0xe3, 0xe2, 0xf3, 0xcc, 0x2b, 0x2e, 0x48, 0x4d, 0x2e, 0x81, 0xda,
-	0x25, 0x24, 0xa6, 0x07, 0x71, 0xaa, 0x1e, 0xcc, 0xa9, 0x7a, 0xae, 0x20, 0xa7, 0x4a, 0x09, 0xe9,
-	0x41, 0xbc, 0x85, 0xe4, 0x26, 0x25, 0x06, 0x27, 0xcb, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92,
-	0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x31, 0x4a, 0x3b, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39,
-	0x3f, 0x57, 0xbf, 0x20, 0x31, 0x39, 0xa3, 0x32, 0x25, 0xb5, 0x08, 0x99, 0x55, 0x66, 0xa4, 0x5f,
-	0x5c, 0x94, 0x0c, 0x09, 0x9f, 0x24, 0x36, 0xb0, 0x05, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x32, 0xdc, 0x5e, 0xe5, 0x35, 0x01, 0x00, 0x00,
+	// 552 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0xad, 0x9d, 0x36, 0x8f, 0x9b, 0x12, 0xca, 0x08, 0x52, 0x63, 0x20, 0x09, 0x5e, 0x45, 0x54,
+	0xb2, 0x4b, 0x50, 0x17, 0xdd, 0x20, 0x11, 0xc2, 0xc2, 0xaa, 0x50, 0xab, 0x81, 0x15, 0x20, 0x45,
+	0x8e, 0x67, 0xea, 0x5a, 0xb5, 0x67, 0xcc, 0xcc, 0xb8, 0x52, 0xfe, 0x8e, 0x25, 0x4b, 0xbe, 0xa0,
+	0x42, 0xe1, 0x47, 0x50, 0xfc, 0x08, 0x79, 0x34, 0x74, 0x13, 0xdd, 0x99, 0x7b, 0xce, 0xb9, 0x39,
+	0xf7, 0x8c, 0x0c, 0x8f, 0x3c, 0x12, 0x87, 0xcc, 0xc9, 0x7e, 0xed, 0x44, 0x70, 0xc5, 0xd1, 0x5e,
+	0x76, 0x30, 0x9f, 0x05, 0x9c, 0x07, 0x11, 0x75, 0xb2, 0xcb, 0x49, 0x7a, 0xe9, 0xd0, 0x38, 0x51,
+	0xd3, 0x1c, 0x63, 0x76, 0xd7, 0x9b, 0x2a, 0x8c, 0xa9, 0x54, 0x5e, 0x9c, 0x14, 0x80, 0xc7, 0x01,
+	0x0f, 0x78, 0x56, 0x3a, 0xf3, 0x2a, 0xbf, 0xb5, 0xbe, 0x41, 0xf3, 0x7d, 0x94, 0x4a, 0x45, 0x85,
+	0xcb, 0x2e, 0x39, 0x6a, 0x83, 0x1e, 0x12, 0x43, 0xeb, 0x69, 0xfd, 0xc6, 0xb0, 0x3a, 0xbb, 0xed,
+	0xea, 0xee, 0x08, 0xeb, 0x21, 0x41, 0x27, 0xf0, 0x80, 0xd0, 0x24, 0xe2, 0xd3, 0x98, 0x32, 0x35,
+	0x0e, 0x89, 0xa1, 0x67, 0x90, 0x83, 0xd9, 0x6d, 0x77, 0x7f, 0xb4, 0x68, 0xb8, 0x23, 0xbc, 0xff,
+	0x0f, 0xe6, 0x12, 0xeb, 0x8f, 0x06, 0x8d, 0x8f, 0x61, 0x20, 0x3c, 0x15, 0x72, 0xb6, 0x24, 0x5e,
+	0x59, 0x11, 0x47, 0xb0, 0xcb, 0xbc, 0x98, 0xe6, 0x9a, 0x38, 0xab, 0xd1, 0x29, 0x80, 0x54, 0x9e,
+	0x50, 0xe3, 0xb9, 0x0d, 0xa3, 0xd2, 0xd3, 0xfa, 0xcd, 0x81, 0x69, 0xe7, 0x1e, 0xed, 0xd2, 0xa3,
+	0xfd, 0xb9, 0xf4, 0x88, 0x1b, 0x19, 0x7a, 0x7e, 0x46, 0x27, 0x50, 0xa7, 0x8c, 0xe4, 0xc4, 0xdd,
+	0x7b, 0x89, 0x35, 0xca, 0x48, 0x46, 0x33, 0xa0, 0xe6, 0x25, 0x49, 0x14, 0x52, 0x62, 0xec, 0xf5,
+	0xb4, 0x7e, 0x1d, 0x97, 0x47, 0xd4, 0x01, 0x10, 0xf4, 0x86, 0x0a, 0x19, 0x4e, 0x22, 0x6a, 0x54,
+	0xb3, 0xe6, 0xd2, 0x8d, 0x75, 0x06, 0x87, 0x0b, 0x93, 0x9f, 0x94, 0xa7, 0x52, 0x89, 0xa9, 0x4c,
+	0x38, 0x93, 0x14, 0x1d, 0x03, 0xc4, 0x65, 0x4b, 0x1a, 0x5a, 0xaf, 0xd2, 0x6f, 0x0e, 0x0e, 0xec,
+	0x3c, 0xdb, 0x05, 0x07, 0x2f, 0x61, 0xac, 0x63, 0x78, 0x72, 0x11, 0x79, 0x6c, 0xd1, 0x94, 0x98,
+	0x7e, 0x4f, 0xa9, 0x54, 0xe8, 0x10, 0x6a, 0x44, 0x4c, 0xc7, 0x22, 0x65, 0xd9, 0x0a, 0xeb, 0xb8,
+	0x4a, 0xc4, 0x14, 0xa7, 0xcc, 0x1a, 0x41, 0x7b, 0x9d, 0x51, 0x4c, 0x7f, 0x05, 0xb5, 0x84, 0x32,
+	0x12, 0xb2, 0x60, 0xeb, 0xe8, 0x12, 0x60, 0x1d, 0xc1, 0x53, 0xcc, 0xa3, 0x68, 0xe2, 0xf9, 0xd7,
+	0x9b, 0xb3, 0x5b, 0xa0, 0x2b, 0x9e, 0x27, 0x87, 0x75, 0xc5, 0xad, 0x73, 0x30, 0xef, 0x02, 0x17,
+	0x63, 0x5f, 0x43, 0x53, 0xf0, 0x28, 0xa2, 0x64, 0x3c, 0x07, 0x6c, 0x77, 0x9d, 0x83, 0x86, 0x9e,
+	0x7f, 0x3d, 0xf8, 0xa1, 0x43, 0xe5, 0xdd, 0x85, 0x8b, 0xde, 0x42, 0xcb, 0x65, 0x32, 0xa1, 0xbe,
+	0x2a, 0x5e, 0x25, 0x6a, 0x6f, 0x64, 0xf7, 0x61, 0xfe, 0xea, 0x4d, 0x54, 0xe8, 0x2d, 0xbd, 0x5e,
+	0x6b, 0x07, 0x9d, 0xc1, 0xc3, 0xb5, 0x28, 0xb6, 0x0a, 0x74, 0xd6, 0xff, 0xd0, 0x6a, 0x74, 0xd6,
+	0x0e, 0x3a, 0x87, 0xd6, 0xea, 0x62, 0xd1, 0xf3, 0x82, 0x73, 0x67, 0x42, 0xe6, 0x8b, 0x2d, 0xdd,
+	0x85, 0xe0, 0x57, 0x40, 0x9b, 0x6b, 0x43, 0xbd, 0x82, 0xb6, 0x75, 0xfd, 0xe6, 0xcb, 0xff, 0x20,
+	0x4a, 0xf1, 0xe1, 0xe9, 0xcf, 0x59, 0x47, 0xfb, 0x35, 0xeb, 0x68, 0xbf, 0x67, 0x1d, 0xed, 0xcb,
+	0x51, 0x10, 0xaa, 0xab, 0x74, 0x62, 0xfb, 0x3c, 0x76, 0x12, 0xcf, 0xbf, 0x9a, 0x12, 0x2a, 0x96,
+	0xab, 0x9b, 0x81, 0x23, 0x85, 0x9f, 0x7f, 0x65, 0x26, 0xd5, 0x6c, 0x35, 0x6f, 0xfe, 0x0e, 0x00,
+	0x61, 0x03, 0x7b, 0x0f, 0x7b, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -122,6 +475,12 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type APIClient interface {
 	InspectCluster(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ClusterInfo, error)
+	// MigrationStatus returns the applied and pending migrations.
+	MigrationStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*MigrationStatusResponse, error)
+	// PlanMigrations returns the pending migrations.
+	PlanMigrations(ctx context.Context, in *PlanMigrationsRequest, opts ...grpc.CallOption) (*PlanMigrationsResponse, error)
+	// RollbackMigrations reverts the applied migrations newer than 'to'.
+	RollbackMigrations(ctx context.Context, in *RollbackMigrationsRequest, opts ...grpc.CallOption) (*RollbackMigrationsResponse, error)
 }
 
 type aPIClient struct {
@@ -141,9 +500,42 @@ func (c *aPIClient) InspectCluster(ctx context.Context, in *types.Empty, opts ..
 	return out, nil
 }
 
+func (c *aPIClient) MigrationStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*MigrationStatusResponse, error) {
+	out := new(MigrationStatusResponse)
+	err := c.cc.Invoke(ctx, "/admin.API/MigrationStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) PlanMigrations(ctx context.Context, in *PlanMigrationsRequest, opts ...grpc.CallOption) (*PlanMigrationsResponse, error) {
+	out := new(PlanMigrationsResponse)
+	err := c.cc.Invoke(ctx, "/admin.API/PlanMigrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RollbackMigrations(ctx context.Context, in *RollbackMigrationsRequest, opts ...grpc.CallOption) (*RollbackMigrationsResponse, error) {
+	out := new(RollbackMigrationsResponse)
+	err := c.cc.Invoke(ctx, "/admin.API/RollbackMigrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // APIServer is the server API for API service.
 type APIServer interface {
 	InspectCluster(context.Context, *types.Empty) (*ClusterInfo, error)
+	// MigrationStatus returns the applied and pending migrations.
+	MigrationStatus(context.Context, *types.Empty) (*MigrationStatusResponse, error)
+	// PlanMigrations returns the pending migrations.
+	PlanMigrations(context.Context, *PlanMigrationsRequest) (*PlanMigrationsResponse, error)
+	// RollbackMigrations reverts the applied migrations newer than 'to'.
+	RollbackMigrations(context.Context, *RollbackMigrationsRequest) (*RollbackMigrationsResponse, error)
 }
 
 // UnimplementedAPIServer can be embedded to have forward compatible implementations.
@@ -153,6 +545,15 @@ type UnimplementedAPIServer struct {
 func (*UnimplementedAPIServer) InspectCluster(ctx context.Context, req *types.Empty) (*ClusterInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InspectCluster not implemented")
 }
+func (*UnimplementedAPIServer) MigrationStatus(ctx context.Context, req *types.Empty) (*MigrationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrationStatus not implemented")
+}
+func (*UnimplementedAPIServer) PlanMigrations(ctx context.Context, req *PlanMigrationsRequest) (*PlanMigrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanMigrations not implemented")
+}
+func (*UnimplementedAPIServer) RollbackMigrations(ctx context.Context, req *RollbackMigrationsRequest) (*RollbackMigrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackMigrations not implemented")
+}
 
 func RegisterAPIServer(s *grpc.Server, srv APIServer) {
 	s.RegisterService(&_API_serviceDesc, srv)
@@ -176,6 +577,60 @@ func _API_InspectCluster_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_MigrationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).MigrationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.API/MigrationStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).MigrationStatus(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_PlanMigrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanMigrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).PlanMigrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.API/PlanMigrations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).PlanMigrations(ctx, req.(*PlanMigrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RollbackMigrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackMigrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RollbackMigrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.API/RollbackMigrations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RollbackMigrations(ctx, req.(*RollbackMigrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _API_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "admin.API",
 	HandlerType: (*APIServer)(nil),
@@ -184,6 +639,18 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InspectCluster",
 			Handler:    _API_InspectCluster_Handler,
 		},
+		{
+			MethodName: "MigrationStatus",
+			Handler:    _API_MigrationStatus_Handler,
+		},
+		{
+			MethodName: "PlanMigrations",
+			Handler:    _API_PlanMigrations_Handler,
+		},
+		{
+			MethodName: "RollbackMigrations",
+			Handler:    _API_RollbackMigrations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/admin.proto",
@@ -230,9 +697,284 @@ func (m *ClusterInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAdmin(v)
-	base := offset
+func (m *Migration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Migration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Migration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Reversible {
+		i--
+		if m.Reversible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Applied {
+		i--
+		if m.Applied {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EndTime != nil {
+		{
+			size, err := m.EndTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StartTime != nil {
+		{
+			size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MigrationStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrationStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigrationStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Migrations) > 0 {
+		for iNdEx := len(m.Migrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Migrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlanMigrationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlanMigrationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlanMigrationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlanMigrationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlanMigrationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlanMigrationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Pending) > 0 {
+		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RollbackMigrationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackMigrationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollbackMigrationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.To != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RollbackMigrationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackMigrationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollbackMigrationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RolledBack) > 0 {
+		for iNdEx := len(m.RolledBack) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RolledBack[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAdmin(v)
+	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
@@ -255,19 +997,621 @@ func (m *ClusterInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAdmin(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Migration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovAdmin(uint64(m.ID))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.StartTime != nil {
+		l = m.StartTime.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = m.EndTime.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Applied {
+		n += 2
+	}
+	if m.Reversible {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MigrationStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Migrations) > 0 {
+		for _, e := range m.Migrations {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PlanMigrationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DryRun {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PlanMigrationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for _, e := range m.Pending {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RollbackMigrationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.To != 0 {
+		n += 1 + sovAdmin(uint64(m.To))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RollbackMigrationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RolledBack) > 0 {
+		for _, e := range m.RolledBack {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovAdmin(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAdmin(x uint64) (n int) {
+	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeploymentID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Migration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Migration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Migration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = &types.Timestamp{}
+			}
+			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = &types.Timestamp{}
+			}
+			if err := m.EndTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Applied = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reversible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reversible = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MigrationStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MigrationStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MigrationStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Migrations = append(m.Migrations, &Migration{})
+			if err := m.Migrations[len(m.Migrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *PlanMigrationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlanMigrationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlanMigrationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func sovAdmin(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAdmin(x uint64) (n int) {
-	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
+func (m *PlanMigrationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -290,17 +1634,17 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlanMigrationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlanMigrationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -310,29 +1654,152 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Pending = append(m.Pending, &Migration{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollbackMigrationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackMigrationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackMigrationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollbackMigrationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackMigrationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackMigrationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RolledBack", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -342,23 +1809,25 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeploymentID = string(dAtA[iNdEx:postIndex])
+			m.RolledBack = append(m.RolledBack, &Migration{})
+			if err := m.RolledBack[len(m.RolledBack)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -80,6 +80,12 @@ const (
 	Permission_CLUSTER_LIST_SECRETS        Permission = 144
 	Permission_SECRET_DELETE               Permission = 145
 	Permission_SECRET_INSPECT              Permission = 146
+	Permission_CLUSTER_CREATE_REMOTE       Permission = 148
+	Permission_CLUSTER_DELETE_REMOTE       Permission = 149
+	Permission_CLUSTER_GET_MIGRATIONS      Permission = 150
+	Permission_CLUSTER_ROLLBACK_MIGRATIONS Permission = 151
+	Permission_CLUSTER_INSPECT_STORAGE     Permission = 152
+	Permission_CLUSTER_VERIFY_MIRROR       Permission = 153
 	Permission_CLUSTER_DELETE_ALL          Permission = 138
 	Permission_REPO_READ                   Permission = 200
 	Permission_REPO_WRITE                  Permission = 201
@@ -144,6 +150,12 @@ var Permission_name = map[int32]string{
 	144: "CLUSTER_LIST_SECRETS",
 	145: "SECRET_DELETE",
 	146: "SECRET_INSPECT",
+	148: "CLUSTER_CREATE_REMOTE",
+	149: "CLUSTER_DELETE_REMOTE",
+	150: "CLUSTER_GET_MIGRATIONS",
+	151: "CLUSTER_ROLLBACK_MIGRATIONS",
+	152: "CLUSTER_INSPECT_STORAGE",
+	153: "CLUSTER_VERIFY_MIRROR",
 	138: "CLUSTER_DELETE_ALL",
 	200: "REPO_READ",
 	201: "REPO_WRITE",
@@ -208,6 +220,12 @@ var Permission_value = map[string]int32{
 	"CLUSTER_LIST_SECRETS":                       144,
 	"SECRET_DELETE":                              145,
 	"SECRET_INSPECT":                             146,
+	"CLUSTER_CREATE_REMOTE":                      148,
+	"CLUSTER_DELETE_REMOTE":                      149,
+	"CLUSTER_GET_MIGRATIONS":                     150,
+	"CLUSTER_ROLLBACK_MIGRATIONS":                151,
+	"CLUSTER_INSPECT_STORAGE":                    152,
+	"CLUSTER_VERIFY_MIRROR":                      153,
 	"CLUSTER_DELETE_ALL":                         138,
 	"REPO_READ":                                  200,
 	"REPO_WRITE":                                 201,
@@ -242,18 +260,22 @@ const (
 	ResourceType_RESOURCE_TYPE_UNKNOWN ResourceType = 0
 	ResourceType_CLUSTER               ResourceType = 1
 	ResourceType_REPO                  ResourceType = 2
+	// Role bindings on a project apply to all the repos in the project.
+	ResourceType_PROJECT ResourceType = 3
 )
 
 var ResourceType_name = map[int32]string{
 	0: "RESOURCE_TYPE_UNKNOWN",
 	1: "CLUSTER",
 	2: "REPO",
+	3: "PROJECT",
 }
 
 var ResourceType_value = map[string]int32{
 	"RESOURCE_TYPE_UNKNOWN": 0,
 	"CLUSTER":               1,
 	"REPO":                  2,
+	"PROJECT":               3,
 }
 
 func (x ResourceType) String() string {
@@ -3022,173 +3044,177 @@ func init() {
 func init() { proto.RegisterFile("auth/auth.proto", fileDescriptor_712ec48c1eaf43a2) }
 
 var fileDescriptor_712ec48c1eaf43a2 = []byte{
-	// 2646 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x59, 0x77, 0x23, 0x47,
-	0x15, 0x9e, 0xb6, 0xc7, 0xb6, 0x74, 0xe5, 0xb1, 0x7b, 0xca, 0x9b, 0xdc, 0xe3, 0xf1, 0xd2, 0xc9,
-	0x64, 0x26, 0x93, 0x60, 0x07, 0x43, 0xc2, 0x90, 0xe4, 0x70, 0xa2, 0xa5, 0xad, 0x74, 0x22, 0x4b,
-	0xa2, 0xba, 0x35, 0x93, 0xf0, 0x40, 0x23, 0x4b, 0x35, 0x76, 0x13, 0x5b, 0xad, 0x74, 0xb7, 0x4c,
-	0x1c, 0x96, 0x90, 0xc3, 0xbe, 0x07, 0xf8, 0x05, 0xfc, 0x80, 0xbc, 0xf0, 0x2b, 0x02, 0x04, 0x08,
-	0xeb, 0xe3, 0xc0, 0xf1, 0x4f, 0xe0, 0x99, 0x07, 0x4e, 0xd7, 0xd2, 0x9b, 0x5a, 0x4e, 0x32, 0xc0,
-	0x8b, 0xdd, 0x75, 0xef, 0x77, 0x97, 0xba, 0x75, 0x6f, 0x55, 0xdd, 0x12, 0xcc, 0x77, 0x86, 0xfe,
-	0xd1, 0x4e, 0xf0, 0x67, 0x7b, 0xe0, 0x3a, 0xbe, 0x83, 0x2e, 0x07, 0xdf, 0xca, 0xe2, 0xa1, 0x73,
-	0xe8, 0x50, 0xc2, 0x4e, 0xf0, 0xc5, 0x78, 0xca, 0xc6, 0xa1, 0xe3, 0x1c, 0x1e, 0x93, 0x1d, 0x3a,
-	0x3a, 0x18, 0xde, 0xdf, 0xf1, 0xed, 0x13, 0xe2, 0xf9, 0x9d, 0x93, 0x01, 0x03, 0xa8, 0x4f, 0xc1,
-	0x7c, 0xa9, 0xeb, 0xdb, 0xa7, 0x1d, 0x9f, 0x60, 0xf2, 0xfa, 0x90, 0x78, 0x3e, 0xba, 0x0e, 0xe0,
-	0x3a, 0x8e, 0x6f, 0xf9, 0xce, 0x6b, 0xa4, 0x5f, 0x94, 0x36, 0xa5, 0x5b, 0x79, 0x9c, 0x0f, 0x28,
-	0x66, 0x40, 0x50, 0x3f, 0x09, 0x72, 0x24, 0xe1, 0x0d, 0x9c, 0xbe, 0x47, 0x02, 0x91, 0x41, 0xa7,
-	0x7b, 0x94, 0x14, 0x09, 0x28, 0x4c, 0x64, 0x01, 0xae, 0x56, 0x49, 0x27, 0x69, 0x46, 0x5d, 0x04,
-	0x14, 0x27, 0x32, 0x4d, 0xea, 0x67, 0x60, 0x19, 0x3b, 0x7e, 0x40, 0x11, 0x06, 0x3f, 0xa2, 0x5b,
-	0x77, 0x60, 0x65, 0x44, 0x30, 0xf2, 0xee, 0x22, 0xc9, 0x5f, 0x4d, 0x00, 0x34, 0xf5, 0x6a, 0xa5,
-	0xe2, 0xf4, 0xef, 0xdb, 0x87, 0x68, 0x19, 0xa6, 0x6d, 0xcf, 0x1b, 0x12, 0x97, 0x23, 0xf9, 0x08,
-	0x3d, 0x0e, 0xf9, 0xee, 0xb1, 0x4d, 0xfa, 0xbe, 0x65, 0xf7, 0x8a, 0x13, 0x01, 0xab, 0x3c, 0x7b,
-	0xfe, 0x60, 0x23, 0x57, 0xa1, 0x44, 0xbd, 0x8a, 0x73, 0x8c, 0xad, 0xf7, 0xd0, 0x23, 0x70, 0x85,
-	0x43, 0x3d, 0xd2, 0x75, 0x89, 0x5f, 0x9c, 0xa4, 0x9a, 0x66, 0x19, 0xd1, 0xa0, 0x34, 0xb4, 0x0b,
-	0xb3, 0x2e, 0xe9, 0xd9, 0x2e, 0xe9, 0xfa, 0xd6, 0xd0, 0xb5, 0x8b, 0x97, 0xa9, 0xca, 0xf9, 0xf3,
-	0x07, 0x1b, 0x05, 0xcc, 0xe9, 0x6d, 0xac, 0xe3, 0x82, 0x00, 0xb5, 0x5d, 0x3b, 0xf0, 0xcd, 0xeb,
-	0x3a, 0x03, 0xe2, 0x15, 0xa7, 0x36, 0x27, 0x03, 0xdf, 0xd8, 0x08, 0x7d, 0x1a, 0x96, 0x5d, 0xf2,
-	0xfa, 0xd0, 0x76, 0x89, 0x45, 0x4e, 0x3a, 0xf6, 0xb1, 0x75, 0x4a, 0x5c, 0xfb, 0xbe, 0x4d, 0x7a,
-	0xc5, 0xe9, 0x4d, 0xe9, 0x56, 0x0e, 0x2f, 0x72, 0xae, 0x16, 0x30, 0xef, 0x72, 0x1e, 0x7a, 0x1c,
-	0xe4, 0x63, 0xa7, 0xdb, 0x39, 0x3e, 0x72, 0x3c, 0xdf, 0xe2, 0x73, 0x9e, 0xa1, 0xf8, 0xf9, 0x90,
-	0xae, 0x53, 0xb2, 0xba, 0x0a, 0x2b, 0x35, 0xe2, 0xb3, 0x08, 0x0d, 0xdd, 0x8e, 0x6f, 0x3b, 0x62,
-	0x5d, 0x54, 0x0c, 0xc5, 0x51, 0x16, 0x8f, 0xfc, 0x33, 0x70, 0xa5, 0x1b, 0x67, 0xd0, 0x90, 0x16,
-	0x76, 0xe5, 0x6d, 0x9a, 0xbe, 0x51, 0xd0, 0x71, 0x12, 0xa6, 0x7e, 0x1e, 0x56, 0x8c, 0x6c, 0x73,
-	0x0f, 0xad, 0x52, 0x81, 0xa2, 0x31, 0xc6, 0x4d, 0xf5, 0xd7, 0x12, 0xe4, 0x69, 0x2e, 0xe8, 0xfd,
-	0xfb, 0x0e, 0x2a, 0xc2, 0x8c, 0x37, 0x3c, 0xf8, 0x32, 0xe9, 0xfa, 0x3c, 0x03, 0xc4, 0x10, 0x19,
-	0x00, 0xe4, 0x8d, 0x81, 0xcd, 0x0d, 0x4f, 0x50, 0xc3, 0xca, 0x36, 0x2b, 0xb1, 0x6d, 0x51, 0x62,
-	0xdb, 0xa6, 0x28, 0xb1, 0xf2, 0xca, 0xbf, 0x1e, 0x6c, 0xcc, 0xf7, 0x0e, 0x9e, 0x55, 0x23, 0x29,
-	0xf5, 0x9d, 0x7f, 0x6c, 0x48, 0x38, 0xa6, 0x06, 0x3d, 0x03, 0xb3, 0x47, 0x1d, 0xef, 0x88, 0xf4,
-	0x78, 0x7e, 0xd2, 0x5c, 0x29, 0x2f, 0x08, 0x51, 0x4a, 0xb4, 0x02, 0x84, 0x8a, 0x0b, 0x0c, 0xc8,
-	0xd2, 0xf6, 0x8b, 0xb0, 0x50, 0x1a, 0xfa, 0x47, 0xa4, 0xef, 0xdb, 0xdd, 0x58, 0xf5, 0x3e, 0x09,
-	0xe0, 0xd8, 0xbd, 0xae, 0xe5, 0x05, 0xb5, 0xc0, 0x26, 0x50, 0xbe, 0x72, 0xfe, 0x60, 0x23, 0x1f,
-	0x84, 0xc6, 0xa0, 0x05, 0x92, 0x0f, 0x00, 0xf4, 0x13, 0xad, 0x42, 0xce, 0x16, 0x86, 0x27, 0xd8,
-	0x64, 0x6d, 0xae, 0xff, 0x69, 0x58, 0x4c, 0xea, 0xff, 0x68, 0xb5, 0x3e, 0x0f, 0x57, 0xee, 0x1d,
-	0x39, 0xa5, 0x13, 0x5d, 0xe4, 0xc7, 0xdb, 0x12, 0xcc, 0x09, 0x0a, 0x57, 0xa1, 0x40, 0x6e, 0xe8,
-	0x11, 0xb7, 0xdf, 0x39, 0xe1, 0x1e, 0xe2, 0x70, 0xfc, 0x7f, 0x89, 0xb1, 0xea, 0xc0, 0x14, 0x76,
-	0x8e, 0x89, 0x87, 0x9e, 0x84, 0x29, 0x37, 0xf8, 0x28, 0x4a, 0x9b, 0x93, 0xb7, 0x0a, 0xbb, 0xcb,
-	0x2c, 0x6b, 0x28, 0x8f, 0xfd, 0xd5, 0xfa, 0xbe, 0x7b, 0x86, 0x19, 0x48, 0xb9, 0x03, 0x10, 0x11,
-	0x91, 0x0c, 0x93, 0xaf, 0x91, 0x33, 0xee, 0x70, 0xf0, 0x89, 0x16, 0x61, 0xea, 0xb4, 0x73, 0x3c,
-	0x24, 0xd4, 0xcd, 0x1c, 0x66, 0x83, 0x67, 0x27, 0xee, 0x48, 0xea, 0x3b, 0x12, 0x14, 0x02, 0xd1,
-	0xb2, 0xdd, 0xef, 0xd9, 0xfd, 0x43, 0x74, 0x07, 0x66, 0x48, 0xdf, 0x77, 0xed, 0xd0, 0xf2, 0x7a,
-	0x64, 0x99, 0x63, 0xb6, 0x35, 0x06, 0x60, 0x1e, 0x08, 0xb8, 0x52, 0x83, 0xd9, 0x38, 0x23, 0xc3,
-	0x8b, 0xad, 0xb8, 0x17, 0x85, 0xdd, 0x42, 0x6c, 0x4e, 0x71, 0x97, 0xf6, 0x20, 0x87, 0x89, 0xe7,
-	0x0c, 0xdd, 0x2e, 0x41, 0x8f, 0xc1, 0x65, 0xff, 0x6c, 0xc0, 0x82, 0x3f, 0xb7, 0x8b, 0xb8, 0x04,
-	0xe7, 0x9a, 0x67, 0x03, 0x82, 0x29, 0x1f, 0x21, 0xb8, 0x4c, 0x17, 0x89, 0xa5, 0x06, 0xfd, 0x56,
-	0xdf, 0x82, 0xa9, 0xb6, 0x47, 0x5c, 0x0f, 0xdd, 0x81, 0xbc, 0x58, 0x35, 0x31, 0x2b, 0x85, 0x69,
-	0xa2, 0x7c, 0xfa, 0x97, 0x32, 0xd9, 0x8c, 0x22, 0xb0, 0xf2, 0x3c, 0xcc, 0x25, 0x99, 0x1f, 0x2b,
-	0xb6, 0x43, 0x98, 0xae, 0xb9, 0xce, 0x70, 0xe0, 0xa1, 0xa7, 0x60, 0xfa, 0x90, 0x7e, 0x71, 0xf3,
-	0x45, 0x66, 0x9e, 0x71, 0xf9, 0x3f, 0x66, 0x9c, 0xe3, 0x94, 0xcf, 0x42, 0x21, 0x46, 0xfe, 0x58,
-	0x66, 0x5d, 0x90, 0x83, 0x7a, 0x70, 0x5c, 0xfb, 0xcd, 0xb0, 0xd8, 0x6e, 0x43, 0xce, 0xe5, 0x51,
-	0xe3, 0xfb, 0xd0, 0x5c, 0x32, 0x96, 0x38, 0xe4, 0xa3, 0x5d, 0x28, 0x0c, 0x88, 0x7b, 0x62, 0x7b,
-	0x9e, 0xed, 0xf4, 0xbd, 0xe2, 0xc4, 0xe6, 0xe4, 0xad, 0x39, 0xb1, 0x6d, 0xb5, 0x42, 0x06, 0x8e,
-	0x83, 0xd4, 0x77, 0x25, 0xb8, 0x1a, 0x33, 0xca, 0xcb, 0x67, 0x1d, 0xa0, 0x23, 0x88, 0x3d, 0x6a,
-	0x37, 0x87, 0x63, 0x14, 0xb4, 0x0d, 0x79, 0xaf, 0xe3, 0xdb, 0x1e, 0x3d, 0x00, 0xc6, 0xd9, 0x89,
-	0x20, 0xe8, 0x36, 0xcc, 0x50, 0x6a, 0xff, 0xb0, 0x38, 0x39, 0x06, 0x2d, 0x00, 0x68, 0x0d, 0xf2,
-	0x03, 0xd7, 0xee, 0x77, 0xed, 0x41, 0xe7, 0x98, 0x1d, 0x59, 0x38, 0x22, 0xa8, 0x15, 0x58, 0xaa,
-	0x11, 0x3f, 0x92, 0xf3, 0x1e, 0x22, 0x50, 0xea, 0x09, 0x6c, 0x25, 0x95, 0xec, 0x39, 0x6e, 0x4b,
-	0x98, 0x78, 0x98, 0xc8, 0x27, 0x7c, 0x9e, 0x48, 0xfb, 0x7c, 0x00, 0xcb, 0x69, 0x9f, 0x79, 0x9c,
-	0x53, 0x2b, 0x26, 0x7d, 0x84, 0x15, 0x0b, 0xf2, 0x87, 0x6d, 0x30, 0x13, 0xf4, 0x80, 0x66, 0x03,
-	0xf5, 0x4d, 0x28, 0xee, 0x3b, 0x3d, 0xfb, 0xfe, 0x59, 0xac, 0xde, 0xff, 0xe7, 0x33, 0x89, 0x6c,
-	0x4f, 0xc6, 0x6d, 0x5f, 0x83, 0xd5, 0x0c, 0xdb, 0xfc, 0xe4, 0x63, 0x0b, 0xf6, 0xdf, 0x79, 0xa5,
-	0x6a, 0x34, 0x82, 0x19, 0xea, 0xd1, 0x13, 0x30, 0x73, 0xc0, 0x48, 0x5c, 0xc9, 0xd5, 0x91, 0x6d,
-	0x0f, 0x0b, 0x84, 0xfa, 0x25, 0x28, 0x18, 0x84, 0x86, 0x91, 0x1e, 0xc3, 0x8b, 0x30, 0xd5, 0x77,
-	0xfa, 0x5d, 0x71, 0x42, 0xb0, 0x41, 0x40, 0xa5, 0x37, 0x1c, 0x3e, 0x7b, 0x36, 0x40, 0x37, 0x60,
-	0xae, 0xeb, 0xf4, 0x4f, 0x89, 0x1b, 0x48, 0x5b, 0xc4, 0x75, 0xe9, 0x29, 0x9a, 0xa3, 0x77, 0x00,
-	0x4e, 0xd5, 0x5c, 0x57, 0x5d, 0x82, 0x85, 0x1a, 0xf1, 0x83, 0x83, 0xb0, 0xee, 0x1c, 0xda, 0xe1,
-	0x0d, 0xe6, 0x1e, 0x2c, 0x26, 0xc9, 0xdc, 0xfb, 0xc7, 0x21, 0x7f, 0x1c, 0x10, 0xac, 0xa1, 0x7b,
-	0xcc, 0x4f, 0x52, 0x7a, 0xe3, 0xa3, 0xa8, 0x36, 0xae, 0xe3, 0x1c, 0x65, 0xb7, 0x5d, 0x1a, 0x7a,
-	0x76, 0xe0, 0x72, 0xb7, 0xe8, 0x40, 0xad, 0x51, 0xc5, 0xd8, 0x39, 0x48, 0x5d, 0x65, 0xe9, 0x42,
-	0x1d, 0x38, 0xe2, 0x7e, 0xc1, 0x06, 0x68, 0x15, 0x26, 0x7d, 0x9f, 0x4d, 0x6c, 0xb2, 0x3c, 0x73,
-	0xfe, 0x60, 0x63, 0xd2, 0x34, 0xeb, 0x38, 0xa0, 0xa9, 0x9f, 0xe0, 0xcb, 0x74, 0x90, 0xbe, 0xda,
-	0x2e, 0xc2, 0x54, 0xfc, 0x1c, 0x66, 0x03, 0x75, 0x1b, 0x96, 0x31, 0x39, 0x75, 0x5e, 0x23, 0xc1,
-	0xde, 0x91, 0xb6, 0x9c, 0x81, 0x5f, 0x85, 0x95, 0x11, 0x3c, 0x4f, 0x90, 0x7d, 0x7a, 0x13, 0x63,
-	0x7b, 0xe6, 0x9e, 0xe3, 0x06, 0xdb, 0xb6, 0xd0, 0x75, 0xd1, 0x29, 0xbe, 0x1c, 0xee, 0xcc, 0xac,
-	0x0e, 0xf8, 0x88, 0xdf, 0xc2, 0x52, 0xea, 0xb8, 0xa9, 0xbb, 0xb0, 0xc8, 0x12, 0x75, 0x9f, 0x9c,
-	0x1c, 0x10, 0xd7, 0x8b, 0xf9, 0x4c, 0xa5, 0x85, 0xcf, 0x74, 0x10, 0x6c, 0xdd, 0x9d, 0x5e, 0x8f,
-	0xab, 0x0f, 0x3e, 0x03, 0x9b, 0x2e, 0x39, 0x71, 0x4e, 0x09, 0xcf, 0x7f, 0x3e, 0x52, 0x57, 0x60,
-	0x29, 0xa5, 0x97, 0x1b, 0x44, 0x20, 0xd7, 0x84, 0x33, 0x22, 0x17, 0x9e, 0x87, 0xb5, 0x5a, 0xcc,
-	0xc1, 0x91, 0x7d, 0x27, 0x51, 0x81, 0x52, 0x7a, 0x2f, 0x79, 0x02, 0xae, 0xc6, 0x34, 0xf2, 0x35,
-	0x5a, 0x4e, 0x9c, 0x52, 0x51, 0x2c, 0x6e, 0xc2, 0x7c, 0x8d, 0xf8, 0xf4, 0xac, 0xbc, 0x70, 0xaa,
-	0xea, 0x53, 0xd4, 0x4f, 0x0e, 0xe4, 0x4a, 0xd7, 0xd2, 0x87, 0x6f, 0x3e, 0x76, 0xc0, 0x06, 0x61,
-	0xd6, 0xde, 0xf0, 0xdd, 0x4e, 0xd7, 0x0f, 0x57, 0x34, 0x9c, 0x61, 0x15, 0x56, 0x33, 0x78, 0x5c,
-	0xed, 0x4d, 0x98, 0xa6, 0x29, 0x21, 0x4e, 0xd4, 0x79, 0x56, 0xaf, 0xe1, 0xe5, 0x18, 0x73, 0xb6,
-	0xfa, 0x42, 0x90, 0x32, 0x9e, 0xef, 0xb8, 0xa3, 0x39, 0x76, 0x23, 0x9e, 0x63, 0x19, 0x2a, 0x78,
-	0xd2, 0x29, 0x50, 0x1c, 0xd5, 0xc0, 0x57, 0xe6, 0x79, 0x58, 0x4f, 0x25, 0xe4, 0xc7, 0x48, 0x3e,
-	0x75, 0x0b, 0x36, 0xc6, 0x4a, 0x73, 0x03, 0x9b, 0xb0, 0x5e, 0x25, 0xc7, 0xc4, 0x27, 0x5a, 0x70,
-	0x49, 0x24, 0xbd, 0xd1, 0x30, 0x6d, 0xc1, 0xc6, 0x58, 0x04, 0x53, 0x72, 0xfb, 0xfd, 0x39, 0x80,
-	0xe8, 0x1c, 0x40, 0x05, 0x98, 0x69, 0x37, 0x5e, 0x6e, 0x34, 0xef, 0x35, 0xe4, 0x4b, 0xe8, 0x1a,
-	0xac, 0x54, 0xea, 0x6d, 0xc3, 0xd4, 0xb0, 0xb5, 0xdf, 0xac, 0xea, 0x7b, 0xaf, 0x5a, 0x65, 0xbd,
-	0x51, 0xd5, 0x1b, 0x35, 0x43, 0xee, 0xa1, 0x22, 0x2c, 0x0a, 0x66, 0x4d, 0x33, 0x23, 0x4e, 0x70,
-	0x1f, 0x5f, 0x12, 0x9c, 0x52, 0xdb, 0x7c, 0xd1, 0x2a, 0x55, 0x4c, 0xfd, 0x6e, 0xc9, 0xd4, 0xe4,
-	0xfb, 0x71, 0x8d, 0x94, 0x55, 0xd5, 0x42, 0xe6, 0xe1, 0x08, 0x33, 0x50, 0x5b, 0x69, 0x36, 0xf6,
-	0xf4, 0x9a, 0x7c, 0x34, 0xc2, 0x34, 0x22, 0xa6, 0x8d, 0xb6, 0x60, 0x6d, 0x44, 0x12, 0x37, 0xcb,
-	0x4d, 0xd3, 0x32, 0x9b, 0x2f, 0x6b, 0x0d, 0xf9, 0x47, 0x12, 0xba, 0x01, 0x5b, 0x09, 0x08, 0x9f,
-	0x50, 0x0d, 0x37, 0xdb, 0x2d, 0x6b, 0x5f, 0xdb, 0x2f, 0x6b, 0xd8, 0x90, 0x4f, 0x32, 0x7d, 0xa0,
-	0x18, 0x43, 0xee, 0xa3, 0xcd, 0x0c, 0x33, 0x4c, 0x41, 0xdb, 0x08, 0xc4, 0x1d, 0xb4, 0x01, 0xd7,
-	0x12, 0x08, 0xed, 0x15, 0x13, 0x97, 0x2a, 0xdc, 0x0d, 0x43, 0x1e, 0xa0, 0x75, 0x50, 0x12, 0x00,
-	0xac, 0x19, 0x66, 0x13, 0x6b, 0xdc, 0xcf, 0xd7, 0xd1, 0x0e, 0xdc, 0x1e, 0x31, 0xd1, 0xd2, 0xf0,
-	0xbe, 0x6e, 0x18, 0x7a, 0xb3, 0x61, 0x58, 0x7b, 0x4d, 0x6c, 0xb5, 0xb0, 0xde, 0xa8, 0xe8, 0xad,
-	0x52, 0x5d, 0xfe, 0x89, 0x84, 0x6e, 0x82, 0x9a, 0x8a, 0x68, 0x5d, 0x33, 0x35, 0x4b, 0x7b, 0xa5,
-	0xa5, 0x63, 0xad, 0x2a, 0x0c, 0xff, 0x58, 0x42, 0x8f, 0xc2, 0x46, 0xca, 0xf2, 0xdd, 0xe6, 0xcb,
-	0x1a, 0xf5, 0x5c, 0xa0, 0x7e, 0x2a, 0xa1, 0x47, 0x60, 0x3d, 0x89, 0x6a, 0x9a, 0x25, 0x53, 0xb3,
-	0x70, 0x33, 0x8c, 0xe5, 0x2f, 0xa5, 0xf8, 0x2c, 0xb5, 0x86, 0xa9, 0xe1, 0x16, 0xd6, 0x0d, 0x2d,
-	0x5a, 0x66, 0x37, 0x1e, 0xa8, 0x18, 0xe0, 0x45, 0xad, 0x84, 0xcd, 0xb2, 0x56, 0x32, 0x65, 0x6f,
-	0x8c, 0x0a, 0xb6, 0xe2, 0x55, 0x4d, 0xf6, 0xd1, 0x16, 0x5c, 0xcf, 0x00, 0xc4, 0xf2, 0x65, 0x18,
-	0xd7, 0xa1, 0x57, 0xb5, 0x86, 0xa9, 0x9b, 0xaf, 0xc6, 0xd3, 0xe2, 0x34, 0x13, 0x10, 0x4b, 0xaa,
-	0xaf, 0x64, 0x02, 0x2a, 0x58, 0x0b, 0x66, 0xac, 0x57, 0x5b, 0xf2, 0x1b, 0x99, 0x80, 0x76, 0xab,
-	0x2a, 0x00, 0x67, 0xf1, 0xf5, 0x0c, 0x01, 0x75, 0xdd, 0x30, 0x03, 0xb6, 0x21, 0xbf, 0x89, 0xd6,
-	0xa0, 0x98, 0xe9, 0x42, 0x20, 0xfd, 0xd5, 0x4c, 0xf5, 0x7c, 0x01, 0x03, 0xc0, 0xd7, 0xd0, 0x4d,
-	0x78, 0x64, 0x9c, 0x83, 0xc1, 0x51, 0x6f, 0x55, 0xea, 0xba, 0xd6, 0x30, 0xe5, 0xaf, 0x67, 0x02,
-	0xb9, 0xa3, 0x71, 0xe0, 0x37, 0xd0, 0x63, 0x51, 0xbe, 0x24, 0x1d, 0x8e, 0xc1, 0x0c, 0xf9, 0x2d,
-	0x74, 0x03, 0x36, 0x33, 0x1d, 0x8f, 0x6b, 0xfb, 0xa6, 0x84, 0x6e, 0x65, 0xd8, 0xe5, 0x33, 0x88,
-	0x23, 0xdf, 0x96, 0xd0, 0x0a, 0x20, 0x81, 0xac, 0x6a, 0xe5, 0x76, 0xcd, 0xaa, 0xb6, 0xf7, 0x5b,
-	0xf2, 0xb7, 0x24, 0x74, 0x3d, 0x0a, 0x51, 0x5d, 0xaf, 0x68, 0x8d, 0x78, 0x2a, 0x7d, 0x3b, 0x93,
-	0x1d, 0xa6, 0xc9, 0x77, 0x24, 0xb4, 0x19, 0x85, 0x30, 0x94, 0xae, 0x56, 0x2d, 0x4e, 0x93, 0xbf,
-	0x9b, 0x48, 0x69, 0x81, 0xe0, 0x91, 0x11, 0xa0, 0xef, 0x65, 0x82, 0xf8, 0x34, 0x04, 0xe8, 0xfb,
-	0x12, 0x52, 0xa3, 0x9c, 0x14, 0x20, 0x1a, 0x3a, 0x4e, 0x34, 0xe4, 0x1f, 0x48, 0x48, 0x89, 0x36,
-	0x3f, 0xbe, 0x50, 0x86, 0x56, 0xc1, 0x9a, 0x29, 0xff, 0x4c, 0x42, 0xab, 0xd1, 0x96, 0x49, 0xe5,
-	0x18, 0xc7, 0x90, 0xdf, 0x91, 0x10, 0x82, 0x2b, 0x6c, 0xc4, 0xcd, 0xca, 0x3f, 0x97, 0xd0, 0x02,
-	0xcc, 0x71, 0x9a, 0xde, 0x30, 0x5a, 0x5a, 0xc5, 0x94, 0x7f, 0x91, 0x0a, 0x23, 0x75, 0xb0, 0x54,
-	0xaf, 0xcb, 0x3f, 0x94, 0xd0, 0x1c, 0xe4, 0xb1, 0xd6, 0x6a, 0x5a, 0x58, 0x2b, 0x55, 0xe5, 0xf7,
-	0x24, 0x34, 0x0f, 0x40, 0xc7, 0xf7, 0xb0, 0x6e, 0x6a, 0xf2, 0x6f, 0xa8, 0x75, 0x4a, 0x48, 0x6f,
-	0xe5, 0xbf, 0x95, 0x90, 0x0c, 0x05, 0xca, 0xe2, 0xb6, 0x7f, 0x27, 0xa1, 0x22, 0x2c, 0x50, 0x0a,
-	0xb7, 0x6c, 0x55, 0x9a, 0xfb, 0xfb, 0xba, 0x29, 0xbf, 0x2f, 0xa1, 0x25, 0x90, 0x29, 0x87, 0xcd,
-	0x9c, 0x91, 0x7f, 0x4f, 0xfd, 0x8a, 0xa9, 0x10, 0x8c, 0x3f, 0x44, 0x0c, 0x1e, 0x8d, 0x32, 0x2e,
-	0x35, 0x2a, 0x2f, 0xca, 0x7f, 0x4c, 0x29, 0xe2, 0xe4, 0x0f, 0x46, 0x14, 0x71, 0xc6, 0x9f, 0x24,
-	0xb4, 0x0c, 0x57, 0x13, 0x2e, 0xed, 0xe9, 0x75, 0x4d, 0xfe, 0x33, 0x0d, 0x53, 0xa4, 0x87, 0x12,
-	0xff, 0x42, 0xb3, 0x86, 0x12, 0x83, 0x5c, 0x68, 0xe9, 0x2d, 0xad, 0xae, 0x37, 0x34, 0x1a, 0x1a,
-	0x0d, 0xcb, 0x7f, 0xa5, 0x59, 0xc3, 0x83, 0xb5, 0xdf, 0xbc, 0xab, 0x8d, 0x20, 0xfe, 0x36, 0x46,
-	0x01, 0x8d, 0x25, 0x96, 0xff, 0x4e, 0x9d, 0x09, 0xa9, 0xd4, 0xf0, 0x4b, 0xcd, 0xb2, 0xfc, 0xee,
-	0xc4, 0xed, 0x17, 0x60, 0x36, 0xfe, 0x04, 0x11, 0x9c, 0x85, 0x58, 0x33, 0x9a, 0x6d, 0x5c, 0xd1,
-	0x2c, 0xf3, 0xd5, 0x96, 0x66, 0x45, 0xa7, 0x6b, 0x01, 0x66, 0x44, 0x6e, 0x49, 0x28, 0x07, 0x97,
-	0x03, 0x73, 0xf2, 0xc4, 0xee, 0xbf, 0xe7, 0x60, 0xb2, 0xd4, 0xd2, 0xd1, 0x73, 0x90, 0x13, 0x4f,
-	0xd4, 0x68, 0x89, 0x5d, 0x3f, 0x52, 0x8f, 0xdc, 0xca, 0x72, 0x9a, 0xcc, 0x2f, 0x06, 0x97, 0x50,
-	0x09, 0x20, 0x7a, 0x97, 0x46, 0x2b, 0x0c, 0x37, 0xf2, 0x7c, 0xad, 0x14, 0x47, 0x19, 0xa1, 0x0a,
-	0x83, 0x5e, 0xd8, 0x12, 0x6f, 0x8d, 0xe8, 0x3a, 0x7f, 0x9b, 0xc8, 0x7e, 0xd6, 0x54, 0xd6, 0xc7,
-	0xb1, 0xe3, 0x4a, 0x8d, 0x31, 0x4a, 0x8d, 0x8b, 0x95, 0x1a, 0xe3, 0x95, 0xd6, 0x60, 0x36, 0xfe,
-	0xc8, 0x87, 0x56, 0x79, 0x58, 0x46, 0x1f, 0x16, 0x15, 0x25, 0x8b, 0x15, 0x2a, 0xfa, 0x1c, 0xe4,
-	0xc3, 0x87, 0x0a, 0xb4, 0x1c, 0x41, 0xe3, 0xcf, 0x25, 0xca, 0xca, 0x08, 0x3d, 0x94, 0xdf, 0x87,
-	0xb9, 0x64, 0x17, 0x8e, 0xae, 0x85, 0x11, 0x19, 0x7d, 0x4f, 0x50, 0xd6, 0xb2, 0x99, 0xa1, 0x3a,
-	0x02, 0xca, 0xf8, 0x37, 0x04, 0x74, 0x33, 0x4b, 0x3a, 0xe3, 0xb6, 0xff, 0xa1, 0x66, 0x9e, 0x86,
-	0x69, 0xf6, 0xb4, 0x89, 0x16, 0x18, 0x32, 0xf1, 0xf4, 0xa9, 0x2c, 0x26, 0x89, 0xa1, 0xd8, 0x5d,
-	0xb8, 0x3a, 0xd2, 0x92, 0x23, 0xbe, 0x58, 0xe3, 0xde, 0x09, 0x94, 0x8d, 0xb1, 0xfc, 0x54, 0x10,
-	0xe3, 0x4a, 0xa3, 0x20, 0x66, 0x68, 0x5c, 0xcb, 0x66, 0xc6, 0x93, 0x23, 0xde, 0x17, 0x8b, 0xe4,
-	0xc8, 0x68, 0xa1, 0x45, 0x72, 0x64, 0xb5, 0xd1, 0xea, 0x25, 0xf4, 0x12, 0x5c, 0x49, 0xb4, 0xaf,
-	0x48, 0x89, 0x59, 0x4e, 0x35, 0xc7, 0xca, 0xb5, 0x4c, 0x5e, 0xa8, 0xab, 0x05, 0xf3, 0xa9, 0xcb,
-	0x3d, 0x5a, 0x13, 0x2f, 0x13, 0x59, 0x2d, 0xaf, 0x72, 0x7d, 0x0c, 0x37, 0xd4, 0x78, 0x34, 0xd2,
-	0xfd, 0x8a, 0x76, 0x01, 0x3d, 0x9a, 0x29, 0x9b, 0xea, 0x45, 0x94, 0x1b, 0x1f, 0x82, 0x4a, 0x95,
-	0x70, 0xa2, 0xfb, 0x8d, 0x95, 0x70, 0x56, 0x93, 0x1d, 0x2b, 0xe1, 0xec, 0xa6, 0x99, 0x06, 0x37,
-	0xd1, 0xde, 0x8a, 0xe0, 0x66, 0xf5, 0xd2, 0x22, 0xb8, 0xd9, 0xfd, 0x30, 0xad, 0xe2, 0xb0, 0x7f,
-	0x15, 0x55, 0x9c, 0x6e, 0x91, 0x45, 0x15, 0x8f, 0x34, 0xba, 0x34, 0xb1, 0x97, 0x32, 0xbb, 0x67,
-	0xa4, 0xa6, 0x64, 0xb2, 0x8a, 0xed, 0x02, 0xbd, 0xcf, 0x41, 0x4e, 0x74, 0xc0, 0x62, 0x43, 0x4f,
-	0xb5, 0xce, 0xca, 0x72, 0x9a, 0x1c, 0xaf, 0xb6, 0x91, 0x86, 0x57, 0x54, 0xdb, 0xb8, 0x2e, 0x59,
-	0x54, 0xdb, 0xd8, 0x4e, 0x99, 0xad, 0x66, 0xba, 0x81, 0x45, 0x61, 0xb2, 0x65, 0xb6, 0xc6, 0x62,
-	0x35, 0xc7, 0xf6, 0xbd, 0x34, 0x19, 0xc7, 0xb4, 0x9d, 0x22, 0x19, 0x2f, 0xee, 0x5b, 0x45, 0x32,
-	0x7e, 0x48, 0xef, 0xca, 0x0b, 0x29, 0xf9, 0x83, 0x69, 0x58, 0x48, 0x99, 0x3f, 0xc0, 0x86, 0x85,
-	0x94, 0xfd, 0x2b, 0xab, 0x7a, 0xa9, 0x7c, 0xe7, 0xbd, 0xf3, 0x75, 0xe9, 0x83, 0xf3, 0x75, 0xe9,
-	0x9f, 0xe7, 0xeb, 0xd2, 0x17, 0x6e, 0x1f, 0xda, 0xfe, 0xd1, 0xf0, 0x60, 0xbb, 0xeb, 0x9c, 0xec,
-	0x0c, 0x3a, 0xdd, 0xa3, 0xb3, 0x1e, 0x71, 0xe3, 0x5f, 0xa7, 0xbb, 0x3b, 0x9e, 0xdb, 0xa5, 0x3f,
-	0x64, 0x1f, 0x4c, 0xd3, 0x1f, 0x76, 0x3e, 0xf5, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x21, 0x45,
-	0x32, 0x61, 0xdc, 0x1e, 0x00, 0x00,
+	// 2711 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x59, 0x73, 0xe3, 0xc6,
+	0xf1, 0x37, 0xa4, 0x95, 0x44, 0x35, 0x75, 0x60, 0x47, 0x12, 0x45, 0x41, 0x37, 0xd6, 0xeb, 0x5d,
+	0xaf, 0xfd, 0x97, 0xfc, 0x57, 0x62, 0x67, 0x63, 0xbb, 0x52, 0xe1, 0x31, 0xa2, 0xe1, 0xe5, 0x95,
+	0x01, 0xa8, 0xb5, 0xf3, 0x10, 0x86, 0x22, 0x67, 0x25, 0xc4, 0x12, 0x21, 0x03, 0xa0, 0x62, 0x39,
+	0x87, 0xe3, 0xca, 0x7d, 0x3b, 0x77, 0xf2, 0x98, 0x0f, 0xe0, 0x97, 0x7c, 0x0a, 0xe7, 0x76, 0xce,
+	0xc7, 0x4d, 0x4a, 0x1f, 0x21, 0xcf, 0x49, 0x55, 0x0a, 0x83, 0x01, 0x30, 0x00, 0xc1, 0xf5, 0x91,
+	0xe4, 0x45, 0xc2, 0xf4, 0xef, 0x37, 0xdd, 0x3d, 0x3d, 0xdd, 0x73, 0x11, 0xe6, 0x3b, 0x03, 0xf7,
+	0x78, 0xd7, 0xfb, 0xb3, 0x73, 0x66, 0x5b, 0xae, 0x85, 0xae, 0x78, 0xdf, 0xca, 0xe2, 0x91, 0x75,
+	0x64, 0x31, 0xc1, 0xae, 0xf7, 0xe5, 0x63, 0xca, 0xe6, 0x91, 0x65, 0x1d, 0x9d, 0xd0, 0x5d, 0xd6,
+	0x3a, 0x1c, 0xdc, 0xdb, 0x75, 0xcd, 0x53, 0xea, 0xb8, 0x9d, 0xd3, 0x33, 0x9f, 0xa0, 0x3e, 0x01,
+	0xf3, 0x85, 0xae, 0x6b, 0x9e, 0x77, 0x5c, 0x4a, 0xe8, 0xcb, 0x03, 0xea, 0xb8, 0x68, 0x1d, 0xc0,
+	0xb6, 0x2c, 0xb7, 0xed, 0x5a, 0x2f, 0xd1, 0x7e, 0x5e, 0xda, 0x92, 0x6e, 0x4e, 0x93, 0x69, 0x4f,
+	0x62, 0x78, 0x02, 0xf5, 0xff, 0x41, 0x8e, 0x7a, 0x38, 0x67, 0x56, 0xdf, 0xa1, 0x5e, 0x97, 0xb3,
+	0x4e, 0xf7, 0x38, 0xde, 0xc5, 0x93, 0xf8, 0x5d, 0x16, 0xe0, 0x6a, 0x99, 0x76, 0xe2, 0x66, 0xd4,
+	0x45, 0x40, 0xa2, 0xd0, 0xd7, 0xa4, 0x7e, 0x08, 0x72, 0xc4, 0x72, 0x3d, 0x49, 0x60, 0xf0, 0x5d,
+	0xba, 0x75, 0x1b, 0x96, 0x87, 0x3a, 0x46, 0xde, 0x3d, 0xa8, 0xe7, 0xcf, 0xc7, 0x00, 0x1a, 0x5a,
+	0xb9, 0x54, 0xb2, 0xfa, 0xf7, 0xcc, 0x23, 0x94, 0x83, 0x49, 0xd3, 0x71, 0x06, 0xd4, 0xe6, 0x4c,
+	0xde, 0x42, 0x8f, 0xc2, 0x74, 0xf7, 0xc4, 0xa4, 0x7d, 0xb7, 0x6d, 0xf6, 0xf2, 0x63, 0x1e, 0x54,
+	0x9c, 0xb9, 0xbc, 0xbf, 0x99, 0x29, 0x31, 0xa1, 0x56, 0x26, 0x19, 0x1f, 0xd6, 0x7a, 0xe8, 0x1a,
+	0xcc, 0x72, 0xaa, 0x43, 0xbb, 0x36, 0x75, 0xf3, 0xe3, 0x4c, 0xd3, 0x8c, 0x2f, 0xd4, 0x99, 0x0c,
+	0xed, 0xc1, 0x8c, 0x4d, 0x7b, 0xa6, 0x4d, 0xbb, 0x6e, 0x7b, 0x60, 0x9b, 0xf9, 0x2b, 0x4c, 0xe5,
+	0xfc, 0xe5, 0xfd, 0xcd, 0x2c, 0xe1, 0xf2, 0x16, 0xd1, 0x48, 0x36, 0x20, 0xb5, 0x6c, 0xd3, 0xf3,
+	0xcd, 0xe9, 0x5a, 0x67, 0xd4, 0xc9, 0x4f, 0x6c, 0x8d, 0x7b, 0xbe, 0xf9, 0x2d, 0xf4, 0x41, 0xc8,
+	0xd9, 0xf4, 0xe5, 0x81, 0x69, 0xd3, 0x36, 0x3d, 0xed, 0x98, 0x27, 0xed, 0x73, 0x6a, 0x9b, 0xf7,
+	0x4c, 0xda, 0xcb, 0x4f, 0x6e, 0x49, 0x37, 0x33, 0x64, 0x91, 0xa3, 0xd8, 0x03, 0x0f, 0x38, 0x86,
+	0x1e, 0x05, 0xf9, 0xc4, 0xea, 0x76, 0x4e, 0x8e, 0x2d, 0xc7, 0x6d, 0xf3, 0x31, 0x4f, 0x31, 0xfe,
+	0x7c, 0x28, 0xd7, 0x98, 0x58, 0x5d, 0x81, 0xe5, 0x0a, 0x75, 0xfd, 0x08, 0x0d, 0xec, 0x8e, 0x6b,
+	0x5a, 0xc1, 0xbc, 0xa8, 0x04, 0xf2, 0xc3, 0x10, 0x8f, 0xfc, 0x53, 0x30, 0xdb, 0x15, 0x01, 0x16,
+	0xd2, 0xec, 0x9e, 0xbc, 0xc3, 0xd2, 0x37, 0x0a, 0x3a, 0x89, 0xd3, 0xd4, 0x8f, 0xc1, 0xb2, 0x9e,
+	0x6e, 0xee, 0x7d, 0xab, 0x54, 0x20, 0xaf, 0x8f, 0x70, 0x53, 0xfd, 0x85, 0x04, 0xd3, 0x2c, 0x17,
+	0xb4, 0xfe, 0x3d, 0x0b, 0xe5, 0x61, 0xca, 0x19, 0x1c, 0x7e, 0x8a, 0x76, 0x5d, 0x9e, 0x01, 0x41,
+	0x13, 0xe9, 0x00, 0xf4, 0x95, 0x33, 0x93, 0x1b, 0x1e, 0x63, 0x86, 0x95, 0x1d, 0xbf, 0xc4, 0x76,
+	0x82, 0x12, 0xdb, 0x31, 0x82, 0x12, 0x2b, 0x2e, 0xff, 0xe3, 0xfe, 0xe6, 0x7c, 0xef, 0xf0, 0x69,
+	0x35, 0xea, 0xa5, 0xbe, 0xf1, 0xb7, 0x4d, 0x89, 0x08, 0x6a, 0xd0, 0x53, 0x30, 0x73, 0xdc, 0x71,
+	0x8e, 0x69, 0x8f, 0xe7, 0x27, 0xcb, 0x95, 0xe2, 0x42, 0xd0, 0x95, 0x09, 0xdb, 0x1e, 0x43, 0x25,
+	0x59, 0x9f, 0xe8, 0xa7, 0xed, 0x27, 0x60, 0xa1, 0x30, 0x70, 0x8f, 0x69, 0xdf, 0x35, 0xbb, 0x42,
+	0xf5, 0x3e, 0x0e, 0x60, 0x99, 0xbd, 0x6e, 0xdb, 0xf1, 0x6a, 0xc1, 0x1f, 0x40, 0x71, 0xf6, 0xf2,
+	0xfe, 0xe6, 0xb4, 0x17, 0x1a, 0xdd, 0x13, 0x92, 0x69, 0x8f, 0xc0, 0x3e, 0xd1, 0x0a, 0x64, 0xcc,
+	0xc0, 0xf0, 0x98, 0x3f, 0x58, 0x93, 0xeb, 0x7f, 0x12, 0x16, 0xe3, 0xfa, 0xdf, 0x5d, 0xad, 0xcf,
+	0xc3, 0xec, 0xdd, 0x63, 0xab, 0x70, 0xaa, 0x05, 0xf9, 0xf1, 0xba, 0x04, 0x73, 0x81, 0x84, 0xab,
+	0x50, 0x20, 0x33, 0x70, 0xa8, 0xdd, 0xef, 0x9c, 0x72, 0x0f, 0x49, 0xd8, 0xfe, 0x9f, 0xc4, 0x58,
+	0xb5, 0x60, 0x82, 0x58, 0x27, 0xd4, 0x41, 0x8f, 0xc3, 0x84, 0xed, 0x7d, 0xe4, 0xa5, 0xad, 0xf1,
+	0x9b, 0xd9, 0xbd, 0x9c, 0x9f, 0x35, 0x0c, 0xf3, 0xff, 0xe2, 0xbe, 0x6b, 0x5f, 0x10, 0x9f, 0xa4,
+	0xdc, 0x06, 0x88, 0x84, 0x48, 0x86, 0xf1, 0x97, 0xe8, 0x05, 0x77, 0xd8, 0xfb, 0x44, 0x8b, 0x30,
+	0x71, 0xde, 0x39, 0x19, 0x50, 0xe6, 0x66, 0x86, 0xf8, 0x8d, 0xa7, 0xc7, 0x6e, 0x4b, 0xea, 0x1b,
+	0x12, 0x64, 0xbd, 0xae, 0x45, 0xb3, 0xdf, 0x33, 0xfb, 0x47, 0xe8, 0x36, 0x4c, 0xd1, 0xbe, 0x6b,
+	0x9b, 0xa1, 0xe5, 0x8d, 0xc8, 0x32, 0xe7, 0xec, 0x60, 0x9f, 0xe0, 0x7b, 0x10, 0xd0, 0x95, 0x0a,
+	0xcc, 0x88, 0x40, 0x8a, 0x17, 0xdb, 0xa2, 0x17, 0xd9, 0xbd, 0xac, 0x30, 0x26, 0xd1, 0xa5, 0x7d,
+	0xc8, 0x10, 0xea, 0x58, 0x03, 0xbb, 0x4b, 0xd1, 0x23, 0x70, 0xc5, 0xbd, 0x38, 0xf3, 0x83, 0x3f,
+	0xb7, 0x87, 0x78, 0x0f, 0x8e, 0x1a, 0x17, 0x67, 0x94, 0x30, 0x1c, 0x21, 0xb8, 0xc2, 0x26, 0xc9,
+	0x4f, 0x0d, 0xf6, 0xad, 0xbe, 0x06, 0x13, 0x2d, 0x87, 0xda, 0x0e, 0xba, 0x0d, 0xd3, 0xc1, 0xac,
+	0x05, 0xa3, 0x52, 0x7c, 0x4d, 0x0c, 0xdf, 0x69, 0x05, 0xa0, 0x3f, 0xa2, 0x88, 0xac, 0x3c, 0x0b,
+	0x73, 0x71, 0xf0, 0x3d, 0xc5, 0x76, 0x00, 0x93, 0x15, 0xdb, 0x1a, 0x9c, 0x39, 0xe8, 0x09, 0x98,
+	0x3c, 0x62, 0x5f, 0xdc, 0x7c, 0xde, 0x37, 0xef, 0xa3, 0xfc, 0x9f, 0x6f, 0x9c, 0xf3, 0x94, 0x0f,
+	0x43, 0x56, 0x10, 0xbf, 0x27, 0xb3, 0x36, 0xc8, 0x5e, 0x3d, 0x58, 0xb6, 0xf9, 0x6a, 0x58, 0x6c,
+	0xb7, 0x20, 0x63, 0xf3, 0xa8, 0xf1, 0x75, 0x68, 0x2e, 0x1e, 0x4b, 0x12, 0xe2, 0x68, 0x0f, 0xb2,
+	0x67, 0xd4, 0x3e, 0x35, 0x1d, 0xc7, 0xb4, 0xfa, 0x4e, 0x7e, 0x6c, 0x6b, 0xfc, 0xe6, 0x5c, 0xb0,
+	0x6c, 0x35, 0x43, 0x80, 0x88, 0x24, 0xf5, 0x4d, 0x09, 0xae, 0x0a, 0x46, 0x79, 0xf9, 0x6c, 0x00,
+	0x74, 0x02, 0x61, 0x8f, 0xd9, 0xcd, 0x10, 0x41, 0x82, 0x76, 0x60, 0xda, 0xe9, 0xb8, 0xa6, 0xc3,
+	0x36, 0x80, 0x51, 0x76, 0x22, 0x0a, 0xba, 0x05, 0x53, 0x4c, 0xda, 0x3f, 0xca, 0x8f, 0x8f, 0x60,
+	0x07, 0x04, 0xb4, 0x06, 0xd3, 0x67, 0xb6, 0xd9, 0xef, 0x9a, 0x67, 0x9d, 0x13, 0x7f, 0xcb, 0x22,
+	0x91, 0x40, 0x2d, 0xc1, 0x52, 0x85, 0xba, 0x51, 0x3f, 0xe7, 0x7d, 0x04, 0x4a, 0x3d, 0x85, 0xed,
+	0xb8, 0x92, 0x7d, 0xcb, 0x6e, 0x06, 0x26, 0xde, 0x4f, 0xe4, 0x63, 0x3e, 0x8f, 0x25, 0x7d, 0x3e,
+	0x84, 0x5c, 0xd2, 0x67, 0x1e, 0xe7, 0xc4, 0x8c, 0x49, 0xef, 0x62, 0xc6, 0xbc, 0xfc, 0xf1, 0x17,
+	0x98, 0x31, 0xb6, 0x41, 0xfb, 0x0d, 0xf5, 0x55, 0xc8, 0xd7, 0xac, 0x9e, 0x79, 0xef, 0x42, 0xa8,
+	0xf7, 0xff, 0xfa, 0x48, 0x22, 0xdb, 0xe3, 0xa2, 0xed, 0x55, 0x58, 0x49, 0xb1, 0xcd, 0x77, 0x3e,
+	0x7f, 0xc2, 0xfe, 0x33, 0xaf, 0x54, 0x0c, 0xb9, 0xa4, 0x12, 0x1e, 0xc1, 0xc7, 0x60, 0xea, 0xd0,
+	0x17, 0x71, 0x25, 0x57, 0x87, 0x96, 0x3d, 0x12, 0x30, 0xd4, 0x4f, 0x42, 0x56, 0xa7, 0x2c, 0x8c,
+	0x6c, 0x1b, 0x5e, 0x84, 0x89, 0xbe, 0xd5, 0xef, 0x06, 0x3b, 0x84, 0xdf, 0xf0, 0xa4, 0xec, 0x84,
+	0xc3, 0x47, 0xef, 0x37, 0xd0, 0x75, 0x98, 0xeb, 0x5a, 0xfd, 0x73, 0x6a, 0x7b, 0xbd, 0xdb, 0xd4,
+	0xb6, 0xd9, 0x2e, 0x9a, 0x21, 0xb3, 0x91, 0x14, 0xdb, 0xb6, 0xba, 0x04, 0x0b, 0x15, 0xea, 0x7a,
+	0x1b, 0x61, 0xd5, 0x3a, 0x32, 0xc3, 0x13, 0xcc, 0x5d, 0x58, 0x8c, 0x8b, 0xb9, 0xf7, 0x8f, 0xc2,
+	0xf4, 0x89, 0x27, 0x68, 0x0f, 0xec, 0x93, 0xbc, 0x14, 0x9d, 0xf8, 0x18, 0xab, 0x45, 0xaa, 0x24,
+	0xc3, 0xe0, 0x96, 0xcd, 0x42, 0xef, 0x6f, 0xb8, 0xdc, 0x2d, 0xd6, 0x50, 0x2b, 0x4c, 0x31, 0xb1,
+	0x0e, 0x13, 0x47, 0x59, 0x36, 0x51, 0x87, 0x56, 0x70, 0xbe, 0xf0, 0x1b, 0x68, 0x05, 0xc6, 0x5d,
+	0xd7, 0x1f, 0xd8, 0x78, 0x71, 0xea, 0xf2, 0xfe, 0xe6, 0xb8, 0x61, 0x54, 0x89, 0x27, 0x53, 0xff,
+	0x0f, 0x96, 0x12, 0x8a, 0xb8, 0x8b, 0x8b, 0x30, 0x21, 0xee, 0xc3, 0x7e, 0x43, 0xdd, 0x81, 0x1c,
+	0xa1, 0xe7, 0xd6, 0x4b, 0xd4, 0x5b, 0x3b, 0x92, 0x96, 0x53, 0xf8, 0x2b, 0xb0, 0x3c, 0xc4, 0xe7,
+	0x09, 0x52, 0x63, 0x27, 0x31, 0x7f, 0xcd, 0xdc, 0xb7, 0x6c, 0x6f, 0xd9, 0x0e, 0x74, 0x3d, 0x68,
+	0x17, 0xcf, 0x85, 0x2b, 0xb3, 0x5f, 0x07, 0xbc, 0xc5, 0x4f, 0x61, 0x09, 0x75, 0xdc, 0xd4, 0x01,
+	0x2c, 0xfa, 0x89, 0x5a, 0xa3, 0xa7, 0x87, 0xd4, 0x76, 0x04, 0x9f, 0x59, 0xef, 0xc0, 0x67, 0xd6,
+	0xf0, 0x96, 0xee, 0x4e, 0xaf, 0xc7, 0xd5, 0x7b, 0x9f, 0x9e, 0x4d, 0x9b, 0x9e, 0x5a, 0xe7, 0x94,
+	0xe7, 0x3f, 0x6f, 0xa9, 0xcb, 0xb0, 0x94, 0xd0, 0xcb, 0x0d, 0x22, 0x90, 0x2b, 0x81, 0x33, 0x41,
+	0x2e, 0x3c, 0x0b, 0x6b, 0x15, 0xc1, 0xc1, 0xa1, 0x75, 0x27, 0x56, 0x81, 0x52, 0x72, 0x2d, 0x79,
+	0x0c, 0xae, 0x0a, 0x1a, 0xf9, 0x1c, 0xe5, 0x62, 0xbb, 0x54, 0x14, 0x8b, 0x1b, 0x30, 0x5f, 0xa1,
+	0x2e, 0xdb, 0x2b, 0x1f, 0x38, 0x54, 0xf5, 0x09, 0x90, 0x23, 0x22, 0x57, 0xba, 0x96, 0xdc, 0x7c,
+	0xa7, 0x85, 0x0d, 0xd6, 0x0b, 0x33, 0x7e, 0xc5, 0xb5, 0x3b, 0x5d, 0x37, 0x9c, 0xd1, 0x70, 0x84,
+	0x65, 0x58, 0x49, 0xc1, 0xb8, 0xda, 0x1b, 0x30, 0xc9, 0x52, 0x22, 0xd8, 0x51, 0xe7, 0xfd, 0x7a,
+	0x0d, 0x0f, 0xc7, 0x84, 0xc3, 0xea, 0x47, 0xbd, 0x94, 0x71, 0x5c, 0xcb, 0x1e, 0xce, 0xb1, 0xeb,
+	0x62, 0x8e, 0xa5, 0xa8, 0xe0, 0x49, 0xa7, 0x40, 0x7e, 0x58, 0x03, 0x9f, 0x99, 0x67, 0x61, 0x23,
+	0x91, 0x90, 0xef, 0x21, 0xf9, 0xd4, 0x6d, 0xd8, 0x1c, 0xd9, 0x9b, 0x1b, 0xd8, 0x82, 0x8d, 0x32,
+	0x3d, 0xa1, 0x2e, 0xc5, 0xde, 0x21, 0x91, 0xf6, 0x86, 0xc3, 0xb4, 0x0d, 0x9b, 0x23, 0x19, 0xbe,
+	0x92, 0x5b, 0xff, 0x9a, 0x07, 0x88, 0xf6, 0x01, 0x94, 0x85, 0xa9, 0x56, 0xfd, 0x4e, 0xbd, 0x71,
+	0xb7, 0x2e, 0x3f, 0x84, 0x56, 0x61, 0xb9, 0x54, 0x6d, 0xe9, 0x06, 0x26, 0xed, 0x5a, 0xa3, 0xac,
+	0xed, 0xbf, 0xd8, 0x2e, 0x6a, 0xf5, 0xb2, 0x56, 0xaf, 0xe8, 0x72, 0x0f, 0xe5, 0x61, 0x31, 0x00,
+	0x2b, 0xd8, 0x88, 0x10, 0xef, 0x3c, 0xbe, 0x14, 0x20, 0x85, 0x96, 0xf1, 0x5c, 0xbb, 0x50, 0x32,
+	0xb4, 0x83, 0x82, 0x81, 0xe5, 0x7b, 0xa2, 0x46, 0x06, 0x95, 0x71, 0x08, 0x1e, 0x0d, 0x81, 0x9e,
+	0xda, 0x52, 0xa3, 0xbe, 0xaf, 0x55, 0xe4, 0xe3, 0x21, 0x50, 0x8f, 0x40, 0x13, 0x6d, 0xc3, 0xda,
+	0x50, 0x4f, 0xd2, 0x28, 0x36, 0x8c, 0xb6, 0xd1, 0xb8, 0x83, 0xeb, 0xf2, 0x37, 0x25, 0x74, 0x1d,
+	0xb6, 0x63, 0x14, 0x3e, 0xa0, 0x0a, 0x69, 0xb4, 0x9a, 0xed, 0x1a, 0xae, 0x15, 0x31, 0xd1, 0xe5,
+	0xd3, 0x54, 0x1f, 0x18, 0x47, 0x97, 0xfb, 0x68, 0x0b, 0xd6, 0xd2, 0xc1, 0x76, 0x4b, 0xf7, 0xba,
+	0x5b, 0x68, 0x13, 0x56, 0x63, 0x0c, 0xfc, 0x82, 0x41, 0x0a, 0x25, 0xee, 0x86, 0x2e, 0x9f, 0xa1,
+	0x0d, 0x50, 0x62, 0x04, 0x82, 0x75, 0xa3, 0x41, 0x30, 0xf7, 0xf3, 0x65, 0xb4, 0x0b, 0xb7, 0x86,
+	0x4c, 0x34, 0x31, 0xa9, 0x69, 0xba, 0xae, 0x35, 0xea, 0x7a, 0x7b, 0xbf, 0x41, 0xda, 0x4d, 0xa2,
+	0xd5, 0x4b, 0x5a, 0xb3, 0x50, 0x95, 0xbf, 0x2d, 0xa1, 0x1b, 0xa0, 0x26, 0x22, 0x5a, 0xc5, 0x06,
+	0x6e, 0xe3, 0x17, 0x9a, 0x1a, 0xc1, 0xe5, 0xc0, 0xf0, 0xb7, 0x24, 0xf4, 0x30, 0x6c, 0x26, 0x2c,
+	0x1f, 0x34, 0xee, 0x60, 0xe6, 0x79, 0xc0, 0xfa, 0x8e, 0x84, 0xae, 0xc1, 0x46, 0x9c, 0xd5, 0x30,
+	0x0a, 0x06, 0x6e, 0x93, 0x46, 0x18, 0xcb, 0x1f, 0x48, 0xe2, 0x28, 0x71, 0xdd, 0xc0, 0xa4, 0x49,
+	0x34, 0x1d, 0x47, 0xd3, 0x6c, 0x8b, 0x81, 0x12, 0x08, 0xcf, 0xe1, 0x02, 0x31, 0x8a, 0xb8, 0x60,
+	0xc8, 0xce, 0x08, 0x15, 0xfe, 0x8c, 0x97, 0xb1, 0xec, 0xa2, 0x6d, 0x58, 0x4f, 0x21, 0x08, 0xf9,
+	0x32, 0x10, 0x75, 0x68, 0x65, 0x5c, 0x37, 0x34, 0xe3, 0x45, 0x31, 0x2d, 0xce, 0x53, 0x09, 0x42,
+	0x52, 0x7d, 0x3a, 0x95, 0x50, 0x22, 0xd8, 0x1b, 0xb1, 0x56, 0x6e, 0xca, 0xaf, 0xa4, 0x12, 0x5a,
+	0xcd, 0x72, 0x40, 0xb8, 0x10, 0xe7, 0x33, 0x24, 0x54, 0x35, 0xdd, 0xf0, 0x60, 0x5d, 0x7e, 0x15,
+	0xad, 0x41, 0x3e, 0xd5, 0x05, 0xaf, 0xf7, 0x67, 0x52, 0xd5, 0xf3, 0x09, 0xf4, 0x08, 0x9f, 0x45,
+	0x37, 0xe0, 0xda, 0x28, 0x07, 0xbd, 0xad, 0xbe, 0x5d, 0xaa, 0x6a, 0xb8, 0x6e, 0xc8, 0x9f, 0x4b,
+	0x25, 0x72, 0x47, 0x45, 0xe2, 0xe7, 0xd1, 0x23, 0xa0, 0x0e, 0x11, 0x99, 0xc3, 0x02, 0x4d, 0x97,
+	0x5f, 0x43, 0xd7, 0x61, 0x2b, 0xd5, 0x71, 0x51, 0xdb, 0x17, 0x24, 0x74, 0x13, 0xae, 0x8d, 0x1a,
+	0x81, 0xc8, 0x7c, 0x5d, 0x42, 0xcb, 0x80, 0x02, 0x66, 0x19, 0x17, 0x5b, 0x95, 0x76, 0xb9, 0x55,
+	0x6b, 0xca, 0x5f, 0x94, 0xd0, 0x7a, 0x14, 0xa2, 0xaa, 0x56, 0xc2, 0x75, 0x31, 0x95, 0xbe, 0x94,
+	0x0a, 0x87, 0x69, 0xf2, 0x65, 0x09, 0x6d, 0xc1, 0x6a, 0x12, 0x2e, 0x94, 0xcb, 0x6d, 0x2e, 0x93,
+	0xbf, 0x12, 0x4b, 0xe9, 0x80, 0xc1, 0x23, 0x13, 0x90, 0xbe, 0x9a, 0x4a, 0xe2, 0xc3, 0x08, 0x48,
+	0x5f, 0x93, 0x90, 0x0a, 0xeb, 0x49, 0x12, 0x0b, 0x1d, 0x17, 0xea, 0xf2, 0xd7, 0x25, 0xa4, 0x44,
+	0x8b, 0x1f, 0x9f, 0x28, 0x1d, 0x97, 0x08, 0x36, 0xe4, 0xef, 0x4a, 0x68, 0x25, 0x5a, 0x32, 0x59,
+	0x3f, 0x1f, 0xd1, 0xe5, 0x37, 0x24, 0x84, 0x60, 0xd6, 0x6f, 0x71, 0xb3, 0xf2, 0xf7, 0x24, 0xb4,
+	0x00, 0x73, 0x5c, 0xa6, 0xd5, 0xf5, 0x26, 0x2e, 0x19, 0xf2, 0xf7, 0xd3, 0xf4, 0x13, 0x5c, 0x6b,
+	0x18, 0x58, 0xfe, 0x61, 0x0c, 0xe3, 0xce, 0x73, 0xec, 0x47, 0x12, 0x5a, 0x85, 0x9c, 0xb8, 0x5c,
+	0xd7, 0xb4, 0x0a, 0x29, 0x18, 0xde, 0x92, 0x22, 0xff, 0x38, 0x16, 0x44, 0xd2, 0xa8, 0x56, 0x8b,
+	0x85, 0xd2, 0x1d, 0x91, 0xf1, 0x13, 0x09, 0xad, 0x45, 0xeb, 0x22, 0x77, 0xa6, 0xed, 0x2d, 0x5c,
+	0x85, 0x0a, 0x96, 0x7f, 0x1a, 0x33, 0x7c, 0x80, 0x89, 0xb7, 0xae, 0xd6, 0x34, 0x42, 0x1a, 0x44,
+	0xfe, 0x59, 0x62, 0xde, 0x99, 0x53, 0x85, 0x6a, 0x55, 0xfe, 0x86, 0x84, 0xe6, 0x60, 0x9a, 0xe0,
+	0x66, 0xa3, 0x4d, 0x70, 0xa1, 0x2c, 0xbf, 0x25, 0xa1, 0x79, 0x00, 0xd6, 0xbe, 0x4b, 0x34, 0x03,
+	0xcb, 0xbf, 0x64, 0xe1, 0x62, 0x82, 0xe4, 0xde, 0xf3, 0x2b, 0x09, 0xc9, 0x90, 0x65, 0x10, 0x0f,
+	0xd6, 0xaf, 0x25, 0x94, 0x87, 0x05, 0x26, 0x09, 0xbc, 0x2b, 0x35, 0x6a, 0x35, 0xcd, 0x90, 0x7f,
+	0x23, 0xa1, 0x25, 0x90, 0x19, 0xe2, 0x4f, 0x95, 0x2f, 0xfe, 0x2d, 0xf3, 0x4b, 0x50, 0x11, 0x00,
+	0xbf, 0x8b, 0x00, 0x1e, 0xde, 0x22, 0x29, 0xd4, 0x4b, 0xcf, 0xc9, 0xbf, 0x4f, 0x28, 0xe2, 0xe2,
+	0xb7, 0x87, 0x14, 0x71, 0xe0, 0x0f, 0x12, 0xca, 0xc1, 0xd5, 0x98, 0x4b, 0xfb, 0x5a, 0x15, 0xcb,
+	0x7f, 0x64, 0xf3, 0x1a, 0xe9, 0x61, 0xc2, 0x3f, 0xb1, 0x34, 0x67, 0x42, 0x2f, 0x79, 0x9b, 0x5a,
+	0x13, 0x57, 0xb5, 0x3a, 0x66, 0xa1, 0xc1, 0x44, 0xfe, 0x33, 0x9b, 0x21, 0x1e, 0xac, 0x5a, 0xe3,
+	0x00, 0x0f, 0x31, 0xfe, 0x32, 0x42, 0x01, 0x8b, 0x25, 0x91, 0xff, 0xca, 0x9c, 0x09, 0xa5, 0xcc,
+	0xf0, 0xf3, 0x8d, 0xa2, 0xfc, 0xe6, 0xd8, 0xad, 0x1a, 0xcc, 0x88, 0x6f, 0x26, 0xde, 0xe6, 0x4d,
+	0xb0, 0xde, 0x68, 0x91, 0x12, 0x6e, 0x1b, 0x2f, 0x36, 0x71, 0x3b, 0x3a, 0x0e, 0x64, 0x61, 0x2a,
+	0x28, 0x06, 0x09, 0x65, 0xe0, 0x8a, 0x67, 0x4e, 0x1e, 0xf3, 0xc4, 0x4d, 0xd2, 0x78, 0xde, 0xcb,
+	0xcf, 0xf1, 0xbd, 0x7f, 0xce, 0xc1, 0x78, 0xa1, 0xa9, 0xa1, 0x67, 0x20, 0x13, 0x3c, 0xb0, 0xa3,
+	0x25, 0xff, 0xf0, 0x94, 0x78, 0xa2, 0x57, 0x72, 0x49, 0x31, 0x3f, 0xd6, 0x3c, 0x84, 0x0a, 0x00,
+	0xd1, 0xab, 0x3a, 0x5a, 0xf6, 0x79, 0x43, 0x8f, 0xef, 0x4a, 0x7e, 0x18, 0x08, 0x55, 0xe8, 0xec,
+	0xb8, 0x19, 0x7b, 0x29, 0x45, 0xeb, 0x3e, 0x7f, 0xc4, 0x1b, 0xb0, 0xb2, 0x31, 0x0a, 0x16, 0x95,
+	0xea, 0x23, 0x94, 0xea, 0x0f, 0x56, 0xaa, 0x8f, 0x56, 0x5a, 0x81, 0x19, 0xf1, 0x89, 0x12, 0xad,
+	0xf0, 0xb0, 0x0c, 0x3f, 0x8b, 0x2a, 0x4a, 0x1a, 0x14, 0x2a, 0xfa, 0x08, 0x4c, 0x87, 0xcf, 0x2c,
+	0x28, 0x17, 0x51, 0xc5, 0xc7, 0x1e, 0x65, 0x79, 0x48, 0x1e, 0xf6, 0xaf, 0xc1, 0x5c, 0xfc, 0x0d,
+	0x01, 0xad, 0x86, 0x11, 0x19, 0x7e, 0x0d, 0x51, 0xd6, 0xd2, 0xc1, 0x50, 0x1d, 0x05, 0x65, 0xf4,
+	0x0b, 0x08, 0xba, 0x91, 0xd6, 0x3b, 0xe5, 0xae, 0xf2, 0x8e, 0x66, 0x9e, 0x84, 0x49, 0xff, 0x61,
+	0x16, 0x2d, 0xf8, 0xcc, 0xd8, 0xc3, 0xad, 0xb2, 0x18, 0x17, 0x86, 0xdd, 0x0e, 0xe0, 0xea, 0xd0,
+	0x83, 0x02, 0xe2, 0x93, 0x35, 0xea, 0x95, 0x43, 0xd9, 0x1c, 0x89, 0x27, 0x82, 0x28, 0x2a, 0x8d,
+	0x82, 0x98, 0xa2, 0x71, 0x2d, 0x1d, 0x14, 0x93, 0x43, 0xbc, 0xd5, 0x07, 0xc9, 0x91, 0xf2, 0x00,
+	0xa0, 0x28, 0x69, 0x50, 0xa8, 0xe8, 0x79, 0x98, 0x8d, 0x5d, 0xbe, 0x91, 0x22, 0x58, 0x4e, 0x5c,
+	0xed, 0x95, 0xd5, 0x54, 0x2c, 0xd4, 0xd5, 0x84, 0xf9, 0xc4, 0xd5, 0x04, 0xad, 0x05, 0xef, 0x2a,
+	0x69, 0x17, 0x76, 0x65, 0x7d, 0x04, 0x1a, 0x6a, 0x3c, 0x1e, 0xba, 0xbb, 0x07, 0x97, 0x1d, 0xf4,
+	0x70, 0x6a, 0xdf, 0xc4, 0x4d, 0x4a, 0xb9, 0xfe, 0x0e, 0xac, 0x44, 0x09, 0xc7, 0xee, 0xee, 0x42,
+	0x09, 0xa7, 0x3d, 0x11, 0x28, 0x1b, 0xa3, 0x60, 0x31, 0xb8, 0xb1, 0xcb, 0x79, 0x10, 0xdc, 0xb4,
+	0x97, 0x00, 0x65, 0x35, 0x15, 0x13, 0xab, 0x38, 0xbc, 0x7d, 0x07, 0x55, 0x9c, 0xbc, 0xe0, 0x2b,
+	0xcb, 0x43, 0x72, 0x21, 0xb1, 0x97, 0x52, 0xef, 0xfe, 0x48, 0x4d, 0xf4, 0x49, 0x2b, 0xb6, 0x07,
+	0xe8, 0x7d, 0x06, 0x32, 0xc1, 0xfd, 0x3d, 0x58, 0xd0, 0x13, 0x17, 0x7f, 0x25, 0x97, 0x14, 0x8b,
+	0xd5, 0x36, 0x74, 0x5d, 0x0f, 0xaa, 0x6d, 0xd4, 0x1d, 0x5f, 0xd9, 0x1c, 0x89, 0x8b, 0xb3, 0x99,
+	0xbc, 0x7e, 0xa3, 0x30, 0xd9, 0x52, 0x2f, 0xf6, 0xca, 0xc6, 0x28, 0x58, 0x4c, 0xc6, 0x11, 0x97,
+	0xe6, 0x20, 0x19, 0x1f, 0x7c, 0xeb, 0x56, 0xae, 0xbf, 0x03, 0x2b, 0x56, 0x48, 0xf1, 0x9f, 0x7b,
+	0xc3, 0x42, 0x4a, 0xfd, 0xf9, 0x58, 0x59, 0x1f, 0x81, 0x06, 0x1a, 0x8b, 0xb7, 0xdf, 0xba, 0xdc,
+	0x90, 0xde, 0xbe, 0xdc, 0x90, 0xfe, 0x7e, 0xb9, 0x21, 0x7d, 0xfc, 0xd6, 0x91, 0xe9, 0x1e, 0x0f,
+	0x0e, 0x77, 0xba, 0xd6, 0xe9, 0xae, 0xf7, 0xe3, 0xd6, 0x45, 0x8f, 0xda, 0xe2, 0xd7, 0xf9, 0xde,
+	0xae, 0x63, 0x77, 0xd9, 0xcf, 0xf0, 0x87, 0x93, 0xec, 0x67, 0xa9, 0x0f, 0xfc, 0x7b, 0x00, 0xbe,
+	0x99, 0x24, 0xfd, 0x9a, 0x1f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
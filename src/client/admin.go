@@ -14,3 +14,34 @@ func (c APIClient) InspectCluster() (*admin.ClusterInfo, error) {
 	}
 	return clusterInfo, nil
 }
+
+// MigrationStatus returns the applied and pending migrations of the cluster's
+// database.
+func (c APIClient) MigrationStatus() ([]*admin.Migration, error) {
+	resp, err := c.AdminAPIClient.MigrationStatus(c.Ctx(), &types.Empty{})
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return resp.Migrations, nil
+}
+
+// PlanMigrations returns the migrations that haven't been applied to the
+// cluster's database. If dryRun is true, they are also run in a transaction
+// that's rolled back, to check that they succeed.
+func (c APIClient) PlanMigrations(dryRun bool) ([]*admin.Migration, error) {
+	resp, err := c.AdminAPIClient.PlanMigrations(c.Ctx(), &admin.PlanMigrationsRequest{DryRun: dryRun})
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return resp.Pending, nil
+}
+
+// RollbackMigrations reverts the migrations applied to the cluster's database
+// after migration 'to', and returns the migrations that were reverted.
+func (c APIClient) RollbackMigrations(to int64) ([]*admin.Migration, error) {
+	resp, err := c.AdminAPIClient.RollbackMigrations(c.Ctx(), &admin.RollbackMigrationsRequest{To: to})
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return resp.RolledBack, nil
+}
@@ -67,3 +67,26 @@ func (c APIClient) ModifyRepoRoleBinding(repo, principal string, roles []string)
 	}
 	return nil
 }
+
+// GetProjectRoleBinding returns the role binding of a project, which applies
+// to all of the project's repos.
+func (c APIClient) GetProjectRoleBinding(project string) (*auth.RoleBinding, error) {
+	resp, err := c.GetRoleBinding(c.Ctx(), &auth.GetRoleBindingRequest{
+		Resource: &auth.Resource{Type: auth.ResourceType_PROJECT, Name: project},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Binding, nil
+}
+
+// ModifyProjectRoleBinding sets the roles that principal has on all of a
+// project's repos.
+func (c APIClient) ModifyProjectRoleBinding(project, principal string, roles []string) error {
+	_, err := c.ModifyRoleBinding(c.Ctx(), &auth.ModifyRoleBindingRequest{
+		Resource:  &auth.Resource{Type: auth.ResourceType_PROJECT, Name: project},
+		Principal: principal,
+		Roles:     roles,
+	})
+	return err
+}
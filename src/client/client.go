@@ -143,6 +143,12 @@ func (c *APIClient) GetAddress() *grpcutil.PachdAddress {
 	return c.addr
 }
 
+// ClientConn returns the grpc connection that 'c' uses to communicate with
+// pachd, for callers that need to invoke RPCs generically.
+func (c *APIClient) ClientConn() *grpc.ClientConn {
+	return c.clientConn
+}
+
 // DefaultMaxConcurrentStreams defines the max number of Putfiles or Getfiles happening simultaneously
 const DefaultMaxConcurrentStreams = 100
 
@@ -74,3 +74,28 @@ func TestInterceptors(t *testing.T) {
 		t.Errorf("stream call count:\n  got: %v\n want: %v", got, want)
 	}
 }
+
+func TestNewRepoProject(t *testing.T) {
+	repo := NewRepo("team/foo")
+	if got, want := repo.ProjectName(), "team"; got != want {
+		t.Errorf("project:\n  got: %v\n want: %v", got, want)
+	}
+	if got, want := repo.Name, "foo"; got != want {
+		t.Errorf("name:\n  got: %v\n want: %v", got, want)
+	}
+	if got, want := repo.QualifiedName(), "team/foo"; got != want {
+		t.Errorf("qualified name:\n  got: %v\n want: %v", got, want)
+	}
+
+	// Repos in the default project don't name it.
+	repo = NewRepo("foo")
+	if repo.Project != nil {
+		t.Errorf("project: got %v, want nil", repo.Project)
+	}
+	if got, want := repo.ProjectName(), pfs.DefaultProjectName; got != want {
+		t.Errorf("project:\n  got: %v\n want: %v", got, want)
+	}
+	if got, want := NewRepo("default/foo").QualifiedName(), "foo"; got != want {
+		t.Errorf("qualified name:\n  got: %v\n want: %v", got, want)
+	}
+}
@@ -36,12 +36,19 @@ func (c APIClient) Binary(filter *debug.Filter, w io.Writer) (retErr error) {
 
 // Dump collects a standard set of debugging information.
 func (c APIClient) Dump(filter *debug.Filter, limit int64, w io.Writer) (retErr error) {
+	return c.DumpSelected(filter, limit, nil, w)
+}
+
+// DumpSelected is like Dump, but only collects the debugging information
+// chosen by 'selector' (which may be nil, to collect everything).
+func (c APIClient) DumpSelected(filter *debug.Filter, limit int64, selector *debug.DumpSelector, w io.Writer) (retErr error) {
 	defer func() {
 		retErr = grpcutil.ScrubGRPC(retErr)
 	}()
 	dumpC, err := c.DebugClient.Dump(c.Ctx(), &debug.DumpRequest{
-		Filter: filter,
-		Limit:  limit,
+		Filter:   filter,
+		Limit:    limit,
+		Selector: selector,
 	})
 	if err != nil {
 		return err
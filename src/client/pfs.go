@@ -8,17 +8,32 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pagination"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
-// NewRepo creates a pfs.Repo.
+// NewProject creates a pfs.Project.
+func NewProject(projectName string) *pfs.Project {
+	return &pfs.Project{Name: projectName}
+}
+
+// NewRepo creates a pfs.Repo. repoName may be qualified with the repo's
+// project, as in "project/repo".
 func NewRepo(repoName string) *pfs.Repo {
-	return &pfs.Repo{Name: repoName, Type: pfs.UserRepoType}
+	return NewSystemRepo(repoName, pfs.UserRepoType)
 }
 
 // NewSystemRepo creates a pfs.Repo of the given type
 func NewSystemRepo(repoName string, repoType string) *pfs.Repo {
-	return &pfs.Repo{Name: repoName, Type: repoType}
+	project, name := pfs.SplitProject(repoName)
+	repo := &pfs.Repo{Name: name, Type: repoType}
+	if project != "" {
+		repo.Project = NewProject(project)
+	}
+	return repo
 }
 
 // NewBranch creates a pfs.Branch
@@ -130,6 +145,70 @@ func (c APIClient) DeleteRepo(repoName string, force bool) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// CreateProject creates a new project, which is a namespace for repos and
+// pipelines.
+func (c APIClient) CreateProject(projectName string) error {
+	_, err := c.PfsAPIClient.CreateProject(
+		c.Ctx(),
+		&pfs.CreateProjectRequest{
+			Project: NewProject(projectName),
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// InspectProject returns info about a specific project.
+func (c APIClient) InspectProject(projectName string) (*pfs.ProjectInfo, error) {
+	resp, err := c.PfsAPIClient.InspectProject(
+		c.Ctx(),
+		&pfs.InspectProjectRequest{
+			Project: NewProject(projectName),
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return resp, nil
+}
+
+// ListProject returns info about all projects.
+func (c APIClient) ListProject() ([]*pfs.ProjectInfo, error) {
+	resp, err := c.PfsAPIClient.ListProject(
+		c.Ctx(),
+		&pfs.ListProjectRequest{},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return resp.ProjectInfo, nil
+}
+
+// ListProjectRepo returns info about the user repos in a project.
+func (c APIClient) ListProjectRepo(projectName string) ([]*pfs.RepoInfo, error) {
+	resp, err := c.PfsAPIClient.ListRepo(
+		c.Ctx(),
+		&pfs.ListRepoRequest{
+			Type:    pfs.UserRepoType,
+			Project: NewProject(projectName),
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return resp.RepoInfo, nil
+}
+
+// DeleteProject deletes a project, which must not contain any repos.
+func (c APIClient) DeleteProject(projectName string) error {
+	_, err := c.PfsAPIClient.DeleteProject(
+		c.Ctx(),
+		&pfs.DeleteProjectRequest{
+			Project: NewProject(projectName),
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // StartCommit begins the process of committing data to a Repo. Once started
 // you can write to the Commit with PutFile and when all the data has been
 // written you must finish the Commit with FinishCommit. NOTE, data is not
@@ -282,6 +361,43 @@ func (c APIClient) ListCommitF(repoName string, toBranchName string, to string,
 	return nil
 }
 
+// ListCommitPage lists at most pageSize commits in a repo, in commit key
+// order, starting after the page that pageToken is the next page token of. It
+// returns the token of the next page, or "" if there are no more commits or f
+// ended the listing by returning errutil.ErrBreak.
+func (c APIClient) ListCommitPage(repoName string, pageSize int64, pageToken string, f func(*pfs.CommitInfo) error) (_ string, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	var trailer metadata.MD
+	stream, err := c.PfsAPIClient.ListCommit(
+		c.Ctx(),
+		&pfs.ListCommitRequest{
+			Repo:      NewRepo(repoName),
+			PageSize:  pageSize,
+			PageToken: pageToken,
+		},
+		grpc.Trailer(&trailer),
+	)
+	if err != nil {
+		return "", err
+	}
+	for {
+		ci, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return pagination.NextPageTokenFromTrailer(trailer), nil
+		} else if err != nil {
+			return "", err
+		}
+		if err := f(ci); err != nil {
+			if errors.Is(err, errutil.ErrBreak) {
+				return "", nil
+			}
+			return "", err
+		}
+	}
+}
+
 // ListCommitByRepo lists all commits in a repo.
 func (c APIClient) ListCommitByRepo(repoName string) ([]*pfs.CommitInfo, error) {
 	return c.ListCommit(repoName, "", "", "", "", 0)
@@ -362,6 +478,94 @@ func (c APIClient) DeleteBranch(repoName string, branchName string, force bool)
 	return grpcutil.ScrubGRPC(err)
 }
 
+// MergeBranch merges the changes made on the source branch since its common
+// ancestor with the target branch into the target branch. Conflicting paths
+// are resolved with 'resolutions', or 'strategy' if they aren't in
+// 'resolutions'. If a conflict is unresolved, no commit is made and the
+// response lists the conflicts.
+func (c APIClient) MergeBranch(repoName, source, target string, strategy pfs.MergeStrategy, resolutions ...*pfs.MergeResolution) (*pfs.MergeBranchResponse, error) {
+	resp, err := c.PfsAPIClient.MergeBranch(
+		c.Ctx(),
+		&pfs.MergeBranchRequest{
+			Source:      NewBranch(repoName, source),
+			Target:      NewBranch(repoName, target),
+			Strategy:    strategy,
+			Resolutions: resolutions,
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return resp, nil
+}
+
+// CreateRemote creates a remote pachd named 'name' at 'pachdAddress', which
+// branches can be pushed to and pulled from. If 'update' is true, an existing
+// remote with the same name is replaced.
+func (c APIClient) CreateRemote(name, pachdAddress string, update bool) error {
+	_, err := c.PfsAPIClient.CreateRemote(
+		c.Ctx(),
+		&pfs.CreateRemoteRequest{
+			Remote: &pfs.Remote{Name: name, PachdAddress: pachdAddress},
+			Update: update,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// ListRemote returns the remote pachds.
+func (c APIClient) ListRemote() ([]*pfs.Remote, error) {
+	resp, err := c.PfsAPIClient.ListRemote(c.Ctx(), &pfs.ListRemoteRequest{})
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return resp.Remotes, nil
+}
+
+// DeleteRemote deletes a remote pachd.
+func (c APIClient) DeleteRemote(name string) error {
+	_, err := c.PfsAPIClient.DeleteRemote(c.Ctx(), &pfs.DeleteRemoteRequest{Name: name})
+	return grpcutil.ScrubGRPC(err)
+}
+
+// PushBranch copies the commits on a branch that the remote doesn't have to
+// 'remoteBranch' in the same repo on the remote, or to the branch with the
+// same name if 'remoteBranch' is empty. The remote's branch must not have
+// commits that aren't on the local branch.
+func (c APIClient) PushBranch(repoName, branchName, remote, remoteBranch string) (*pfs.TransferStats, error) {
+	request := &pfs.PushBranchRequest{
+		Branch: NewBranch(repoName, branchName),
+		Remote: remote,
+	}
+	if remoteBranch != "" {
+		request.RemoteBranch = NewBranch(repoName, remoteBranch)
+	}
+	resp, err := c.PfsAPIClient.PushBranch(c.Ctx(), request)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return resp, nil
+}
+
+// PullBranch copies the commits on 'remoteBranch' in the same repo on the
+// remote, or on the branch with the same name if 'remoteBranch' is empty, that
+// this pachd doesn't have to a local branch. The local branch must not have
+// commits that aren't on the remote's branch.
+func (c APIClient) PullBranch(repoName, branchName, remote, remoteBranch string) (*pfs.TransferStats, error) {
+	request := &pfs.PullBranchRequest{
+		Branch: NewBranch(repoName, branchName),
+		Remote: remote,
+	}
+	if remoteBranch != "" {
+		request.RemoteBranch = NewBranch(repoName, remoteBranch)
+	}
+	resp, err := c.PfsAPIClient.PullBranch(c.Ctx(), request)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return resp, nil
+}
+
 // SquashCommit deletes a commit.
 func (c APIClient) SquashCommit(repoName string, branchName string, commitID string) error {
 	_, err := c.PfsAPIClient.SquashCommit(
@@ -542,6 +746,57 @@ func (c APIClient) FsckFastExit() error {
 	}
 }
 
+// InspectStorage returns information about how PFS is using the object store.
+func (c APIClient) InspectStorage() (_ *pfs.StorageInfo, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	return c.PfsAPIClient.InspectStorage(c.Ctx(), &pfs.InspectStorageRequest{})
+}
+
+// RehydrateCommit moves the chunks of a commit out of the cold storage tier,
+// and returns the number of chunks that it moved.
+func (c APIClient) RehydrateCommit(repoName string, branchName string, commitID string) (_ int64, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	resp, err := c.PfsAPIClient.RehydrateCommit(
+		c.Ctx(),
+		&pfs.RehydrateCommitRequest{
+			Commit: NewCommit(repoName, branchName, commitID),
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return resp.ChunksMoved, nil
+}
+
+// VerifyMirror compares the chunks in the primary object store with those in
+// its mirror, and calls cb with each chunk that's only in one of them. If
+// repair is true, the differences are also repaired.
+func (c APIClient) VerifyMirror(repair bool, cb func(*pfs.MirrorDifference) error) error {
+	client, err := c.PfsAPIClient.VerifyMirror(c.Ctx(), &pfs.VerifyMirrorRequest{Repair: repair})
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	for {
+		diff, err := client.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return grpcutil.ScrubGRPC(err)
+		}
+		if err := cb(diff); err != nil {
+			if errors.Is(err, errutil.ErrBreak) {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
 // RunPFSLoadTest runs a PFS load test.
 func (c APIClient) RunPFSLoadTest(spec []byte, seed ...int64) (_ *pfs.RunLoadTestResponse, retErr error) {
 	defer func() {
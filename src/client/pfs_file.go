@@ -10,9 +10,13 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pagination"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tarutil"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 // PutFile puts a file into PFS from a reader.
@@ -376,7 +380,7 @@ func (c APIClient) RenewFileSet(ID string, ttl time.Duration) (retErr error) {
 // If size is set to 0 then all of the data will be returned.
 // TODO: Should we error if multiple files are matched?
 func (c APIClient) GetFile(commit *pfs.Commit, path string, w io.Writer) error {
-	r, err := c.getFileTar(commit, path)
+	r, err := c.getFileTar(commit, path, "")
 	if err != nil {
 		return err
 	}
@@ -385,12 +389,13 @@ func (c APIClient) GetFile(commit *pfs.Commit, path string, w io.Writer) error {
 	}, true)
 }
 
-func (c APIClient) getFileTar(commit *pfs.Commit, path string) (_ io.Reader, retErr error) {
+func (c APIClient) getFileTar(commit *pfs.Commit, path, after string) (_ io.Reader, retErr error) {
 	defer func() {
 		retErr = grpcutil.ScrubGRPC(retErr)
 	}()
 	req := &pfs.GetFileRequest{
-		File: commit.NewFile(path),
+		File:  commit.NewFile(path),
+		After: after,
 	}
 	client, err := c.PfsAPIClient.GetFile(c.Ctx(), req)
 	if err != nil {
@@ -401,13 +406,19 @@ func (c APIClient) getFileTar(commit *pfs.Commit, path string) (_ io.Reader, ret
 
 // GetFileTar gets a tar file from PFS.
 func (c APIClient) GetFileTar(commit *pfs.Commit, path string) (io.Reader, error) {
-	return c.getFileTar(commit, path)
+	return c.getFileTar(commit, path, "")
+}
+
+// GetFileTarAfter gets a tar file from PFS with the files matching path whose
+// paths sort after after.
+func (c APIClient) GetFileTarAfter(commit *pfs.Commit, path, after string) (io.Reader, error) {
+	return c.getFileTar(commit, path, after)
 }
 
 // GetFileReader gets a reader for the specified path
 // TODO: This should probably be an io.ReadCloser so we can close the rpc if the full file isn't read.
 func (c APIClient) GetFileReader(commit *pfs.Commit, path string) (io.Reader, error) {
-	r, err := c.getFileTar(commit, path)
+	r, err := c.getFileTar(commit, path, "")
 	if err != nil {
 		return nil, err
 	}
@@ -514,6 +525,50 @@ func (c APIClient) InspectFile(commit *pfs.Commit, path string) (_ *pfs.FileInfo
 	return fi, err
 }
 
+// InspectFileLineage returns the lineage of a file: the datums that wrote
+// it, if it's in a pipeline's output, and, recursively, the lineage of their
+// input files.
+func (c APIClient) InspectFileLineage(commit *pfs.Commit, path string) (_ *pfs.FileLineage, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	return c.PfsAPIClient.InspectFileLineage(
+		c.Ctx(),
+		&pfs.InspectFileLineageRequest{
+			File: commit.NewFile(path),
+		},
+	)
+}
+
+// ListFileHistory returns up to limit versions of the file at path, from the
+// newest to the oldest, in the commits reachable from commit that modified
+// it. A limit of 0 returns every version.
+func (c APIClient) ListFileHistory(commit *pfs.Commit, path string, limit int64) (_ []*pfs.FileVersion, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	var versions []*pfs.FileVersion
+	var pageToken string
+	for {
+		request := &pfs.ListFileHistoryRequest{
+			File:      commit.NewFile(path),
+			PageToken: pageToken,
+		}
+		if limit > 0 {
+			request.Limit = limit - int64(len(versions))
+		}
+		response, err := c.PfsAPIClient.ListFileHistory(c.Ctx(), request)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, response.Versions...)
+		if response.NextPageToken == "" || (limit > 0 && int64(len(versions)) >= limit) {
+			return versions, nil
+		}
+		pageToken = response.NextPageToken
+	}
+}
+
 // ListFile returns info about all files in a Commit under path, calling cb with each FileInfo.
 func (c APIClient) ListFile(commit *pfs.Commit, path string, cb func(fi *pfs.FileInfo) error) (retErr error) {
 	defer func() {
@@ -545,6 +600,36 @@ func (c APIClient) ListFile(commit *pfs.Commit, path string, cb func(fi *pfs.Fil
 	}
 }
 
+// ListFilePage is like ListFile, but lists at most pageSize files, starting
+// after the page that pageToken is the next page token of. It returns the
+// token of the next page, or "" if there are no more files or cb ended the
+// listing by returning errutil.ErrBreak.
+func (c APIClient) ListFilePage(commit *pfs.Commit, path string, pageSize int64, pageToken string, cb func(fi *pfs.FileInfo) error) (_ string, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	var trailer metadata.MD
+	client, err := c.PfsAPIClient.ListFile(
+		c.Ctx(),
+		&pfs.ListFileRequest{
+			File:      commit.NewFile(path),
+			PageSize:  pageSize,
+			PageToken: pageToken,
+		},
+		grpc.Trailer(&trailer),
+	)
+	if err != nil {
+		return "", err
+	}
+	if err := recvFileInfos(client, cb); err != nil {
+		if errors.Is(err, errutil.ErrBreak) {
+			return "", nil
+		}
+		return "", err
+	}
+	return pagination.NextPageTokenFromTrailer(trailer), nil
+}
+
 // ListFileAll returns info about all files in a Commit under path.
 func (c APIClient) ListFileAll(commit *pfs.Commit, path string) (_ []*pfs.FileInfo, retErr error) {
 	defer func() {
@@ -594,6 +679,54 @@ func (c APIClient) GlobFile(commit *pfs.Commit, pattern string, cb func(fi *pfs.
 	}
 }
 
+// GlobFilePage is like GlobFile, but lists at most pageSize files, starting
+// after the page that pageToken is the next page token of. It returns the
+// token of the next page, or "" if there are no more files or cb ended the
+// listing by returning errutil.ErrBreak.
+func (c APIClient) GlobFilePage(commit *pfs.Commit, pattern string, pageSize int64, pageToken string, cb func(fi *pfs.FileInfo) error) (_ string, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	var trailer metadata.MD
+	client, err := c.PfsAPIClient.GlobFile(
+		c.Ctx(),
+		&pfs.GlobFileRequest{
+			Commit:    commit,
+			Pattern:   pattern,
+			PageSize:  pageSize,
+			PageToken: pageToken,
+		},
+		grpc.Trailer(&trailer),
+	)
+	if err != nil {
+		return "", err
+	}
+	if err := recvFileInfos(client, cb); err != nil {
+		if errors.Is(err, errutil.ErrBreak) {
+			return "", nil
+		}
+		return "", err
+	}
+	return pagination.NextPageTokenFromTrailer(trailer), nil
+}
+
+// recvFileInfos calls cb with each FileInfo in a stream of them, until the
+// stream ends. The trailer of the stream is only set once it's ended.
+func recvFileInfos(client interface{ Recv() (*pfs.FileInfo, error) }, cb func(fi *pfs.FileInfo) error) error {
+	for {
+		fi, err := client.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if err := cb(fi); err != nil {
+			return err
+		}
+	}
+}
+
 // GlobFileAll returns files that match a given glob pattern in a given commit.
 // The pattern is documented here: https://golang.org/pkg/path/filepath/#Match
 func (c APIClient) GlobFileAll(commit *pfs.Commit, pattern string) (_ []*pfs.FileInfo, retErr error) {
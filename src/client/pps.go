@@ -9,10 +9,13 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pagination"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 
 	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 const (
@@ -30,6 +33,9 @@ const (
 	// PPSPipelineNameEnv is the env var that sets the name of the pipeline
 	// that the workers are running.
 	PPSPipelineNameEnv = "PPS_PIPELINE_NAME"
+	// PPSProjectNameEnv is the env var that sets the name of the project of the
+	// pipeline that the workers are running.
+	PPSProjectNameEnv = "PPS_PROJECT_NAME"
 	// PPSJobIDEnv is the env var that sets the ID of the job that the
 	// workers are running (if the workers belong to an orphan job, rather than a
 	// pipeline).
@@ -197,9 +203,15 @@ func NewPipelineJobInput(repoName string, branchName string, commitID string, gl
 	}
 }
 
-// NewPipeline creates a pps.Pipeline.
+// NewPipeline creates a pps.Pipeline. pipelineName may be qualified with the
+// pipeline's project, as in "project/pipeline".
 func NewPipeline(pipelineName string) *pps.Pipeline {
-	return &pps.Pipeline{Name: pipelineName}
+	project, name := pfs.SplitProject(pipelineName)
+	pipeline := &pps.Pipeline{Name: name}
+	if project != "" {
+		pipeline.Project = NewProject(project)
+	}
+	return pipeline
 }
 
 // CreatePipelineJob creates and runs a job in PPS.
@@ -329,6 +341,48 @@ func (c APIClient) ListPipelineJobFilterF(pipelineName string, inputCommit []*pf
 	}
 }
 
+// ListPipelineJobPage lists at most pageSize jobs, newest first, starting
+// after the page that pageToken is the next page token of. If pipelineName is
+// non empty then only jobs that were started by the named pipeline are
+// listed. It returns the token of the next page, or "" if there are no more
+// jobs or f ended the listing by returning errutil.ErrBreak.
+func (c APIClient) ListPipelineJobPage(pipelineName string, pageSize int64, pageToken string, f func(*pps.PipelineJobInfo) error) (_ string, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	var pipeline *pps.Pipeline
+	if pipelineName != "" {
+		pipeline = NewPipeline(pipelineName)
+	}
+	var trailer metadata.MD
+	client, err := c.PpsAPIClient.ListPipelineJob(
+		c.Ctx(),
+		&pps.ListPipelineJobRequest{
+			Pipeline:  pipeline,
+			PageSize:  pageSize,
+			PageToken: pageToken,
+		},
+		grpc.Trailer(&trailer),
+	)
+	if err != nil {
+		return "", err
+	}
+	for {
+		pji, err := client.Recv()
+		if errors.Is(err, io.EOF) {
+			return pagination.NextPageTokenFromTrailer(trailer), nil
+		} else if err != nil {
+			return "", err
+		}
+		if err := f(pji); err != nil {
+			if errors.Is(err, errutil.ErrBreak) {
+				return "", nil
+			}
+			return "", err
+		}
+	}
+}
+
 // FlushPipelineJob calls f with all the jobs which were triggered by commits.
 // If toPipelines is non-nil then only the jobs between commits and those
 // pipelines in the DAG will be returned.
@@ -447,6 +501,43 @@ func (c APIClient) ListDatumAll(job string) (_ []*pps.DatumInfo, retErr error) {
 	return dis, nil
 }
 
+// ListDatumPage lists at most pageSize datums in a job, in datum ID order,
+// starting after the page that pageToken is the next page token of. It
+// returns the token of the next page, or "" if there are no more datums or cb
+// ended the listing by returning errutil.ErrBreak.
+func (c APIClient) ListDatumPage(job string, pageSize int64, pageToken string, cb func(*pps.DatumInfo) error) (_ string, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	var trailer metadata.MD
+	client, err := c.PpsAPIClient.ListDatum(
+		c.Ctx(),
+		&pps.ListDatumRequest{
+			PipelineJob: NewPipelineJob(job),
+			PageSize:    pageSize,
+			PageToken:   pageToken,
+		},
+		grpc.Trailer(&trailer),
+	)
+	if err != nil {
+		return "", err
+	}
+	for {
+		di, err := client.Recv()
+		if errors.Is(err, io.EOF) {
+			return pagination.NextPageTokenFromTrailer(trailer), nil
+		} else if err != nil {
+			return "", err
+		}
+		if err := cb(di); err != nil {
+			if errors.Is(err, errutil.ErrBreak) {
+				return "", nil
+			}
+			return "", err
+		}
+	}
+}
+
 // ListDatumInput returns info about datums for a pipeline with input. The
 // pipeline doesn't need to exist.
 func (c APIClient) ListDatumInput(input *pps.Input, cb func(*pps.DatumInfo) error) (retErr error) {
@@ -764,6 +855,26 @@ func (c APIClient) RunPipeline(name string, provenance []*pfs.CommitProvenance,
 	return grpcutil.ScrubGRPC(err)
 }
 
+// RedriveQuarantined re-runs the latest job of a pipeline with a QUARANTINE
+// datum failure policy, once it has finished, so that the datums it
+// quarantined are processed again. Datums that were processed successfully
+// are skipped by the new job. It returns an error if the job didn't
+// quarantine any datums.
+func (c APIClient) RedriveQuarantined(pipeline string) error {
+	pipelineInfo, err := c.InspectPipeline(pipeline)
+	if err != nil {
+		return err
+	}
+	pipelineJobInfo, err := c.InspectPipelineJobOutputCommit(pipeline, pipelineInfo.OutputBranch, "", true)
+	if err != nil {
+		return err
+	}
+	if pipelineJobInfo.DataQuarantined == 0 {
+		return errors.Errorf("the latest job of pipeline %q (%s) has no quarantined datums", pipeline, pipelineJobInfo.PipelineJob.ID)
+	}
+	return c.RunPipeline(pipeline, nil, pipelineJobInfo.PipelineJob.ID)
+}
+
 // RunCron runs a pipeline. It can be passed a list of commit provenance.
 // This will trigger a new job provenant on those commits, effectively running the pipeline on the data in those commits.
 func (c APIClient) RunCron(name string) error {
@@ -291,6 +291,18 @@ func (c *pfsBuilderClient) DeleteRepo(ctx context.Context, req *pfs.DeleteRepoRe
 	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{DeleteRepo: req})
 	return nil, nil
 }
+func (c *pfsBuilderClient) CreateProject(ctx context.Context, req *pfs.CreateProjectRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("CreateProject")
+}
+func (c *pfsBuilderClient) InspectProject(ctx context.Context, req *pfs.InspectProjectRequest, opts ...grpc.CallOption) (*pfs.ProjectInfo, error) {
+	return nil, unsupportedError("InspectProject")
+}
+func (c *pfsBuilderClient) ListProject(ctx context.Context, req *pfs.ListProjectRequest, opts ...grpc.CallOption) (*pfs.ListProjectResponse, error) {
+	return nil, unsupportedError("ListProject")
+}
+func (c *pfsBuilderClient) DeleteProject(ctx context.Context, req *pfs.DeleteProjectRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("DeleteProject")
+}
 func (c *pfsBuilderClient) StartCommit(ctx context.Context, req *pfs.StartCommitRequest, opts ...grpc.CallOption) (*pfs.Commit, error) {
 	// Note that since we are batching requests (no extra round-trips), we do not
 	// have the commit id to return here. If you need an operation that relies
@@ -314,6 +326,10 @@ func (c *pfsBuilderClient) DeleteBranch(ctx context.Context, req *pfs.DeleteBran
 	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{DeleteBranch: req})
 	return nil, nil
 }
+func (c *pfsBuilderClient) AddFileset(ctx context.Context, req *pfs.AddFilesetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{AddFileset: req})
+	return nil, nil
+}
 func (c *ppsBuilderClient) StopPipelineJob(ctx context.Context, req *pps.StopPipelineJobRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{StopPipelineJob: req})
 	return nil, nil
@@ -374,6 +390,9 @@ func (c *pfsBuilderClient) InspectFile(ctx context.Context, req *pfs.InspectFile
 func (c *pfsBuilderClient) ListFile(ctx context.Context, req *pfs.ListFileRequest, opts ...grpc.CallOption) (pfs.API_ListFileClient, error) {
 	return nil, unsupportedError("ListFile")
 }
+func (c *pfsBuilderClient) ListFileHistory(ctx context.Context, req *pfs.ListFileHistoryRequest, opts ...grpc.CallOption) (*pfs.ListFileHistoryResponse, error) {
+	return nil, unsupportedError("ListFileHistory")
+}
 func (c *pfsBuilderClient) WalkFile(ctx context.Context, req *pfs.WalkFileRequest, opts ...grpc.CallOption) (pfs.API_WalkFileClient, error) {
 	return nil, unsupportedError("WalkFile")
 }
@@ -389,21 +408,60 @@ func (c *pfsBuilderClient) DeleteAll(ctx context.Context, req *types.Empty, opts
 func (c *pfsBuilderClient) Fsck(ctx context.Context, req *pfs.FsckRequest, opts ...grpc.CallOption) (pfs.API_FsckClient, error) {
 	return nil, unsupportedError("Fsck")
 }
+func (c *pfsBuilderClient) MergeBranch(ctx context.Context, req *pfs.MergeBranchRequest, opts ...grpc.CallOption) (*pfs.MergeBranchResponse, error) {
+	return nil, unsupportedError("MergeBranch")
+}
+func (c *pfsBuilderClient) InspectFileLineage(ctx context.Context, req *pfs.InspectFileLineageRequest, opts ...grpc.CallOption) (*pfs.FileLineage, error) {
+	return nil, unsupportedError("InspectFileLineage")
+}
+func (c *pfsBuilderClient) InspectStorage(ctx context.Context, req *pfs.InspectStorageRequest, opts ...grpc.CallOption) (*pfs.StorageInfo, error) {
+	return nil, unsupportedError("InspectStorage")
+}
 func (c *pfsBuilderClient) CreateFileset(ctx context.Context, opts ...grpc.CallOption) (pfs.API_CreateFilesetClient, error) {
 	return nil, unsupportedError("CreateFileset")
 }
 func (c *pfsBuilderClient) RenewFileset(ctx context.Context, req *pfs.RenewFilesetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("RenewFileset")
 }
-func (c *pfsBuilderClient) AddFileset(ctx context.Context, req *pfs.AddFilesetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	return nil, unsupportedError("AddFileset")
-}
 func (c *pfsBuilderClient) GetFileset(ctx context.Context, req *pfs.GetFilesetRequest, opts ...grpc.CallOption) (*pfs.CreateFilesetResponse, error) {
 	return nil, unsupportedError("GetFileset")
 }
 func (c *pfsBuilderClient) RunLoadTest(ctx context.Context, req *pfs.RunLoadTestRequest, opts ...grpc.CallOption) (*pfs.RunLoadTestResponse, error) {
 	return nil, unsupportedError("RunLoadTest")
 }
+func (c *pfsBuilderClient) CreateRemote(ctx context.Context, req *pfs.CreateRemoteRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("CreateRemote")
+}
+func (c *pfsBuilderClient) ListRemote(ctx context.Context, req *pfs.ListRemoteRequest, opts ...grpc.CallOption) (*pfs.ListRemoteResponse, error) {
+	return nil, unsupportedError("ListRemote")
+}
+func (c *pfsBuilderClient) DeleteRemote(ctx context.Context, req *pfs.DeleteRemoteRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("DeleteRemote")
+}
+func (c *pfsBuilderClient) PushBranch(ctx context.Context, req *pfs.PushBranchRequest, opts ...grpc.CallOption) (*pfs.TransferStats, error) {
+	return nil, unsupportedError("PushBranch")
+}
+func (c *pfsBuilderClient) PullBranch(ctx context.Context, req *pfs.PullBranchRequest, opts ...grpc.CallOption) (*pfs.TransferStats, error) {
+	return nil, unsupportedError("PullBranch")
+}
+func (c *pfsBuilderClient) MissingChunks(ctx context.Context, req *pfs.MissingChunksRequest, opts ...grpc.CallOption) (*pfs.MissingChunksResponse, error) {
+	return nil, unsupportedError("MissingChunks")
+}
+func (c *pfsBuilderClient) RehydrateCommit(ctx context.Context, req *pfs.RehydrateCommitRequest, opts ...grpc.CallOption) (*pfs.RehydrateCommitResponse, error) {
+	return nil, unsupportedError("RehydrateCommit")
+}
+func (c *pfsBuilderClient) VerifyMirror(ctx context.Context, req *pfs.VerifyMirrorRequest, opts ...grpc.CallOption) (pfs.API_VerifyMirrorClient, error) {
+	return nil, unsupportedError("VerifyMirror")
+}
+func (c *pfsBuilderClient) ExportCommit(ctx context.Context, req *pfs.ExportCommitRequest, opts ...grpc.CallOption) (*pfs.ExportCommitResponse, error) {
+	return nil, unsupportedError("ExportCommit")
+}
+func (c *pfsBuilderClient) GetChunk(ctx context.Context, req *pfs.GetChunkRequest, opts ...grpc.CallOption) (pfs.API_GetChunkClient, error) {
+	return nil, unsupportedError("GetChunk")
+}
+func (c *pfsBuilderClient) ReceiveCommit(ctx context.Context, opts ...grpc.CallOption) (pfs.API_ReceiveCommitClient, error) {
+	return nil, unsupportedError("ReceiveCommit")
+}
 
 func (c *ppsBuilderClient) CreatePipelineJob(ctx context.Context, req *pps.CreatePipelineJobRequest, opts ...grpc.CallOption) (*pps.PipelineJob, error) {
 	return nil, unsupportedError("CreatePipelineJob")
@@ -566,6 +624,18 @@ func (c *adminBuilderClient) InspectCluster(ctx context.Context, req *types.Empt
 	return nil, unsupportedError("InspectCluster")
 }
 
+func (c *adminBuilderClient) MigrationStatus(ctx context.Context, req *types.Empty, opts ...grpc.CallOption) (*admin.MigrationStatusResponse, error) {
+	return nil, unsupportedError("MigrationStatus")
+}
+
+func (c *adminBuilderClient) PlanMigrations(ctx context.Context, req *admin.PlanMigrationsRequest, opts ...grpc.CallOption) (*admin.PlanMigrationsResponse, error) {
+	return nil, unsupportedError("PlanMigrations")
+}
+
+func (c *adminBuilderClient) RollbackMigrations(ctx context.Context, req *admin.RollbackMigrationsRequest, opts ...grpc.CallOption) (*admin.RollbackMigrationsResponse, error) {
+	return nil, unsupportedError("RollbackMigrations")
+}
+
 func (c *transactionBuilderClient) BatchTransaction(ctx context.Context, req *transaction.BatchTransactionRequest, opts ...grpc.CallOption) (*transaction.TransactionInfo, error) {
 	return nil, unsupportedError("BatchTransaction")
 }
@@ -0,0 +1,63 @@
+package debug
+
+import (
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+// ProfilesRepo is the PFS repo in which pachd and workers record profiles
+// when continuous profiling is enabled.
+const ProfilesRepo = "__profiles__"
+
+// The collectors that may be named in DumpSelector.Collectors.
+const (
+	// CollectVersion collects the pachd version.
+	CollectVersion = "version"
+	// CollectLogs collects pachd and worker container logs.
+	CollectLogs = "logs"
+	// CollectProfiles collects goroutine and heap profiles from pachd and
+	// workers.
+	CollectProfiles = "profiles"
+	// CollectCommits collects the commits in input repos and pipeline output
+	// repos.
+	CollectCommits = "commits"
+	// CollectSpecs collects pipeline specs.
+	CollectSpecs = "specs"
+	// CollectJobs collects pipeline job infos.
+	CollectJobs = "jobs"
+	// CollectJobReasons collects the state and reason of every pipeline job
+	// that has one, which is usually the quickest way to see why jobs failed.
+	CollectJobReasons = "job-reasons"
+	// CollectDatabase collects the state of pachd's Postgres collections and
+	// migrations.
+	CollectDatabase = "database"
+)
+
+// Collectors lists every collector that a dump may run.
+var Collectors = []string{
+	CollectVersion,
+	CollectLogs,
+	CollectProfiles,
+	CollectCommits,
+	CollectSpecs,
+	CollectJobs,
+	CollectJobReasons,
+	CollectDatabase,
+}
+
+// ValidateCollectors returns an error if 'collectors' names a collector that
+// doesn't exist.
+func ValidateCollectors(collectors []string) error {
+	for _, c := range collectors {
+		var ok bool
+		for _, known := range Collectors {
+			if c == known {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return errors.Errorf("unrecognized debug collector %q (must be one of %v)", c, Collectors)
+		}
+	}
+	return nil
+}
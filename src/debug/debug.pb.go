@@ -341,20 +341,102 @@ func (m *BinaryRequest) GetFilter() *Filter {
 	return nil
 }
 
-type DumpRequest struct {
-	Filter *Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
-	// Limit sets the limit for the number of commits / jobs that are returned for each repo / pipeline in the dump.
-	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+// DumpSelector narrows down the information collected by a dump.
+type DumpSelector struct {
+	// Pipelines restricts the dump to the given pipelines (and their workers).
+	// If empty, every pipeline is included.
+	Pipelines []*pps.Pipeline `protobuf:"bytes,1,rep,name=pipelines,proto3" json:"pipelines,omitempty"`
+	// Since and until restrict the logs, commits and jobs in the dump to those
+	// within the given time window. Either end may be left open.
+	Since *types.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+	Until *types.Timestamp `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
+	// Collectors restricts the dump to the named collectors (e.g. "logs",
+	// "specs", "jobs"). If empty, every collector runs.
+	Collectors           []string `protobuf:"bytes,4,rep,name=collectors,proto3" json:"collectors,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
+func (m *DumpSelector) Reset()         { *m = DumpSelector{} }
+func (m *DumpSelector) String() string { return proto.CompactTextString(m) }
+func (*DumpSelector) ProtoMessage()    {}
+func (*DumpSelector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ae24eab94cb53d5, []int{5}
+}
+func (m *DumpSelector) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DumpSelector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DumpSelector.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DumpSelector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DumpSelector.Merge(m, src)
+}
+func (m *DumpSelector) XXX_Size() int {
+	return m.Size()
+}
+func (m *DumpSelector) XXX_DiscardUnknown() {
+	xxx_messageInfo_DumpSelector.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DumpSelector proto.InternalMessageInfo
+
+func (m *DumpSelector) GetPipelines() []*pps.Pipeline {
+	if m != nil {
+		return m.Pipelines
+	}
+	return nil
+}
+
+func (m *DumpSelector) GetSince() *types.Timestamp {
+	if m != nil {
+		return m.Since
+	}
+	return nil
+}
+
+func (m *DumpSelector) GetUntil() *types.Timestamp {
+	if m != nil {
+		return m.Until
+	}
+	return nil
+}
+
+func (m *DumpSelector) GetCollectors() []string {
+	if m != nil {
+		return m.Collectors
+	}
+	return nil
+}
+
+// DumpRequest requests a dump of debugging information. Secrets, tokens and
+// environment variable values are always redacted from the dump before it's
+// returned.
+type DumpRequest struct {
+	Filter *Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Limit sets the limit for the number of commits / jobs that are returned for each repo / pipeline in the dump.
+	Limit                int64         `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Selector             *DumpSelector `protobuf:"bytes,3,opt,name=selector,proto3" json:"selector,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
 func (m *DumpRequest) Reset()         { *m = DumpRequest{} }
 func (m *DumpRequest) String() string { return proto.CompactTextString(m) }
 func (*DumpRequest) ProtoMessage()    {}
 func (*DumpRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ae24eab94cb53d5, []int{5}
+	return fileDescriptor_5ae24eab94cb53d5, []int{6}
 }
 func (m *DumpRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -397,47 +479,61 @@ func (m *DumpRequest) GetLimit() int64 {
 	return 0
 }
 
+func (m *DumpRequest) GetSelector() *DumpSelector {
+	if m != nil {
+		return m.Selector
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ProfileRequest)(nil), "debug.ProfileRequest")
 	proto.RegisterType((*Profile)(nil), "debug.Profile")
 	proto.RegisterType((*Filter)(nil), "debug.Filter")
 	proto.RegisterType((*Worker)(nil), "debug.Worker")
 	proto.RegisterType((*BinaryRequest)(nil), "debug.BinaryRequest")
+	proto.RegisterType((*DumpSelector)(nil), "debug.DumpSelector")
 	proto.RegisterType((*DumpRequest)(nil), "debug.DumpRequest")
 }
 
 func init() { proto.RegisterFile("debug/debug.proto", fileDescriptor_5ae24eab94cb53d5) }
 
 var fileDescriptor_5ae24eab94cb53d5 = []byte{
-	// 441 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xd1, 0x6a, 0x13, 0x41,
-	0x14, 0xcd, 0x98, 0x66, 0xbb, 0xde, 0x92, 0xa2, 0x97, 0x2a, 0xb1, 0xc2, 0x22, 0x0b, 0x62, 0xa1,
-	0xb0, 0x2b, 0x11, 0x05, 0xf5, 0x41, 0x0c, 0x41, 0x82, 0x4f, 0x65, 0x10, 0x05, 0xdf, 0x36, 0xd9,
-	0x9b, 0x74, 0x70, 0x77, 0x67, 0x9c, 0x9d, 0xb5, 0xe4, 0xcd, 0xcf, 0xeb, 0xa3, 0x9f, 0x20, 0xf9,
-	0x12, 0xd9, 0x99, 0xd9, 0x34, 0xb5, 0x60, 0xf1, 0x25, 0xcc, 0x9c, 0x73, 0xee, 0x99, 0x7b, 0xee,
-	0xcd, 0xc2, 0xfd, 0x9c, 0xe6, 0xcd, 0x2a, 0xb5, 0xbf, 0x89, 0xd2, 0xd2, 0x48, 0x1c, 0xd8, 0xcb,
-	0x71, 0xb4, 0x92, 0x72, 0x55, 0x50, 0x6a, 0xc1, 0x79, 0xb3, 0x4c, 0x2f, 0x74, 0xa6, 0x14, 0xe9,
-	0xda, 0xc9, 0x6e, 0xf2, 0x79, 0xa3, 0x33, 0x23, 0x64, 0xe5, 0xf9, 0xa1, 0x52, 0x75, 0xaa, 0x94,
-	0x97, 0xc7, 0x19, 0x1c, 0x9e, 0x69, 0xb9, 0x14, 0x05, 0x71, 0xfa, 0xde, 0x50, 0x6d, 0xf0, 0x04,
-	0xf6, 0x95, 0x43, 0x46, 0xec, 0x09, 0x3b, 0x39, 0x18, 0x1f, 0x26, 0xae, 0x8d, 0x4e, 0xd7, 0xd1,
-	0xf8, 0x14, 0x82, 0xa5, 0x28, 0x0c, 0xe9, 0xd1, 0x1d, 0x2b, 0x1c, 0x7a, 0xe1, 0x07, 0x0b, 0x72,
-	0x4f, 0xc6, 0x9f, 0x60, 0xdf, 0x97, 0x22, 0xc2, 0x5e, 0x95, 0x95, 0xce, 0xf8, 0x2e, 0xb7, 0x67,
-	0x7c, 0x09, 0x61, 0xd7, 0xa2, 0xf7, 0x79, 0x94, 0xb8, 0x0c, 0x49, 0x97, 0x21, 0x99, 0x7a, 0x01,
-	0xdf, 0x4a, 0xe3, 0x9f, 0x0c, 0x02, 0xf7, 0x10, 0x3e, 0x84, 0x81, 0xca, 0x16, 0xe7, 0xb9, 0xb5,
-	0x0d, 0x67, 0x3d, 0xee, 0xae, 0x78, 0x0a, 0xa1, 0x12, 0x8a, 0x0a, 0x51, 0xd1, 0xb6, 0xc3, 0x36,
-	0xf9, 0x99, 0x07, 0x67, 0x3d, 0xbe, 0x15, 0xe0, 0x33, 0x08, 0x2e, 0xa4, 0xfe, 0x46, 0x7a, 0xd4,
-	0xbf, 0x16, 0xe6, 0x8b, 0x05, 0x67, 0x3d, 0xee, 0xe9, 0x49, 0xd8, 0xa5, 0x8e, 0xdf, 0x40, 0xe0,
-	0x58, 0xbc, 0x07, 0x7d, 0x25, 0x73, 0x1f, 0xab, 0x3d, 0x62, 0x04, 0xa0, 0x29, 0x17, 0x9a, 0x16,
-	0x86, 0x72, 0xfb, 0x7a, 0xc8, 0x77, 0x90, 0xf8, 0x15, 0x0c, 0x27, 0xa2, 0xca, 0xf4, 0xba, 0x1b,
-	0xfb, 0xd5, 0x30, 0xd9, 0xbf, 0x86, 0xf9, 0x11, 0x0e, 0xa6, 0x4d, 0xa9, 0xfe, 0xaf, 0x0a, 0x8f,
-	0x60, 0x50, 0x88, 0x52, 0x18, 0xdb, 0x48, 0x9f, 0xbb, 0xcb, 0xf8, 0x92, 0xc1, 0x60, 0xda, 0xca,
-	0xf1, 0xfd, 0xd5, 0x8a, 0x1e, 0xfc, 0xb5, 0x6d, 0xf7, 0xd0, 0xf1, 0xe3, 0x1b, 0x3b, 0x99, 0xac,
-	0x0d, 0xd5, 0x9f, 0xb3, 0xa2, 0xa1, 0xb8, 0xf7, 0x9c, 0xe1, 0x3b, 0x08, 0x5c, 0x20, 0x3c, 0xf2,
-	0x0e, 0xd7, 0xf2, 0xdd, 0x6e, 0xf0, 0x16, 0xf6, 0xda, 0x64, 0x88, 0xbe, 0x7c, 0x27, 0xe6, 0xad,
-	0xc5, 0x93, 0xd7, 0x97, 0x9b, 0x88, 0xfd, 0xda, 0x44, 0xec, 0xf7, 0x26, 0x62, 0x5f, 0x4f, 0x57,
-	0xc2, 0x9c, 0x37, 0xf3, 0x64, 0x21, 0xcb, 0xb4, 0xfd, 0x2b, 0xac, 0x73, 0xd2, 0xbb, 0xa7, 0x1f,
-	0xe3, 0xb4, 0xd6, 0x0b, 0xf7, 0x75, 0xcd, 0x03, 0xeb, 0xf9, 0xe2, 0x4f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xa9, 0x54, 0xd2, 0x6e, 0x73, 0x03, 0x00, 0x00,
+	// 533 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x51, 0x8b, 0xd3, 0x4c,
+	0x14, 0xed, 0x7c, 0xd9, 0x66, 0xd3, 0xbb, 0x5f, 0x17, 0x1d, 0x57, 0x89, 0x15, 0x6a, 0x09, 0x88,
+	0x85, 0x42, 0xb2, 0x54, 0x14, 0xd4, 0x07, 0xb1, 0x14, 0xe9, 0xe3, 0x32, 0x2e, 0x0a, 0xbe, 0xa5,
+	0xc9, 0xb4, 0x3b, 0x98, 0x64, 0xc6, 0xc9, 0xc4, 0xa5, 0xf8, 0xe2, 0x3f, 0xf3, 0x75, 0x1f, 0xfd,
+	0x09, 0xd2, 0x5f, 0x22, 0x99, 0x99, 0xb4, 0xdd, 0x2d, 0xb8, 0xfa, 0x52, 0x66, 0xee, 0x39, 0xf7,
+	0xe6, 0x9c, 0x33, 0x97, 0xc2, 0xdd, 0x94, 0xce, 0xab, 0x65, 0xa4, 0x7f, 0x43, 0x21, 0xb9, 0xe2,
+	0xb8, 0xad, 0x2f, 0xbd, 0xfe, 0x92, 0xf3, 0x65, 0x46, 0x23, 0x5d, 0x9c, 0x57, 0x8b, 0xe8, 0x52,
+	0xc6, 0x42, 0x50, 0x59, 0x1a, 0xda, 0x3e, 0x9e, 0x56, 0x32, 0x56, 0x8c, 0x17, 0x16, 0x7f, 0x7c,
+	0x13, 0x57, 0x2c, 0xa7, 0xa5, 0x8a, 0x73, 0x61, 0x09, 0x5d, 0x21, 0xca, 0x48, 0x08, 0x3b, 0x2f,
+	0x88, 0xe1, 0xf8, 0x4c, 0xf2, 0x05, 0xcb, 0x28, 0xa1, 0x5f, 0x2a, 0x5a, 0x2a, 0x3c, 0x84, 0x43,
+	0x61, 0x2a, 0x3e, 0x1a, 0xa0, 0xe1, 0xd1, 0xf8, 0x38, 0x34, 0x3a, 0x1b, 0x5e, 0x03, 0xe3, 0x27,
+	0xe0, 0x2e, 0x58, 0xa6, 0xa8, 0xf4, 0xff, 0xd3, 0xc4, 0xae, 0x25, 0xbe, 0xd3, 0x45, 0x62, 0xc1,
+	0xe0, 0x1c, 0x0e, 0x6d, 0x2b, 0xc6, 0x70, 0x50, 0xc4, 0xb9, 0x19, 0xdc, 0x21, 0xfa, 0x8c, 0x9f,
+	0x83, 0xd7, 0x78, 0xb0, 0x73, 0x1e, 0x86, 0xc6, 0x44, 0xd8, 0x98, 0x08, 0xa7, 0x96, 0x40, 0x36,
+	0xd4, 0xe0, 0x3b, 0x02, 0xd7, 0x7c, 0x08, 0x3f, 0x80, 0xb6, 0x88, 0x93, 0x8b, 0x54, 0x8f, 0xf5,
+	0x66, 0x2d, 0x62, 0xae, 0x78, 0x04, 0x9e, 0x60, 0x82, 0x66, 0xac, 0xa0, 0x1b, 0x85, 0xb5, 0xf3,
+	0x33, 0x5b, 0x9c, 0xb5, 0xc8, 0x86, 0x80, 0x9f, 0x82, 0x7b, 0xc9, 0xe5, 0x67, 0x2a, 0x7d, 0xe7,
+	0x9a, 0x99, 0x8f, 0xba, 0x38, 0x6b, 0x11, 0x0b, 0x4f, 0xbc, 0xc6, 0x75, 0xf0, 0x0a, 0x5c, 0x83,
+	0xe2, 0x3b, 0xe0, 0x08, 0x9e, 0x5a, 0x5b, 0xf5, 0x11, 0xf7, 0x01, 0x24, 0x4d, 0x99, 0xa4, 0x89,
+	0xa2, 0xa9, 0xfe, 0xba, 0x47, 0x76, 0x2a, 0xc1, 0x0b, 0xe8, 0x4e, 0x58, 0x11, 0xcb, 0x55, 0x13,
+	0xfb, 0x36, 0x4c, 0xf4, 0xa7, 0x30, 0x7f, 0x20, 0xf8, 0x7f, 0x5a, 0xe5, 0xe2, 0x3d, 0xcd, 0x68,
+	0xa2, 0xb8, 0xc4, 0x23, 0xe8, 0x34, 0x1e, 0x4a, 0x1f, 0x0d, 0x9c, 0x3d, 0x97, 0x64, 0x8b, 0xe3,
+	0x53, 0x68, 0x97, 0xac, 0x48, 0x9a, 0x38, 0x7a, 0x7b, 0x41, 0x9f, 0x37, 0xdb, 0x42, 0x0c, 0xb1,
+	0xee, 0xa8, 0x0a, 0xc5, 0x32, 0xdf, 0xb9, 0xbd, 0x43, 0x13, 0x6b, 0xe7, 0x09, 0xcf, 0x8c, 0xba,
+	0xd2, 0x3f, 0x18, 0x38, 0xc3, 0x0e, 0xd9, 0xa9, 0x04, 0xdf, 0xe0, 0xa8, 0x36, 0xf0, 0x6f, 0xbe,
+	0xf1, 0x09, 0xb4, 0x33, 0x96, 0x33, 0xa5, 0x95, 0x3b, 0xc4, 0x5c, 0x70, 0x04, 0x5e, 0x69, 0x83,
+	0xb0, 0x02, 0xef, 0xd9, 0xf6, 0xdd, 0x8c, 0xc8, 0x86, 0x34, 0xbe, 0x42, 0xd0, 0x9e, 0xd6, 0x04,
+	0xfc, 0x76, 0xbb, 0x95, 0xf7, 0x6f, 0x2c, 0xb8, 0x51, 0xd6, 0x7b, 0xb4, 0xe7, 0x75, 0xb2, 0x52,
+	0xb4, 0xfc, 0x10, 0x67, 0x15, 0x0d, 0x5a, 0xa7, 0x08, 0xbf, 0x01, 0xd7, 0xbc, 0x21, 0x3e, 0xb1,
+	0x13, 0xae, 0x3d, 0xe9, 0xed, 0x03, 0x5e, 0xc3, 0x41, 0xad, 0x13, 0xe3, 0x1d, 0xd1, 0x7f, 0xdb,
+	0x3c, 0x79, 0x79, 0xb5, 0xee, 0xa3, 0x9f, 0xeb, 0x3e, 0xfa, 0xb5, 0xee, 0xa3, 0x4f, 0xa3, 0x25,
+	0x53, 0x17, 0xd5, 0x3c, 0x4c, 0x78, 0x1e, 0xd5, 0xdb, 0xbf, 0x4a, 0xa9, 0xdc, 0x3d, 0x7d, 0x1d,
+	0x47, 0xa5, 0x4c, 0xcc, 0x3f, 0xce, 0xdc, 0xd5, 0x33, 0x9f, 0xfd, 0x1e, 0x00, 0x59, 0x18, 0x6c,
+	0x1b, 0x87, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -948,6 +1044,80 @@ func (m *BinaryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DumpSelector) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DumpSelector) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DumpSelector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Collectors) > 0 {
+		for iNdEx := len(m.Collectors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Collectors[iNdEx])
+			copy(dAtA[i:], m.Collectors[iNdEx])
+			i = encodeVarintDebug(dAtA, i, uint64(len(m.Collectors[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Until != nil {
+		{
+			size, err := m.Until.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Since != nil {
+		{
+			size, err := m.Since.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pipelines) > 0 {
+		for iNdEx := len(m.Pipelines) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pipelines[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DumpRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -972,6 +1142,18 @@ func (m *DumpRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Selector != nil {
+		{
+			size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Limit != 0 {
 		i = encodeVarintDebug(dAtA, i, uint64(m.Limit))
 		i--
@@ -1126,6 +1308,38 @@ func (m *BinaryRequest) Size() (n int) {
 	return n
 }
 
+func (m *DumpSelector) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pipelines) > 0 {
+		for _, e := range m.Pipelines {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.Since != nil {
+		l = m.Since.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Until != nil {
+		l = m.Until.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if len(m.Collectors) > 0 {
+		for _, s := range m.Collectors {
+			l = len(s)
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *DumpRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1139,6 +1353,10 @@ func (m *DumpRequest) Size() (n int) {
 	if m.Limit != 0 {
 		n += 1 + sovDebug(uint64(m.Limit))
 	}
+	if m.Selector != nil {
+		l = m.Selector.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1725,6 +1943,195 @@ func (m *BinaryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DumpSelector) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DumpSelector: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DumpSelector: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipelines", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pipelines = append(m.Pipelines, &pps.Pipeline{})
+			if err := m.Pipelines[len(m.Pipelines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Since == nil {
+				m.Since = &types.Timestamp{}
+			}
+			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Until == nil {
+				m.Until = &types.Timestamp{}
+			}
+			if err := m.Until.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collectors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collectors = append(m.Collectors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DumpRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1809,6 +2216,42 @@ func (m *DumpRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Selector == nil {
+				m.Selector = &DumpSelector{}
+			}
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDebug(dAtA[iNdEx:])
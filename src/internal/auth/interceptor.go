@@ -24,6 +24,10 @@ var authHandlers = map[string]authHandler{
 	// Allow InspectCluster to succeed before a user logs in
 	"/admin.API/InspectCluster": unauthenticated,
 
+	"/admin.API/MigrationStatus":    authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_GET_MIGRATIONS)),
+	"/admin.API/PlanMigrations":     authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_GET_MIGRATIONS)),
+	"/admin.API/RollbackMigrations": authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_ROLLBACK_MIGRATIONS)),
+
 	//
 	// Auth API
 	//
@@ -119,37 +123,56 @@ var authHandlers = map[string]authHandler{
 	//
 
 	// TODO: Add methods to handle repo permissions
-	"/pfs.API/ActivateAuth":    clusterPermissions(auth.Permission_CLUSTER_AUTH_ACTIVATE),
-	"/pfs.API/CreateRepo":      authDisabledOr(authenticated),
-	"/pfs.API/InspectRepo":     authDisabledOr(authenticated),
-	"/pfs.API/ListRepo":        authDisabledOr(authenticated),
-	"/pfs.API/DeleteRepo":      authDisabledOr(authenticated),
-	"/pfs.API/StartCommit":     authDisabledOr(authenticated),
-	"/pfs.API/FinishCommit":    authDisabledOr(authenticated),
-	"/pfs.API/InspectCommit":   authDisabledOr(authenticated),
-	"/pfs.API/ListCommit":      authDisabledOr(authenticated),
-	"/pfs.API/SquashCommit":    authDisabledOr(authenticated),
-	"/pfs.API/FlushCommit":     authDisabledOr(authenticated),
-	"/pfs.API/SubscribeCommit": authDisabledOr(authenticated),
-	"/pfs.API/ClearCommit":     authDisabledOr(authenticated),
-	"/pfs.API/CreateBranch":    authDisabledOr(authenticated),
-	"/pfs.API/InspectBranch":   authDisabledOr(authenticated),
-	"/pfs.API/ListBranch":      authDisabledOr(authenticated),
-	"/pfs.API/DeleteBranch":    authDisabledOr(authenticated),
-	"/pfs.API/ModifyFile":      authDisabledOr(authenticated),
-	"/pfs.API/GetFile":         authDisabledOr(authenticated),
-	"/pfs.API/InspectFile":     authDisabledOr(authenticated),
-	"/pfs.API/ListFile":        authDisabledOr(authenticated),
-	"/pfs.API/WalkFile":        authDisabledOr(authenticated),
-	"/pfs.API/GlobFile":        authDisabledOr(authenticated),
-	"/pfs.API/DiffFile":        authDisabledOr(authenticated),
-	"/pfs.API/DeleteAll":       authDisabledOr(authenticated),
-	"/pfs.API/Fsck":            authDisabledOr(authenticated),
-	"/pfs.API/CreateFileset":   authDisabledOr(authenticated),
-	"/pfs.API/GetFileset":      authDisabledOr(authenticated),
-	"/pfs.API/AddFileset":      authDisabledOr(authenticated),
-	"/pfs.API/RenewFileset":    authDisabledOr(authenticated),
-	"/pfs.API/RunLoadTest":     authDisabledOr(authenticated),
+	"/pfs.API/ActivateAuth":       clusterPermissions(auth.Permission_CLUSTER_AUTH_ACTIVATE),
+	"/pfs.API/CreateRepo":         authDisabledOr(authenticated),
+	"/pfs.API/InspectRepo":        authDisabledOr(authenticated),
+	"/pfs.API/ListRepo":           authDisabledOr(authenticated),
+	"/pfs.API/DeleteRepo":         authDisabledOr(authenticated),
+	"/pfs.API/CreateProject":      authDisabledOr(authenticated),
+	"/pfs.API/InspectProject":     authDisabledOr(authenticated),
+	"/pfs.API/ListProject":        authDisabledOr(authenticated),
+	"/pfs.API/DeleteProject":      authDisabledOr(authenticated),
+	"/pfs.API/StartCommit":        authDisabledOr(authenticated),
+	"/pfs.API/FinishCommit":       authDisabledOr(authenticated),
+	"/pfs.API/InspectCommit":      authDisabledOr(authenticated),
+	"/pfs.API/ListCommit":         authDisabledOr(authenticated),
+	"/pfs.API/SquashCommit":       authDisabledOr(authenticated),
+	"/pfs.API/FlushCommit":        authDisabledOr(authenticated),
+	"/pfs.API/SubscribeCommit":    authDisabledOr(authenticated),
+	"/pfs.API/ClearCommit":        authDisabledOr(authenticated),
+	"/pfs.API/CreateBranch":       authDisabledOr(authenticated),
+	"/pfs.API/InspectBranch":      authDisabledOr(authenticated),
+	"/pfs.API/ListBranch":         authDisabledOr(authenticated),
+	"/pfs.API/DeleteBranch":       authDisabledOr(authenticated),
+	"/pfs.API/ModifyFile":         authDisabledOr(authenticated),
+	"/pfs.API/GetFile":            authDisabledOr(authenticated),
+	"/pfs.API/InspectFile":        authDisabledOr(authenticated),
+	"/pfs.API/InspectFileLineage": authDisabledOr(authenticated),
+	"/pfs.API/ListFile":           authDisabledOr(authenticated),
+	"/pfs.API/ListFileHistory":    authDisabledOr(authenticated),
+	"/pfs.API/WalkFile":           authDisabledOr(authenticated),
+	"/pfs.API/GlobFile":           authDisabledOr(authenticated),
+	"/pfs.API/DiffFile":           authDisabledOr(authenticated),
+	"/pfs.API/MergeBranch":        authDisabledOr(authenticated),
+	"/pfs.API/DeleteAll":          authDisabledOr(authenticated),
+	"/pfs.API/Fsck":               authDisabledOr(authenticated),
+	"/pfs.API/CreateFileset":      authDisabledOr(authenticated),
+	"/pfs.API/GetFileset":         authDisabledOr(authenticated),
+	"/pfs.API/AddFileset":         authDisabledOr(authenticated),
+	"/pfs.API/RenewFileset":       authDisabledOr(authenticated),
+	"/pfs.API/RunLoadTest":        authDisabledOr(authenticated),
+	"/pfs.API/InspectStorage":     authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_INSPECT_STORAGE)),
+	"/pfs.API/RehydrateCommit":    authDisabledOr(authenticated),
+	"/pfs.API/VerifyMirror":       authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_VERIFY_MIRROR)),
+	"/pfs.API/CreateRemote":       authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_CREATE_REMOTE)),
+	"/pfs.API/ListRemote":         authDisabledOr(authenticated),
+	"/pfs.API/DeleteRemote":       authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_DELETE_REMOTE)),
+	"/pfs.API/PushBranch":         authDisabledOr(authenticated),
+	"/pfs.API/PullBranch":         authDisabledOr(authenticated),
+	"/pfs.API/MissingChunks":      authDisabledOr(authenticated),
+	"/pfs.API/ExportCommit":       authDisabledOr(authenticated),
+	"/pfs.API/GetChunk":           authDisabledOr(authenticated),
+	"/pfs.API/ReceiveCommit":      authDisabledOr(authenticated),
 
 	//
 	// PPS API
@@ -6,12 +6,14 @@ import (
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/migrations"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactiondb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/work"
 	"github.com/pachyderm/pachyderm/v2/src/server/auth"
 	authserver "github.com/pachyderm/pachyderm/v2/src/server/auth/server"
 	"github.com/pachyderm/pachyderm/v2/src/server/identity"
@@ -85,4 +87,32 @@ var DesiredClusterState migrations.State = migrations.InitialState().
 	}).
 	Apply("license clusters client_id column", func(ctx context.Context, env migrations.Env) error {
 		return license.AddClusterClientIdColumn(ctx, env.Tx)
+	}).
+	Apply("storage gc runs v0", func(ctx context.Context, env migrations.Env) error {
+		return track.SetupPostgresGCRunsV0(ctx, env.Tx)
+	}).
+	Apply("pfs remotes collection v0", func(ctx context.Context, env migrations.Env) error {
+		return col.SetupPostgresCollections(ctx, env.Tx, pfsdb.Remotes(nil, nil))
+	}).
+	Apply("create work schema", func(ctx context.Context, env migrations.Env) error {
+		_, err := env.Tx.ExecContext(ctx, `CREATE SCHEMA work`)
+		return errors.EnsureStack(err)
+	}).
+	Apply("work task queue v0", func(ctx context.Context, env migrations.Env) error {
+		return work.SetupPostgresTaskQueueV0(ctx, env.Tx)
+	}).
+	Apply("pfs projects collection v0", func(ctx context.Context, env migrations.Env) error {
+		return col.SetupPostgresCollections(ctx, env.Tx, pfsdb.Projects(nil, nil))
+	}).
+	Apply("storage chunk store v1", func(ctx context.Context, env migrations.Env) error {
+		return chunk.SetupPostgresStoreV1(env.Tx)
+	}).
+	Apply("storage mirror repair queue v0", func(ctx context.Context, env migrations.Env) error {
+		return obj.SetupPostgresRepairQueueV0(ctx, env.Tx)
+	}).
+	WithDown(func(ctx context.Context, env migrations.Env) error {
+		return obj.DropPostgresRepairQueueV0(ctx, env.Tx)
+	}).
+	Apply("work task queue notifications v0", func(ctx context.Context, env migrations.Env) error {
+		return work.SetupPostgresTaskQueueNotificationsV0(ctx, env.Tx)
 	})
@@ -111,23 +111,45 @@ func isValidBranch(name string) bool {
 	return err == nil
 }
 
+// CurrentProject is the project of the repos named on the command line without
+// a project. pachctl sets it from the active context.
+var CurrentProject string
+
+// ParseRepo takes an argument of the form "[project/]repo[.type]" and returns
+// the corresponding *pfs.Repo. Repos that don't name a project are in
+// CurrentProject.
+func ParseRepo(name string) *pfs.Repo {
+	return repoFromString(name)
+}
+
+// QualifyPipeline takes an argument of the form "[project/]pipeline" and
+// returns the pipeline's qualified name. Pipelines that don't name a project
+// are in CurrentProject.
+func QualifyPipeline(name string) string {
+	if project, _ := pfs.SplitProject(name); project == "" {
+		return pfs.QualifyName(CurrentProject, name)
+	}
+	return name
+}
+
 func repoFromString(name string) *pfs.Repo {
-	var repo pfs.Repo
+	repoType := pfs.UserRepoType
 	if strings.Contains(name, ".") {
 		repoParts := strings.SplitN(name, ".", 2)
-		repo.Name = repoParts[0]
-		repo.Type = repoParts[1]
-	} else {
-		repo.Name = name
-		repo.Type = pfs.UserRepoType
+		name = repoParts[0]
+		repoType = repoParts[1]
+	}
+	if project, _ := pfs.SplitProject(name); project == "" {
+		name = pfs.QualifyName(CurrentProject, name)
 	}
-	return &repo
+	return client.NewSystemRepo(name, repoType)
 }
 
 // Parses the following formats, any unspecified fields will be left as empty
 // strings in the pfs.File structure.  The second return value is the number of fields parsed -
 // (1: repo only, 2: repo and branch-or-commit, 3: repo, branch, and file).
 //   repo
+//   project/repo
 //   repo@branch
 //   repo@branch:path
 //   repo@branch=commit
@@ -214,6 +214,15 @@ func (c *postgresReadWriteCollection) GetByIndex(index *Index, indexVal string,
 	return c.getByIndex(context.Background(), c.tx, index, indexVal, val, opts, true, f)
 }
 
+func (c *postgresReadWriteCollection) List(val proto.Message, opts *Options, f func(string) error) error {
+	return c.postgresCollection.list(context.Background(), nil, opts, true, c.tx, func(m *model) error {
+		if err := proto.Unmarshal(m.Proto, val); err != nil {
+			return errors.EnsureStack(err)
+		}
+		return f(m.Key)
+	})
+}
+
 func orderToSQL(order etcd.SortOrder) (string, error) {
 	switch order {
 	case SortAscend:
@@ -247,12 +256,41 @@ func (c *postgresCollection) list(
 	query := fmt.Sprintf("select key, createdat, updatedat, proto from collections.%s", c.table)
 
 	params := map[string]interface{}{}
-	if len(withFields) > 0 {
-		fields := []string{}
-		for k, v := range withFields {
-			fields = append(fields, fmt.Sprintf("%s = :%s", k, k))
-			params[k] = v
+	fields := []string{}
+	for k, v := range withFields {
+		fields = append(fields, fmt.Sprintf("%s = :%s", k, k))
+		params[k] = v
+	}
+	if opts.After != "" {
+		if opts.Order == SortNone {
+			return errors.Errorf("listing after a key requires a sort order")
+		}
+		target, err := targetToSQL(opts.Target)
+		if err != nil {
+			return err
+		}
+		op := ">"
+		if opts.Order == SortDescend {
+			op = "<"
+		}
+		if target == "key" {
+			fields = append(fields, fmt.Sprintf("key %s :after", op))
+		} else {
+			// Seek past the row with the key After in (target, key) order, which
+			// breaks ties between rows with the same timestamp
+			after := &model{}
+			if err := sqlx.GetContext(ctx, q, after, fmt.Sprintf("select key, createdat, updatedat from collections.%s where key = $1", c.table), opts.After); err != nil {
+				return c.mapSQLError(err, opts.After)
+			}
+			fields = append(fields, fmt.Sprintf("(%s, key) %s (:after_target, :after)", target, op))
+			params["after_target"] = after.CreatedAt
+			if opts.Target == SortByModRevision {
+				params["after_target"] = after.UpdatedAt
+			}
 		}
+		params["after"] = opts.After
+	}
+	if len(fields) > 0 {
 		query += " where " + strings.Join(fields, " and ")
 	}
 
@@ -261,8 +299,10 @@ func (c *postgresCollection) list(
 			return err
 		} else if target, err := targetToSQL(opts.Target); err != nil {
 			return err
+		} else if target == "key" {
+			query += fmt.Sprintf(" order by key %s", order)
 		} else {
-			query += fmt.Sprintf(" order by %s %s", target, order)
+			query += fmt.Sprintf(" order by %s %s, key %s", target, order, order)
 		}
 	}
 
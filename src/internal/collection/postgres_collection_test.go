@@ -169,6 +169,56 @@ func TestPostgresCollections(suite *testing.T) {
 		})
 	})
 
+	suite.Run("ListAfter", func(subsuite *testing.T) {
+		subsuite.Parallel()
+		defaultRead, _ := initCollection(subsuite, newCollection)
+
+		listAfter := func(t *testing.T, opts *col.Options) []string {
+			keys := []string{}
+			testProto := &col.TestItem{}
+			require.NoError(t, defaultRead.List(testProto, opts, func(key string) error {
+				keys = append(keys, key)
+				return nil
+			}))
+			return keys
+		}
+
+		subsuite.Run("Ascend", func(t *testing.T) {
+			t.Parallel()
+			keys := listAfter(t, &col.Options{Target: col.SortByKey, Order: col.SortAscend, After: makeID(6)})
+			require.Equal(t, idRange(7, defaultCollectionSize), keys)
+		})
+
+		subsuite.Run("Descend", func(t *testing.T) {
+			t.Parallel()
+			keys := listAfter(t, &col.Options{Target: col.SortByKey, Order: col.SortDescend, After: makeID(3)})
+			require.Equal(t, []string{makeID(2), makeID(1), makeID(0)}, keys)
+		})
+
+		subsuite.Run("CreateRevision", func(t *testing.T) {
+			t.Parallel()
+			// Rows created at the same time are ordered by key
+			opts := col.DefaultOptions()
+			opts.After = makeID(3)
+			keys := listAfter(t, opts)
+			require.Equal(t, []string{makeID(2), makeID(1), makeID(0)}, keys)
+		})
+
+		subsuite.Run("RequiresOrder", func(t *testing.T) {
+			t.Parallel()
+			opts := &col.Options{Target: col.SortByKey, Order: col.SortNone, After: makeID(3)}
+			require.YesError(t, defaultRead.List(&col.TestItem{}, opts, func(string) error { return nil }))
+		})
+
+		subsuite.Run("MissingRow", func(t *testing.T) {
+			t.Parallel()
+			opts := col.DefaultOptions()
+			opts.After = "nonexistent"
+			err := defaultRead.List(&col.TestItem{}, opts, func(string) error { return nil })
+			require.True(t, col.IsErrNotFound(err), "unexpected error: %v", err)
+		})
+	})
+
 	// TODO: postgres-specific collection tests:
 	// GetRevByIndex(index *Index, indexVal string, val proto.Message, opts *Options, f func(int64) error) error
 	// DeleteByIndex(index *Index, indexVal string) error
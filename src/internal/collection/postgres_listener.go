@@ -252,6 +252,8 @@ func (pe *postgresEvent) WatchEvent(ctx context.Context, db *sqlx.DB, template p
 
 type watcherSet = map[*postgresWatcher]struct{}
 
+type notifierSet = map[chan struct{}]struct{}
+
 type PostgresListener struct {
 	dsn    string
 	pql    *pq.Listener
@@ -259,16 +261,18 @@ type PostgresListener struct {
 	eg     *errgroup.Group
 	closed bool
 
-	channels map[string]watcherSet
+	channels  map[string]watcherSet
+	notifiers map[string]notifierSet
 }
 
 func NewPostgresListener(dsn string) *PostgresListener {
 	eg, _ := errgroup.WithContext(context.Background())
 
 	l := &PostgresListener{
-		dsn:      dsn,
-		eg:       eg,
-		channels: make(map[string]watcherSet),
+		dsn:       dsn,
+		eg:        eg,
+		channels:  make(map[string]watcherSet),
+		notifiers: make(map[string]notifierSet),
 	}
 
 	return l
@@ -290,7 +294,7 @@ func (l *PostgresListener) Close() error {
 		return err
 	}
 
-	if len(l.channels) != 0 {
+	if len(l.channels) != 0 || len(l.notifiers) != 0 {
 		l.reset(errors.New("PostgresListener has been closed"))
 	}
 	return nil
@@ -309,8 +313,8 @@ func (l *PostgresListener) getPQL() *pq.Listener {
 	return l.pql
 }
 
-// reset will remove all watchers and unlisten from all channels - you must have
-// the lock on the listener's mutex before calling this.
+// reset will remove all watchers and notifiers and unlisten from all channels -
+// you must have the lock on the listener's mutex before calling this.
 func (l *PostgresListener) reset(err error) {
 	for _, watchers := range l.channels {
 		eventData := &postgresEvent{err: err}
@@ -318,8 +322,14 @@ func (l *PostgresListener) reset(err error) {
 			watcher.sendChange(eventData)
 		}
 	}
+	for _, notifiers := range l.notifiers {
+		for notifier := range notifiers {
+			close(notifier)
+		}
+	}
 
 	l.channels = make(map[string]watcherSet)
+	l.notifiers = make(map[string]notifierSet)
 	if !l.closed {
 		// `reset` is only ever called in the case of an error, so it should be fine to discard this error
 		l.getPQL().UnlistenAll()
@@ -336,24 +346,97 @@ func (l *PostgresListener) listen(db *sqlx.DB, sqlChannel string, template proto
 
 	pw := newPostgresWatch(db, l, sqlChannel, template, index, value, opts)
 
+	if err := l.listenChannel(sqlChannel); err != nil {
+		return nil, err
+	}
 	// Subscribe the watch to the given channel
 	watchers, ok := l.channels[sqlChannel]
 	if !ok {
 		watchers = make(watcherSet)
 		l.channels[sqlChannel] = watchers
-		if err := l.getPQL().Listen(sqlChannel); err != nil {
-			// If an error occurs, error out all watches and reset the state of the
-			// listener to prevent desyncs
-			err = errors.EnsureStack(err)
-			l.reset(err)
-			return nil, err
-		}
 	}
 	watchers[pw] = struct{}{}
 
 	return pw, nil
 }
 
+// Notify subscribes to a raw notification channel, for tables that send
+// notifications without a collection payload. A value is sent on the returned
+// channel whenever notifications arrive, coalescing notifications that arrive
+// before the previous one was received. The channel is closed if the
+// connection to the database is lost, since notifications may have been
+// missed. The returned function unsubscribes from the channel.
+func (l *PostgresListener) Notify(sqlChannel string) (<-chan struct{}, func(), error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return nil, nil, errors.New("PostgresListener has been closed")
+	}
+	if err := l.listenChannel(sqlChannel); err != nil {
+		return nil, nil, err
+	}
+	notifiers, ok := l.notifiers[sqlChannel]
+	if !ok {
+		notifiers = make(notifierSet)
+		l.notifiers[sqlChannel] = notifiers
+	}
+	notifier := make(chan struct{}, 1)
+	notifiers[notifier] = struct{}{}
+
+	var once sync.Once
+	return notifier, func() {
+		once.Do(func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			// The notifier is already gone if the listener was reset.
+			if _, ok := l.notifiers[sqlChannel][notifier]; !ok {
+				return
+			}
+			delete(l.notifiers[sqlChannel], notifier)
+			if len(l.notifiers[sqlChannel]) == 0 {
+				delete(l.notifiers, sqlChannel)
+			}
+			// An error resets the listener, which closes the other notifiers, so
+			// there is nothing left to report it to.
+			_ = l.unlistenChannel(sqlChannel)
+		})
+	}, nil
+}
+
+// listenChannel listens to the given channel if nothing is subscribed to it
+// yet - you must have the lock on the listener's mutex before calling this.
+func (l *PostgresListener) listenChannel(sqlChannel string) error {
+	if len(l.channels[sqlChannel]) > 0 || len(l.notifiers[sqlChannel]) > 0 {
+		return nil
+	}
+	if err := l.getPQL().Listen(sqlChannel); err != nil {
+		// If an error occurs, error out all watches and reset the state of the
+		// listener to prevent desyncs
+		err = errors.EnsureStack(err)
+		l.reset(err)
+		return err
+	}
+	return nil
+}
+
+// unlistenChannel unlistens from the given channel if nothing is subscribed to
+// it anymore - you must have the lock on the listener's mutex before calling
+// this.
+func (l *PostgresListener) unlistenChannel(sqlChannel string) error {
+	if len(l.channels[sqlChannel]) > 0 || len(l.notifiers[sqlChannel]) > 0 {
+		return nil
+	}
+	if err := l.getPQL().Unlisten(sqlChannel); err != nil {
+		// If an error occurs, error out all watches and reset the state of the
+		// listener to prevent desyncs
+		err = errors.EnsureStack(err)
+		l.reset(err)
+		return err
+	}
+	return nil
+}
+
 func (l *PostgresListener) unregister(pw *postgresWatcher) error {
 	l.mu.Lock()
 	defer l.mu.Unlock()
@@ -364,13 +447,7 @@ func (l *PostgresListener) unregister(pw *postgresWatcher) error {
 		delete(watchers, pw)
 		if len(watchers) == 0 {
 			delete(l.channels, pw.sqlChannel)
-			if err := l.getPQL().Unlisten(pw.sqlChannel); err != nil {
-				// If an error occurs, error out all watches and reset the state of the
-				// listener to prevent desyncs
-				err = errors.EnsureStack(err)
-				l.reset(err)
-				return err
-			}
+			return l.unlistenChannel(pw.sqlChannel)
 		}
 	}
 	return nil
@@ -406,4 +483,11 @@ func (l *PostgresListener) routeNotification(notification *pq.Notification) {
 			watcher.sendChange(eventData)
 		}
 	}
+	for notifier := range l.notifiers[notification.Channel] {
+		select {
+		case notifier <- struct{}{}:
+		default:
+			// A notification is already pending for this notifier.
+		}
+	}
 }
@@ -32,12 +32,17 @@ type SortOrder = etcd.SortOrder
 type Options struct {
 	Target SortTarget
 	Order  SortOrder
+	// After, if set, restricts a listing of a postgres collection to the rows
+	// after the row with the key After in the listing's order, to continue a
+	// listing that ended with that row. It requires a sort order, and the row
+	// must still exist.
+	After string
 }
 
 // DefaultOptions are the default sort options when iterating through etcd
 // key/values.
 func DefaultOptions() *Options {
-	return &Options{SortByCreateRevision, SortDescend}
+	return &Options{Target: SortByCreateRevision, Order: SortDescend}
 }
 
 func listFuncs(opts *Options) (func(*mvccpb.KeyValue) etcd.OpOption, func(kv1 *mvccpb.KeyValue, kv2 *mvccpb.KeyValue) int) {
@@ -69,6 +74,9 @@ func listFuncs(opts *Options) (func(*mvccpb.KeyValue) etcd.OpOption, func(kv1 *m
 }
 
 func listRevision(c *etcdReadOnlyCollection, prefix string, limitPtr *int64, opts *Options, f func(*mvccpb.KeyValue) error) error {
+	if opts.After != "" {
+		return errors.Errorf("etcd collections don't support listing after a key")
+	}
 	etcdOpts := []etcd.OpOption{etcd.WithPrefix(), etcd.WithSort(opts.Target, opts.Order)}
 	var fromKey *mvccpb.KeyValue
 	from, compare := listFuncs(opts)
@@ -92,6 +92,9 @@ type PostgresReadWriteCollection interface {
 	// GetByIndex can have a large impact on database contention if used to retrieve
 	// a large number of rows. Consider using a read-only collection if possible
 	GetByIndex(index *Index, indexVal string, val proto.Message, opts *Options, f func(string) error) error
+	// List reads every row of the collection inside the transaction, so it has
+	// the same caveat as GetByIndex
+	List(val proto.Message, opts *Options, f func(string) error) error
 
 	// Unsupported operations - only here during migration so we can compile
 	// TODO: remove these before merging into master
@@ -321,7 +321,10 @@ type Context struct {
 	ClusterDeploymentID string `protobuf:"bytes,10,opt,name=cluster_deployment_id,json=clusterDeploymentId,proto3" json:"cluster_deployment_id,omitempty"`
 	// A boolean that records whether the context points at an enterprise server.
 	// If false, the context points at a stand-alone pachd.
-	EnterpriseServer     bool     `protobuf:"varint,11,opt,name=enterprise_server,json=enterpriseServer,proto3" json:"enterprise_server,omitempty"`
+	EnterpriseServer bool `protobuf:"varint,11,opt,name=enterprise_server,json=enterpriseServer,proto3" json:"enterprise_server,omitempty"`
+	// The current project. Repos and pipelines that pachctl commands aren't
+	// given a project for belong to this project.
+	Project              string   `protobuf:"bytes,12,opt,name=project,proto3" json:"project,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -437,6 +440,13 @@ func (m *Context) GetEnterpriseServer() bool {
 	return false
 }
 
+func (m *Context) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("config.ContextSource", ContextSource_name, ContextSource_value)
 	proto.RegisterType((*Config)(nil), "config.Config")
@@ -450,52 +460,53 @@ func init() {
 func init() { proto.RegisterFile("internal/config/config.proto", fileDescriptor_4f3ceaeb67f76019) }
 
 var fileDescriptor_4f3ceaeb67f76019 = []byte{
-	// 720 bytes of a gzipped FileDescriptorProto
+	// 730 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x6e, 0xe2, 0x46,
 	0x14, 0xae, 0x6d, 0x02, 0xf8, 0x00, 0x09, 0x19, 0x12, 0xc5, 0x4d, 0x23, 0x42, 0x89, 0x22, 0xa1,
 	0xb6, 0x01, 0xe1, 0xaa, 0x52, 0x95, 0x9b, 0x2a, 0x18, 0xd2, 0xa2, 0xa6, 0x24, 0x72, 0x7e, 0x2e,
-	0x7a, 0x63, 0x39, 0xf6, 0x00, 0x56, 0xb0, 0xc7, 0x9d, 0x31, 0x34, 0x3c, 0xd6, 0x3e, 0xc4, 0x4a,
-	0xb9, 0xdc, 0x27, 0x88, 0x56, 0x3c, 0xc9, 0xca, 0x33, 0xe6, 0x27, 0x24, 0xab, 0xdd, 0xbd, 0xf2,
-	0xf1, 0xf7, 0x7d, 0x67, 0xce, 0xcf, 0x7c, 0x1a, 0x38, 0xf0, 0x82, 0x08, 0xd3, 0xc0, 0x1e, 0x35,
-	0x1c, 0x12, 0xf4, 0xbd, 0x41, 0xf2, 0xa9, 0x87, 0x94, 0x44, 0x04, 0xa5, 0xc5, 0xdf, 0xfe, 0xce,
-	0x80, 0x0c, 0x08, 0x87, 0x1a, 0x71, 0x24, 0xd8, 0xea, 0x7f, 0x90, 0x36, 0x38, 0x8f, 0x8e, 0x20,
-	0x33, 0x66, 0x98, 0x5a, 0x9e, 0xab, 0x49, 0x15, 0xa9, 0xa6, 0xb6, 0x60, 0xf6, 0x7c, 0x98, 0xbe,
-	0x65, 0x98, 0x76, 0xdb, 0x66, 0x3a, 0xa6, 0xba, 0x2e, 0xaa, 0x80, 0x3c, 0x69, 0x6a, 0x72, 0x45,
-	0xaa, 0xe5, 0xf4, 0x62, 0x3d, 0xa9, 0x23, 0x0e, 0xb8, 0x6b, 0x9a, 0xf2, 0xa4, 0xc9, 0x15, 0xba,
-	0xa6, 0xbc, 0xa9, 0xd0, 0x4d, 0x79, 0xa2, 0x57, 0xdf, 0x49, 0x90, 0x9d, 0xa7, 0xa0, 0x23, 0x28,
-	0x84, 0xb6, 0x33, 0x74, 0x2d, 0xdb, 0x75, 0x29, 0x66, 0x4c, 0xd4, 0x36, 0xf3, 0x1c, 0x3c, 0x13,
-	0x18, 0xfa, 0x05, 0x80, 0x61, 0x3a, 0xc1, 0xd4, 0x72, 0x6c, 0xc6, 0xab, 0xab, 0xad, 0xc2, 0xec,
-	0xf9, 0x50, 0xbd, 0xe6, 0xa8, 0x71, 0xc6, 0x4c, 0x55, 0x08, 0x0c, 0x9b, 0xc5, 0x47, 0x32, 0xcc,
-	0x98, 0x47, 0x02, 0x2b, 0x22, 0x0f, 0x38, 0xe0, 0xcd, 0xa8, 0x66, 0x3e, 0x01, 0x6f, 0x62, 0x0c,
-	0x9d, 0x00, 0xb2, 0x9d, 0xc8, 0x9b, 0x60, 0x2b, 0xa2, 0x76, 0xc0, 0xe2, 0x98, 0x04, 0x5a, 0x8a,
-	0x2b, 0xb7, 0x05, 0x73, 0xb3, 0x24, 0xaa, 0xef, 0xe5, 0x45, 0xcf, 0x3a, 0x3a, 0x86, 0xcd, 0x24,
-	0xd7, 0x21, 0x41, 0x84, 0x1f, 0xa3, 0xa4, 0xe9, 0x82, 0x40, 0x0d, 0x01, 0xa2, 0x53, 0xf8, 0x3e,
-	0x91, 0xe1, 0xf8, 0x7e, 0x42, 0xea, 0xb1, 0x65, 0x06, 0x1f, 0xc2, 0xdc, 0x13, 0x82, 0xce, 0x82,
-	0x5f, 0xe6, 0x66, 0x13, 0x25, 0xd3, 0x94, 0x8a, 0x52, 0xcb, 0xe9, 0xe5, 0xf5, 0x5d, 0xd6, 0x13,
-	0x2d, 0xeb, 0x04, 0x11, 0x9d, 0x9a, 0x0b, 0x3d, 0xd2, 0x20, 0xe3, 0xe3, 0x88, 0x7a, 0x0e, 0xe3,
-	0xf3, 0x64, 0xcd, 0xf9, 0x2f, 0xd2, 0x61, 0xd7, 0xb7, 0x1f, 0x2d, 0x36, 0xc4, 0xa3, 0x91, 0xe5,
-	0x10, 0x3f, 0x1c, 0xe1, 0x78, 0x3a, 0xa6, 0x6d, 0x54, 0xa4, 0x9a, 0x62, 0x96, 0x7c, 0xfb, 0xf1,
-	0x3a, 0xe6, 0x8c, 0x25, 0xb5, 0x7f, 0x01, 0x85, 0x17, 0x85, 0x50, 0x11, 0x94, 0x07, 0x3c, 0x4d,
-	0x46, 0x8e, 0x43, 0x74, 0x0c, 0x1b, 0x13, 0x7b, 0x34, 0xc6, 0x89, 0x2f, 0xb6, 0x56, 0x3a, 0x8d,
-	0xf3, 0x4c, 0xc1, 0x9e, 0xca, 0xbf, 0x4b, 0xd5, 0xa7, 0x14, 0x64, 0xe6, 0x33, 0x9e, 0x40, 0x9a,
-	0x91, 0x31, 0x75, 0x30, 0x3f, 0x6b, 0x53, 0xdf, 0x5d, 0xcb, 0xbb, 0xe6, 0xa4, 0x99, 0x88, 0x5e,
-	0x3b, 0x45, 0xfe, 0xa2, 0x53, 0x94, 0x6f, 0x75, 0x4a, 0xea, 0xab, 0x9d, 0xb2, 0xf1, 0x19, 0xa7,
-	0xa0, 0x1f, 0x21, 0xef, 0x8c, 0xc6, 0x2c, 0xc2, 0xd4, 0x0a, 0x6c, 0x1f, 0x6b, 0x69, 0x2e, 0xcc,
-	0x25, 0x58, 0xcf, 0xf6, 0x31, 0xfa, 0x01, 0x54, 0x7b, 0x1c, 0x0d, 0x2d, 0x2f, 0xe8, 0x13, 0x2d,
-	0xc3, 0xf9, 0x6c, 0x0c, 0x74, 0x83, 0x3e, 0x41, 0x07, 0xa0, 0xc6, 0x79, 0x2c, 0xb4, 0x1d, 0xac,
-	0x65, 0x39, 0xb9, 0x04, 0xd0, 0x05, 0x6c, 0x85, 0x84, 0x46, 0x56, 0x9f, 0xd0, 0xff, 0x6d, 0xea,
-	0x62, 0xca, 0x34, 0x95, 0xdb, 0xe3, 0x68, 0x6d, 0x79, 0xf5, 0x2b, 0x42, 0xa3, 0xf3, 0x85, 0x4a,
-	0x78, 0x64, 0x33, 0x7c, 0x01, 0xa2, 0xbf, 0x61, 0x77, 0xde, 0xab, 0x8b, 0xc3, 0x11, 0x99, 0xfa,
-	0x38, 0x88, 0xe2, 0x07, 0x00, 0xf8, 0xe2, 0xf6, 0x66, 0xcf, 0x87, 0x25, 0x43, 0x08, 0xda, 0x0b,
-	0xbe, 0xdb, 0x36, 0x4b, 0xce, 0x2b, 0xd0, 0x45, 0x3f, 0xc3, 0xf6, 0x8a, 0xcf, 0xc5, 0x92, 0xb5,
-	0x1c, 0x37, 0x60, 0x71, 0x49, 0x88, 0x7b, 0xd8, 0x3f, 0x83, 0xd2, 0x1b, 0x0d, 0xbe, 0xe1, 0xad,
-	0x9d, 0x55, 0x6f, 0x15, 0x56, 0xac, 0xf4, 0xd3, 0x1f, 0x0b, 0x63, 0x0a, 0xa3, 0xa0, 0x2c, 0xa4,
-	0x7a, 0x97, 0xbd, 0x4e, 0xf1, 0x3b, 0x54, 0x00, 0xd5, 0xb8, 0xec, 0x9d, 0x77, 0xff, 0xb4, 0xee,
-	0x9a, 0x45, 0x09, 0x65, 0x40, 0xf9, 0xeb, 0xb6, 0x55, 0x94, 0x51, 0x1e, 0xb2, 0xdd, 0x7f, 0xae,
-	0x2e, 0xcd, 0x9b, 0x4e, 0xbb, 0xa8, 0xb4, 0x8c, 0xa7, 0x59, 0x59, 0xfa, 0x30, 0x2b, 0x4b, 0x1f,
-	0x67, 0x65, 0xe9, 0xdf, 0xdf, 0x06, 0x5e, 0x34, 0x1c, 0xdf, 0xd7, 0x1d, 0xe2, 0x37, 0x62, 0x4b,
-	0x4d, 0x5d, 0x4c, 0x57, 0xa3, 0x89, 0xde, 0x60, 0xd4, 0x69, 0xac, 0x3d, 0xb5, 0xf7, 0x69, 0xfe,
-	0x8c, 0xfe, 0xfa, 0x29, 0x00, 0x00, 0xff, 0xff, 0x81, 0xf1, 0xd5, 0xd0, 0x84, 0x05, 0x00, 0x00,
+	0x7a, 0x63, 0x39, 0xf6, 0x00, 0x6e, 0xb0, 0xc7, 0x9d, 0x19, 0xd8, 0xf0, 0x58, 0xfb, 0x10, 0x2b,
+	0xed, 0xe5, 0x3e, 0x41, 0xb4, 0xe2, 0x49, 0x56, 0x1e, 0x9b, 0x9f, 0x90, 0xac, 0x76, 0xf7, 0xca,
+	0x67, 0xbe, 0xef, 0x3b, 0x73, 0x7e, 0xfc, 0x69, 0xe0, 0xc0, 0x0b, 0x38, 0xa6, 0x81, 0x3d, 0x6a,
+	0x38, 0x24, 0xe8, 0x7b, 0x83, 0xe4, 0x53, 0x0f, 0x29, 0xe1, 0x04, 0xa5, 0xe3, 0xd3, 0xfe, 0xce,
+	0x80, 0x0c, 0x88, 0x80, 0x1a, 0x51, 0x14, 0xb3, 0xd5, 0xff, 0x21, 0x6d, 0x08, 0x1e, 0x1d, 0x41,
+	0x66, 0xcc, 0x30, 0xb5, 0x3c, 0x57, 0x93, 0x2a, 0x52, 0x4d, 0x6d, 0xc1, 0xec, 0xe9, 0x30, 0x7d,
+	0xcb, 0x30, 0xed, 0xb6, 0xcd, 0x74, 0x44, 0x75, 0x5d, 0x54, 0x01, 0x79, 0xd2, 0xd4, 0xe4, 0x8a,
+	0x54, 0xcb, 0xe9, 0xc5, 0x7a, 0x52, 0x27, 0xbe, 0xe0, 0xae, 0x69, 0xca, 0x93, 0xa6, 0x50, 0xe8,
+	0x9a, 0xf2, 0xaa, 0x42, 0x37, 0xe5, 0x89, 0x5e, 0x7d, 0x2b, 0x41, 0x76, 0x9e, 0x82, 0x8e, 0xa0,
+	0x10, 0xda, 0xce, 0xd0, 0xb5, 0x6c, 0xd7, 0xa5, 0x98, 0xb1, 0xb8, 0xb6, 0x99, 0x17, 0xe0, 0x59,
+	0x8c, 0xa1, 0x5f, 0x00, 0x18, 0xa6, 0x13, 0x4c, 0x2d, 0xc7, 0x66, 0xa2, 0xba, 0xda, 0x2a, 0xcc,
+	0x9e, 0x0e, 0xd5, 0x6b, 0x81, 0x1a, 0x67, 0xcc, 0x54, 0x63, 0x81, 0x61, 0xb3, 0xe8, 0x4a, 0x86,
+	0x19, 0xf3, 0x48, 0x60, 0x71, 0xf2, 0x80, 0x03, 0xd1, 0x8c, 0x6a, 0xe6, 0x13, 0xf0, 0x26, 0xc2,
+	0xd0, 0x09, 0x20, 0xdb, 0xe1, 0xde, 0x04, 0x5b, 0x9c, 0xda, 0x01, 0x8b, 0x62, 0x12, 0x68, 0x29,
+	0xa1, 0xdc, 0x8e, 0x99, 0x9b, 0x25, 0x51, 0x7d, 0x27, 0x2f, 0x7a, 0xd6, 0xd1, 0x31, 0x6c, 0x26,
+	0xb9, 0x0e, 0x09, 0x38, 0x7e, 0xe4, 0x49, 0xd3, 0x85, 0x18, 0x35, 0x62, 0x10, 0x9d, 0xc2, 0xf7,
+	0x89, 0x0c, 0x47, 0xff, 0x27, 0xa4, 0x1e, 0x5b, 0x66, 0x88, 0x21, 0xcc, 0xbd, 0x58, 0xd0, 0x59,
+	0xf0, 0xcb, 0xdc, 0x6c, 0xa2, 0x64, 0x9a, 0x52, 0x51, 0x6a, 0x39, 0xbd, 0xbc, 0xbe, 0xcb, 0x7a,
+	0xa2, 0x65, 0x9d, 0x80, 0xd3, 0xa9, 0xb9, 0xd0, 0x23, 0x0d, 0x32, 0x3e, 0xe6, 0xd4, 0x73, 0x98,
+	0x98, 0x27, 0x6b, 0xce, 0x8f, 0x48, 0x87, 0x5d, 0xdf, 0x7e, 0xb4, 0xd8, 0x10, 0x8f, 0x46, 0x96,
+	0x43, 0xfc, 0x70, 0x84, 0xa3, 0xe9, 0x98, 0xb6, 0x51, 0x91, 0x6a, 0x8a, 0x59, 0xf2, 0xed, 0xc7,
+	0xeb, 0x88, 0x33, 0x96, 0xd4, 0xfe, 0x05, 0x14, 0x9e, 0x15, 0x42, 0x45, 0x50, 0x1e, 0xf0, 0x34,
+	0x19, 0x39, 0x0a, 0xd1, 0x31, 0x6c, 0x4c, 0xec, 0xd1, 0x18, 0x27, 0xbe, 0xd8, 0x5a, 0xe9, 0x34,
+	0xca, 0x33, 0x63, 0xf6, 0x54, 0xfe, 0x5d, 0xaa, 0xce, 0x52, 0x90, 0x99, 0xcf, 0x78, 0x02, 0x69,
+	0x46, 0xc6, 0xd4, 0xc1, 0xe2, 0xae, 0x4d, 0x7d, 0x77, 0x2d, 0xef, 0x5a, 0x90, 0x66, 0x22, 0x7a,
+	0xe9, 0x14, 0xf9, 0x8b, 0x4e, 0x51, 0xbe, 0xd5, 0x29, 0xa9, 0xaf, 0x76, 0xca, 0xc6, 0x67, 0x9c,
+	0x82, 0x7e, 0x84, 0xbc, 0x33, 0x1a, 0x33, 0x8e, 0xa9, 0x15, 0xd8, 0x3e, 0xd6, 0xd2, 0x42, 0x98,
+	0x4b, 0xb0, 0x9e, 0xed, 0x63, 0xf4, 0x03, 0xa8, 0xf6, 0x98, 0x0f, 0x2d, 0x2f, 0xe8, 0x13, 0x2d,
+	0x23, 0xf8, 0x6c, 0x04, 0x74, 0x83, 0x3e, 0x41, 0x07, 0xa0, 0x46, 0x79, 0x2c, 0xb4, 0x1d, 0xac,
+	0x65, 0x05, 0xb9, 0x04, 0xd0, 0x05, 0x6c, 0x85, 0x84, 0x72, 0xab, 0x4f, 0xe8, 0x1b, 0x9b, 0xba,
+	0x98, 0x32, 0x4d, 0x15, 0xf6, 0x38, 0x5a, 0x5b, 0x5e, 0xfd, 0x8a, 0x50, 0x7e, 0xbe, 0x50, 0xc5,
+	0x1e, 0xd9, 0x0c, 0x9f, 0x81, 0xe8, 0x6f, 0xd8, 0x9d, 0xf7, 0xea, 0xe2, 0x70, 0x44, 0xa6, 0x3e,
+	0x0e, 0x78, 0xf4, 0x00, 0x80, 0x58, 0xdc, 0xde, 0xec, 0xe9, 0xb0, 0x64, 0xc4, 0x82, 0xf6, 0x82,
+	0xef, 0xb6, 0xcd, 0x92, 0xf3, 0x02, 0x74, 0xd1, 0xcf, 0xb0, 0xbd, 0xe2, 0xf3, 0x78, 0xc9, 0x5a,
+	0x4e, 0x18, 0xb0, 0xb8, 0x24, 0xe2, 0xff, 0x10, 0x79, 0x34, 0xa4, 0xe4, 0x3f, 0xec, 0x70, 0x2d,
+	0x2f, 0x66, 0x9c, 0x1f, 0xf7, 0xcf, 0xa0, 0xf4, 0x4a, 0xeb, 0xaf, 0xb8, 0x6e, 0x67, 0xd5, 0x75,
+	0x85, 0x15, 0x93, 0xfd, 0xf4, 0x07, 0x14, 0x9e, 0x59, 0x08, 0x65, 0x21, 0xd5, 0xbb, 0xec, 0x75,
+	0x8a, 0xdf, 0xa1, 0x02, 0xa8, 0xc6, 0x65, 0xef, 0xbc, 0xfb, 0xa7, 0x75, 0xd7, 0x2c, 0x4a, 0x28,
+	0x03, 0xca, 0x5f, 0xb7, 0xad, 0xa2, 0x8c, 0xf2, 0x90, 0xed, 0xfe, 0x73, 0x75, 0x69, 0xde, 0x74,
+	0xda, 0x45, 0xa5, 0x65, 0xbc, 0x9f, 0x95, 0xa5, 0x0f, 0xb3, 0xb2, 0xf4, 0x71, 0x56, 0x96, 0xfe,
+	0xfd, 0x6d, 0xe0, 0xf1, 0xe1, 0xf8, 0xbe, 0xee, 0x10, 0xbf, 0x11, 0x99, 0x6d, 0xea, 0x62, 0xba,
+	0x1a, 0x4d, 0xf4, 0x06, 0xa3, 0x4e, 0x63, 0xed, 0x11, 0xbe, 0x4f, 0x8b, 0x07, 0xf6, 0xd7, 0x4f,
+	0x03, 0x00, 0x0f, 0xea, 0xbb, 0x26, 0x9e, 0x05, 0x00, 0x00,
 }
 
 func (m *Config) Marshal() (dAtA []byte, err error) {
@@ -717,6 +728,13 @@ func (m *Context) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Project) > 0 {
+		i -= len(m.Project)
+		copy(dAtA[i:], m.Project)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.Project)))
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.EnterpriseServer {
 		i--
 		if m.EnterpriseServer {
@@ -962,6 +980,10 @@ func (m *Context) Size() (n int) {
 	if m.EnterpriseServer {
 		n += 2
 	}
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2028,6 +2050,38 @@ func (m *Context) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnterpriseServer = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfig(dAtA[iNdEx:])
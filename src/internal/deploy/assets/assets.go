@@ -130,6 +130,20 @@ const (
 
 	// PutFileConcurrencyLimitEnvVar is the environment variable for the PutFile concurrency limit.
 	PutFileConcurrencyLimitEnvVar = "STORAGE_PUT_FILE_CONCURRENCY_LIMIT"
+
+	// ChunkCacheBytesEnvVar is the environment variable for the size of the chunk cache.
+	ChunkCacheBytesEnvVar = "STORAGE_CHUNK_CACHE_BYTES"
+
+	// ColdTierURLEnvVar is the environment variable for the object store URL
+	// of the cold storage tier.
+	ColdTierURLEnvVar = "STORAGE_COLD_TIER_URL"
+
+	// MirrorURLEnvVar is the environment variable for the object store URL
+	// that chunks are mirrored to.
+	MirrorURLEnvVar = "STORAGE_MIRROR_URL"
+
+	// MirrorModeEnvVar is the environment variable for the mirroring mode.
+	MirrorModeEnvVar = "STORAGE_MIRROR_MODE"
 )
 
 const (
@@ -1015,6 +1029,7 @@ func amazonBasicSecret(region, bucket, distribution string, advancedConfig *obj.
 		"upload-acl":          []byte(advancedConfig.UploadACL),
 		"part-size":           []byte(strconv.FormatInt(advancedConfig.PartSize, 10)),
 		"max-upload-parts":    []byte(strconv.Itoa(advancedConfig.MaxUploadParts)),
+		"upload-concurrency":  []byte(strconv.Itoa(advancedConfig.UploadConcurrency)),
 		"disable-ssl":         []byte(strconv.FormatBool(advancedConfig.DisableSSL)),
 		"no-verify-ssl":       []byte(strconv.FormatBool(advancedConfig.NoVerifySSL)),
 		"log-options":         []byte(advancedConfig.LogOptions),
@@ -355,6 +355,7 @@ func standardDeployCmds() []*cobra.Command {
 	var uploadACL string
 	var partSize int64
 	var maxUploadParts int
+	var uploadConcurrency int
 	var disableSSL bool
 	var noVerifySSL bool
 	var logOptions string
@@ -364,6 +365,7 @@ func standardDeployCmds() []*cobra.Command {
 		cmd.Flags().StringVar(&uploadACL, "upload-acl", obj.DefaultUploadACL, "(rarely set) Set a custom upload ACL for object storage uploads.")
 		cmd.Flags().Int64Var(&partSize, "part-size", obj.DefaultPartSize, "(rarely set) Set a custom part size for object storage uploads.")
 		cmd.Flags().IntVar(&maxUploadParts, "max-upload-parts", obj.DefaultMaxUploadParts, "(rarely set) Set a custom maximum number of upload parts.")
+		cmd.Flags().IntVar(&uploadConcurrency, "upload-concurrency", obj.DefaultUploadConcurrency, "(rarely set) Set a custom number of parts to upload concurrently.")
 		cmd.Flags().BoolVar(&disableSSL, "disable-ssl", obj.DefaultDisableSSL, "(rarely set) Disable SSL.")
 		cmd.Flags().BoolVar(&noVerifySSL, "no-verify-ssl", obj.DefaultNoVerifySSL, "(rarely set) Skip SSL certificate verification (typically used for enabling self-signed certificates).")
 		cmd.Flags().StringVar(&logOptions, "obj-log-options", obj.DefaultAwsLogOptions, "(rarely set) Enable verbose logging in Pachyderm's internal S3 client for debugging. Comma-separated list containing zero or more of: 'Debug', 'Signing', 'HTTPBody', 'RequestRetries', 'RequestErrors', 'EventStreamBody', or 'all' (case-insensitive). See 'AWS SDK for Go' docs for details.")
@@ -627,14 +629,15 @@ If <object store backend> is \"s3\", then the arguments are:
 			}()
 			// Setup advanced configuration.
 			advancedConfig := &obj.AmazonAdvancedConfiguration{
-				Retries:        retries,
-				Timeout:        timeout,
-				UploadACL:      uploadACL,
-				PartSize:       partSize,
-				MaxUploadParts: maxUploadParts,
-				DisableSSL:     disableSSL,
-				NoVerifySSL:    noVerifySSL,
-				LogOptions:     logOptions,
+				Retries:           retries,
+				Timeout:           timeout,
+				UploadACL:         uploadACL,
+				PartSize:          partSize,
+				MaxUploadParts:    maxUploadParts,
+				UploadConcurrency: uploadConcurrency,
+				DisableSSL:        disableSSL,
+				NoVerifySSL:       noVerifySSL,
+				LogOptions:        logOptions,
 			}
 			if isS3V2 {
 				fmt.Printf("DEPRECATED: Support for the S3V2 option is being deprecated. It will be removed in a future version\n\n")
@@ -777,14 +780,15 @@ If <object store backend> is \"s3\", then the arguments are:
 			}
 			// Setup advanced configuration.
 			advancedConfig := &obj.AmazonAdvancedConfiguration{
-				Retries:        retries,
-				Timeout:        timeout,
-				UploadACL:      uploadACL,
-				PartSize:       partSize,
-				MaxUploadParts: maxUploadParts,
-				DisableSSL:     disableSSL,
-				NoVerifySSL:    noVerifySSL,
-				LogOptions:     logOptions,
+				Retries:           retries,
+				Timeout:           timeout,
+				UploadACL:         uploadACL,
+				PartSize:          partSize,
+				MaxUploadParts:    maxUploadParts,
+				UploadConcurrency: uploadConcurrency,
+				DisableSSL:        disableSSL,
+				NoVerifySSL:       noVerifySSL,
+				LogOptions:        logOptions,
 			}
 			// Generate manifest and write assets.
 			var buf bytes.Buffer
@@ -930,14 +934,15 @@ If <object store backend> is \"s3\", then the arguments are:
 			}
 			// Setup advanced configuration.
 			advancedConfig := &obj.AmazonAdvancedConfiguration{
-				Retries:        retries,
-				Timeout:        timeout,
-				UploadACL:      uploadACL,
-				PartSize:       partSize,
-				MaxUploadParts: maxUploadParts,
-				DisableSSL:     disableSSL,
-				NoVerifySSL:    noVerifySSL,
-				LogOptions:     logOptions,
+				Retries:           retries,
+				Timeout:           timeout,
+				UploadACL:         uploadACL,
+				PartSize:          partSize,
+				MaxUploadParts:    maxUploadParts,
+				UploadConcurrency: uploadConcurrency,
+				DisableSSL:        disableSSL,
+				NoVerifySSL:       noVerifySSL,
+				LogOptions:        logOptions,
 			}
 			return deployStorageSecrets(assets.AmazonSecret(args[0], "", args[1], args[2], token, "", "", advancedConfig))
 		}),
@@ -0,0 +1,73 @@
+package dlock
+
+import (
+	"context"
+	"database/sql"
+	"hash/fnv"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+// postgresPingInterval is how often the connection holding a lock is checked.
+// The lock is lost if the connection is.
+const postgresPingInterval = 5 * time.Second
+
+type postgresImpl struct {
+	db  *sqlx.DB
+	key int64
+
+	conn   *sql.Conn
+	cancel context.CancelFunc
+}
+
+// NewPostgresDLock creates a distributed lock on the given prefix, which is
+// held as a Postgres session level advisory lock.
+func NewPostgresDLock(db *sqlx.DB, prefix string) DLock {
+	h := fnv.New64a()
+	h.Write([]byte(prefix))
+	return &postgresImpl{
+		db:  db,
+		key: int64(h.Sum64()),
+	}
+}
+
+func (d *postgresImpl) Lock(ctx context.Context) (context.Context, error) {
+	// Advisory locks belong to a session, so the lock needs its own
+	// connection for as long as it is held.
+	conn, err := d.db.Conn(ctx)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, d.key); err != nil {
+		conn.Close()
+		return nil, errors.EnsureStack(err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		defer cancel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(postgresPingInterval):
+			}
+			if err := conn.PingContext(ctx); err != nil {
+				return
+			}
+		}
+	}()
+
+	d.conn = conn
+	d.cancel = cancel
+	return ctx, nil
+}
+
+func (d *postgresImpl) Unlock(ctx context.Context) error {
+	d.cancel()
+	defer d.conn.Close()
+	_, err := d.conn.ExecContext(ctx, `SELECT pg_advisory_unlock($1)`, d.key)
+	return errors.EnsureStack(err)
+}
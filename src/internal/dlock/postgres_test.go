@@ -0,0 +1,40 @@
+package dlock_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testutil"
+)
+
+func TestPostgresDLock(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	ctx := context.Background()
+	lock1 := dlock.NewPostgresDLock(db, "prefix")
+	lock2 := dlock.NewPostgresDLock(db, "prefix")
+	other := dlock.NewPostgresDLock(db, "other-prefix")
+
+	lockCtx, err := lock1.Lock(ctx)
+	require.NoError(t, err)
+	// The lock is held, so a second lock on the same prefix blocks until its
+	// context is done.
+	timeoutCtx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	_, err = lock2.Lock(timeoutCtx)
+	require.YesError(t, err)
+	// Locks on other prefixes are independent.
+	otherCtx, err := other.Lock(ctx)
+	require.NoError(t, err)
+	require.NoError(t, other.Unlock(otherCtx))
+
+	// Once the lock is released, the second lock acquires it, and the first
+	// lock's context is canceled.
+	require.NoError(t, lock1.Unlock(lockCtx))
+	require.YesError(t, lockCtx.Err())
+	lockCtx, err = lock2.Lock(ctx)
+	require.NoError(t, err)
+	require.NoError(t, lock2.Unlock(lockCtx))
+}
@@ -3,6 +3,7 @@ package migrations
 import (
 	"context"
 	"database/sql"
+	"sort"
 	"strings"
 	"time"
 
@@ -37,6 +38,7 @@ type State struct {
 	n      int
 	prev   *State
 	change Func
+	down   Func
 	name   string
 }
 
@@ -50,6 +52,13 @@ func (s State) Apply(name string, fn Func) State {
 	}
 }
 
+// WithDown sets the function that reverts the last change applied to the
+// state. Only migrations with a down function can be rolled back.
+func (s State) WithDown(fn Func) State {
+	s.down = fn
+	return s
+}
+
 // Name returns the name of the state
 func (s State) Name() string {
 	return s.name
@@ -150,21 +159,13 @@ func applyMigration(ctx context.Context, db *sqlx.DB, baseEnv Env, state State)
 // by calling ApplyMigrations.
 // If the cluster ever enters a state newer than the state passed to BlockUntil, it errors.
 func BlockUntil(ctx context.Context, db *sqlx.DB, state State) error {
-	const (
-		schemaName = "public"
-		tableName  = "migrations"
-	)
 	// poll database until this state is registered
 	ticker := time.NewTicker(time.Second)
 	defer ticker.Stop()
 	for {
-		var tableExists bool
-		if err := db.GetContext(ctx, &tableExists, `SELECT EXISTS (
-			SELECT FROM information_schema.tables
-			WHERE table_schema = $1
-			AND table_name = $2
-		)`, schemaName, tableName); err != nil {
-			return errors.EnsureStack(err)
+		tableExists, err := migrationsTableExists(ctx, db)
+		if err != nil {
+			return err
 		}
 		if tableExists {
 			var latest int
@@ -202,3 +203,212 @@ func isFinished(ctx context.Context, tx *sqlx.Tx, state State) (bool, error) {
 	}
 	return true, nil
 }
+
+func migrationsTableExists(ctx context.Context, q sqlx.QueryerContext) (bool, error) {
+	const (
+		schemaName = "public"
+		tableName  = "migrations"
+	)
+	var exists bool
+	if err := sqlx.GetContext(ctx, q, &exists, `SELECT EXISTS (
+		SELECT FROM information_schema.tables
+		WHERE table_schema = $1
+		AND table_name = $2
+	)`, schemaName, tableName); err != nil {
+		return false, errors.EnsureStack(err)
+	}
+	return exists, nil
+}
+
+// Info describes a migration and whether it has been applied.
+type Info struct {
+	Number int
+	Name   string
+	// StartTime and EndTime are nil if the migration hasn't been applied.
+	StartTime *time.Time
+	EndTime   *time.Time
+	Applied   bool
+	// Reversible is true if the migration has a down function.
+	Reversible bool
+}
+
+type migrationRow struct {
+	ID        int        `db:"id"`
+	Name      string     `db:"name"`
+	StartTime *time.Time `db:"start_time"`
+	EndTime   *time.Time `db:"end_time"`
+}
+
+// Status returns the migrations leading to state, and whether each of them has
+// been applied to db. Migrations that have been applied to db but are newer
+// than state (e.g. by a newer version of pachd) are included at the end.
+func Status(ctx context.Context, db *sqlx.DB, state State) ([]Info, error) {
+	exists, err := migrationsTableExists(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	applied := make(map[int]migrationRow)
+	if exists {
+		var rows []migrationRow
+		if err := db.SelectContext(ctx, &rows, `SELECT id, name, start_time, end_time FROM migrations`); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		for _, row := range rows {
+			applied[row.ID] = row
+		}
+	}
+	var infos []Info
+	for _, state := range collectStates(make([]State, 0, state.n+1), state) {
+		info := Info{
+			Number:     state.n,
+			Name:       state.name,
+			Reversible: state.down != nil,
+		}
+		if row, ok := applied[state.n]; ok {
+			if row.Name != state.name {
+				return nil, errors.Errorf("migration mismatch %d HAVE: %s WANT: %s", state.n, row.Name, state.name)
+			}
+			info.StartTime, info.EndTime, info.Applied = row.StartTime, row.EndTime, true
+			delete(applied, state.n)
+		}
+		infos = append(infos, info)
+	}
+	var newer []Info
+	for _, row := range applied {
+		newer = append(newer, Info{
+			Number:    row.ID,
+			Name:      row.Name,
+			StartTime: row.StartTime,
+			EndTime:   row.EndTime,
+			Applied:   true,
+		})
+	}
+	sort.Slice(newer, func(i, j int) bool { return newer[i].Number < newer[j].Number })
+	return append(infos, newer...), nil
+}
+
+// DryRun applies the migrations leading to state that haven't been applied to
+// db yet, and then rolls them back. It returns the migrations that would be
+// applied, or the error from the first one that fails.
+// All the migrations run in a single transaction with the migrations table
+// locked, so they hold the same locks they would during ApplyMigrations until
+// DryRun returns. Changes made outside of env.Tx (e.g. to objects) are not
+// rolled back.
+func DryRun(ctx context.Context, db *sqlx.DB, baseEnv Env, state State) ([]Info, error) {
+	tx, err := db.BeginTxx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			logrus.Error(err)
+		}
+	}()
+	env := baseEnv
+	env.Tx = tx
+	exists, err := migrationsTableExists(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	var pending []Info
+	for _, state := range collectStates(make([]State, 0, state.n+1), state) {
+		if state.n == 0 {
+			if !exists {
+				pending = append(pending, Info{Number: state.n, Name: state.name})
+			}
+			if err := state.change(ctx, env); err != nil {
+				return nil, errors.Wrapf(err, "migration %d %s", state.n, state.name)
+			}
+			if _, err := tx.ExecContext(ctx, `LOCK TABLE migrations IN EXCLUSIVE MODE`); err != nil {
+				return nil, errors.EnsureStack(err)
+			}
+			continue
+		}
+		if finished, err := isFinished(ctx, tx, state); err != nil {
+			return nil, err
+		} else if finished {
+			continue
+		}
+		logrus.Infof("dry-running migration %d %s", state.n, state.name)
+		if err := state.change(ctx, env); err != nil {
+			return nil, errors.Wrapf(err, "migration %d %s", state.n, state.name)
+		}
+		pending = append(pending, Info{Number: state.n, Name: state.name, Reversible: state.down != nil})
+	}
+	return pending, nil
+}
+
+// Rollback reverts the migrations applied to db after migration number to, by
+// running their down functions from newest to oldest, and returns the
+// migrations that were reverted.
+// The migrations are reverted in a single transaction, and only if all of them
+// have a down function. It errors if db is newer than state, since the down
+// functions for the newer migrations aren't known.
+func Rollback(ctx context.Context, db *sqlx.DB, baseEnv Env, state State, to int) ([]Info, error) {
+	if to < 0 || to > state.n {
+		return nil, errors.Errorf("cannot roll back to migration %d, which is not between 0 and %d", to, state.n)
+	}
+	tx, err := db.BeginTxx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	env := baseEnv
+	env.Tx = tx
+	var reverted []Info
+	if err := func() error {
+		if exists, err := migrationsTableExists(ctx, tx); err != nil {
+			return err
+		} else if !exists {
+			return errors.Errorf("no migrations have been applied")
+		}
+		if _, err := tx.ExecContext(ctx, `LOCK TABLE migrations IN EXCLUSIVE MODE`); err != nil {
+			return errors.EnsureStack(err)
+		}
+		var latest int
+		if err := tx.GetContext(ctx, &latest, `SELECT COALESCE(MAX(id), 0) FROM migrations`); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if latest > state.n {
+			return errors.Errorf("database state %d is newer than application state %d", latest, state.n)
+		}
+		if latest <= to {
+			return nil
+		}
+		states := collectStates(make([]State, 0, state.n+1), state)[to+1 : latest+1]
+		var irreversible []string
+		for _, state := range states {
+			if finished, err := isFinished(ctx, tx, state); err != nil {
+				return err
+			} else if !finished {
+				return errors.Errorf("migration %d %s has not been applied", state.n, state.name)
+			}
+			if state.down == nil {
+				irreversible = append(irreversible, state.name)
+			}
+		}
+		if len(irreversible) > 0 {
+			return errors.Errorf("migrations cannot be rolled back: %s", strings.Join(irreversible, ", "))
+		}
+		for i := len(states) - 1; i >= 0; i-- {
+			state := states[i]
+			logrus.Infof("rolling back migration %d %s", state.n, state.name)
+			if err := state.down(ctx, env); err != nil {
+				return errors.Wrapf(err, "migration %d %s", state.n, state.name)
+			}
+			if _, err := tx.ExecContext(ctx, `DELETE FROM migrations WHERE id = $1`, state.n); err != nil {
+				return errors.EnsureStack(err)
+			}
+			reverted = append(reverted, Info{Number: state.n, Name: state.name, Reversible: true})
+		}
+		return nil
+	}(); err != nil {
+		if err := tx.Rollback(); err != nil {
+			logrus.Error(err)
+		}
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return reverted, nil
+}
@@ -49,3 +49,80 @@ func TestMigration(t *testing.T) {
 	require.NoError(t, db.GetContext(ctx, &max, `SELECT max(id) FROM migrations`))
 	assert.Equal(t, state.Number(), max)
 }
+
+func TestMigrationStatusDryRunRollback(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	ctx := context.Background()
+	createTable := func(name string) Func {
+		return func(ctx context.Context, env Env) error {
+			_, err := env.Tx.ExecContext(ctx, `CREATE TABLE `+name+` (id BIGSERIAL PRIMARY KEY)`)
+			return err
+		}
+	}
+	dropTable := func(name string) Func {
+		return func(ctx context.Context, env Env) error {
+			_, err := env.Tx.ExecContext(ctx, `DROP TABLE `+name)
+			return err
+		}
+	}
+	tableExists := func(name string) bool {
+		var exists bool
+		require.NoError(t, db.GetContext(ctx, &exists, `SELECT to_regclass($1) IS NOT NULL`, name))
+		return exists
+	}
+	state1 := InitialState().
+		Apply("test 1", createTable("test_table1"))
+	state3 := state1.
+		Apply("test 2", createTable("test_table2")).
+		WithDown(dropTable("test_table2")).
+		Apply("test 3", createTable("test_table3")).
+		WithDown(dropTable("test_table3"))
+
+	// Nothing has been applied yet.
+	infos, err := Status(ctx, db, state3)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(infos))
+	for _, info := range infos {
+		require.False(t, info.Applied)
+		require.Nil(t, info.StartTime)
+	}
+
+	require.NoError(t, ApplyMigrations(ctx, db, Env{}, state1))
+	infos, err = Status(ctx, db, state3)
+	require.NoError(t, err)
+	require.True(t, infos[1].Applied)
+	require.NotNil(t, infos[1].EndTime)
+	require.False(t, infos[2].Applied)
+	require.True(t, infos[2].Reversible)
+
+	// A dry run reports the pending migrations without applying them.
+	pending, err := DryRun(ctx, db, Env{}, state3)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(pending))
+	require.Equal(t, "test 2", pending[0].Name)
+	require.False(t, tableExists("test_table2"))
+	failing := state3.Apply("test 4", createTable("test_table1"))
+	_, err = DryRun(ctx, db, Env{}, failing)
+	require.YesError(t, err)
+	require.Matches(t, "test 4", err.Error())
+	require.False(t, tableExists("test_table3"))
+
+	// Migrations without a down function can't be rolled back.
+	require.NoError(t, ApplyMigrations(ctx, db, Env{}, state3))
+	_, err = Rollback(ctx, db, Env{}, state3, 0)
+	require.YesError(t, err)
+	require.Matches(t, "test 1", err.Error())
+	require.True(t, tableExists("test_table3"))
+
+	reverted, err := Rollback(ctx, db, Env{}, state3, 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(reverted))
+	require.Equal(t, "test 3", reverted[0].Name)
+	require.False(t, tableExists("test_table2"))
+	require.False(t, tableExists("test_table3"))
+	require.NoError(t, BlockUntil(ctx, db, state1))
+
+	// The rolled back migrations can be applied again.
+	require.NoError(t, ApplyMigrations(ctx, db, Env{}, state3))
+	require.True(t, tableExists("test_table3"))
+}
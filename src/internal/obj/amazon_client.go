@@ -244,6 +244,7 @@ func newAmazonClient(region, bucket string, creds *AmazonCreds, cloudfrontDistri
 		uploader: s3manager.NewUploader(session, func(u *s3manager.Uploader) {
 			u.PartSize = advancedConfig.PartSize
 			u.MaxUploadParts = advancedConfig.MaxUploadParts
+			u.Concurrency = advancedConfig.UploadConcurrency
 		}),
 		advancedConfig: advancedConfig,
 	}
@@ -315,8 +316,13 @@ func (c *amazonClient) Walk(ctx context.Context, name string, fn func(name strin
 	return fnErr
 }
 
-func (c *amazonClient) Get(ctx context.Context, name string, w io.Writer) (retErr error) {
+func (c *amazonClient) Get(ctx context.Context, name string, w io.Writer) error {
+	return c.GetRange(ctx, name, 0, 0, w)
+}
+
+func (c *amazonClient) GetRange(ctx context.Context, name string, offset, size int64, w io.Writer) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, name) }()
+	byteRange := httpRange(offset, size)
 	var reader io.ReadCloser
 	if c.cloudfrontDistribution != "" {
 		var resp *http.Response
@@ -334,6 +340,9 @@ func (c *amazonClient) Get(ctx context.Context, name string, w io.Writer) (retEr
 		if err != nil {
 			return err
 		}
+		if byteRange != "" {
+			req.Header.Set("Range", byteRange)
+		}
 
 		backoff.RetryNotify(func() (retErr error) {
 			span, _ := tracing.AddSpanToAnyExisting(ctx, "/Amazon.Cloudfront/Get")
@@ -362,6 +371,9 @@ func (c *amazonClient) Get(ctx context.Context, name string, w io.Writer) (retEr
 			Bucket: aws.String(c.bucket),
 			Key:    aws.String(name),
 		}
+		if byteRange != "" {
+			objIn.Range = aws.String(byteRange)
+		}
 		getObjectOutput, err := c.s3.GetObject(objIn)
 		if err != nil {
 			return err
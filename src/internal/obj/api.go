@@ -13,7 +13,6 @@ type Client interface {
 	Put(ctx context.Context, name string, r io.Reader) error
 
 	// Get writes the data for an object to w
-	// If `size == 0`, the reader should read from the offset till the end of the object.
 	// It should error if the object doesn't exist or we don't have sufficient
 	// permission to read it.
 	Get(ctx context.Context, name string, w io.Writer) error
@@ -29,3 +28,53 @@ type Client interface {
 	// Exists checks if a given object already exists
 	Exists(ctx context.Context, name string) (bool, error)
 }
+
+// RangeReader is implemented by clients that can read part of an object
+// without reading all of it.
+type RangeReader interface {
+	// GetRange writes size bytes of an object, starting at offset, to w.
+	// If `size == 0`, it writes from the offset till the end of the object.
+	// It should error if the object doesn't exist or we don't have sufficient
+	// permission to read it.
+	GetRange(ctx context.Context, name string, offset, size int64, w io.Writer) error
+}
+
+// GetRange writes size bytes of an object, starting at offset, to w (or from
+// the offset till the end of the object if size is 0). If c isn't a
+// RangeReader, it reads the whole object and discards the rest.
+func GetRange(ctx context.Context, c Client, name string, offset, size int64, w io.Writer) error {
+	if rr, ok := c.(RangeReader); ok {
+		return rr.GetRange(ctx, name, offset, size, w)
+	}
+	return c.Get(ctx, name, newRangeWriter(w, offset, size))
+}
+
+// rangeWriter passes the bytes in [offset, offset+size) of the data written to
+// it to w, and discards the rest.
+type rangeWriter struct {
+	w            io.Writer
+	offset, size int64
+	pos          int64
+}
+
+func newRangeWriter(w io.Writer, offset, size int64) *rangeWriter {
+	return &rangeWriter{w: w, offset: offset, size: size}
+}
+
+func (w *rangeWriter) Write(data []byte) (int, error) {
+	// start and end are the range to pass on, relative to data.
+	start, end := w.offset-w.pos, int64(len(data))
+	if w.size > 0 && w.offset+w.size-w.pos < end {
+		end = w.offset + w.size - w.pos
+	}
+	w.pos += int64(len(data))
+	if start < 0 {
+		start = 0
+	}
+	if start < end {
+		if _, err := w.w.Write(data[start:end]); err != nil {
+			return 0, err
+		}
+	}
+	return len(data), nil
+}
@@ -41,17 +41,23 @@ func NewCacheClient(slow, fast Client, size int) Client {
 }
 
 func (c *cacheClient) Get(ctx context.Context, p string, w io.Writer) error {
+	return c.GetRange(ctx, p, 0, 0, w)
+}
+
+// GetRange reads part of an object from the cache, copying all of it into the
+// cache first if it isn't there.
+func (c *cacheClient) GetRange(ctx context.Context, p string, offset, size int64, w io.Writer) error {
 	c.doPopulateOnce(ctx)
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	if _, exists := c.cache.Get(p); exists {
-		return c.fast.Get(ctx, p, w)
+		return GetRange(ctx, c.fast, p, offset, size, w)
 	}
 	if err := Copy(ctx, c.slow, c.fast, p, p); err != nil {
 		return err
 	}
 	c.cache.Add(p, struct{}{})
-	return c.fast.Get(ctx, p, w)
+	return GetRange(ctx, c.fast, p, offset, size, w)
 }
 
 func (c *cacheClient) Put(ctx context.Context, p string, r io.Reader) error {
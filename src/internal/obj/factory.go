@@ -87,15 +87,16 @@ const (
 
 // Advanced configuration environment variables
 const (
-	RetriesEnvVar        = "RETRIES"
-	TimeoutEnvVar        = "TIMEOUT"
-	UploadACLEnvVar      = "UPLOAD_ACL"
-	ReverseEnvVar        = "REVERSE"
-	PartSizeEnvVar       = "PART_SIZE"
-	MaxUploadPartsEnvVar = "MAX_UPLOAD_PARTS"
-	DisableSSLEnvVar     = "DISABLE_SSL"
-	NoVerifySSLEnvVar    = "NO_VERIFY_SSL"
-	LogOptionsEnvVar     = "OBJ_LOG_OPTS"
+	RetriesEnvVar           = "RETRIES"
+	TimeoutEnvVar           = "TIMEOUT"
+	UploadACLEnvVar         = "UPLOAD_ACL"
+	ReverseEnvVar           = "REVERSE"
+	PartSizeEnvVar          = "PART_SIZE"
+	MaxUploadPartsEnvVar    = "MAX_UPLOAD_PARTS"
+	UploadConcurrencyEnvVar = "UPLOAD_CONCURRENCY"
+	DisableSSLEnvVar        = "DISABLE_SSL"
+	NoVerifySSLEnvVar       = "NO_VERIFY_SSL"
+	LogOptionsEnvVar        = "OBJ_LOG_OPTS"
 )
 
 const (
@@ -111,6 +112,9 @@ const (
 	DefaultPartSize = 5242880
 	// DefaultMaxUploadParts is the default maximum number of upload parts.
 	DefaultMaxUploadParts = 10000
+	// DefaultUploadConcurrency is the default number of parts of an object
+	// that are uploaded at a time.
+	DefaultUploadConcurrency = 5
 	// DefaultDisableSSL is the default for whether SSL should be disabled.
 	DefaultDisableSSL = false
 	// DefaultNoVerifySSL is the default for whether SSL certificate verification should be disabled.
@@ -126,12 +130,13 @@ type AmazonAdvancedConfiguration struct {
 	// By default, objects uploaded to a bucket are only accessible to the
 	// uploader, and not the owner of the bucket. Using the default ensures that
 	// the owner of the bucket can access the objects as well.
-	UploadACL      string `env:"UPLOAD_ACL, default=bucket-owner-full-control"`
-	PartSize       int64  `env:"PART_SIZE, default=5242880"`
-	MaxUploadParts int    `env:"MAX_UPLOAD_PARTS, default=10000"`
-	DisableSSL     bool   `env:"DISABLE_SSL, default=false"`
-	NoVerifySSL    bool   `env:"NO_VERIFY_SSL, default=false"`
-	LogOptions     string `env:"OBJ_LOG_OPTS, default="`
+	UploadACL         string `env:"UPLOAD_ACL, default=bucket-owner-full-control"`
+	PartSize          int64  `env:"PART_SIZE, default=5242880"`
+	MaxUploadParts    int    `env:"MAX_UPLOAD_PARTS, default=10000"`
+	UploadConcurrency int    `env:"UPLOAD_CONCURRENCY, default=5"`
+	DisableSSL        bool   `env:"DISABLE_SSL, default=false"`
+	NoVerifySSL       bool   `env:"NO_VERIFY_SSL, default=false"`
+	LogOptions        string `env:"OBJ_LOG_OPTS, default="`
 }
 
 // EnvVarToSecretKey is an environment variable name to secret key mapping
@@ -170,6 +175,7 @@ var EnvVarToSecretKey = []struct {
 	{Key: ReverseEnvVar, Value: "reverse"},
 	{Key: PartSizeEnvVar, Value: "part-size"},
 	{Key: MaxUploadPartsEnvVar, Value: "max-upload-parts"},
+	{Key: UploadConcurrencyEnvVar, Value: "upload-concurrency"},
 	{Key: DisableSSLEnvVar, Value: "disable-ssl"},
 	{Key: NoVerifySSLEnvVar, Value: "no-verify-ssl"},
 	{Key: LogOptionsEnvVar, Value: "log-options"},
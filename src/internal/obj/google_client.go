@@ -1,7 +1,9 @@
 package obj
 
 import (
+	"fmt"
 	"io"
+	"path"
 	"strings"
 	"time"
 
@@ -9,12 +11,23 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	"golang.org/x/net/context"
 	"google.golang.org/api/googleapi"
 	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 )
 
+const (
+	// googlePartSize is the size of the parts that objects are uploaded in.
+	googlePartSize = 8 * 1024 * 1024
+	// googleMaxComposeParts is the most objects that GCS can compose at once.
+	googleMaxComposeParts = 32
+	// googlePartsPrefix is where the parts of objects are uploaded to before
+	// they're composed.
+	googlePartsPrefix = "upload-parts"
+)
+
 type googleClient struct {
 	bucketName string
 	bucket     *storage.BucketHandle
@@ -42,17 +55,80 @@ func (c *googleClient) Exists(ctx context.Context, name string) (bool, error) {
 	return true, nil
 }
 
+// Put uploads objects larger than googlePartSize in parts, concurrently, and
+// then composes the parts into the object.
 func (c *googleClient) Put(ctx context.Context, name string, r io.Reader) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, name) }()
+	partsPrefix := path.Join(googlePartsPrefix, uuid.NewWithoutDashes())
+	partName := func(i int) string {
+		return path.Join(partsPrefix, fmt.Sprintf("%08d", i))
+	}
+	var multipart bool
+	defer func() {
+		if !multipart {
+			return
+		}
+		if err := c.Walk(ctx, partsPrefix+"/", func(part string) error {
+			return c.bucket.Object(part).Delete(ctx)
+		}); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	n, err := putParts(ctx, r, googlePartSize, DefaultUploadConcurrency, func(ctx context.Context, data []byte) error {
+		return c.write(ctx, name, data)
+	}, func(context.Context) error {
+		multipart = true
+		return nil
+	}, func(ctx context.Context, i int, part []byte) error {
+		return c.write(ctx, partName(i), part)
+	})
+	if err != nil || n == 0 {
+		return err
+	}
+	parts := make([]string, n)
+	for i := range parts {
+		parts[i] = partName(i)
+	}
+	return c.compose(ctx, name, parts, partsPrefix)
+}
+
+func (c *googleClient) write(ctx context.Context, name string, data []byte) error {
 	ctx, cf := context.WithCancel(ctx)
 	defer cf() // this aborts the write if the writer is not already closed
 	wc := c.bucket.Object(name).NewWriter(ctx)
-	if _, err := io.Copy(wc, r); err != nil {
+	if _, err := wc.Write(data); err != nil {
 		return err
 	}
 	return wc.Close()
 }
 
+// compose composes the objects in parts into name. GCS composes at most
+// googleMaxComposeParts objects at a time, so larger objects are composed from
+// intermediate objects under prefix.
+func (c *googleClient) compose(ctx context.Context, name string, parts []string, prefix string) error {
+	var srcs []*storage.ObjectHandle
+	for _, part := range parts {
+		srcs = append(srcs, c.bucket.Object(part))
+	}
+	if len(srcs) <= googleMaxComposeParts {
+		_, err := c.bucket.Object(name).ComposerFrom(srcs...).Run(ctx)
+		return err
+	}
+	var composed []string
+	for i := 0; i < len(parts); i += googleMaxComposeParts {
+		end := i + googleMaxComposeParts
+		if end > len(parts) {
+			end = len(parts)
+		}
+		intermediate := path.Join(prefix, fmt.Sprintf("composed-%08d", len(composed)))
+		if err := c.compose(ctx, intermediate, parts[i:end], prefix); err != nil {
+			return err
+		}
+		composed = append(composed, intermediate)
+	}
+	return c.compose(ctx, name, composed, path.Join(prefix, "composed"))
+}
+
 func (c *googleClient) Walk(ctx context.Context, name string, fn func(name string) error) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, name) }()
 	objectIter := c.bucket.Objects(ctx, &storage.Query{Prefix: name})
@@ -71,17 +147,25 @@ func (c *googleClient) Walk(ctx context.Context, name string, fn func(name strin
 	return nil
 }
 
-func (c *googleClient) Get(ctx context.Context, name string, w io.Writer) (retErr error) {
+func (c *googleClient) Get(ctx context.Context, name string, w io.Writer) error {
+	return c.GetRange(ctx, name, 0, 0, w)
+}
+
+func (c *googleClient) GetRange(ctx context.Context, name string, offset, size int64, w io.Writer) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, name) }()
-	reader, err := c.bucket.Object(name).NewReader(ctx)
+	length := size
+	if size == 0 {
+		length = -1
+	}
+	reader, err := c.bucket.Object(name).NewRangeReader(ctx, offset, length)
+	if err != nil {
+		return err
+	}
 	defer func() {
 		if err := reader.Close(); retErr == nil {
 			retErr = err
 		}
 	}()
-	if err != nil {
-		return err
-	}
 	_, err = io.Copy(w, reader)
 	return err
 }
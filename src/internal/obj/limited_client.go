@@ -53,3 +53,11 @@ func (loc *limitedClient) Get(ctx context.Context, name string, w io.Writer) err
 	defer loc.readersSem.Release(limitClientSemCost)
 	return loc.Client.Get(ctx, name, w)
 }
+
+func (loc *limitedClient) GetRange(ctx context.Context, name string, offset, size int64, w io.Writer) error {
+	if err := loc.readersSem.Acquire(ctx, limitClientSemCost); err != nil {
+		return err
+	}
+	defer loc.readersSem.Release(limitClientSemCost)
+	return GetRange(ctx, loc.Client, name, offset, size, w)
+}
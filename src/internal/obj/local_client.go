@@ -25,6 +25,9 @@ func NewLocalClient(root string) (Client, error) {
 	return newUniformClient(c), nil
 }
 
+// localPartSize is the size of the parts that objects are written in.
+const localPartSize = 8 * 1024 * 1024
+
 type localClient struct {
 	root string
 }
@@ -56,13 +59,21 @@ func (c *localClient) Put(ctx context.Context, path string, r io.Reader) (retErr
 			return err
 		}
 	}
-	if _, err := io.Copy(file, r); err != nil {
+	_, err = putParts(ctx, r, localPartSize, DefaultUploadConcurrency, func(_ context.Context, data []byte) error {
+		_, err := file.Write(data)
 		return err
-	}
-	return nil
+	}, nil, func(_ context.Context, i int, part []byte) error {
+		_, err := file.WriteAt(part, int64(i)*localPartSize)
+		return err
+	})
+	return err
+}
+
+func (c *localClient) Get(ctx context.Context, path string, w io.Writer) error {
+	return c.GetRange(ctx, path, 0, 0, w)
 }
 
-func (c *localClient) Get(ctx context.Context, path string, w io.Writer) (retErr error) {
+func (c *localClient) GetRange(ctx context.Context, path string, offset, size int64, w io.Writer) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, path) }()
 	file, err := os.Open(c.normPath(path))
 	if err != nil {
@@ -78,7 +89,19 @@ func (c *localClient) Get(ctx context.Context, path string, w io.Writer) (retErr
 			return err
 		}
 	}
-	_, err = io.Copy(w, file)
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+	if size == 0 {
+		_, err = io.Copy(w, file)
+		return err
+	}
+	_, err = io.CopyN(w, file, size)
+	if errors.Is(err, io.EOF) {
+		// like the other clients, ranges past the end of the object are
+		// truncated
+		err = nil
+	}
 	return err
 }
 
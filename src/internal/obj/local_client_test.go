@@ -18,3 +18,7 @@ func newTestLocalClient(t testing.TB) Client {
 	require.NoError(t, err)
 	return c
 }
+
+func BenchmarkLocalClient(b *testing.B) {
+	BenchmarkSuite(b, newTestLocalClient)
+}
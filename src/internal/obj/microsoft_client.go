@@ -52,8 +52,27 @@ func (c *microsoftClient) Put(ctx context.Context, name string, r io.Reader) err
 }
 
 // TODO: should respect context
-func (c *microsoftClient) Get(_ context.Context, name string, w io.Writer) (retErr error) {
-	r, err := c.container.GetBlobReference(name).Get(nil)
+func (c *microsoftClient) Get(ctx context.Context, name string, w io.Writer) error {
+	return c.GetRange(ctx, name, 0, 0, w)
+}
+
+// TODO: should respect context
+func (c *microsoftClient) GetRange(_ context.Context, name string, offset, size int64, w io.Writer) (retErr error) {
+	blob := c.container.GetBlobReference(name)
+	var r io.ReadCloser
+	var err error
+	if offset == 0 && size == 0 {
+		r, err = blob.Get(nil)
+	} else {
+		blobRange := &storage.BlobRange{Start: uint64(offset)}
+		if size > 0 {
+			// An End of 0 means the end of the blob, so a range of the first
+			// byte reads the whole blob, which the range writer trims.
+			blobRange.End = uint64(offset + size - 1)
+			w = newRangeWriter(w, 0, size)
+		}
+		r, err = blob.GetRange(&storage.GetBlobRangeOptions{Range: blobRange})
+	}
 	if err != nil {
 		return err
 	}
@@ -1,16 +1,23 @@
 package obj
 
 import (
+	"bytes"
 	"context"
 	"io"
+	"sort"
+	"sync"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
 
 	minio "github.com/minio/minio-go/v6"
+	log "github.com/sirupsen/logrus"
 )
 
+// minioPartSize is the size of the parts that objects are uploaded in.
+const minioPartSize = 8 * 1024 * 1024
+
 // Represents minio client instance for any s3 compatible server.
 type minioClient struct {
 	*minio.Client
@@ -41,13 +48,46 @@ func newMinioClientV2(endpoint, bucket, id, secret string, secure bool) (*minioC
 	}, nil
 }
 
+// Put uploads objects larger than minioPartSize with a multipart upload, with
+// the parts uploaded concurrently.
 func (c *minioClient) Put(ctx context.Context, name string, r io.Reader) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, name) }()
-	wc := newMinioWriter(ctx, c, name)
-	if _, err := io.Copy(wc, r); err != nil {
+	core := minio.Core{Client: c.Client}
+	opts := minio.PutObjectOptions{ContentType: "application/octet-stream"}
+	var uploadID string
+	var mu sync.Mutex
+	var parts []minio.CompletePart
+	n, err := putParts(ctx, r, minioPartSize, DefaultUploadConcurrency, func(ctx context.Context, data []byte) error {
+		_, err := c.PutObjectWithContext(ctx, c.bucket, name, bytes.NewReader(data), int64(len(data)), opts)
+		return err
+	}, func(context.Context) error {
+		var err error
+		uploadID, err = core.NewMultipartUpload(c.bucket, name, opts)
+		return err
+	}, func(ctx context.Context, i int, part []byte) error {
+		objPart, err := core.PutObjectPartWithContext(ctx, c.bucket, name, uploadID, i+1, bytes.NewReader(part), int64(len(part)), "", "", nil)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		parts = append(parts, minio.CompletePart{PartNumber: objPart.PartNumber, ETag: objPart.ETag})
+		return nil
+	})
+	if err != nil {
+		if uploadID != "" {
+			if err := core.AbortMultipartUploadWithContext(ctx, c.bucket, name, uploadID); err != nil {
+				log.Errorf("could not abort multipart upload of %q: %v", name, err)
+			}
+		}
 		return err
 	}
-	return wc.Close()
+	if n == 0 {
+		return nil
+	}
+	sort.Slice(parts, func(i, j int) bool { return parts[i].PartNumber < parts[j].PartNumber })
+	_, err = core.CompleteMultipartUploadWithContext(ctx, c.bucket, name, uploadID, parts)
+	return err
 }
 
 // TODO: this should respect the context
@@ -68,9 +108,17 @@ func (c *minioClient) Walk(_ context.Context, name string, fn func(name string)
 	return nil
 }
 
-func (c *minioClient) Get(ctx context.Context, name string, w io.Writer) (retErr error) {
+func (c *minioClient) Get(ctx context.Context, name string, w io.Writer) error {
+	return c.GetRange(ctx, name, 0, 0, w)
+}
+
+func (c *minioClient) GetRange(ctx context.Context, name string, offset, size int64, w io.Writer) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, name) }()
-	rc, err := c.GetObjectWithContext(ctx, c.bucket, name, minio.GetObjectOptions{})
+	opts := minio.GetObjectOptions{}
+	if byteRange := httpRange(offset, size); byteRange != "" {
+		opts.Set("Range", byteRange)
+	}
+	rc, err := c.GetObjectWithContext(ctx, c.bucket, name, opts)
 	if err != nil {
 		return err
 	}
@@ -123,52 +171,3 @@ func (c *minioClient) transformError(err error, objectPath string) error {
 // Sentinel error response returned if err is not
 // of type *minio.ErrorResponse.
 var sentinelErrResp = minio.ErrorResponse{}
-
-// Represents minio writer structure with pipe and the error channel
-type minioWriter struct {
-	ctx     context.Context
-	errChan chan error
-	pipe    *io.PipeWriter
-}
-
-// Creates a new minio writer and a go routine to upload objects to minio server
-func newMinioWriter(ctx context.Context, client *minioClient, name string) *minioWriter {
-	reader, writer := io.Pipe()
-	w := &minioWriter{
-		ctx:     ctx,
-		errChan: make(chan error),
-		pipe:    writer,
-	}
-	go func() {
-		opts := minio.PutObjectOptions{
-			ContentType: "application/octet-stream",
-			PartSize:    uint64(8 * 1024 * 1024),
-		}
-		_, err := client.PutObject(client.bucket, name, reader, -1, opts)
-		if err != nil {
-			reader.CloseWithError(err)
-		}
-		w.errChan <- err
-	}()
-	return w
-}
-
-func (w *minioWriter) Write(p []byte) (retN int, retErr error) {
-	span, _ := tracing.AddSpanToAnyExisting(w.ctx, "/Minio.Writer/Write")
-	defer func() {
-		tracing.FinishAnySpan(span, "bytes", retN, "err", retErr)
-	}()
-	return w.pipe.Write(p)
-}
-
-// This will block till upload is done
-func (w *minioWriter) Close() (retErr error) {
-	span, _ := tracing.AddSpanToAnyExisting(w.ctx, "/Minio.Writer/Close")
-	defer func() {
-		tracing.FinishAnySpan(span, "err", retErr)
-	}()
-	if err := w.pipe.Close(); err != nil {
-		return err
-	}
-	return <-w.errChan
-}
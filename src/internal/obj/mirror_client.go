@@ -0,0 +1,239 @@
+package obj
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	log "github.com/sirupsen/logrus"
+)
+
+// MirrorMode is when a mirror client writes to its secondary store.
+type MirrorMode string
+
+const (
+	// MirrorSync writes to the secondary store before a write completes, and
+	// fails the write if it can't.
+	MirrorSync MirrorMode = "sync"
+	// MirrorAsync writes to the secondary store in the background, after a
+	// write to the primary store completes.
+	MirrorAsync MirrorMode = "async"
+)
+
+// ParseMirrorMode parses a MirrorMode.
+func ParseMirrorMode(mode string) (MirrorMode, error) {
+	switch MirrorMode(mode) {
+	case MirrorSync, MirrorAsync:
+		return MirrorMode(mode), nil
+	default:
+		return "", errors.Errorf("unrecognized mirror mode %q (must be %q or %q)", mode, MirrorSync, MirrorAsync)
+	}
+}
+
+const (
+	// maxAsyncMirrorWrites bounds the background writes to a secondary store.
+	// Writes beyond it are left for the repair queue.
+	maxAsyncMirrorWrites = 100
+	asyncMirrorTimeout   = 5 * time.Minute
+)
+
+// RepairQueue records the objects that a mirror client failed to write to, or
+// delete from, its secondary store.
+type RepairQueue interface {
+	// Add records that the object name may differ between the stores, because
+	// of err.
+	Add(ctx context.Context, name string, err error) error
+	// Repair calls cb with each recorded object, and forgets the objects that
+	// cb repairs without an error.
+	Repair(ctx context.Context, cb func(name string) error) error
+}
+
+var _ Client = &mirrorClient{}
+
+type mirrorClient struct {
+	primary, secondary Client
+	mode               MirrorMode
+	queue              RepairQueue
+	async              chan struct{}
+}
+
+// NewMirrorClient returns a client that writes objects to both primary and
+// secondary, and reads them from primary. Writes to secondary that fail are
+// added to queue, and are repaired by RepairMirror. Each object is buffered in
+// memory while it's written.
+func NewMirrorClient(primary, secondary Client, mode MirrorMode, queue RepairQueue) Client {
+	return &mirrorClient{
+		primary:   primary,
+		secondary: secondary,
+		mode:      mode,
+		queue:     queue,
+		async:     make(chan struct{}, maxAsyncMirrorWrites),
+	}
+}
+
+func (c *mirrorClient) Put(ctx context.Context, name string, r io.Reader) error {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	if err := c.primary.Put(ctx, name, bytes.NewReader(data)); err != nil {
+		return err
+	}
+	return c.mirror(ctx, name, true, func(ctx context.Context) error {
+		return c.secondary.Put(ctx, name, bytes.NewReader(data))
+	})
+}
+
+// Get reads an object from primary, or from secondary if primary doesn't have
+// it (e.g. because it was lost).
+func (c *mirrorClient) Get(ctx context.Context, name string, w io.Writer) error {
+	return c.GetRange(ctx, name, 0, 0, w)
+}
+
+func (c *mirrorClient) GetRange(ctx context.Context, name string, offset, size int64, w io.Writer) error {
+	err := GetRange(ctx, c.primary, name, offset, size, w)
+	if !pacherr.IsNotExist(err) {
+		return err
+	}
+	if err := GetRange(ctx, c.secondary, name, offset, size, w); err != nil {
+		if pacherr.IsNotExist(err) {
+			return pacherr.NewNotExist("mirror", name)
+		}
+		return err
+	}
+	return nil
+}
+
+func (c *mirrorClient) Delete(ctx context.Context, name string) error {
+	if err := c.primary.Delete(ctx, name); err != nil {
+		return err
+	}
+	// The object is already gone from primary, so failing to delete it from
+	// secondary only leaves garbage for the repair queue to clean up.
+	return c.mirror(ctx, name, false, func(ctx context.Context) error {
+		if err := c.secondary.Delete(ctx, name); err != nil && !pacherr.IsNotExist(err) {
+			return err
+		}
+		return nil
+	})
+}
+
+func (c *mirrorClient) Walk(ctx context.Context, prefix string, fn func(name string) error) error {
+	return c.primary.Walk(ctx, prefix, fn)
+}
+
+func (c *mirrorClient) Exists(ctx context.Context, name string) (bool, error) {
+	return c.primary.Exists(ctx, name)
+}
+
+// mirror applies a change to an object in primary to secondary with f, and
+// adds the object to the repair queue if f fails. In sync mode, f's error is
+// also returned if strict is true.
+func (c *mirrorClient) mirror(ctx context.Context, name string, strict bool, f func(context.Context) error) error {
+	if c.mode == MirrorSync {
+		if err := f(ctx); err != nil {
+			qErr := c.queue.Add(ctx, name, err)
+			if strict {
+				if qErr != nil {
+					log.Errorf("could not add %q to the mirror repair queue: %v", name, qErr)
+				}
+				return errors.Wrapf(err, "error mirroring %q", name)
+			}
+			return qErr
+		}
+		return nil
+	}
+	select {
+	case c.async <- struct{}{}:
+	default:
+		return c.queue.Add(ctx, name, errors.Errorf("too many pending mirror writes"))
+	}
+	go func() {
+		defer func() { <-c.async }()
+		// the write outlives the request that started it
+		ctx, cancel := context.WithTimeout(context.Background(), asyncMirrorTimeout)
+		defer cancel()
+		if err := f(ctx); err != nil {
+			if qErr := c.queue.Add(ctx, name, err); qErr != nil {
+				log.Errorf("could not add %q to the mirror repair queue: %v", name, qErr)
+			}
+		}
+	}()
+	return nil
+}
+
+// RepairMirror makes the objects in queue the same in secondary as in primary,
+// by copying the objects that primary has and deleting those it doesn't. It
+// returns the number of objects that it repaired.
+func RepairMirror(ctx context.Context, primary, secondary Client, queue RepairQueue) (int64, error) {
+	var n int64
+	err := queue.Repair(ctx, func(name string) error {
+		if err := repairObject(ctx, primary, secondary, name); err != nil {
+			return err
+		}
+		n++
+		return nil
+	})
+	return n, err
+}
+
+func repairObject(ctx context.Context, primary, secondary Client, name string) error {
+	exists, err := primary.Exists(ctx, name)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return Copy(ctx, primary, secondary, name, name)
+	}
+	if err := secondary.Delete(ctx, name); err != nil && !pacherr.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// MirrorDifference is an object that's only in one of a mirror's stores.
+type MirrorDifference struct {
+	Name        string
+	InPrimary   bool
+	InSecondary bool
+}
+
+// VerifyMirror compares the objects under prefix in primary and secondary, and
+// calls cb with each object that's only in one of them. If repair is true, it
+// also repairs each of them (see RepairMirror) before calling cb.
+func VerifyMirror(ctx context.Context, primary, secondary Client, prefix string, repair bool, cb func(MirrorDifference) error) error {
+	secondaryNames := make(map[string]bool)
+	if err := secondary.Walk(ctx, prefix, func(name string) error {
+		secondaryNames[name] = true
+		return nil
+	}); err != nil {
+		return err
+	}
+	check := func(diff MirrorDifference) error {
+		if repair {
+			if err := repairObject(ctx, primary, secondary, diff.Name); err != nil {
+				return err
+			}
+		}
+		return cb(diff)
+	}
+	if err := primary.Walk(ctx, prefix, func(name string) error {
+		if secondaryNames[name] {
+			delete(secondaryNames, name)
+			return nil
+		}
+		return check(MirrorDifference{Name: name, InPrimary: true})
+	}); err != nil {
+		return err
+	}
+	for name := range secondaryNames {
+		if err := check(MirrorDifference{Name: name, InSecondary: true}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
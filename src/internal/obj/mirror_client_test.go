@@ -0,0 +1,122 @@
+package obj
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestMirrorClient(t *testing.T) {
+	t.Parallel()
+	TestSuite(t, func(t testing.TB) Client {
+		return NewMirrorClient(newTestLocalClient(t), newTestLocalClient(t), MirrorSync, &testRepairQueue{})
+	})
+}
+
+func TestMirrorClientRepair(t *testing.T) {
+	ctx := context.Background()
+	primary, secondary := newTestLocalClient(t), newTestLocalClient(t)
+	failing := &failingClient{Client: secondary, fail: true}
+	queue := &testRepairQueue{}
+	c := NewMirrorClient(primary, failing, MirrorSync, queue)
+
+	// A write that can't be mirrored fails, and is queued for repair.
+	data := []byte("data")
+	require.YesError(t, c.Put(ctx, "object", bytes.NewReader(data)))
+	require.Equal(t, 1, queue.len())
+	exists, err := secondary.Exists(ctx, "object")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// Repairing the mirror copies the object once the secondary is back.
+	failing.fail = false
+	n, err := RepairMirror(ctx, primary, secondary, queue)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), n)
+	require.Equal(t, 0, queue.len())
+	buf := &bytes.Buffer{}
+	require.NoError(t, secondary.Get(ctx, "object", buf))
+	require.Equal(t, data, buf.Bytes())
+
+	// Objects lost from the primary are read from the secondary.
+	require.NoError(t, primary.Delete(ctx, "object"))
+	buf.Reset()
+	require.NoError(t, c.Get(ctx, "object", buf))
+	require.Equal(t, data, buf.Bytes())
+}
+
+func TestVerifyMirror(t *testing.T) {
+	ctx := context.Background()
+	primary, secondary := newTestLocalClient(t), newTestLocalClient(t)
+	for _, name := range []string{"both", "primary"} {
+		require.NoError(t, primary.Put(ctx, name, bytes.NewReader([]byte(name))))
+	}
+	for _, name := range []string{"both", "secondary"} {
+		require.NoError(t, secondary.Put(ctx, name, bytes.NewReader([]byte(name))))
+	}
+	verify := func(repair bool) []MirrorDifference {
+		var diffs []MirrorDifference
+		require.NoError(t, VerifyMirror(ctx, primary, secondary, "", repair, func(diff MirrorDifference) error {
+			diffs = append(diffs, diff)
+			return nil
+		}))
+		return diffs
+	}
+	expected := []MirrorDifference{
+		{Name: "primary", InPrimary: true},
+		{Name: "secondary", InSecondary: true},
+	}
+	require.ElementsEqual(t, expected, verify(false))
+	require.ElementsEqual(t, expected, verify(true))
+	require.Equal(t, 0, len(verify(false)))
+}
+
+// testRepairQueue is an in-memory RepairQueue.
+type testRepairQueue struct {
+	mu    sync.Mutex
+	names []string
+}
+
+func (q *testRepairQueue) Add(_ context.Context, name string, _ error) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.names = append(q.names, name)
+	return nil
+}
+
+func (q *testRepairQueue) Repair(_ context.Context, cb func(name string) error) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var failed []string
+	for _, name := range q.names {
+		if err := cb(name); err != nil {
+			failed = append(failed, name)
+		}
+	}
+	q.names = failed
+	return nil
+}
+
+func (q *testRepairQueue) len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.names)
+}
+
+// failingClient is a Client whose writes fail while fail is true.
+type failingClient struct {
+	Client
+	fail bool
+}
+
+func (c *failingClient) Put(ctx context.Context, name string, r io.Reader) error {
+	if c.fail {
+		return errors.New("failingClient: put failed")
+	}
+	return c.Client.Put(ctx, name, r)
+}
@@ -55,6 +55,14 @@ func (c *monkeyClient) Get(ctx context.Context, path string, w io.Writer) error
 	return c.c.Get(ctx, path, w)
 }
 
+// GetRange wraps the ranged get operation.
+func (c *monkeyClient) GetRange(ctx context.Context, path string, offset, size int64, w io.Writer) error {
+	if enabled && localRand.Float64() < failProb {
+		return errMsg
+	}
+	return GetRange(ctx, c.c, path, offset, size, w)
+}
+
 // Put wraps the put operation.
 func (c *monkeyClient) Put(ctx context.Context, path string, r io.Reader) error {
 	if enabled && localRand.Float64() < failProb {
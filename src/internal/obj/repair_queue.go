@@ -0,0 +1,88 @@
+package obj
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// SetupPostgresRepairQueueV0 sets up the table of objects that a mirror client
+// failed to mirror.
+func SetupPostgresRepairQueueV0(ctx context.Context, tx *sqlx.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+	CREATE TABLE storage.mirror_repairs (
+		name TEXT PRIMARY KEY,
+		error TEXT NOT NULL,
+		attempts INT NOT NULL DEFAULT 0,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+	`)
+	return errors.EnsureStack(err)
+}
+
+// DropPostgresRepairQueueV0 reverts SetupPostgresRepairQueueV0. Objects that
+// were queued for repair are only repaired again by verifying the mirror.
+func DropPostgresRepairQueueV0(ctx context.Context, tx *sqlx.Tx) error {
+	_, err := tx.ExecContext(ctx, `DROP TABLE storage.mirror_repairs`)
+	return errors.EnsureStack(err)
+}
+
+type postgresRepairQueue struct {
+	db *sqlx.DB
+}
+
+// NewPostgresRepairQueue returns a RepairQueue stored in db.
+func NewPostgresRepairQueue(db *sqlx.DB) RepairQueue {
+	return &postgresRepairQueue{db: db}
+}
+
+func (q *postgresRepairQueue) Add(ctx context.Context, name string, err error) error {
+	_, dbErr := q.db.ExecContext(ctx, `
+	INSERT INTO storage.mirror_repairs (name, error)
+	VALUES ($1, $2)
+	ON CONFLICT (name) DO UPDATE SET
+		error = EXCLUDED.error,
+		updated_at = CURRENT_TIMESTAMP
+	`, name, err.Error())
+	return errors.EnsureStack(dbErr)
+}
+
+// Repair calls cb with each object in the queue, oldest first. An object that
+// is added again while cb repairs it stays in the queue, and an object that cb
+// fails to repair stays in the queue with cb's error.
+func (q *postgresRepairQueue) Repair(ctx context.Context, cb func(name string) error) error {
+	var repairs []struct {
+		Name      string    `db:"name"`
+		UpdatedAt time.Time `db:"updated_at"`
+	}
+	if err := q.db.SelectContext(ctx, &repairs, `
+	SELECT name, updated_at FROM storage.mirror_repairs
+	ORDER BY created_at
+	`); err != nil {
+		return errors.EnsureStack(err)
+	}
+	for _, repair := range repairs {
+		if err := cb(repair.Name); err != nil {
+			log.Errorf("could not repair mirrored object %q: %v", repair.Name, err)
+			if _, err := q.db.ExecContext(ctx, `
+			UPDATE storage.mirror_repairs
+			SET error = $2, attempts = attempts + 1
+			WHERE name = $1
+			`, repair.Name, err.Error()); err != nil {
+				return errors.EnsureStack(err)
+			}
+			continue
+		}
+		if _, err := q.db.ExecContext(ctx, `
+		DELETE FROM storage.mirror_repairs
+		WHERE name = $1 AND updated_at = $2
+		`, repair.Name, repair.UpdatedAt); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	return nil
+}
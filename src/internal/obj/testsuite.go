@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/rand"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"path"
@@ -71,6 +72,89 @@ func TestSuite(t *testing.T, newClient func(t testing.TB) Client) {
 		actualHash := pachhash.Sum(buf.Bytes())
 		require.Equal(t, expectedHash, actualHash)
 	})
+
+	t.Run("TestGetRange", func(t *testing.T) {
+		t.Parallel()
+		client := newClient(t)
+		name := tu.UniqueString("test-get-range-")
+		data, err := ioutil.ReadAll(io.LimitReader(rand.Reader, 1<<20))
+		require.NoError(t, err)
+		require.NoError(t, client.Put(ctx, name, bytes.NewReader(data)))
+		size := int64(len(data))
+		for _, r := range []struct{ offset, size, end int64 }{
+			{0, 0, size},
+			{0, 1, 1},
+			{0, 100, 100},
+			{1000, 0, size},
+			{1000, 5000, 6000},
+			{size - 10, 10, size},
+			// ranges past the end of the object are truncated
+			{size - 10, 100, size},
+		} {
+			buf := &bytes.Buffer{}
+			require.NoError(t, GetRange(ctx, client, name, r.offset, r.size, buf))
+			require.Equal(t, data[r.offset:r.end], buf.Bytes(), "offset: %d, size: %d", r.offset, r.size)
+		}
+		err = GetRange(ctx, client, tu.UniqueString("test-missing-object-"), 0, 10, &bytes.Buffer{})
+		require.YesError(t, err)
+		require.True(t, pacherr.IsNotExist(err))
+	})
+
+	t.Run("TestMultipartWrite", func(t *testing.T) {
+		t.Parallel()
+		client := newClient(t)
+		name := tu.UniqueString("test-multipart-write-")
+		// large enough to be written in several parts by every client
+		expectedData, err := ioutil.ReadAll(io.LimitReader(rand.Reader, 17<<20))
+		require.NoError(t, err)
+		require.NoError(t, client.Put(ctx, name, bytes.NewReader(expectedData)))
+		buf := &bytes.Buffer{}
+		require.NoError(t, client.Get(ctx, name, buf))
+		require.Equal(t, pachhash.Sum(expectedData), pachhash.Sum(buf.Bytes()))
+		var names []string
+		require.NoError(t, client.Walk(ctx, name, func(name string) error {
+			names = append(names, name)
+			return nil
+		}))
+		require.ElementsEqual(t, []string{name}, names)
+	})
+}
+
+// BenchmarkSuite measures the throughput of the object returned by newClient.
+func BenchmarkSuite(b *testing.B, newClient func(t testing.TB) Client) {
+	ctx := context.Background()
+	for _, size := range []int{1 << 20, 64 << 20} {
+		data, err := ioutil.ReadAll(io.LimitReader(rand.Reader, int64(size)))
+		require.NoError(b, err)
+		b.Run(fmt.Sprintf("Put/%dMB", size>>20), func(b *testing.B) {
+			client := newClient(b)
+			b.SetBytes(int64(size))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				require.NoError(b, client.Put(ctx, "object", bytes.NewReader(data)))
+			}
+		})
+		b.Run(fmt.Sprintf("Get/%dMB", size>>20), func(b *testing.B) {
+			client := newClient(b)
+			require.NoError(b, client.Put(ctx, "object", bytes.NewReader(data)))
+			b.SetBytes(int64(size))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				require.NoError(b, client.Get(ctx, "object", ioutil.Discard))
+			}
+		})
+		// reading the middle 1KB of the object should take the same time
+		// regardless of the object's size.
+		b.Run(fmt.Sprintf("GetRange/%dMB", size>>20), func(b *testing.B) {
+			client := newClient(b)
+			require.NoError(b, client.Put(ctx, "object", bytes.NewReader(data)))
+			b.SetBytes(1 << 10)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				require.NoError(b, GetRange(ctx, client, "object", int64(size/2), 1<<10, ioutil.Discard))
+			}
+		})
+	}
 }
 
 // TestStorage is a defensive method for checking to make sure that storage is
@@ -46,8 +46,8 @@ func (o *tracingObjClient) Put(ctx context.Context, name string, r io.Reader) (r
 	return o.Client.Put(ctx, name, r)
 }
 
-// Reader implements the corresponding method in the Client interface
-func (o *tracingObjClient) Reader(ctx context.Context, name string, offset uint64, size uint64, w io.Writer) (retErr error) {
+// GetRange implements the corresponding method in the RangeReader interface
+func (o *tracingObjClient) GetRange(ctx context.Context, name string, offset, size int64, w io.Writer) (retErr error) {
 	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/"+o.provider+".Reader/Connect",
 		"name", name,
 		"offset", fmt.Sprintf("%d", offset),
@@ -55,7 +55,7 @@ func (o *tracingObjClient) Reader(ctx context.Context, name string, offset uint6
 	defer func() {
 		tracing.FinishAnySpan(span, "err", retErr)
 	}()
-	return o.Client.Get(ctx, name, w)
+	return GetRange(ctx, o.Client, name, offset, size, w)
 }
 
 // Delete implements the corresponding method in the Client interface
@@ -37,6 +37,14 @@ func (cc *uniformClient) Get(ctx context.Context, name string, w io.Writer) (ret
 	return cc.c.Get(ctx, name, w)
 }
 
+func (cc *uniformClient) GetRange(ctx context.Context, name string, offset, size int64, w io.Writer) (retErr error) {
+	defer func() {
+		retErr = errors.EnsureStack(retErr)
+	}()
+	name = strings.Trim(name, "/")
+	return GetRange(ctx, cc.c, name, offset, size, w)
+}
+
 func (cc *uniformClient) Delete(ctx context.Context, name string) (retErr error) {
 	defer func() {
 		retErr = errors.EnsureStack(retErr)
@@ -2,10 +2,14 @@ package obj
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"io/ioutil"
 	"strings"
 	"testing"
 
+	"github.com/pachyderm/pachyderm/v2/src/client/limit"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 	"golang.org/x/sync/errgroup"
 )
@@ -38,6 +42,83 @@ func WithPipe(wcb func(w io.Writer) error, rcb func(r io.Reader) error) error {
 	return eg.Wait()
 }
 
+// httpRange returns the value of an HTTP Range header that requests size bytes
+// starting at offset (or from offset to the end if size is 0), or "" if it
+// requests the whole object.
+func httpRange(offset, size int64) string {
+	switch {
+	case offset == 0 && size == 0:
+		return ""
+	case size == 0:
+		return fmt.Sprintf("bytes=%d-", offset)
+	default:
+		return fmt.Sprintf("bytes=%d-%d", offset, offset+size-1)
+	}
+}
+
+// putParts reads r in parts of partSize bytes. If all of r fits in one part,
+// it calls putObject with it and returns 0. Otherwise, it calls begin (if it's
+// not nil), then calls putPart with each part and its index, concurrently for
+// up to concurrency parts, and returns the number of parts.
+func putParts(ctx context.Context, r io.Reader, partSize int64, concurrency int,
+	putObject func(ctx context.Context, data []byte) error,
+	begin func(ctx context.Context) error,
+	putPart func(ctx context.Context, i int, part []byte) error) (int, error) {
+	first, last, err := readPart(r, partSize)
+	if err != nil {
+		return 0, err
+	}
+	if last {
+		return 0, putObject(ctx, first)
+	}
+	if begin != nil {
+		if err := begin(ctx); err != nil {
+			return 0, err
+		}
+	}
+	eg, ctx := errgroup.WithContext(ctx)
+	limiter := limit.New(concurrency)
+	put := func(i int, part []byte) {
+		limiter.Acquire()
+		eg.Go(func() error {
+			defer limiter.Release()
+			return putPart(ctx, i, part)
+		})
+	}
+	put(0, first)
+	n := 1
+	for !last {
+		var part []byte
+		part, last, err = readPart(r, partSize)
+		if err != nil {
+			eg.Wait()
+			return 0, err
+		}
+		if len(part) == 0 {
+			break
+		}
+		put(n, part)
+		n++
+		// stop reading if a part has already failed
+		select {
+		case <-ctx.Done():
+			return 0, eg.Wait()
+		default:
+		}
+	}
+	return n, eg.Wait()
+}
+
+// readPart reads up to partSize bytes from r, and reports whether it reached
+// the end of r.
+func readPart(r io.Reader, partSize int64) ([]byte, bool, error) {
+	part, err := ioutil.ReadAll(io.LimitReader(r, partSize))
+	if err != nil {
+		return nil, false, errors.EnsureStack(err)
+	}
+	return part, int64(len(part)) < partSize, nil
+}
+
 // NewTestClient creates a obj.Client which is cleaned up after the test exists
 func NewTestClient(t testing.TB) (Client, string) {
 	dir := t.TempDir()
@@ -0,0 +1,178 @@
+// Package pagination implements the page tokens of the listing RPCs. A page
+// token is opaque to clients; it records the key of the last item of a page,
+// so that the next page can continue the listing after it.
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// TrailerKey is the gRPC trailer that the next page token of a paginated
+// streaming RPC is returned in.
+const TrailerKey = "pach-next-page-token"
+
+// The kinds of listings. A page token can only continue a listing of the kind
+// it was returned by.
+const (
+	// Files are listed in path order.
+	Files = "files"
+	// Commits are listed in commit key order.
+	Commits = "commits"
+	// CommitAncestors are listed by walking a commit's ancestry.
+	CommitAncestors = "commit-ancestors"
+	// FileHistory is listed by walking a commit's ancestry, so its keys are a
+	// commit ID and a path in that commit.
+	FileHistory = "file-history"
+	// PipelineJobs are listed newest first by creation time.
+	PipelineJobs = "jobs"
+	// Datums are listed in datum ID order.
+	Datums = "datums"
+	// InputDatums are listed in datum ID order from a snapshot of an input's
+	// datums in a temporary fileset, so their keys are the fileset's ID and a
+	// datum ID.
+	InputDatums = "input-datums"
+)
+
+type token struct {
+	Kind  string `json:"k"`
+	After string `json:"a"`
+}
+
+// Encode returns the page token that continues a listing of kind after the
+// item with the key after.
+func Encode(kind, after string) string {
+	data, err := json.Marshal(&token{Kind: kind, After: after})
+	if err != nil {
+		// Marshalling a struct of strings can't fail.
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Decode returns the key of the item that the page token pageToken continues
+// a listing of kind after. The key of an empty token is empty.
+func Decode(kind, pageToken string) (string, error) {
+	if pageToken == "" {
+		return "", nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return "", errors.Errorf("invalid page token %q", pageToken)
+	}
+	var t token
+	if err := json.Unmarshal(data, &t); err != nil {
+		return "", errors.Errorf("invalid page token %q", pageToken)
+	}
+	if t.Kind != kind {
+		return "", errors.Errorf("page token %q is for a listing of %s, not %s", pageToken, t.Kind, kind)
+	}
+	return t.After, nil
+}
+
+// EncodeFileHistory returns the page token that continues a FileHistory
+// listing at the commit with ID commitID, after the version of the file at
+// path in it. An empty path continues with the first file in the commit.
+func EncodeFileHistory(commitID, path string) string {
+	return Encode(FileHistory, commitID+":"+path)
+}
+
+// DecodeFileHistory returns the commit ID and path of a page token returned
+// by EncodeFileHistory. Both are empty for an empty token.
+func DecodeFileHistory(pageToken string) (commitID, path string, _ error) {
+	after, err := Decode(FileHistory, pageToken)
+	if err != nil || after == "" {
+		return "", "", err
+	}
+	parts := strings.SplitN(after, ":", 2)
+	if len(parts) != 2 {
+		return "", "", errors.Errorf("invalid page token %q", pageToken)
+	}
+	return parts[0], parts[1], nil
+}
+
+// InputDatumsKey returns the key of the datum with ID datumID in the snapshot
+// of an InputDatums listing in the fileset with ID filesetID.
+func InputDatumsKey(filesetID, datumID string) string {
+	return filesetID + ":" + datumID
+}
+
+// DecodeInputDatums returns the fileset ID and datum ID of a page token
+// returned for an InputDatums listing. Both are empty for an empty token.
+func DecodeInputDatums(pageToken string) (filesetID, datumID string, _ error) {
+	after, err := Decode(InputDatums, pageToken)
+	if err != nil || after == "" {
+		return "", "", err
+	}
+	parts := strings.SplitN(after, ":", 2)
+	if len(parts) != 2 {
+		return "", "", errors.Errorf("invalid page token %q", pageToken)
+	}
+	return parts[0], parts[1], nil
+}
+
+// Pager limits a listing to a page, and computes the token of the next page.
+type Pager struct {
+	kind     string
+	pageSize int64
+	sent     int64
+	last     string
+	next     string
+}
+
+// NewPager returns a Pager for a listing of kind whose pages have at most
+// pageSize items, or any number of items if pageSize is 0.
+func NewPager(kind string, pageSize int64) (*Pager, error) {
+	if pageSize < 0 {
+		return nil, errors.Errorf("page size must not be negative, got %d", pageSize)
+	}
+	return &Pager{kind: kind, pageSize: pageSize}, nil
+}
+
+// Add must be called with the key of each item before it's sent. If the page
+// is already full, it records the next page token, which continues the
+// listing with this item, and returns errutil.ErrBreak.
+func (p *Pager) Add(key string) error {
+	if p.pageSize > 0 && p.sent == p.pageSize {
+		p.next = Encode(p.kind, p.last)
+		return errutil.ErrBreak
+	}
+	p.sent++
+	p.last = key
+	return nil
+}
+
+// NextPageToken returns the token of the next page, or "" if the listing
+// ended within this page.
+func (p *Pager) NextPageToken() string {
+	return p.next
+}
+
+// Finish returns the error that ended the listing, after sending the next
+// page token, if any, in the trailer of stream. A listing ended by Add isn't
+// an error.
+func (p *Pager) Finish(stream grpc.ServerStream, err error) error {
+	if errors.Is(err, errutil.ErrBreak) {
+		err = nil
+	}
+	if err == nil && p.next != "" {
+		stream.SetTrailer(metadata.Pairs(TrailerKey, p.next))
+	}
+	return err
+}
+
+// NextPageTokenFromTrailer returns the next page token in the trailer of a
+// paginated streaming RPC, or "" if it was the last page.
+func NextPageTokenFromTrailer(trailer metadata.MD) string {
+	if values := trailer.Get(TrailerKey); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
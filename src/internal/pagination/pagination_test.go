@@ -0,0 +1,89 @@
+package pagination
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestToken(t *testing.T) {
+	after, err := Decode(Files, Encode(Files, "/a/b"))
+	require.NoError(t, err)
+	require.Equal(t, "/a/b", after)
+
+	after, err = Decode(Files, "")
+	require.NoError(t, err)
+	require.Equal(t, "", after)
+
+	_, err = Decode(Commits, Encode(Files, "/a/b"))
+	require.YesError(t, err)
+	_, err = Decode(Files, "not a token")
+	require.YesError(t, err)
+}
+
+func TestFileHistoryToken(t *testing.T) {
+	commitID, path, err := DecodeFileHistory(EncodeFileHistory("abc", "/a:b"))
+	require.NoError(t, err)
+	require.Equal(t, "abc", commitID)
+	require.Equal(t, "/a:b", path)
+
+	commitID, path, err = DecodeFileHistory("")
+	require.NoError(t, err)
+	require.Equal(t, "", commitID)
+	require.Equal(t, "", path)
+
+	_, _, err = DecodeFileHistory(Encode(FileHistory, "abc"))
+	require.YesError(t, err)
+	_, _, err = DecodeFileHistory(Encode(Files, "abc:/a"))
+	require.YesError(t, err)
+}
+
+func TestInputDatumsToken(t *testing.T) {
+	filesetID, datumID, err := DecodeInputDatums(Encode(InputDatums, InputDatumsKey("fs", "d1")))
+	require.NoError(t, err)
+	require.Equal(t, "fs", filesetID)
+	require.Equal(t, "d1", datumID)
+
+	filesetID, datumID, err = DecodeInputDatums("")
+	require.NoError(t, err)
+	require.Equal(t, "", filesetID)
+	require.Equal(t, "", datumID)
+
+	_, _, err = DecodeInputDatums(Encode(InputDatums, "3"))
+	require.YesError(t, err)
+	_, _, err = DecodeInputDatums(Encode(Datums, "fs:d1"))
+	require.YesError(t, err)
+}
+
+func TestPager(t *testing.T) {
+	_, err := NewPager(Files, -1)
+	require.YesError(t, err)
+
+	p, err := NewPager(Datums, 2)
+	require.NoError(t, err)
+	require.NoError(t, p.Add("a"))
+	require.NoError(t, p.Add("b"))
+	require.Equal(t, "", p.NextPageToken())
+	require.True(t, errors.Is(p.Add("c"), errutil.ErrBreak))
+	after, err := Decode(Datums, p.NextPageToken())
+	require.NoError(t, err)
+	require.Equal(t, "b", after)
+
+	// A page size of 0 doesn't limit the listing.
+	p, err = NewPager(Datums, 0)
+	require.NoError(t, err)
+	for _, key := range []string{"a", "b", "c"} {
+		require.NoError(t, p.Add(key))
+	}
+	require.Equal(t, "", p.NextPageToken())
+}
+
+func TestNextPageTokenFromTrailer(t *testing.T) {
+	token := Encode(Commits, "repo@id")
+	require.Equal(t, token, NextPageTokenFromTrailer(metadata.Pairs(TrailerKey, token)))
+	require.Equal(t, "", NextPageTokenFromTrailer(metadata.MD{}))
+}
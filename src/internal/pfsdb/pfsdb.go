@@ -14,13 +14,37 @@ import (
 )
 
 const (
+	projectsCollectionName    = "projects"
 	reposCollectionName       = "repos"
 	branchesCollectionName    = "branches"
 	commitsCollectionName     = "commits"
 	openCommitsCollectionName = "open_commits"
 	jobsCollectionName        = "jobs"
+	remotesCollectionName     = "remotes"
 )
 
+var projectsIndexes = []*col.Index{}
+
+// Projects returns a collection of projects, keyed by name
+func Projects(db *sqlx.DB, listener *col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(
+		projectsCollectionName,
+		db,
+		listener,
+		&pfs.ProjectInfo{},
+		projectsIndexes,
+		nil,
+	)
+}
+
+// ProjectKey returns the key of a project in the projects collection.
+func ProjectKey(project *pfs.Project) string {
+	if pfs.IsDefaultProject(project.GetName()) {
+		return pfs.DefaultProjectName
+	}
+	return project.Name
+}
+
 var ReposTypeIndex = &col.Index{
 	Name: "type",
 	Extract: func(val proto.Message) string {
@@ -28,17 +52,22 @@ var ReposTypeIndex = &col.Index{
 	},
 }
 
+// ReposNameIndex maps repo names, qualified with their project, to the repos
+// of every type with that name.
 var ReposNameIndex = &col.Index{
 	Name: "name",
 	Extract: func(val proto.Message) string {
-		return val.(*pfs.RepoInfo).Repo.Name
+		return val.(*pfs.RepoInfo).Repo.QualifiedName()
 	},
 }
 
 var reposIndexes = []*col.Index{ReposNameIndex, ReposTypeIndex}
 
+// RepoKey returns the key of a repo in the repos collection. Repos in the
+// default project aren't prefixed with it, so that repos created before
+// projects existed keep their keys.
 func RepoKey(repo *pfs.Repo) string {
-	return repo.Name + "." + repo.Type
+	return repo.QualifiedName() + "." + repo.Type
 }
 
 func repoKeyCheck(key string) error {
@@ -148,6 +177,20 @@ func Jobs(db *sqlx.DB, listener *col.PostgresListener) col.PostgresCollection {
 	)
 }
 
+var remotesIndexes = []*col.Index{}
+
+// Remotes returns a collection of remotes, keyed by name
+func Remotes(db *sqlx.DB, listener *col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(
+		remotesCollectionName,
+		db,
+		listener,
+		&pfs.Remote{},
+		remotesIndexes,
+		nil,
+	)
+}
+
 // AllCollections returns a list of all the PFS collections for
 // postgres-initialization purposes. These collections are not usable for
 // querying.
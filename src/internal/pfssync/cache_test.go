@@ -0,0 +1,66 @@
+package pfssync_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfssync"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
+	tu "github.com/pachyderm/pachyderm/v2/src/internal/testutil"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+func TestDownloadCached(t *testing.T) {
+	env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+	c := env.PachClient
+	repo := "repo"
+	require.NoError(t, c.CreateRepo(repo))
+	files := map[string]string{
+		"/a":     "a\n",
+		"/b":     "b\n",
+		"/dir/c": "c\n",
+	}
+	commit1, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	for p, data := range files {
+		require.NoError(t, c.PutFile(commit1, p, strings.NewReader(data)))
+	}
+	require.NoError(t, c.FinishCommit(repo, "master", commit1.ID))
+	cache := kv.NewMemCache(10)
+	download := func(commit *pfs.Commit) string {
+		dir := t.TempDir()
+		require.NoError(t, pfssync.WithDownloader(c, func(d pfssync.Downloader) error {
+			return d.Download(dir, client.NewFile(repo, "master", commit.ID, "/"))
+		}, pfssync.WithCache(cache, 1024)))
+		return dir
+	}
+	check := func(dir string) {
+		for p, data := range files {
+			actual, err := ioutil.ReadFile(filepath.Join(dir, p))
+			require.NoError(t, err)
+			require.Equal(t, data, string(actual))
+		}
+	}
+	// Nothing is cached yet, so everything is downloaded and cached.
+	check(download(commit1))
+	for p := range files {
+		fi, err := c.InspectFile(commit1, p)
+		require.NoError(t, err)
+		require.NoError(t, cache.Get(c.Ctx(), fi.Hash, func([]byte) error { return nil }))
+	}
+	// Only the new file misses the cache, and is downloaded on its own.
+	commit2, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	files["/dir/d"] = "d\n"
+	require.NoError(t, c.PutFile(commit2, "/dir/d", strings.NewReader(files["/dir/d"])))
+	require.NoError(t, c.FinishCommit(repo, "master", commit2.ID))
+	check(download(commit2))
+	fi, err := c.InspectFile(commit2, "/dir/d")
+	require.NoError(t, err)
+	require.NoError(t, cache.Get(c.Ctx(), fi.Hash, func([]byte) error { return nil }))
+}
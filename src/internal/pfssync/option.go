@@ -1,6 +1,24 @@
 package pfssync
 
-import "archive/tar"
+import (
+	"archive/tar"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+)
+
+// DownloaderOption configures a downloader.
+type DownloaderOption func(*downloader)
+
+// WithCache configures the downloader to copy files out of 'cache' (which is
+// keyed by file hash) when their content is in it, rather than downloading
+// them, and to add the files that it downloads to 'cache'. Files larger than
+// maxBytes are never added.
+func WithCache(cache kv.GetPut, maxBytes int64) DownloaderOption {
+	return func(d *downloader) {
+		d.cache = cache
+		d.maxCacheBytes = maxBytes
+	}
+}
 
 // DownloadOption configures a download call.
 type DownloadOption func(*downloadConfig)
@@ -2,7 +2,9 @@ package pfssync
 
 import (
 	"archive/tar"
+	"bytes"
 	"io"
+	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
@@ -10,6 +12,8 @@ import (
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tarutil"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"golang.org/x/sync/errgroup"
@@ -22,19 +26,24 @@ type Downloader interface {
 }
 
 type downloader struct {
-	pachClient *client.APIClient
-	pipes      map[string]struct{}
-	eg         *errgroup.Group
-	done       bool
+	pachClient    *client.APIClient
+	pipes         map[string]struct{}
+	eg            *errgroup.Group
+	done          bool
+	cache         kv.GetPut
+	maxCacheBytes int64
 }
 
 // WithDownloader provides a scoped environment for a Downloader.
-func WithDownloader(pachClient *client.APIClient, cb func(Downloader) error) (retErr error) {
+func WithDownloader(pachClient *client.APIClient, cb func(Downloader) error, opts ...DownloaderOption) (retErr error) {
 	d := &downloader{
 		pachClient: pachClient,
 		pipes:      make(map[string]struct{}),
 		eg:         &errgroup.Group{},
 	}
+	for _, opt := range opts {
+		opt(d)
+	}
 	defer func() {
 		d.done = true
 		if err := d.closePipes(); retErr == nil {
@@ -84,6 +93,9 @@ func (d *downloader) Download(storageRoot string, file *pfs.File, opts ...Downlo
 	if dc.lazy || dc.empty {
 		return d.downloadInfo(storageRoot, file, dc)
 	}
+	if d.cache != nil {
+		return d.downloadCached(storageRoot, file, dc)
+	}
 	r, err := d.pachClient.GetFileTar(file.Commit, file.Path)
 	if err != nil {
 		return err
@@ -131,3 +143,138 @@ func (d *downloader) downloadInfo(storageRoot string, file *pfs.File, config *do
 		return errors.EnsureStack(f.Close())
 	})
 }
+
+// downloadCached downloads a PFS file like Download, but copies files out of
+// the cache when their content is in it, and adds the files that it does have
+// to download to the cache. Only the files that aren't in the cache are
+// downloaded, unless none of them are, in which case they're all downloaded
+// in one request.
+func (d *downloader) downloadCached(storageRoot string, file *pfs.File, config *downloadConfig) error {
+	ctx := d.pachClient.Ctx()
+	// Copy as many files as possible out of the cache, remembering the ones
+	// that aren't in it.
+	misses := make(map[string]*pfs.FileInfo)
+	var files int
+	if err := d.pachClient.WalkFile(file.Commit, file.Path, func(fi *pfs.FileInfo) error {
+		fullPath := path.Join(storageRoot, fi.File.Path)
+		if fi.FileType == pfs.FileType_DIR {
+			return errors.EnsureStack(os.MkdirAll(fullPath, 0777))
+		}
+		files++
+		if len(fi.Hash) == 0 {
+			misses[fi.File.Path] = fi
+			return nil
+		}
+		if err := d.cache.Get(ctx, fi.Hash, func(data []byte) error {
+			if config.headerCallback != nil {
+				if err := config.headerCallback(tarutil.NewHeader(fi.File.Path, int64(len(data)))); err != nil {
+					return err
+				}
+			}
+			return writeFile(fullPath, data)
+		}); err != nil {
+			if !pacherr.IsNotExist(err) {
+				return err
+			}
+			misses[fi.File.Path] = fi
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if len(misses) == 0 {
+		return nil
+	}
+	if len(misses) < files {
+		for _, fi := range misses {
+			if err := d.downloadMiss(storageRoot, file.Commit, fi, config); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	r, err := d.pachClient.GetFileTar(file.Commit, file.Path)
+	if err != nil {
+		return err
+	}
+	return tarutil.Iterate(r, func(f tarutil.File) error {
+		hdr, err := f.Header()
+		if err != nil {
+			return err
+		}
+		fi, ok := misses[hdr.Name]
+		if !ok {
+			// directories
+			return nil
+		}
+		if config.headerCallback != nil {
+			if err := config.headerCallback(hdr); err != nil {
+				return err
+			}
+		}
+		fullPath := path.Join(storageRoot, hdr.Name)
+		if !d.cacheable(fi) {
+			return writeFileFrom(fullPath, f.Content)
+		}
+		buf := &bytes.Buffer{}
+		if err := f.Content(buf); err != nil {
+			return err
+		}
+		return d.cacheAndWrite(fullPath, fi, buf.Bytes())
+	}, true)
+}
+
+// downloadMiss downloads a single file that isn't in the cache.
+func (d *downloader) downloadMiss(storageRoot string, commit *pfs.Commit, fi *pfs.FileInfo, config *downloadConfig) error {
+	if config.headerCallback != nil {
+		if err := config.headerCallback(tarutil.NewHeader(fi.File.Path, int64(fi.SizeBytes))); err != nil {
+			return err
+		}
+	}
+	fullPath := path.Join(storageRoot, fi.File.Path)
+	get := func(w io.Writer) error {
+		return d.pachClient.GetFile(commit, fi.File.Path, w)
+	}
+	if !d.cacheable(fi) {
+		return writeFileFrom(fullPath, get)
+	}
+	buf := &bytes.Buffer{}
+	if err := get(buf); err != nil {
+		return err
+	}
+	return d.cacheAndWrite(fullPath, fi, buf.Bytes())
+}
+
+func (d *downloader) cacheable(fi *pfs.FileInfo) bool {
+	return len(fi.Hash) > 0 && int64(fi.SizeBytes) <= d.maxCacheBytes
+}
+
+func (d *downloader) cacheAndWrite(fullPath string, fi *pfs.FileInfo, data []byte) error {
+	if err := d.cache.Put(d.pachClient.Ctx(), fi.Hash, data); err != nil {
+		return err
+	}
+	return writeFile(fullPath, data)
+}
+
+func writeFile(fullPath string, data []byte) error {
+	if err := os.MkdirAll(path.Dir(fullPath), 0777); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(ioutil.WriteFile(fullPath, data, 0666))
+}
+
+func writeFileFrom(fullPath string, content func(io.Writer) error) (retErr error) {
+	if err := os.MkdirAll(path.Dir(fullPath), 0777); err != nil {
+		return errors.EnsureStack(err)
+	}
+	out, err := os.Create(fullPath)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := out.Close(); retErr == nil {
+			retErr = errors.EnsureStack(err)
+		}
+	}()
+	return content(out)
+}
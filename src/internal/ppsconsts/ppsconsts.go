@@ -6,7 +6,8 @@
 package ppsconsts
 
 const (
-	// SpecRepo contains every pipeline's PipelineInfo (in its own branch)
+	// SpecRepo contains every pipeline's PipelineInfo (in its own branch).
+	// Each project has its own spec repo with this name.
 	SpecRepo = "__spec__"
 
 	// SpecRepoDesc is the description applied to the spec repo.
@@ -17,7 +17,8 @@ const (
 
 var pipelinesIndexes = []*col.Index{}
 
-// Pipelines returns a PostgresCollection of pipelines
+// Pipelines returns a PostgresCollection of pipelines, keyed by their
+// project-qualified names (see pps.Pipeline.QualifiedName)
 func Pipelines(db *sqlx.DB, listener *col.PostgresListener) col.PostgresCollection {
 	return col.NewPostgresCollection(
 		pipelinesCollectionName,
@@ -33,7 +34,7 @@ func Pipelines(db *sqlx.DB, listener *col.PostgresListener) col.PostgresCollecti
 var PipelineJobsPipelineIndex = &col.Index{
 	Name: "Pipeline",
 	Extract: func(val proto.Message) string {
-		return val.(*pps.StoredPipelineJobInfo).Pipeline.Name
+		return val.(*pps.StoredPipelineJobInfo).Pipeline.QualifiedName()
 	},
 }
 
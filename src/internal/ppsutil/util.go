@@ -41,15 +41,33 @@ import (
 
 // PipelineRepo creates a pfs repo for a given pipeline.
 func PipelineRepo(pipeline *pps.Pipeline) *pfs.Repo {
-	return client.NewRepo(pipeline.Name)
+	return client.NewRepo(pipeline.OutputRepoName())
+}
+
+// SpecRepo returns the name of the repo that holds the specs of the pipelines
+// in project. The default project's pipelines share ppsconsts.SpecRepo, and
+// every other project has a spec repo of its own.
+func SpecRepo(project string) string {
+	return pfs.QualifyName(project, ppsconsts.SpecRepo)
+}
+
+// SpecBranch returns the branch of its project's spec repo that holds a
+// pipeline's specs.
+func SpecBranch(pipeline *pps.Pipeline) *pfs.Branch {
+	return client.NewBranch(SpecRepo(pipeline.GetProject().GetName()), pipeline.Name)
 }
 
 // PipelineRcName generates the name of the k8s replication controller that
-// manages a pipeline's workers
-func PipelineRcName(name string, version uint64) string {
+// manages a pipeline's workers. Pipelines outside the default project include
+// their project in the name.
+func PipelineRcName(pipeline *pps.Pipeline, version uint64) string {
 	// k8s won't allow RC names that contain upper-case letters
 	// or underscores
 	// TODO: deal with name collision
+	name := pipeline.Name
+	if project := pipeline.GetProject().GetName(); !pfs.IsDefaultProject(project) {
+		name = project + "-" + name
+	}
 	name = strings.Replace(name, "_", "-", -1)
 	return fmt.Sprintf("pipeline-%s-v%d", strings.ToLower(name), version)
 }
@@ -110,6 +128,20 @@ func GetLimitsResourceList(limits *pps.ResourceSpec) (*v1.ResourceList, error) {
 	return getResourceListFromSpec(limits)
 }
 
+// CacheBytes returns the number of bytes that each of a pipeline's caches may
+// use: the sidecar's chunk cache and the worker's input file cache each get
+// half of the pipeline's cache_size. It returns 0 if cache_size is unset.
+func CacheBytes(pipelineInfo *pps.PipelineInfo) (int64, error) {
+	if pipelineInfo.CacheSize == "" {
+		return 0, nil
+	}
+	cacheSize, err := resource.ParseQuantity(pipelineInfo.CacheSize)
+	if err != nil {
+		return 0, errors.Wrapf(err, "could not parse cache size %q", pipelineInfo.CacheSize)
+	}
+	return cacheSize.Value() / 2, nil
+}
+
 // GetPipelineInfoAllowIncomplete retrieves and returns a PipelineInfo from PFS,
 // or a sparsely-populated PipelineInfo if the spec data cannot be found in PPS
 // (e.g. due to corruption or a missing block). It does the PFS
@@ -132,7 +164,13 @@ func GetPipelineInfoAllowIncomplete(pachClient *client.APIClient, ptr *pps.Store
 	result.Reason = ptr.Reason
 	result.JobCounts = ptr.JobCounts
 	result.LastJobState = ptr.LastJobState
+	result.QueueState = ptr.QueueState
 	result.SpecCommit = ptr.SpecCommit
+	if !result.MaxQueueSizeEnforced {
+		// Pipelines created before max_queue_size was enforced all have it
+		// defaulted to 1, which would now make them process one job at a time.
+		result.MaxQueueSize = 0
+	}
 	return result, nil
 }
 
@@ -146,13 +184,15 @@ func GetPipelineInfo(pachClient *client.APIClient, ptr *pps.StoredPipelineInfo)
 	return result, err
 }
 
-// FailPipeline updates the pipeline's state to failed and sets the failure reason
+// FailPipeline updates the pipeline's state to failed and sets the failure
+// reason. pipelineName is qualified with the pipeline's project.
 func FailPipeline(ctx context.Context, db *sqlx.DB, pipelinesCollection col.PostgresCollection, pipelineName string, reason string) error {
 	return SetPipelineState(ctx, db, pipelinesCollection, pipelineName,
 		nil, pps.PipelineState_PIPELINE_FAILURE, reason)
 }
 
-// CrashingPipeline updates the pipeline's state to crashing and sets the reason
+// CrashingPipeline updates the pipeline's state to crashing and sets the
+// reason. pipelineName is qualified with the pipeline's project.
 func CrashingPipeline(ctx context.Context, db *sqlx.DB, pipelinesCollection col.PostgresCollection, pipelineName string, reason string) error {
 	return SetPipelineState(ctx, db, pipelinesCollection, pipelineName,
 		nil, pps.PipelineState_PIPELINE_CRASHING, reason)
@@ -207,8 +247,9 @@ func logSetPipelineState(pipeline string, from []pps.PipelineState, to pps.Pipel
 }
 
 // SetPipelineState is a helper that moves the state of 'pipeline' from any of
-// the states in 'from' (if not nil) to 'to'. It will annotate any trace in
-// 'ctx' with information about 'pipeline' that it reads.
+// the states in 'from' (if not nil) to 'to'. 'pipeline' is the pipeline's
+// project-qualified name. It will annotate any trace in 'ctx' with information
+// about 'pipeline' that it reads.
 //
 // This function logs a lot for a library function, but it's mostly (maybe
 // exclusively?) called by the PPS master
@@ -274,7 +315,7 @@ func PipelineJobInput(pipelineInfo *pps.PipelineInfo, outputCommitInfo *pfs.Comm
 	// for a given branch, the commit assigned to it will be the latest commit on that branch
 	// this is ensured by the way we sort the commit provenance when creating the outputCommit
 	for _, prov := range outputCommitInfo.Provenance {
-		branchToCommit[key(prov.Commit.Branch.Repo.Name, prov.Commit.Branch.Name)] = prov.Commit
+		branchToCommit[key(prov.Commit.Branch.Repo.QualifiedName(), prov.Commit.Branch.Name)] = prov.Commit
 	}
 	jobInput := proto.Clone(pipelineInfo.Input).(*pps.Input)
 	pps.VisitInput(jobInput, func(input *pps.Input) error {
@@ -314,6 +355,8 @@ func PipelineReqFromInfo(pipelineInfo *pps.PipelineInfo) *pps.CreatePipelineRequ
 		CacheSize:             pipelineInfo.CacheSize,
 		EnableStats:           pipelineInfo.EnableStats,
 		MaxQueueSize:          pipelineInfo.MaxQueueSize,
+		QueueOverflowPolicy:   pipelineInfo.QueueOverflowPolicy,
+		DatumFailurePolicy:    pipelineInfo.DatumFailurePolicy,
 		Service:               pipelineInfo.Service,
 		ChunkSpec:             pipelineInfo.ChunkSpec,
 		DatumTimeout:          pipelineInfo.DatumTimeout,
@@ -353,7 +396,7 @@ func UpdatePipelineJobState(pipelines col.ReadWriteCollection, pipelineJobs col.
 
 	// Update pipeline
 	pipelinePtr := &pps.StoredPipelineInfo{}
-	if err := pipelines.Get(pipelineJobPtr.Pipeline.Name, pipelinePtr); err != nil {
+	if err := pipelines.Get(pipelineJobPtr.Pipeline.QualifiedName(), pipelinePtr); err != nil {
 		return err
 	}
 	if pipelinePtr.JobCounts == nil {
@@ -364,7 +407,7 @@ func UpdatePipelineJobState(pipelines col.ReadWriteCollection, pipelineJobs col.
 	}
 	pipelinePtr.JobCounts[int32(state)]++
 	pipelinePtr.LastJobState = state
-	if err := pipelines.Put(pipelineJobPtr.Pipeline.Name, pipelinePtr); err != nil {
+	if err := pipelines.Put(pipelineJobPtr.Pipeline.QualifiedName(), pipelinePtr); err != nil {
 		return err
 	}
 
@@ -410,23 +453,25 @@ func FinishPipelineJob(pachClient *client.APIClient, pipelineJobInfo *pps.Pipeli
 
 func WriteJobInfo(pachClient *client.APIClient, pipelineJobInfo *pps.PipelineJobInfo) error {
 	_, err := pachClient.PpsAPIClient.UpdatePipelineJobState(pachClient.Ctx(), &pps.UpdatePipelineJobStateRequest{
-		PipelineJob:   pipelineJobInfo.PipelineJob,
-		State:         pipelineJobInfo.State,
-		Reason:        pipelineJobInfo.Reason,
-		Restart:       pipelineJobInfo.Restart,
-		DataProcessed: pipelineJobInfo.DataProcessed,
-		DataSkipped:   pipelineJobInfo.DataSkipped,
-		DataTotal:     pipelineJobInfo.DataTotal,
-		DataFailed:    pipelineJobInfo.DataFailed,
-		DataRecovered: pipelineJobInfo.DataRecovered,
-		Stats:         pipelineJobInfo.Stats,
+		PipelineJob:           pipelineJobInfo.PipelineJob,
+		State:                 pipelineJobInfo.State,
+		Reason:                pipelineJobInfo.Reason,
+		Restart:               pipelineJobInfo.Restart,
+		DataProcessed:         pipelineJobInfo.DataProcessed,
+		DataSkipped:           pipelineJobInfo.DataSkipped,
+		DataTotal:             pipelineJobInfo.DataTotal,
+		DataFailed:            pipelineJobInfo.DataFailed,
+		DataRecovered:         pipelineJobInfo.DataRecovered,
+		DataQuarantined:       pipelineJobInfo.DataQuarantined,
+		DataSkippedByExitCode: pipelineJobInfo.DataSkippedByExitCode,
+		Stats:                 pipelineJobInfo.Stats,
 	})
 	return err
 }
 
 func GetStatsCommit(commitInfo *pfs.CommitInfo) *pfs.Commit {
 	for _, commitRange := range commitInfo.Subvenance {
-		if commitRange.Lower.Branch.Repo.Name == commitInfo.Commit.Branch.Repo.Name {
+		if commitRange.Lower.Branch.Repo.QualifiedName() == commitInfo.Commit.Branch.Repo.QualifiedName() {
 			return commitRange.Lower
 		}
 	}
@@ -457,6 +502,34 @@ func SidecarS3GatewayService(pipelineJobID string) string {
 	return "s3-" + pipelineJobID
 }
 
+// datumS3BucketIDLen is the number of characters of the datum ID included in
+// the name of a per-datum s3 bucket. The full ID would exceed the maximum
+// length of an s3 bucket name.
+const datumS3BucketIDLen = 16
+
+// DatumS3Bucket returns the name of the bucket through which the sidecar s3
+// gateway exposes the files in input 'inputName' that belong to the datum
+// 'datumID'. Like SidecarS3GatewayService, this is in ppsutil because both the
+// s3 gateway sidecar (which serves the bucket) and the worker (which passes
+// the bucket name to the user code) need to know it.
+func DatumS3Bucket(datumID, inputName string) string {
+	if len(datumID) > datumS3BucketIDLen {
+		datumID = datumID[:datumS3BucketIDLen]
+	}
+	return datumID + "." + inputName
+}
+
+// ParseDatumS3Bucket is the inverse of DatumS3Bucket. It returns the datum ID
+// prefix and input name encoded in 'bucket', and false if 'bucket' isn't the
+// name of a per-datum bucket.
+func ParseDatumS3Bucket(bucket string) (datumIDPrefix, inputName string, ok bool) {
+	parts := strings.SplitN(bucket, ".", 2)
+	if len(parts) != 2 || len(parts[0]) != datumS3BucketIDLen || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
 // ErrorState returns true if s is an error state for a pipeline, that is, a
 // state that users should be aware of and one which will have a "Reason" set
 // for why it's in this state.
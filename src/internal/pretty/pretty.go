@@ -37,6 +37,19 @@ func Ago(timestamp *types.Timestamp) string {
 	return fmt.Sprintf("%s ago", since)
 }
 
+// Until pretty-prints the amount of time until timestamp as a human-readable
+// string, such as "in 5 minutes", or as Ago does if timestamp has passed.
+func Until(timestamp *types.Timestamp) string {
+	t, _ := types.TimestampFromProto(timestamp)
+	if t.Equal(time.Time{}) {
+		return ""
+	}
+	if !t.After(time.Now()) {
+		return Ago(timestamp)
+	}
+	return fmt.Sprintf("in %s", units.HumanDuration(time.Until(t)))
+}
+
 // TimeDifference pretty-prints the duration of time between from
 // and to as a human-reabable string.
 func TimeDifference(from *types.Timestamp, to *types.Timestamp) string {
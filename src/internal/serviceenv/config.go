@@ -10,6 +10,7 @@ type Configuration struct {
 // GlobalConfiguration contains the global configuration.
 type GlobalConfiguration struct {
 	FeatureFlags
+	ProfilingConfiguration
 	EtcdHost            string `env:"ETCD_SERVICE_HOST,required"`
 	EtcdPort            string `env:"ETCD_SERVICE_PORT,required"`
 	PPSWorkerPort       uint16 `env:"PPS_WORKER_GRPC_PORT,default=80"`
@@ -29,6 +30,7 @@ type GlobalConfiguration struct {
 	PostgresServicePort int    `env:"POSTGRES_SERVICE_PORT"`
 	PostgresServiceSSL  string `env:"POSTGRES_SERVICE_SSL,default=disable"`
 	PostgresDBName      string `env:"POSTGRES_DATABASE_NAME"`
+	CoordinationBackend string `env:"COORDINATION_BACKEND,default=etcd"`
 
 	// PPSSpecCommitID is only set for workers and sidecar pachd instances.
 	// Because both pachd and worker need to know the spec commit (the worker so
@@ -36,8 +38,19 @@ type GlobalConfiguration struct {
 	// sidecar so that it can serve the S3 gateway) it's stored in the
 	// GlobalConfiguration, but it isn't set in a cluster's main pachd containers.
 	PPSSpecCommitID string `env:"PPS_SPEC_COMMIT"`
+	// PPSProjectName is the project of the pipeline that a worker or sidecar
+	// belongs to, and is set alongside PPSSpecCommitID.
+	PPSProjectName string `env:"PPS_PROJECT_NAME"`
 }
 
+const (
+	// EtcdCoordination keeps task queues and distributed locks in etcd.
+	EtcdCoordination = "etcd"
+	// PostgresCoordination keeps task queues and distributed locks in
+	// Postgres.
+	PostgresCoordination = "postgres"
+)
+
 // PachdFullConfiguration contains the full pachd configuration.
 type PachdFullConfiguration struct {
 	GlobalConfiguration
@@ -71,6 +84,16 @@ type PachdSpecificConfiguration struct {
 	MetricsEndpoint            string `env:"METRICS_ENDPOINT",default="`
 	// TODO: Merge this with the worker specific pod name (PPS_POD_NAME) into a global configuration pod name.
 	PachdPodName string `env:"PACHD_POD_NAME,required"`
+	// WorkerRuntime selects how the PPS master runs pipeline workers, either
+	// "kubernetes" (replication controllers) or "local" (processes on the same
+	// host as pachd).
+	WorkerRuntime string `env:"WORKER_RUNTIME,default=kubernetes"`
+	// LocalWorkerBinary is the worker binary that pachd runs for each worker
+	// when WorkerRuntime is "local".
+	LocalWorkerBinary string `env:"LOCAL_WORKER_BINARY,default=worker"`
+	// TransactionTTL is how long a transaction may stay open before it is
+	// deleted, unless a different TTL is requested when it is started.
+	TransactionTTL string `env:"TRANSACTION_TTL,default=24h"`
 
 	IdentityServerDatabase string `env:"IDENTITY_SERVER_DATABASE,default=dex"`
 	IdentityServerUser     string `env:"IDENTITY_SERVER_USER,default=postgres"`
@@ -90,6 +113,23 @@ type StorageConfiguration struct {
 	StorageFileSetsMaxOpen         int    `env:"STORAGE_FILESETS_MAX_OPEN,default=50"`
 	StorageDiskCacheSize           int    `env:"STORAGE_DISK_CACHE_SIZE,default=100"`
 	StorageMemoryCacheSize         int    `env:"STORAGE_MEMORY_CACHE_SIZE,default=10"`
+	// StorageChunkCacheBytes bounds the total size of a disk cache of
+	// chunks read through this process (0 disables it). It's set on pipeline
+	// sidecars from the pipeline's cache_size.
+	StorageChunkCacheBytes int64 `env:"STORAGE_CHUNK_CACHE_BYTES,default=0"`
+	// StorageColdTierURL is the object store (e.g. an archive bucket) that
+	// chunks only referenced by cold commits are moved to. Chunks are never
+	// moved if it's empty.
+	StorageColdTierURL string `env:"STORAGE_COLD_TIER_URL,default="`
+	// StorageColdTierAge is how long after it's finished a commit becomes
+	// cold, for repos that don't set their own age (0 never makes them cold).
+	StorageColdTierAge string `env:"STORAGE_COLD_TIER_AGE,default=0"`
+	// StorageMirrorURL is a second object store (e.g. in another region) that
+	// chunks are also written to. Chunks aren't mirrored if it's empty.
+	StorageMirrorURL string `env:"STORAGE_MIRROR_URL,default="`
+	// StorageMirrorMode is "sync" to write chunks to the mirror before the
+	// write completes, or "async" to write them in the background.
+	StorageMirrorMode string `env:"STORAGE_MIRROR_MODE,default=sync"`
 }
 
 // WorkerFullConfiguration contains the full worker configuration.
@@ -107,6 +147,12 @@ type WorkerSpecificConfiguration struct {
 	PPSPipelineName string `env:"PPS_PIPELINE_NAME,required"`
 	// The name of this pod
 	PodName string `env:"PPS_POD_NAME,required"`
+	// The root directory under which the worker links input data (normally
+	// "/", so that user code sees inputs under /pfs)
+	PPSWorkerRoot string `env:"PPS_WORKER_ROOT,default=/"`
+	// Set when several workers share one IP (e.g. local workers), in which case
+	// workers register themselves in etcd as IP:port rather than just IP
+	PPSWorkerAdvertisePort bool `env:"PPS_WORKER_ADVERTISE_PORT,default=false"`
 }
 
 // FeatureFlags contains the configuration for feature flags.  XXX: if you're
@@ -119,6 +165,26 @@ type FeatureFlags struct {
 	IdentityServerEnabled        bool `env:"IDENTITY_SERVER_ENABLED,default=false"`
 }
 
+// ProfilingConfiguration contains the configuration for continuous
+// profiling, which records profiles of pachd and workers in a PFS repo.
+// Profiling is disabled unless at least one of ProfilingInterval,
+// ProfilingHeapThreshold and ProfilingSlowDatums is set. XXX: these are
+// propagated to workers in src/server/pps/server/worker_rc.go.
+type ProfilingConfiguration struct {
+	// ProfilingInterval is how often to record profiles (e.g. "1h").
+	ProfilingInterval string `env:"PROFILING_INTERVAL,default="`
+	// ProfilingHeapThreshold records profiles when the heap grows beyond this
+	// many bytes.
+	ProfilingHeapThreshold int64 `env:"PROFILING_HEAP_THRESHOLD,default=0"`
+	// ProfilingSlowDatums records profiles when a worker's datum runs for
+	// longer than the 95th percentile of its recent datums.
+	ProfilingSlowDatums bool `env:"PROFILING_SLOW_DATUMS,default=false"`
+	// ProfilingRetention is how long recorded profiles are kept.
+	ProfilingRetention string `env:"PROFILING_RETENTION,default=168h"`
+	// ProfilingCPUDuration is how long each recorded CPU profile runs for.
+	ProfilingCPUDuration string `env:"PROFILING_CPU_DURATION,default=30s"`
+}
+
 // NewConfiguration creates a generic configuration from a specific type of configuration.
 func NewConfiguration(config interface{}) *Configuration {
 	configuration := &Configuration{}
@@ -0,0 +1,47 @@
+package serviceenv
+
+import (
+	"context"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/work"
+)
+
+// CheckCoordinationBackend returns an error if the configured coordination
+// backend isn't one of EtcdCoordination or PostgresCoordination.
+func (c *GlobalConfiguration) CheckCoordinationBackend() error {
+	switch c.CoordinationBackend {
+	case EtcdCoordination, PostgresCoordination:
+		return nil
+	default:
+		return errors.Errorf("unknown coordination backend %q (must be %q or %q)", c.CoordinationBackend, EtcdCoordination, PostgresCoordination)
+	}
+}
+
+// NewDLock creates a distributed lock that locks a given prefix in the
+// coordination backend configured in env.
+func NewDLock(env ServiceEnv, prefix string) dlock.DLock {
+	if env.Config().CoordinationBackend == PostgresCoordination {
+		return dlock.NewPostgresDLock(env.GetDBClient(), prefix)
+	}
+	return dlock.NewDLock(env.GetEtcdClient(), prefix)
+}
+
+// NewTaskQueue sets up a new task queue in the coordination backend configured
+// in env. etcdPrefix is only used by the etcd backend.
+func NewTaskQueue(ctx context.Context, env ServiceEnv, etcdPrefix string, taskNamespace string) (*work.TaskQueue, error) {
+	if env.Config().CoordinationBackend == PostgresCoordination {
+		return work.NewPostgresTaskQueue(ctx, env.GetDBClient(), env.GetPostgresListener(), taskNamespace)
+	}
+	return work.NewTaskQueue(ctx, env.GetEtcdClient(), etcdPrefix, taskNamespace)
+}
+
+// NewTaskWorker creates a new worker in the coordination backend configured in
+// env. etcdPrefix is only used by the etcd backend.
+func NewTaskWorker(env ServiceEnv, etcdPrefix string, taskNamespace string) *work.Worker {
+	if env.Config().CoordinationBackend == PostgresCoordination {
+		return work.NewPostgresWorker(env.GetDBClient(), env.GetPostgresListener(), taskNamespace)
+	}
+	return work.NewWorker(env.GetEtcdClient(), etcdPrefix, taskNamespace)
+}
@@ -25,7 +25,7 @@ func ApplyOptions(config *Configuration, opts ...ConfigOption) {
 // config with certain default values overridden via options.
 func ConfigFromOptions(opts ...ConfigOption) *Configuration {
 	result := &Configuration{
-		GlobalConfiguration:         &GlobalConfiguration{},
+		GlobalConfiguration:         &GlobalConfiguration{CoordinationBackend: EtcdCoordination},
 		PachdSpecificConfiguration:  &PachdSpecificConfiguration{},
 		WorkerSpecificConfiguration: &WorkerSpecificConfiguration{},
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/randutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
 	"github.com/pachyderm/pachyderm/v2/src/internal/testutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
@@ -98,6 +99,65 @@ func TestCopy(t *testing.T) {
 	}
 }
 
+func TestGetRange(t *testing.T) {
+	ctx := context.Background()
+	client := &memClient{chunks: make(map[string][]byte)}
+	cache := kv.NewMemCache(10)
+	for _, test := range []struct {
+		name string
+		algo CompressionAlgo
+		data []byte
+	}{
+		{"Uncompressed", CompressionAlgo_NONE, randutil.Bytes(rand.New(rand.NewSource(0)), units.MB)},
+		{"Compressed", CompressionAlgo_GZIP_BEST_SPEED, make([]byte, units.MB)},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			ref, err := Create(ctx, CreateOptions{Compression: test.algo}, test.data, client.create)
+			require.NoError(t, err)
+			require.Equal(t, test.algo, ref.CompressionAlgo)
+			for _, r := range []struct{ offset, size int64 }{
+				{0, 1},
+				{1, 63},
+				{63, 2},
+				{1000, 5000},
+				{int64(len(test.data)) - 100, 100},
+			} {
+				require.NoError(t, GetRange(ctx, client, cache, ref, r.offset, r.size, func(data []byte) error {
+					require.Equal(t, test.data[r.offset:r.offset+r.size], data, "offset: %d, size: %d", r.offset, r.size)
+					return nil
+				}))
+			}
+		})
+	}
+}
+
+// memClient is a Client that stores chunks in memory.
+type memClient struct {
+	chunks map[string][]byte
+}
+
+func (c *memClient) create(_ context.Context, data []byte) (ID, error) {
+	id := Hash(data)
+	c.chunks[id.HexString()] = append([]byte{}, data...)
+	return id, nil
+}
+
+func (c *memClient) Create(ctx context.Context, _ Metadata, data []byte) (ID, error) {
+	return c.create(ctx, data)
+}
+
+func (c *memClient) Get(_ context.Context, id ID, cb kv.ValueCallback) error {
+	return cb(c.chunks[id.HexString()])
+}
+
+func (c *memClient) GetRange(_ context.Context, id ID, offset, size int64, cb kv.ValueCallback) error {
+	return cb(c.chunks[id.HexString()][offset : offset+size])
+}
+
+func (c *memClient) Close() error {
+	return nil
+}
+
 func BenchmarkWriter(b *testing.B) {
 	_, chunks := newTestStorage(b)
 	seed := time.Now().UTC().UnixNano()
@@ -11,6 +11,7 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
 )
@@ -19,6 +20,7 @@ import (
 type Client interface {
 	Create(ctx context.Context, md Metadata, chunkData []byte) (ID, error)
 	Get(ctx context.Context, chunkID ID, cb kv.ValueCallback) error
+	GetRange(ctx context.Context, chunkID ID, offset, size int64, cb kv.ValueCallback) error
 	Close() error
 }
 
@@ -26,6 +28,7 @@ type Client interface {
 // a tracker and an kv.Store
 type trackedClient struct {
 	store   kv.Store
+	cold    kv.Store
 	db      *sqlx.DB
 	tracker track.Tracker
 	renewer *track.Renewer
@@ -113,9 +116,52 @@ func (c *trackedClient) Create(ctx context.Context, md Metadata, chunkData []byt
 
 // Get writes data for a chunk with ID chunkID to w.
 func (c *trackedClient) Get(ctx context.Context, chunkID ID, cb kv.ValueCallback) (retErr error) {
-	var gen uint64
-	err := c.db.Get(&gen, `
-	SELECT gen
+	return c.withStore(chunkID, func(store kv.Store, key []byte) error {
+		return store.Get(ctx, key, cb)
+	})
+}
+
+// GetRange calls cb with size bytes of the data for a chunk with ID chunkID,
+// starting at offset.
+func (c *trackedClient) GetRange(ctx context.Context, chunkID ID, offset, size int64, cb kv.ValueCallback) (retErr error) {
+	return c.withStore(chunkID, func(store kv.Store, key []byte) error {
+		return store.GetRange(ctx, key, offset, size, cb)
+	})
+}
+
+// withStore calls f with the store that a chunk is in, and the chunk's key.
+func (c *trackedClient) withStore(chunkID ID, f func(store kv.Store, key []byte) error) error {
+	ent, err := c.getEntry(chunkID)
+	if err != nil {
+		return err
+	}
+	store, err := tierStore(c.store, c.cold, ent.Tier)
+	if err != nil {
+		return err
+	}
+	getErr := f(store, chunkKey(chunkID, ent.Gen))
+	if !pacherr.IsNotExist(getErr) {
+		return getErr
+	}
+	// The chunk may have moved to another tier since its entry was read.
+	moved, err := c.getEntry(chunkID)
+	if err != nil {
+		return err
+	}
+	if moved.Gen == ent.Gen && moved.Tier == ent.Tier {
+		return getErr
+	}
+	store, err = tierStore(c.store, c.cold, moved.Tier)
+	if err != nil {
+		return err
+	}
+	return f(store, chunkKey(chunkID, moved.Gen))
+}
+
+func (c *trackedClient) getEntry(chunkID ID) (*Entry, error) {
+	ent := &Entry{}
+	err := c.db.Get(ent, `
+	SELECT chunk_id, gen, tier
 	FROM storage.chunk_objects
 	WHERE uploaded = TRUE AND tombstone = FALSE AND chunk_id = $1
 	LIMIT 1
@@ -124,10 +170,9 @@ func (c *trackedClient) Get(ctx context.Context, chunkID ID, cb kv.ValueCallback
 		if err == sql.ErrNoRows {
 			err = errors.Errorf("no objects for chunk %v", chunkID)
 		}
-		return err
+		return nil, err
 	}
-	key := chunkKey(chunkID, gen)
-	return c.store.Get(ctx, key, cb)
+	return ent, nil
 }
 
 // Close closes the client, stopping the background renewal of created objects
@@ -10,6 +10,7 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
 	"github.com/sirupsen/logrus"
 )
 
@@ -28,7 +29,9 @@ func (gc *GarbageCollector) RunForever(ctx context.Context) error {
 	ticker := time.NewTicker(time.Minute)
 	defer ticker.Stop()
 	for {
-		if err := gc.RunOnce(ctx); err != nil {
+		start := time.Now()
+		n, size, err := gc.runOnce(ctx)
+		if err != nil {
 			select {
 			case <-ctx.Done():
 				return err
@@ -36,6 +39,7 @@ func (gc *GarbageCollector) RunForever(ctx context.Context) error {
 			}
 			logrus.Errorf("during chunk GC: %v", err)
 		}
+		gc.recordRun(ctx, start, n, size, err)
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
@@ -45,13 +49,20 @@ func (gc *GarbageCollector) RunForever(ctx context.Context) error {
 }
 
 // RunOnce runs 1 cycle of garbage collection.
-func (gc *GarbageCollector) RunOnce(ctx context.Context) (retErr error) {
+func (gc *GarbageCollector) RunOnce(ctx context.Context) error {
+	_, _, err := gc.runOnce(ctx)
+	return err
+}
+
+// runOnce is RunOnce, but also returns the number of chunks deleted and their
+// total size.
+func (gc *GarbageCollector) runOnce(ctx context.Context) (n int64, size int64, retErr error) {
 	rows, err := gc.s.db.QueryContext(ctx, `
-	SELECT chunk_id, gen, uploaded FROM storage.chunk_objects
+	SELECT chunk_id, gen, uploaded, tier, size FROM storage.chunk_objects
 	WHERE tombstone = true
 	`)
 	if err != nil {
-		return err
+		return 0, 0, err
 	}
 	defer func() {
 		if err := rows.Close(); retErr == nil {
@@ -59,29 +70,56 @@ func (gc *GarbageCollector) RunOnce(ctx context.Context) (retErr error) {
 		}
 	}()
 	for rows.Next() {
-		var ent Entry
+		var ent struct {
+			Entry
+			Size int64 `db:"size"`
+		}
 		if err := sqlx.StructScan(rows, &ent); err != nil {
-			return err
+			return n, size, err
 		}
 		if !ent.Uploaded {
 			logrus.Warnf("possibility for untracked chunk %s", chunkPath(ent.ChunkID, ent.Gen))
 		}
-		if err := gc.deleteOne(ctx, ent); err != nil {
-			return err
+		if err := gc.deleteOne(ctx, ent.Entry); err != nil {
+			return n, size, err
 		}
+		n++
+		size += ent.Size
+	}
+	return n, size, rows.Err()
+}
+
+// recordRun records a run of the garbage collector, so that it can be
+// reported by track.ListGCRuns.
+func (gc *GarbageCollector) recordRun(ctx context.Context, start time.Time, n, size int64, runErr error) {
+	run := track.GCRun{
+		Name:         track.GCRunChunk,
+		StartedAt:    start.UTC(),
+		FinishedAt:   time.Now().UTC(),
+		Deleted:      n,
+		DeletedBytes: size,
+	}
+	if runErr != nil {
+		run.Error = runErr.Error()
+	}
+	if err := track.RecordGCRun(ctx, gc.s.db, run); err != nil {
+		logrus.Errorf("error recording chunk GC run: %v", err)
 	}
-	return rows.Err()
 }
 
 func (gc *GarbageCollector) deleteOne(ctx context.Context, ent Entry) error {
-	if err := gc.deleteObject(ctx, ent.ChunkID, ent.Gen); err != nil {
+	if err := gc.deleteObject(ctx, ent.ChunkID, ent.Gen, ent.Tier); err != nil {
 		return err
 	}
 	return gc.deleteEntry(ctx, ent.ChunkID, ent.Gen)
 }
 
-func (gc *GarbageCollector) deleteObject(ctx context.Context, chunkID ID, gen uint64) error {
-	return gc.s.store.Delete(ctx, chunkKey(chunkID, gen))
+func (gc *GarbageCollector) deleteObject(ctx context.Context, chunkID ID, gen uint64, tier Tier) error {
+	store, err := tierStore(gc.s.store, gc.s.coldStore, tier)
+	if err != nil {
+		return err
+	}
+	return store.Delete(ctx, chunkKey(chunkID, gen))
 }
 
 func (gc *GarbageCollector) deleteEntry(ctx context.Context, chunkID ID, gen uint64) error {
@@ -50,6 +50,7 @@ type Entry struct {
 	Gen       uint64 `db:"gen"`
 	Uploaded  bool   `db:"uploaded"`
 	Tombstone bool   `db:"tombstone"`
+	Tier      Tier   `db:"tier"`
 }
 
 // SetupPostgresStoreV0 sets up tables in db
@@ -76,6 +77,17 @@ func SetupPostgresStoreV0(tx *sqlx.Tx) error {
 	return errors.EnsureStack(err)
 }
 
+// SetupPostgresStoreV1 records the storage tier of each chunk object, and
+// when the object last moved between tiers.
+func SetupPostgresStoreV1(tx *sqlx.Tx) error {
+	_, err := tx.Exec(`
+	ALTER TABLE storage.chunk_objects
+		ADD COLUMN tier VARCHAR(16) NOT NULL DEFAULT 'hot',
+		ADD COLUMN tiered_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+	`)
+	return errors.EnsureStack(err)
+}
+
 // KeyStore is a store for named secret keys
 type KeyStore interface {
 	Create(ctx context.Context, name string, data []byte) error
@@ -8,6 +8,7 @@ import (
 	"github.com/chmduquesne/rollinghash/buzhash64"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 )
 
@@ -29,6 +30,21 @@ func WithObjectCache(fastLayer obj.Client, size int) StorageOption {
 	}
 }
 
+// WithColdTier sets the object store that chunks are moved to when they're
+// moved to the cold tier.
+func WithColdTier(objC obj.Client) StorageOption {
+	return func(s *Storage) {
+		s.coldStore = kv.NewFromObjectClient(objC)
+	}
+}
+
+// WithChunkCache adds a cache of chunks behind the storage's memory cache.
+func WithChunkCache(cache kv.GetPut) StorageOption {
+	return func(s *Storage) {
+		s.memCache = kv.NewLayeredCache(s.memCache, cache)
+	}
+}
+
 // WithSecret sets the secret used to generate chunk encryption keys
 func WithSecret(secret []byte) StorageOption {
 	return func(s *Storage) {
@@ -83,5 +99,25 @@ func StorageOptions(conf *serviceenv.Configuration) ([]StorageOption, error) {
 		}
 		opts = append(opts, WithObjectCache(diskCache, conf.StorageDiskCacheSize))
 	}
+	if conf.StorageChunkCacheBytes > 0 {
+		// The cache's path is stable across restarts, so that its contents
+		// are reused (the chunks are content addressed).
+		chunkCache, err := kv.NewFileCache(filepath.Join(os.TempDir(), "chunk-cache", conf.PachdPodName), conf.StorageChunkCacheBytes)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithChunkCache(chunkCache))
+	}
+	if conf.StorageColdTierURL != "" {
+		url, err := obj.ParseURL(conf.StorageColdTierURL)
+		if err != nil {
+			return nil, err
+		}
+		coldClient, err := obj.NewClientFromURLAndSecret(url)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithColdTier(coldClient))
+	}
 	return opts, nil
 }
@@ -71,6 +71,14 @@ func (dr *DataReader) DataRef() *DataRef {
 
 // Get writes the data referenced by the data reference.
 func (dr *DataReader) Get(w io.Writer) error {
+	// Data references to a small part of a chunk only read that part. Those to
+	// most of a chunk read all of it, so that it's verified and cached.
+	if dr.dataRef.SizeBytes > 0 && dr.dataRef.SizeBytes < dr.dataRef.Ref.SizeBytes/2 {
+		return GetRange(dr.ctx, dr.client, dr.memCache, dr.dataRef.Ref, dr.dataRef.OffsetBytes, dr.dataRef.SizeBytes, func(data []byte) error {
+			_, err := w.Write(data)
+			return err
+		})
+	}
 	return Get(dr.ctx, dr.client, dr.memCache, dr.dataRef.Ref, func(chunk []byte) error {
 		data := chunk[dr.dataRef.OffsetBytes : dr.dataRef.OffsetBytes+dr.dataRef.SizeBytes]
 		_, err := w.Write(data)
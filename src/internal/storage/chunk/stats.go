@@ -0,0 +1,67 @@
+package chunk
+
+import (
+	"context"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+// Stats summarizes the chunks in the object store.
+type Stats struct {
+	Chunks int64 `db:"chunks"`
+	Bytes  int64 `db:"bytes"`
+	// TombstonedChunks and TombstonedBytes are the chunks that have been
+	// marked for deletion, but not yet deleted by the garbage collector.
+	TombstonedChunks int64 `db:"tombstoned_chunks"`
+	TombstonedBytes  int64 `db:"tombstoned_bytes"`
+	// ColdChunks and ColdBytes are the chunks in the cold tier.
+	ColdChunks int64 `db:"cold_chunks"`
+	ColdBytes  int64 `db:"cold_bytes"`
+}
+
+// Stats returns a summary of the chunks in the object store.
+func (s *Storage) Stats(ctx context.Context) (*Stats, error) {
+	stats := &Stats{}
+	if err := s.db.GetContext(ctx, stats, `
+	SELECT
+		count(*) AS chunks,
+		coalesce(sum(size), 0) AS bytes,
+		count(*) FILTER (WHERE tombstone) AS tombstoned_chunks,
+		coalesce(sum(size) FILTER (WHERE tombstone), 0) AS tombstoned_bytes,
+		count(*) FILTER (WHERE tier = 'cold') AS cold_chunks,
+		coalesce(sum(size) FILTER (WHERE tier = 'cold'), 0) AS cold_bytes
+	FROM storage.chunk_objects
+	`); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return stats, nil
+}
+
+// IterateSizes calls 'cb' with the ID and size of each chunk that isn't
+// marked for deletion.
+func (s *Storage) IterateSizes(ctx context.Context, cb func(id ID, size int64) error) (retErr error) {
+	rows, err := s.db.QueryxContext(ctx, `
+	SELECT chunk_id, max(size) FROM storage.chunk_objects
+	WHERE tombstone = FALSE
+	GROUP BY chunk_id
+	`)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); retErr == nil {
+			retErr = errors.EnsureStack(err)
+		}
+	}()
+	for rows.Next() {
+		var id ID
+		var size int64
+		if err := rows.Scan(&id, &size); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if err := cb(id, size); err != nil {
+			return err
+		}
+	}
+	return errors.EnsureStack(rows.Err())
+}
@@ -2,9 +2,11 @@ package chunk
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
@@ -21,6 +23,7 @@ const (
 type Storage struct {
 	objClient obj.Client
 	store     kv.Store
+	coldStore kv.Store
 	memCache  kv.GetPut
 	tracker   track.Tracker
 	db        *sqlx.DB
@@ -50,7 +53,7 @@ func NewStorage(objC obj.Client, memCache kv.GetPut, db *sqlx.DB, tracker track.
 // NewReader creates a new Reader.
 func (s *Storage) NewReader(ctx context.Context, dataRefs []*DataRef) *Reader {
 	// using the empty string for the tmp id to disable the renewer
-	client := NewClient(s.store, s.db, s.tracker, "")
+	client := s.newClient("")
 	return newReader(ctx, client, s.memCache, dataRefs)
 }
 
@@ -61,15 +64,102 @@ func (s *Storage) NewWriter(ctx context.Context, name string, cb WriterCallback,
 	if name == "" {
 		panic("name must not be empty")
 	}
-	client := NewClient(s.store, s.db, s.tracker, name)
+	client := s.newClient(name)
 	return newWriter(ctx, client, s.memCache, s.createOpts, cb, opts...)
 }
 
-// List lists all of the chunks in object storage.
+// List lists all of the chunks in object storage, in every tier.
 func (s *Storage) List(ctx context.Context, cb func(id ID) error) error {
-	return s.store.Walk(ctx, nil, func(key []byte) error {
-		return cb(ID(key))
-	})
+	for _, store := range []kv.Store{s.store, s.coldStore} {
+		if store == nil {
+			continue
+		}
+		if err := store.Walk(ctx, nil, func(key []byte) error {
+			return cb(ID(key))
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewClient creates a new Client for the chunks' stored (compressed and
+// encrypted) data. Chunks created with the client are kept alive until it is
+// closed. If name is empty, the client can't create chunks.
+func (s *Storage) NewClient(name string) Client {
+	return s.newClient(name)
+}
+
+func (s *Storage) newClient(name string) Client {
+	c := NewClient(s.store, s.db, s.tracker, name).(*trackedClient)
+	c.cold = s.coldStore
+	return c
+}
+
+// Missing returns the IDs in ids of the chunks that aren't stored.
+func (s *Storage) Missing(ctx context.Context, ids []ID) ([]ID, error) {
+	var stored []ID
+	var byteIDs [][]byte
+	for _, id := range ids {
+		byteIDs = append(byteIDs, id)
+	}
+	if err := s.db.SelectContext(ctx, &stored, `
+	SELECT DISTINCT chunk_id
+	FROM storage.chunk_objects
+	WHERE uploaded = TRUE AND tombstone = FALSE AND chunk_id = ANY($1)
+	`, pq.ByteaArray(byteIDs)); err != nil {
+		return nil, err
+	}
+	storedSet := make(map[string]bool)
+	for _, id := range stored {
+		storedSet[string(id)] = true
+	}
+	var missing []ID
+	for _, id := range ids {
+		if !storedSet[string(id)] {
+			missing = append(missing, id)
+		}
+	}
+	return missing, nil
+}
+
+// Walk calls cb with each chunk that is reachable from ids, and the chunks
+// that it points to, according to the tracker. A chunk is visited after the
+// chunks that it points to, and only once.
+func (s *Storage) Walk(ctx context.Context, ids []ID, cb func(id ID, pointsTo []ID) error) error {
+	visited := make(map[string]bool)
+	var walk func(ID) error
+	walk = func(id ID) error {
+		if visited[string(id)] {
+			return nil
+		}
+		visited[string(id)] = true
+		downstream, err := s.tracker.GetDownstream(ctx, id.TrackerID())
+		if err != nil {
+			return err
+		}
+		var pointsTo []ID
+		for _, trackerID := range downstream {
+			if !strings.HasPrefix(trackerID, TrackerPrefix) {
+				continue
+			}
+			childID, err := IDFromHex(trackerID[len(TrackerPrefix):])
+			if err != nil {
+				return err
+			}
+			if err := walk(childID); err != nil {
+				return err
+			}
+			pointsTo = append(pointsTo, childID)
+		}
+		return cb(id, pointsTo)
+	}
+	for _, id := range ids {
+		if err := walk(id); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // NewDeleter creates a deleter for use with a tracker.GC
@@ -0,0 +1,108 @@
+package chunk
+
+import (
+	"context"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+)
+
+// Tier is the object store that a chunk is stored in.
+type Tier string
+
+const (
+	// TierHot is the storage's primary object store, which new chunks are
+	// created in.
+	TierHot Tier = "hot"
+	// TierCold is a cheaper, and usually slower, object store (e.g. an
+	// archive bucket) for chunks that are rarely read.
+	TierCold Tier = "cold"
+)
+
+// tierStore returns the store of tier, given the hot and cold stores.
+func tierStore(hot, cold kv.Store, tier Tier) (kv.Store, error) {
+	switch tier {
+	case TierHot:
+		return hot, nil
+	case TierCold:
+		if cold == nil {
+			return nil, errors.Errorf("no cold storage tier is configured")
+		}
+		return cold, nil
+	default:
+		return nil, errors.Errorf("unrecognized storage tier %q", tier)
+	}
+}
+
+// HasColdTier returns true if the storage has a cold tier to move chunks to.
+func (s *Storage) HasColdTier() bool {
+	return s.coldStore != nil
+}
+
+// MoveToTier moves the chunks in ids to tier, and returns the number of chunks
+// that it moved. Chunks that moved between tiers less than minAge ago are left
+// where they are, so that recently rehydrated chunks aren't immediately
+// archived again.
+func (s *Storage) MoveToTier(ctx context.Context, ids []ID, tier Tier, minAge time.Duration) (int64, error) {
+	to, err := tierStore(s.store, s.coldStore, tier)
+	if err != nil {
+		return 0, err
+	}
+	var n int64
+	for _, id := range ids {
+		moved, err := s.moveToTier(ctx, id, tier, to, minAge)
+		if err != nil {
+			return n, err
+		}
+		if moved {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (s *Storage) moveToTier(ctx context.Context, id ID, tier Tier, to kv.Store, minAge time.Duration) (bool, error) {
+	var ents []Entry
+	if err := s.db.SelectContext(ctx, &ents, `
+	SELECT chunk_id, gen, tier
+	FROM storage.chunk_objects
+	WHERE uploaded = TRUE AND tombstone = FALSE AND chunk_id = $1 AND tier != $2
+	AND tiered_at <= CURRENT_TIMESTAMP - $3 * INTERVAL '1 second'
+	`, id, tier, minAge.Seconds()); err != nil {
+		return false, errors.EnsureStack(err)
+	}
+	for _, ent := range ents {
+		from, err := tierStore(s.store, s.coldStore, ent.Tier)
+		if err != nil {
+			return false, err
+		}
+		key := chunkKey(id, ent.Gen)
+		if err := from.Get(ctx, key, func(data []byte) error {
+			return to.Put(ctx, key, data)
+		}); err != nil {
+			return false, err
+		}
+		res, err := s.db.ExecContext(ctx, `
+		UPDATE storage.chunk_objects
+		SET tier = $3, tiered_at = CURRENT_TIMESTAMP
+		WHERE chunk_id = $1 AND gen = $2 AND tombstone = FALSE
+		`, id, ent.Gen, tier)
+		if err != nil {
+			return false, errors.EnsureStack(err)
+		}
+		updated, err := res.RowsAffected()
+		if err != nil {
+			return false, errors.EnsureStack(err)
+		}
+		if updated == 0 {
+			// The chunk was deleted while it was copied, and the garbage
+			// collector only deletes it from the tier it was in.
+			return false, to.Delete(ctx, key)
+		}
+		if err := from.Delete(ctx, key); err != nil {
+			return false, err
+		}
+	}
+	return len(ents) > 0, nil
+}
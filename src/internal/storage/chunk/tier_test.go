@@ -0,0 +1,78 @@
+package chunk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testutil"
+)
+
+func TestMoveToTier(t *testing.T) {
+	ctx := context.Background()
+	db := testutil.NewTestDB(t)
+	tr := track.NewTestTracker(t, db)
+	coldObjC, _ := obj.NewTestClient(t)
+	hotObjC, chunks := NewTestStorage(t, db, tr, WithColdTier(coldObjC))
+	require.True(t, chunks.HasColdTier())
+
+	client := chunks.NewClient("test")
+	defer client.Close()
+	data := []byte("cold data")
+	id, err := client.Create(ctx, Metadata{Size: len(data)}, data)
+	require.NoError(t, err)
+	countObjects := func(objC obj.Client) int {
+		var n int
+		require.NoError(t, objC.Walk(ctx, "", func(string) error {
+			n++
+			return nil
+		}))
+		return n
+	}
+	checkData := func() {
+		require.NoError(t, client.Get(ctx, id, func(value []byte) error {
+			require.Equal(t, data, value)
+			return nil
+		}))
+	}
+
+	// Move the chunk to the cold tier.
+	n, err := chunks.MoveToTier(ctx, []ID{id}, TierCold, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), n)
+	require.Equal(t, 0, countObjects(hotObjC))
+	require.Equal(t, 1, countObjects(coldObjC))
+	checkData()
+	stats, err := chunks.Stats(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), stats.ColdChunks)
+	require.Equal(t, int64(len(data)), stats.ColdBytes)
+
+	// Chunks that are already in a tier aren't moved again, and chunks that
+	// were moved recently are left where they are.
+	n, err = chunks.MoveToTier(ctx, []ID{id}, TierCold, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), n)
+	n, err = chunks.MoveToTier(ctx, []ID{id}, TierHot, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), n)
+
+	// Move the chunk back to the hot tier.
+	n, err = chunks.MoveToTier(ctx, []ID{id}, TierHot, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), n)
+	require.Equal(t, 1, countObjects(hotObjC))
+	require.Equal(t, 0, countObjects(coldObjC))
+	checkData()
+}
+
+func TestNoColdTier(t *testing.T) {
+	ctx := context.Background()
+	_, chunks := newTestStorage(t)
+	require.False(t, chunks.HasColdTier())
+	_, err := chunks.MoveToTier(ctx, nil, TierCold, 0)
+	require.YesError(t, err)
+}
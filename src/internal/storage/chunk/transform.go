@@ -77,6 +77,35 @@ func Get(ctx context.Context, client Client, cache kv.GetPut, ref *Ref, cb kv.Va
 	})
 }
 
+// GetRange calls cb with size bytes of a chunk's uncompressed plaintext,
+// starting at offset. Only that range is read from uncompressed chunks, but,
+// unlike Get, the range can't be verified against the chunk's ID, and isn't
+// cached. Compressed chunks are read with Get.
+func GetRange(ctx context.Context, client Client, cache kv.GetPut, ref *Ref, offset, size int64, cb kv.ValueCallback) error {
+	sliceCb := func(data []byte) error {
+		return cb(data[offset : offset+size])
+	}
+	if ref.CompressionAlgo != CompressionAlgo_NONE {
+		return Get(ctx, client, cache, ref, sliceCb)
+	}
+	if err := getFromCache(ctx, cache, ref, sliceCb); err == nil {
+		return nil
+	}
+	if ref.EncryptionAlgo != EncryptionAlgo_CHACHA20 {
+		return errors.Errorf("unknown encryption algorithm %d", ref.EncryptionAlgo)
+	}
+	return client.GetRange(ctx, ref.Id, offset, size, func(ctext []byte) error {
+		if int64(len(ctext)) != size {
+			return errors.Errorf("bad chunk range. HAVE: %d bytes WANT: %d bytes at offset %d of %x", len(ctext), size, offset, ref.Id)
+		}
+		ptext := make([]byte, len(ctext))
+		if err := decryptAt(ref.Dek, ptext, ctext, offset); err != nil {
+			return err
+		}
+		return cb(ptext)
+	})
+}
+
 // compress attempts to compress src using algo. If the compressed data is bigger
 // then no compression is used.
 // compress returns the compression algorithm used (algo or NONE), the number of bytes written to dst
@@ -169,6 +198,29 @@ func decrypt(dek []byte, r io.Reader) (io.Reader, error) {
 	return cipher.StreamReader{S: ciph, R: r}, nil
 }
 
+// decryptAt decrypts src, which starts at offset in the ciphertext encrypted
+// using dek, writing the output to dst.
+func decryptAt(dek []byte, dst, src []byte, offset int64) error {
+	if len(dek) != 32 {
+		return errors.Errorf("data encryption key is wrong length")
+	}
+	nonce := [chacha20.NonceSize]byte{}
+	ciph, err := chacha20.NewUnauthenticatedCipher(dek, nonce[:])
+	if err != nil {
+		return err
+	}
+	// seek the keystream to offset: the counter counts 64 byte blocks, and
+	// the rest of the offset is skipped within the block.
+	ciph.SetCounter(uint32(offset / chachaBlockSize))
+	skip := make([]byte, offset%chachaBlockSize)
+	ciph.XORKeyStream(skip, skip)
+	ciph.XORKeyStream(dst, src)
+	return nil
+}
+
+// chachaBlockSize is the size of the blocks of a chacha20 keystream.
+const chachaBlockSize = 64
+
 // deriveKey returns Hash(secret + Hash(ptext))
 func deriveKey(secret, ptext []byte) []byte {
 	var x []byte
@@ -18,6 +18,7 @@ func NewTestStorage(t testing.TB, db *sqlx.DB, tr track.Tracker, opts ...Storage
 	objC, _ := obj.NewTestClient(t)
 	db.MustExec(`CREATE SCHEMA IF NOT EXISTS storage`)
 	require.NoError(t, dbutil.WithTx(context.Background(), db, SetupPostgresStoreV0))
+	require.NoError(t, dbutil.WithTx(context.Background(), db, SetupPostgresStoreV1))
 	return objC, NewStorage(objC, kv.NewMemCache(10), db, tr, opts...)
 }
 
@@ -104,6 +104,18 @@ func Check(t *testing.T, permString string) {
 		actual = actualFiles(t, topIdx, chunks, WithRange(pathRange(expected)))
 		require.Equal(t, expected, actual)
 	})
+	t.Run("LowerBound", func(t *testing.T) {
+		prefix := string(fileNames[len(fileNames)/2][0])
+		lower := fileNames[len(fileNames)/2]
+		var expected []string
+		for _, fileName := range expectedFiles(fileNames, prefix) {
+			if fileName >= lower {
+				expected = append(expected, fileName)
+			}
+		}
+		actual := actualFiles(t, topIdx, chunks, WithPrefix(prefix), WithLowerBound(lower))
+		require.Equal(t, expected, actual)
+	})
 	t.Run("LastRange", func(t *testing.T) {
 		prefix := string(fileNames[len(fileNames)-1][0])
 		expected := expectedFiles(fileNames, prefix)
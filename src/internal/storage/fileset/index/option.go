@@ -28,6 +28,14 @@ func WithExact(key string) Option {
 	return WithRange(&PathRange{Upper: key, Lower: key})
 }
 
+// WithLowerBound skips the paths before lower, in addition to any other
+// filter.
+func WithLowerBound(lower string) Option {
+	return func(r *Reader) {
+		r.lower = lower
+	}
+}
+
 // WithTag adds a tag filter that matches a single tag.
 func WithTag(tag string) Option {
 	return func(r *Reader) {
@@ -16,6 +16,7 @@ import (
 type Reader struct {
 	chunks *chunk.Storage
 	filter *pathFilter
+	lower  string
 	topIdx *Index
 	tag    string
 }
@@ -92,7 +93,11 @@ func (r *Reader) topLevel() pbutil.Reader {
 // atStart returns true when the name is in the valid range for a filter (always true if no filter is set).
 // For a range filter, this means the name is >= to the lower bound.
 // For a prefix filter, this means the name is >= to the prefix.
+// The name must also be >= to the lower bound, if one is set.
 func (r *Reader) atStart(name string) bool {
+	if name < r.lower {
+		return false
+	}
 	if r.filter == nil {
 		return true
 	}
@@ -235,6 +235,26 @@ func (s *Storage) Concat(ctx context.Context, ids []ID, ttl time.Duration) (*ID,
 	return fsw.Close()
 }
 
+// Export returns the primitive filesets that make up the fileset at id.
+func (s *Storage) Export(ctx context.Context, id ID) ([]*Primitive, error) {
+	return s.flattenPrimitives(ctx, []ID{id})
+}
+
+// ImportTx creates a fileset from primitive filesets exported from another
+// storage instance. The chunks that the primitives point to must already be
+// stored.
+func (s *Storage) ImportTx(tx *sqlx.Tx, prims []*Primitive, ttl time.Duration) (*ID, error) {
+	var ids []ID
+	for _, prim := range prims {
+		id, err := s.newPrimitiveTx(tx, prim, ttl)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, *id)
+	}
+	return s.ComposeTx(tx, ids, ttl)
+}
+
 // Drop allows a fileset to be deleted if it is not otherwise referenced.
 func (s *Storage) Drop(ctx context.Context, id ID) error {
 	_, err := s.SetTTL(ctx, id, track.ExpireNow)
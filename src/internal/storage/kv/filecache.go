@@ -0,0 +1,166 @@
+package kv
+
+import (
+	"context"
+	"encoding/hex"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/golang-lru/simplelru"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+)
+
+type fileCache struct {
+	dir      string
+	maxBytes int64
+
+	mu    sync.Mutex
+	size  int64
+	cache *simplelru.LRU
+}
+
+// NewFileCache returns a cache that stores values in files in dir, evicting
+// the least recently used values to keep their total size under maxBytes.
+// Values larger than maxBytes are not cached. Keys are expected to be content
+// addresses, so a Put to an existing key is a no-op.
+// Values that are already in dir (e.g. from before a restart) are kept, least
+// recently modified first, up to maxBytes.
+func NewFileCache(dir string, maxBytes int64) (GetPut, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	fc := &fileCache{
+		dir:      dir,
+		maxBytes: maxBytes,
+	}
+	var err error
+	fc.cache, err = simplelru.NewLRU(math.MaxInt32, fc.onEvict)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	if err := fc.load(); err != nil {
+		return nil, err
+	}
+	return fc, nil
+}
+
+// load adds the values in fc.dir to the cache, and removes the files that
+// aren't values (e.g. temporary files left by a crash).
+func (fc *fileCache) load() error {
+	infos, err := ioutil.ReadDir(fc.dir)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].ModTime().Before(infos[j].ModTime()) })
+	for _, info := range infos {
+		if _, err := hex.DecodeString(info.Name()); err != nil || !info.Mode().IsRegular() {
+			if err := os.RemoveAll(fc.path(info.Name())); err != nil {
+				return errors.EnsureStack(err)
+			}
+			continue
+		}
+		fc.cache.Add(info.Name(), info.Size())
+		fc.size += info.Size()
+		for fc.size > fc.maxBytes {
+			fc.cache.RemoveOldest()
+		}
+	}
+	return nil
+}
+
+func (fc *fileCache) Put(ctx context.Context, key, value []byte) error {
+	if int64(len(value)) > fc.maxBytes {
+		return nil
+	}
+	k := hex.EncodeToString(key)
+	fc.mu.Lock()
+	exists := fc.cache.Contains(k)
+	fc.mu.Unlock()
+	if exists {
+		return nil
+	}
+	// Write to a temporary file first, so that readers never see a partially
+	// written value.
+	tmp := filepath.Join(fc.dir, "tmp-"+uuid.NewWithoutDashes())
+	if err := ioutil.WriteFile(tmp, value, 0600); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if err := os.Rename(tmp, fc.path(k)); err != nil {
+		return errors.EnsureStack(err)
+	}
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	if fc.cache.Contains(k) {
+		return nil
+	}
+	fc.cache.Add(k, int64(len(value)))
+	fc.size += int64(len(value))
+	for fc.size > fc.maxBytes {
+		fc.cache.RemoveOldest()
+	}
+	return nil
+}
+
+func (fc *fileCache) Get(ctx context.Context, key []byte, cb ValueCallback) error {
+	k := hex.EncodeToString(key)
+	fc.mu.Lock()
+	_, ok := fc.cache.Get(k)
+	fc.mu.Unlock()
+	if !ok {
+		return pacherr.NewNotExist("kv.fileCache", k)
+	}
+	data, err := ioutil.ReadFile(fc.path(k))
+	if err != nil {
+		if os.IsNotExist(err) {
+			// evicted since the lookup above
+			return pacherr.NewNotExist("kv.fileCache", k)
+		}
+		return errors.EnsureStack(err)
+	}
+	return cb(data)
+}
+
+// onEvict is called by the LRU, with fc.mu held, for each evicted value.
+func (fc *fileCache) onEvict(key, value interface{}) {
+	fc.size -= value.(int64)
+	os.Remove(fc.path(key.(string)))
+}
+
+func (fc *fileCache) path(k string) string {
+	return filepath.Join(fc.dir, k)
+}
+
+type layeredCache struct {
+	fast, slow GetPut
+}
+
+// NewLayeredCache returns a cache that looks values up in fast before slow,
+// copying values found in slow into fast. Values are written to both.
+func NewLayeredCache(fast, slow GetPut) GetPut {
+	return &layeredCache{fast: fast, slow: slow}
+}
+
+func (lc *layeredCache) Put(ctx context.Context, key, value []byte) error {
+	if err := lc.fast.Put(ctx, key, value); err != nil {
+		return err
+	}
+	return lc.slow.Put(ctx, key, value)
+}
+
+func (lc *layeredCache) Get(ctx context.Context, key []byte, cb ValueCallback) error {
+	if err := lc.fast.Get(ctx, key, cb); err == nil || !pacherr.IsNotExist(err) {
+		return err
+	}
+	return lc.slow.Get(ctx, key, func(value []byte) error {
+		if err := lc.fast.Put(ctx, key, value); err != nil {
+			return err
+		}
+		return cb(value)
+	})
+}
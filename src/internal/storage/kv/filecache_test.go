@@ -0,0 +1,102 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func get(t *testing.T, cache GetPut, key string) ([]byte, bool) {
+	var value []byte
+	err := cache.Get(context.Background(), []byte(key), func(data []byte) error {
+		value = append([]byte{}, data...)
+		return nil
+	})
+	if pacherr.IsNotExist(err) {
+		return nil, false
+	}
+	require.NoError(t, err)
+	return value, true
+}
+
+func TestFileCache(t *testing.T) {
+	ctx := context.Background()
+	cache, err := NewFileCache(t.TempDir(), 10)
+	require.NoError(t, err)
+	require.NoError(t, cache.Put(ctx, []byte("a"), []byte("aaaa")))
+	require.NoError(t, cache.Put(ctx, []byte("b"), []byte("bbbb")))
+	value, ok := get(t, cache, "a")
+	require.True(t, ok)
+	require.Equal(t, "aaaa", string(value))
+	// "b" is now the least recently used, and is evicted to make room for "c"
+	require.NoError(t, cache.Put(ctx, []byte("c"), []byte("cccc")))
+	_, ok = get(t, cache, "b")
+	require.False(t, ok)
+	_, ok = get(t, cache, "a")
+	require.True(t, ok)
+	_, ok = get(t, cache, "c")
+	require.True(t, ok)
+	// values larger than the cache aren't cached
+	require.NoError(t, cache.Put(ctx, []byte("d"), bytes.Repeat([]byte("d"), 11)))
+	_, ok = get(t, cache, "d")
+	require.False(t, ok)
+	_, ok = get(t, cache, "a")
+	require.True(t, ok)
+}
+
+func TestFileCacheReopen(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	cache, err := NewFileCache(dir, 10)
+	require.NoError(t, err)
+	require.NoError(t, cache.Put(ctx, []byte("a"), []byte("aaaa")))
+	require.NoError(t, cache.Put(ctx, []byte("b"), []byte("bbbb")))
+	now := time.Now()
+	require.NoError(t, os.Chtimes(filepath.Join(dir, hex.EncodeToString([]byte("a"))), now.Add(-2*time.Hour), now.Add(-2*time.Hour)))
+	require.NoError(t, os.Chtimes(filepath.Join(dir, hex.EncodeToString([]byte("b"))), now.Add(-time.Hour), now.Add(-time.Hour)))
+	// a temporary file left by a crash
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "tmp-1"), []byte("t"), 0600))
+
+	// Reopening the cache keeps the most recently modified values that fit.
+	cache, err = NewFileCache(dir, 5)
+	require.NoError(t, err)
+	_, ok := get(t, cache, "a")
+	require.False(t, ok)
+	value, ok := get(t, cache, "b")
+	require.True(t, ok)
+	require.Equal(t, "bbbb", string(value))
+	infos, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(infos))
+}
+
+func TestLayeredCache(t *testing.T) {
+	ctx := context.Background()
+	fast := NewMemCache(10)
+	slow, err := NewFileCache(t.TempDir(), 100)
+	require.NoError(t, err)
+	require.NoError(t, slow.Put(ctx, []byte("a"), []byte("aaaa")))
+	cache := NewLayeredCache(fast, slow)
+	_, ok := get(t, fast, "a")
+	require.False(t, ok)
+	value, ok := get(t, cache, "a")
+	require.True(t, ok)
+	require.Equal(t, "aaaa", string(value))
+	// the value was copied into the fast layer
+	_, ok = get(t, fast, "a")
+	require.True(t, ok)
+	// puts go to both layers
+	require.NoError(t, cache.Put(ctx, []byte("b"), []byte("bbbb")))
+	_, ok = get(t, fast, "b")
+	require.True(t, ok)
+	_, ok = get(t, slow, "b")
+	require.True(t, ok)
+}
@@ -19,6 +19,9 @@ type GetPut interface {
 // Store is a key-value store
 type Store interface {
 	GetPut
+	// GetRange calls cb once with size bytes of the value that corresponds
+	// to key, starting at offset (or from offset to the end if size is 0).
+	GetRange(ctx context.Context, key []byte, offset, size int64, cb ValueCallback) error
 	Delete(ctx context.Context, key []byte) error
 	Exists(ctx context.Context, key []byte) (bool, error)
 	Walk(ctx context.Context, prefix []byte, cb func(key []byte) error) error
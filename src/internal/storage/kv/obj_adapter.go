@@ -43,6 +43,18 @@ func (s *objectAdapter) Get(ctx context.Context, key []byte, cb ValueCallback) (
 	})
 }
 
+func (s *objectAdapter) GetRange(ctx context.Context, key []byte, offset, size int64, cb ValueCallback) (retErr error) {
+	return s.withBuffer(func(buf *bytes.Buffer) error {
+		if err := obj.GetRange(ctx, s.objC, string(key), offset, size, buf); err != nil {
+			if pacherr.IsNotExist(err) {
+				err = pacherr.NewNotExist("kv", string(key))
+			}
+			return err
+		}
+		return cb(buf.Bytes())
+	})
+}
+
 func (s *objectAdapter) Delete(ctx context.Context, key []byte) error {
 	return s.objC.Delete(ctx, string(key))
 }
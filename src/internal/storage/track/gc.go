@@ -49,13 +49,18 @@ func (gc *GarbageCollector) RunForever(ctx context.Context) error {
 	ticker := time.NewTicker(gc.period)
 	defer ticker.Stop()
 	for {
-		if err := func() error {
+		start := time.Now()
+		n, err := func() (int, error) {
 			ctx, cf := context.WithTimeout(ctx, gc.period/2)
 			defer cf()
-			return gc.RunUntilEmpty(ctx)
-		}(); err != nil {
+			return gc.runUntilEmpty(ctx)
+		}()
+		if err != nil {
 			logrus.Errorf("gc: %v", err)
 		}
+		if ctx.Err() == nil {
+			gc.recordRun(ctx, start, n, err)
+		}
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
@@ -66,16 +71,41 @@ func (gc *GarbageCollector) RunForever(ctx context.Context) error {
 
 // RunUntilEmpty calls RunOnce repeatedly until it returns an error or 0.
 func (gc *GarbageCollector) RunUntilEmpty(ctx context.Context) error {
+	_, err := gc.runUntilEmpty(ctx)
+	return err
+}
+
+// runUntilEmpty is RunUntilEmpty, but also returns the total number of
+// objects deleted.
+func (gc *GarbageCollector) runUntilEmpty(ctx context.Context) (int, error) {
+	var total int
 	for {
 		n, err := gc.RunOnce(ctx)
+		total += n
 		if err != nil {
-			return err
+			return total, err
 		}
 		if n == 0 {
-			break
+			return total, nil
 		}
 	}
-	return nil
+}
+
+// recordRun records a run of the garbage collector, so that it can be
+// reported by ListGCRuns.
+func (gc *GarbageCollector) recordRun(ctx context.Context, start time.Time, n int, runErr error) {
+	run := GCRun{
+		Name:       GCRunTracker,
+		StartedAt:  start.UTC(),
+		FinishedAt: time.Now().UTC(),
+		Deleted:    int64(n),
+	}
+	if runErr != nil {
+		run.Error = runErr.Error()
+	}
+	if err := RecordGCRun(ctx, gc.tracker.DB(), run); err != nil {
+		logrus.Errorf("gc: error recording run: %v", err)
+	}
 }
 
 // RunOnce run's one cycle of garbage collection.
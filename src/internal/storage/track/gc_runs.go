@@ -0,0 +1,128 @@
+package track
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+// The names of the garbage collectors whose runs are recorded.
+const (
+	// GCRunTracker is the tracker's garbage collector, which deletes expired,
+	// unreferenced objects (marking deleted chunks for deletion).
+	GCRunTracker = "tracker"
+	// GCRunChunk is the chunk garbage collector, which deletes chunks marked
+	// for deletion from the object store.
+	GCRunChunk = "chunk"
+)
+
+// GCRun describes a run of one of the storage layer's garbage collectors.
+type GCRun struct {
+	Name         string    `db:"name"`
+	StartedAt    time.Time `db:"started_at"`
+	FinishedAt   time.Time `db:"finished_at"`
+	Deleted      int64     `db:"deleted"`
+	DeletedBytes int64     `db:"deleted_bytes"`
+	Error        string    `db:"error"`
+}
+
+// SetupPostgresGCRunsV0 sets up the table in which the last run of each
+// garbage collector is recorded.
+func SetupPostgresGCRunsV0(ctx context.Context, tx *sqlx.Tx) error {
+	_, err := tx.ExecContext(ctx, gcRunsSchema)
+	return errors.EnsureStack(err)
+}
+
+var gcRunsSchema = `
+	CREATE TABLE storage.gc_runs (
+		name VARCHAR(64) PRIMARY KEY,
+		started_at TIMESTAMP NOT NULL,
+		finished_at TIMESTAMP NOT NULL,
+		deleted INT8 NOT NULL,
+		deleted_bytes INT8 NOT NULL,
+		error TEXT NOT NULL
+	);
+`
+
+// RecordGCRun records 'run' as the last run of the garbage collector named
+// run.Name.
+func RecordGCRun(ctx context.Context, db *sqlx.DB, run GCRun) error {
+	_, err := db.NamedExecContext(ctx, `
+		INSERT INTO storage.gc_runs (name, started_at, finished_at, deleted, deleted_bytes, error)
+		VALUES (:name, :started_at, :finished_at, :deleted, :deleted_bytes, :error)
+		ON CONFLICT (name) DO UPDATE SET
+			started_at = EXCLUDED.started_at,
+			finished_at = EXCLUDED.finished_at,
+			deleted = EXCLUDED.deleted,
+			deleted_bytes = EXCLUDED.deleted_bytes,
+			error = EXCLUDED.error
+	`, run)
+	return errors.EnsureStack(err)
+}
+
+// ListGCRuns returns the last run of each garbage collector that has run.
+func ListGCRuns(ctx context.Context, db *sqlx.DB) ([]GCRun, error) {
+	var runs []GCRun
+	if err := db.SelectContext(ctx, &runs, `
+		SELECT name, started_at, finished_at, deleted, deleted_bytes, error
+		FROM storage.gc_runs ORDER BY name
+	`); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return runs, nil
+}
+
+// CountDeletable returns the number of objects that the next garbage
+// collection will delete.
+func CountDeletable(ctx context.Context, db *sqlx.DB) (int64, error) {
+	var n int64
+	if err := db.GetContext(ctx, &n, `
+		SELECT count(*) FROM storage.tracker_objects
+		WHERE int_id NOT IN (SELECT to_id FROM storage.tracker_refs)
+		AND expires_at <= CURRENT_TIMESTAMP
+	`); err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	return n, nil
+}
+
+// IterateReachable calls 'cb' with each object whose ID begins with
+// 'targetPrefix' that is reachable from an object whose ID begins with
+// 'rootPrefix', along with the ID of that root. A target reachable from
+// several roots is passed to 'cb' once per root.
+func IterateReachable(ctx context.Context, db *sqlx.DB, rootPrefix, targetPrefix string, cb func(root, target string) error) (retErr error) {
+	rows, err := db.QueryxContext(ctx, `
+		WITH RECURSIVE reachable(root_id, int_id) AS (
+			SELECT int_id, int_id FROM storage.tracker_objects
+			WHERE str_id LIKE $1 || '%'
+		UNION
+			SELECT reachable.root_id, refs.to_id
+			FROM reachable JOIN storage.tracker_refs refs ON reachable.int_id = refs.from_id
+		)
+		SELECT roots.str_id, targets.str_id
+		FROM reachable
+		JOIN storage.tracker_objects roots ON reachable.root_id = roots.int_id
+		JOIN storage.tracker_objects targets ON reachable.int_id = targets.int_id
+		WHERE targets.str_id LIKE $2 || '%'
+	`, rootPrefix, targetPrefix)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); retErr == nil {
+			retErr = errors.EnsureStack(err)
+		}
+	}()
+	for rows.Next() {
+		var root, target string
+		if err := rows.Scan(&root, &target); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if err := cb(root, target); err != nil {
+			return err
+		}
+	}
+	return errors.EnsureStack(rows.Err())
+}
@@ -1,9 +1,13 @@
 package track
 
 import (
+	"context"
 	"testing"
 
+	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 	"github.com/pachyderm/pachyderm/v2/src/internal/testutil"
 )
 
@@ -16,3 +20,35 @@ func TestPostgresTracker(t *testing.T) {
 		return NewPostgresTracker(db)
 	})
 }
+
+func TestIterateReachable(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	db := testutil.NewTestDB(t)
+	tr := NewTestTracker(t, db)
+	for _, obj := range []struct {
+		id       string
+		pointsTo []string
+	}{
+		{"chunk/1", nil},
+		{"chunk/2", nil},
+		{"chunk/3", nil},
+		{"fileset/1", []string{"chunk/1", "chunk/2"}},
+		{"fileset/2", []string{"fileset/1", "chunk/3"}},
+		{"commit/a", []string{"fileset/1"}},
+		{"commit/b", []string{"fileset/2"}},
+	} {
+		require.NoError(t, dbutil.WithTx(ctx, db, func(tx *sqlx.Tx) error {
+			return tr.CreateTx(tx, obj.id, obj.pointsTo, NoTTL)
+		}))
+	}
+	var reachable []string
+	require.NoError(t, IterateReachable(ctx, db, "commit/", "chunk/", func(root, target string) error {
+		reachable = append(reachable, root+"->"+target)
+		return nil
+	}))
+	require.ElementsEqual(t, []string{
+		"commit/a->chunk/1", "commit/a->chunk/2",
+		"commit/b->chunk/1", "commit/b->chunk/2", "commit/b->chunk/3",
+	}, reachable)
+}
@@ -156,5 +156,6 @@ func TestTracker(t *testing.T, newTracker func(testing.TB) Tracker) {
 func NewTestTracker(t testing.TB, db *sqlx.DB) Tracker {
 	db.MustExec("CREATE SCHEMA IF NOT EXISTS storage")
 	db.MustExec(schema)
+	db.MustExec(gcRunsSchema)
 	return NewPostgresTracker(db)
 }
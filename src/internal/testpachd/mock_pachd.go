@@ -45,18 +45,30 @@ func linkServers(mockServerPtr interface{}, realServer interface{}) {
 /* Admin Server Mocks */
 
 type inspectClusterFunc func(context.Context, *types.Empty) (*admin.ClusterInfo, error)
+type migrationStatusFunc func(context.Context, *types.Empty) (*admin.MigrationStatusResponse, error)
+type planMigrationsFunc func(context.Context, *admin.PlanMigrationsRequest) (*admin.PlanMigrationsResponse, error)
+type rollbackMigrationsFunc func(context.Context, *admin.RollbackMigrationsRequest) (*admin.RollbackMigrationsResponse, error)
 
 type mockInspectCluster struct{ handler inspectClusterFunc }
+type mockMigrationStatus struct{ handler migrationStatusFunc }
+type mockPlanMigrations struct{ handler planMigrationsFunc }
+type mockRollbackMigrations struct{ handler rollbackMigrationsFunc }
 
-func (mock *mockInspectCluster) Use(cb inspectClusterFunc) { mock.handler = cb }
+func (mock *mockInspectCluster) Use(cb inspectClusterFunc)         { mock.handler = cb }
+func (mock *mockMigrationStatus) Use(cb migrationStatusFunc)       { mock.handler = cb }
+func (mock *mockPlanMigrations) Use(cb planMigrationsFunc)         { mock.handler = cb }
+func (mock *mockRollbackMigrations) Use(cb rollbackMigrationsFunc) { mock.handler = cb }
 
 type adminServerAPI struct {
 	mock *mockAdminServer
 }
 
 type mockAdminServer struct {
-	api            adminServerAPI
-	InspectCluster mockInspectCluster
+	api                adminServerAPI
+	InspectCluster     mockInspectCluster
+	MigrationStatus    mockMigrationStatus
+	PlanMigrations     mockPlanMigrations
+	RollbackMigrations mockRollbackMigrations
 }
 
 func (api *adminServerAPI) InspectCluster(ctx context.Context, req *types.Empty) (*admin.ClusterInfo, error) {
@@ -65,6 +77,24 @@ func (api *adminServerAPI) InspectCluster(ctx context.Context, req *types.Empty)
 	}
 	return nil, errors.Errorf("unhandled pachd mock admin.InspectCluster")
 }
+func (api *adminServerAPI) MigrationStatus(ctx context.Context, req *types.Empty) (*admin.MigrationStatusResponse, error) {
+	if api.mock.MigrationStatus.handler != nil {
+		return api.mock.MigrationStatus.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock admin.MigrationStatus")
+}
+func (api *adminServerAPI) PlanMigrations(ctx context.Context, req *admin.PlanMigrationsRequest) (*admin.PlanMigrationsResponse, error) {
+	if api.mock.PlanMigrations.handler != nil {
+		return api.mock.PlanMigrations.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock admin.PlanMigrations")
+}
+func (api *adminServerAPI) RollbackMigrations(ctx context.Context, req *admin.RollbackMigrationsRequest) (*admin.RollbackMigrationsResponse, error) {
+	if api.mock.RollbackMigrations.handler != nil {
+		return api.mock.RollbackMigrations.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock admin.RollbackMigrations")
+}
 
 /* Auth Server Mocks */
 
@@ -400,6 +430,10 @@ type createRepoFunc func(context.Context, *pfs.CreateRepoRequest) (*types.Empty,
 type inspectRepoFunc func(context.Context, *pfs.InspectRepoRequest) (*pfs.RepoInfo, error)
 type listRepoFunc func(context.Context, *pfs.ListRepoRequest) (*pfs.ListRepoResponse, error)
 type deleteRepoFunc func(context.Context, *pfs.DeleteRepoRequest) (*types.Empty, error)
+type createProjectFunc func(context.Context, *pfs.CreateProjectRequest) (*types.Empty, error)
+type inspectProjectFunc func(context.Context, *pfs.InspectProjectRequest) (*pfs.ProjectInfo, error)
+type listProjectFunc func(context.Context, *pfs.ListProjectRequest) (*pfs.ListProjectResponse, error)
+type deleteProjectFunc func(context.Context, *pfs.DeleteProjectRequest) (*types.Empty, error)
 type startCommitFunc func(context.Context, *pfs.StartCommitRequest) (*pfs.Commit, error)
 type finishCommitFunc func(context.Context, *pfs.FinishCommitRequest) (*types.Empty, error)
 type inspectCommitFunc func(context.Context, *pfs.InspectCommitRequest) (*pfs.CommitInfo, error)
@@ -412,26 +446,45 @@ type createBranchFunc func(context.Context, *pfs.CreateBranchRequest) (*types.Em
 type inspectBranchFunc func(context.Context, *pfs.InspectBranchRequest) (*pfs.BranchInfo, error)
 type listBranchFunc func(context.Context, *pfs.ListBranchRequest) (*pfs.BranchInfos, error)
 type deleteBranchFunc func(context.Context, *pfs.DeleteBranchRequest) (*types.Empty, error)
+type mergeBranchFunc func(context.Context, *pfs.MergeBranchRequest) (*pfs.MergeBranchResponse, error)
+type inspectFileLineageFunc func(context.Context, *pfs.InspectFileLineageRequest) (*pfs.FileLineage, error)
 type modifyFileFunc func(pfs.API_ModifyFileServer) error
 type getFileFunc func(*pfs.GetFileRequest, pfs.API_GetFileServer) error
 type inspectFileFunc func(context.Context, *pfs.InspectFileRequest) (*pfs.FileInfo, error)
 type listFileFunc func(*pfs.ListFileRequest, pfs.API_ListFileServer) error
+type listFileHistoryFunc func(context.Context, *pfs.ListFileHistoryRequest) (*pfs.ListFileHistoryResponse, error)
 type walkFileFunc func(*pfs.WalkFileRequest, pfs.API_WalkFileServer) error
 type globFileFunc func(*pfs.GlobFileRequest, pfs.API_GlobFileServer) error
 type diffFileFunc func(*pfs.DiffFileRequest, pfs.API_DiffFileServer) error
 type deleteAllPFSFunc func(context.Context, *types.Empty) (*types.Empty, error)
 type fsckFunc func(*pfs.FsckRequest, pfs.API_FsckServer) error
+type inspectStorageFunc func(context.Context, *pfs.InspectStorageRequest) (*pfs.StorageInfo, error)
+type rehydrateCommitFunc func(context.Context, *pfs.RehydrateCommitRequest) (*pfs.RehydrateCommitResponse, error)
+type verifyMirrorFunc func(*pfs.VerifyMirrorRequest, pfs.API_VerifyMirrorServer) error
 type createFilesetFunc func(pfs.API_CreateFilesetServer) error
 type addFilesetFunc func(context.Context, *pfs.AddFilesetRequest) (*types.Empty, error)
 type getFilesetFunc func(context.Context, *pfs.GetFilesetRequest) (*pfs.CreateFilesetResponse, error)
 type renewFilesetFunc func(context.Context, *pfs.RenewFilesetRequest) (*types.Empty, error)
 type runLoadTestFunc func(context.Context, *pfs.RunLoadTestRequest) (*pfs.RunLoadTestResponse, error)
+type createRemoteFunc func(context.Context, *pfs.CreateRemoteRequest) (*types.Empty, error)
+type listRemoteFunc func(context.Context, *pfs.ListRemoteRequest) (*pfs.ListRemoteResponse, error)
+type deleteRemoteFunc func(context.Context, *pfs.DeleteRemoteRequest) (*types.Empty, error)
+type pushBranchFunc func(context.Context, *pfs.PushBranchRequest) (*pfs.TransferStats, error)
+type pullBranchFunc func(context.Context, *pfs.PullBranchRequest) (*pfs.TransferStats, error)
+type missingChunksFunc func(context.Context, *pfs.MissingChunksRequest) (*pfs.MissingChunksResponse, error)
+type exportCommitFunc func(context.Context, *pfs.ExportCommitRequest) (*pfs.ExportCommitResponse, error)
+type getChunkFunc func(*pfs.GetChunkRequest, pfs.API_GetChunkServer) error
+type receiveCommitFunc func(pfs.API_ReceiveCommitServer) error
 
 type mockActivateAuthPFS struct{ handler activateAuthPFSFunc }
 type mockCreateRepo struct{ handler createRepoFunc }
 type mockInspectRepo struct{ handler inspectRepoFunc }
 type mockListRepo struct{ handler listRepoFunc }
 type mockDeleteRepo struct{ handler deleteRepoFunc }
+type mockCreateProject struct{ handler createProjectFunc }
+type mockInspectProject struct{ handler inspectProjectFunc }
+type mockListProject struct{ handler listProjectFunc }
+type mockDeleteProject struct{ handler deleteProjectFunc }
 type mockStartCommit struct{ handler startCommitFunc }
 type mockFinishCommit struct{ handler finishCommitFunc }
 type mockInspectCommit struct{ handler inspectCommitFunc }
@@ -444,90 +497,143 @@ type mockCreateBranch struct{ handler createBranchFunc }
 type mockInspectBranch struct{ handler inspectBranchFunc }
 type mockListBranch struct{ handler listBranchFunc }
 type mockDeleteBranch struct{ handler deleteBranchFunc }
+type mockMergeBranch struct{ handler mergeBranchFunc }
+type mockInspectFileLineage struct{ handler inspectFileLineageFunc }
 type mockModifyFile struct{ handler modifyFileFunc }
 type mockGetFile struct{ handler getFileFunc }
 type mockInspectFile struct{ handler inspectFileFunc }
 type mockListFile struct{ handler listFileFunc }
+type mockListFileHistory struct{ handler listFileHistoryFunc }
 type mockWalkFile struct{ handler walkFileFunc }
 type mockGlobFile struct{ handler globFileFunc }
 type mockDiffFile struct{ handler diffFileFunc }
 type mockDeleteAllPFS struct{ handler deleteAllPFSFunc }
 type mockFsck struct{ handler fsckFunc }
+type mockInspectStorage struct{ handler inspectStorageFunc }
+type mockRehydrateCommit struct{ handler rehydrateCommitFunc }
+type mockVerifyMirror struct{ handler verifyMirrorFunc }
 type mockCreateFileset struct{ handler createFilesetFunc }
 type mockAddFileset struct{ handler addFilesetFunc }
 type mockGetFileset struct{ handler getFilesetFunc }
 type mockRenewFileset struct{ handler renewFilesetFunc }
 type mockRunLoadTest struct{ handler runLoadTestFunc }
-
-func (mock *mockActivateAuthPFS) Use(cb activateAuthPFSFunc) { mock.handler = cb }
-func (mock *mockCreateRepo) Use(cb createRepoFunc)           { mock.handler = cb }
-func (mock *mockInspectRepo) Use(cb inspectRepoFunc)         { mock.handler = cb }
-func (mock *mockListRepo) Use(cb listRepoFunc)               { mock.handler = cb }
-func (mock *mockDeleteRepo) Use(cb deleteRepoFunc)           { mock.handler = cb }
-func (mock *mockStartCommit) Use(cb startCommitFunc)         { mock.handler = cb }
-func (mock *mockFinishCommit) Use(cb finishCommitFunc)       { mock.handler = cb }
-func (mock *mockInspectCommit) Use(cb inspectCommitFunc)     { mock.handler = cb }
-func (mock *mockListCommit) Use(cb listCommitFunc)           { mock.handler = cb }
-func (mock *mockSquashCommit) Use(cb squashCommitFunc)       { mock.handler = cb }
-func (mock *mockFlushCommit) Use(cb flushCommitFunc)         { mock.handler = cb }
-func (mock *mockSubscribeCommit) Use(cb subscribeCommitFunc) { mock.handler = cb }
-func (mock *mockClearCommit) Use(cb clearCommitFunc)         { mock.handler = cb }
-func (mock *mockCreateBranch) Use(cb createBranchFunc)       { mock.handler = cb }
-func (mock *mockInspectBranch) Use(cb inspectBranchFunc)     { mock.handler = cb }
-func (mock *mockListBranch) Use(cb listBranchFunc)           { mock.handler = cb }
-func (mock *mockDeleteBranch) Use(cb deleteBranchFunc)       { mock.handler = cb }
-func (mock *mockModifyFile) Use(cb modifyFileFunc)           { mock.handler = cb }
-func (mock *mockGetFile) Use(cb getFileFunc)                 { mock.handler = cb }
-func (mock *mockInspectFile) Use(cb inspectFileFunc)         { mock.handler = cb }
-func (mock *mockListFile) Use(cb listFileFunc)               { mock.handler = cb }
-func (mock *mockWalkFile) Use(cb walkFileFunc)               { mock.handler = cb }
-func (mock *mockGlobFile) Use(cb globFileFunc)               { mock.handler = cb }
-func (mock *mockDiffFile) Use(cb diffFileFunc)               { mock.handler = cb }
-func (mock *mockDeleteAllPFS) Use(cb deleteAllPFSFunc)       { mock.handler = cb }
-func (mock *mockFsck) Use(cb fsckFunc)                       { mock.handler = cb }
-func (mock *mockCreateFileset) Use(cb createFilesetFunc)     { mock.handler = cb }
-func (mock *mockAddFileset) Use(cb addFilesetFunc)           { mock.handler = cb }
-func (mock *mockGetFileset) Use(cb getFilesetFunc)           { mock.handler = cb }
-func (mock *mockRenewFileset) Use(cb renewFilesetFunc)       { mock.handler = cb }
-func (mock *mockRunLoadTest) Use(cb runLoadTestFunc)         { mock.handler = cb }
+type mockCreateRemote struct{ handler createRemoteFunc }
+type mockListRemote struct{ handler listRemoteFunc }
+type mockDeleteRemote struct{ handler deleteRemoteFunc }
+type mockPushBranch struct{ handler pushBranchFunc }
+type mockPullBranch struct{ handler pullBranchFunc }
+type mockMissingChunks struct{ handler missingChunksFunc }
+type mockExportCommit struct{ handler exportCommitFunc }
+type mockGetChunk struct{ handler getChunkFunc }
+type mockReceiveCommit struct{ handler receiveCommitFunc }
+
+func (mock *mockActivateAuthPFS) Use(cb activateAuthPFSFunc)       { mock.handler = cb }
+func (mock *mockCreateRepo) Use(cb createRepoFunc)                 { mock.handler = cb }
+func (mock *mockInspectRepo) Use(cb inspectRepoFunc)               { mock.handler = cb }
+func (mock *mockListRepo) Use(cb listRepoFunc)                     { mock.handler = cb }
+func (mock *mockDeleteRepo) Use(cb deleteRepoFunc)                 { mock.handler = cb }
+func (mock *mockCreateProject) Use(cb createProjectFunc)           { mock.handler = cb }
+func (mock *mockInspectProject) Use(cb inspectProjectFunc)         { mock.handler = cb }
+func (mock *mockListProject) Use(cb listProjectFunc)               { mock.handler = cb }
+func (mock *mockDeleteProject) Use(cb deleteProjectFunc)           { mock.handler = cb }
+func (mock *mockStartCommit) Use(cb startCommitFunc)               { mock.handler = cb }
+func (mock *mockFinishCommit) Use(cb finishCommitFunc)             { mock.handler = cb }
+func (mock *mockInspectCommit) Use(cb inspectCommitFunc)           { mock.handler = cb }
+func (mock *mockListCommit) Use(cb listCommitFunc)                 { mock.handler = cb }
+func (mock *mockSquashCommit) Use(cb squashCommitFunc)             { mock.handler = cb }
+func (mock *mockFlushCommit) Use(cb flushCommitFunc)               { mock.handler = cb }
+func (mock *mockSubscribeCommit) Use(cb subscribeCommitFunc)       { mock.handler = cb }
+func (mock *mockClearCommit) Use(cb clearCommitFunc)               { mock.handler = cb }
+func (mock *mockCreateBranch) Use(cb createBranchFunc)             { mock.handler = cb }
+func (mock *mockInspectBranch) Use(cb inspectBranchFunc)           { mock.handler = cb }
+func (mock *mockListBranch) Use(cb listBranchFunc)                 { mock.handler = cb }
+func (mock *mockDeleteBranch) Use(cb deleteBranchFunc)             { mock.handler = cb }
+func (mock *mockMergeBranch) Use(cb mergeBranchFunc)               { mock.handler = cb }
+func (mock *mockInspectFileLineage) Use(cb inspectFileLineageFunc) { mock.handler = cb }
+func (mock *mockModifyFile) Use(cb modifyFileFunc)                 { mock.handler = cb }
+func (mock *mockGetFile) Use(cb getFileFunc)                       { mock.handler = cb }
+func (mock *mockInspectFile) Use(cb inspectFileFunc)               { mock.handler = cb }
+func (mock *mockListFile) Use(cb listFileFunc)                     { mock.handler = cb }
+func (mock *mockListFileHistory) Use(cb listFileHistoryFunc)       { mock.handler = cb }
+func (mock *mockWalkFile) Use(cb walkFileFunc)                     { mock.handler = cb }
+func (mock *mockGlobFile) Use(cb globFileFunc)                     { mock.handler = cb }
+func (mock *mockDiffFile) Use(cb diffFileFunc)                     { mock.handler = cb }
+func (mock *mockDeleteAllPFS) Use(cb deleteAllPFSFunc)             { mock.handler = cb }
+func (mock *mockFsck) Use(cb fsckFunc)                             { mock.handler = cb }
+func (mock *mockInspectStorage) Use(cb inspectStorageFunc)         { mock.handler = cb }
+func (mock *mockRehydrateCommit) Use(cb rehydrateCommitFunc)       { mock.handler = cb }
+func (mock *mockVerifyMirror) Use(cb verifyMirrorFunc)             { mock.handler = cb }
+func (mock *mockCreateFileset) Use(cb createFilesetFunc)           { mock.handler = cb }
+func (mock *mockAddFileset) Use(cb addFilesetFunc)                 { mock.handler = cb }
+func (mock *mockGetFileset) Use(cb getFilesetFunc)                 { mock.handler = cb }
+func (mock *mockRenewFileset) Use(cb renewFilesetFunc)             { mock.handler = cb }
+func (mock *mockRunLoadTest) Use(cb runLoadTestFunc)               { mock.handler = cb }
+func (mock *mockCreateRemote) Use(cb createRemoteFunc)             { mock.handler = cb }
+func (mock *mockListRemote) Use(cb listRemoteFunc)                 { mock.handler = cb }
+func (mock *mockDeleteRemote) Use(cb deleteRemoteFunc)             { mock.handler = cb }
+func (mock *mockPushBranch) Use(cb pushBranchFunc)                 { mock.handler = cb }
+func (mock *mockPullBranch) Use(cb pullBranchFunc)                 { mock.handler = cb }
+func (mock *mockMissingChunks) Use(cb missingChunksFunc)           { mock.handler = cb }
+func (mock *mockExportCommit) Use(cb exportCommitFunc)             { mock.handler = cb }
+func (mock *mockGetChunk) Use(cb getChunkFunc)                     { mock.handler = cb }
+func (mock *mockReceiveCommit) Use(cb receiveCommitFunc)           { mock.handler = cb }
 
 type pfsServerAPI struct {
 	mock *mockPFSServer
 }
 
 type mockPFSServer struct {
-	api             pfsServerAPI
-	ActivateAuth    mockActivateAuthPFS
-	CreateRepo      mockCreateRepo
-	InspectRepo     mockInspectRepo
-	ListRepo        mockListRepo
-	DeleteRepo      mockDeleteRepo
-	StartCommit     mockStartCommit
-	FinishCommit    mockFinishCommit
-	InspectCommit   mockInspectCommit
-	ListCommit      mockListCommit
-	SquashCommit    mockSquashCommit
-	FlushCommit     mockFlushCommit
-	SubscribeCommit mockSubscribeCommit
-	ClearCommit     mockClearCommit
-	CreateBranch    mockCreateBranch
-	InspectBranch   mockInspectBranch
-	ListBranch      mockListBranch
-	DeleteBranch    mockDeleteBranch
-	ModifyFile      mockModifyFile
-	GetFile         mockGetFile
-	InspectFile     mockInspectFile
-	ListFile        mockListFile
-	WalkFile        mockWalkFile
-	GlobFile        mockGlobFile
-	DiffFile        mockDiffFile
-	DeleteAll       mockDeleteAllPFS
-	Fsck            mockFsck
-	CreateFileset   mockCreateFileset
-	AddFileset      mockAddFileset
-	GetFileset      mockGetFileset
-	RenewFileset    mockRenewFileset
-	RunLoadTest     mockRunLoadTest
+	api                pfsServerAPI
+	ActivateAuth       mockActivateAuthPFS
+	CreateRepo         mockCreateRepo
+	InspectRepo        mockInspectRepo
+	ListRepo           mockListRepo
+	DeleteRepo         mockDeleteRepo
+	CreateProject      mockCreateProject
+	InspectProject     mockInspectProject
+	ListProject        mockListProject
+	DeleteProject      mockDeleteProject
+	StartCommit        mockStartCommit
+	FinishCommit       mockFinishCommit
+	InspectCommit      mockInspectCommit
+	ListCommit         mockListCommit
+	SquashCommit       mockSquashCommit
+	FlushCommit        mockFlushCommit
+	SubscribeCommit    mockSubscribeCommit
+	ClearCommit        mockClearCommit
+	CreateBranch       mockCreateBranch
+	InspectBranch      mockInspectBranch
+	ListBranch         mockListBranch
+	DeleteBranch       mockDeleteBranch
+	MergeBranch        mockMergeBranch
+	InspectFileLineage mockInspectFileLineage
+	ModifyFile         mockModifyFile
+	GetFile            mockGetFile
+	InspectFile        mockInspectFile
+	ListFile           mockListFile
+	ListFileHistory    mockListFileHistory
+	WalkFile           mockWalkFile
+	GlobFile           mockGlobFile
+	DiffFile           mockDiffFile
+	DeleteAll          mockDeleteAllPFS
+	Fsck               mockFsck
+	InspectStorage     mockInspectStorage
+	RehydrateCommit    mockRehydrateCommit
+	VerifyMirror       mockVerifyMirror
+	CreateFileset      mockCreateFileset
+	AddFileset         mockAddFileset
+	GetFileset         mockGetFileset
+	RenewFileset       mockRenewFileset
+	RunLoadTest        mockRunLoadTest
+	CreateRemote       mockCreateRemote
+	ListRemote         mockListRemote
+	DeleteRemote       mockDeleteRemote
+	PushBranch         mockPushBranch
+	PullBranch         mockPullBranch
+	MissingChunks      mockMissingChunks
+	ExportCommit       mockExportCommit
+	GetChunk           mockGetChunk
+	ReceiveCommit      mockReceiveCommit
 }
 
 func (api *pfsServerAPI) ActivateAuth(ctx context.Context, req *pfs.ActivateAuthRequest) (*pfs.ActivateAuthResponse, error) {
@@ -560,6 +666,30 @@ func (api *pfsServerAPI) DeleteRepo(ctx context.Context, req *pfs.DeleteRepoRequ
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteRepo")
 }
+func (api *pfsServerAPI) CreateProject(ctx context.Context, req *pfs.CreateProjectRequest) (*types.Empty, error) {
+	if api.mock.CreateProject.handler != nil {
+		return api.mock.CreateProject.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.CreateProject")
+}
+func (api *pfsServerAPI) InspectProject(ctx context.Context, req *pfs.InspectProjectRequest) (*pfs.ProjectInfo, error) {
+	if api.mock.InspectProject.handler != nil {
+		return api.mock.InspectProject.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.InspectProject")
+}
+func (api *pfsServerAPI) ListProject(ctx context.Context, req *pfs.ListProjectRequest) (*pfs.ListProjectResponse, error) {
+	if api.mock.ListProject.handler != nil {
+		return api.mock.ListProject.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.ListProject")
+}
+func (api *pfsServerAPI) DeleteProject(ctx context.Context, req *pfs.DeleteProjectRequest) (*types.Empty, error) {
+	if api.mock.DeleteProject.handler != nil {
+		return api.mock.DeleteProject.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteProject")
+}
 func (api *pfsServerAPI) StartCommit(ctx context.Context, req *pfs.StartCommitRequest) (*pfs.Commit, error) {
 	if api.mock.StartCommit.handler != nil {
 		return api.mock.StartCommit.handler(ctx, req)
@@ -632,6 +762,18 @@ func (api *pfsServerAPI) DeleteBranch(ctx context.Context, req *pfs.DeleteBranch
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteBranch")
 }
+func (api *pfsServerAPI) MergeBranch(ctx context.Context, req *pfs.MergeBranchRequest) (*pfs.MergeBranchResponse, error) {
+	if api.mock.MergeBranch.handler != nil {
+		return api.mock.MergeBranch.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.MergeBranch")
+}
+func (api *pfsServerAPI) InspectFileLineage(ctx context.Context, req *pfs.InspectFileLineageRequest) (*pfs.FileLineage, error) {
+	if api.mock.InspectFileLineage.handler != nil {
+		return api.mock.InspectFileLineage.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.InspectFileLineage")
+}
 func (api *pfsServerAPI) ModifyFile(serv pfs.API_ModifyFileServer) error {
 	if api.mock.ModifyFile.handler != nil {
 		return api.mock.ModifyFile.handler(serv)
@@ -656,6 +798,12 @@ func (api *pfsServerAPI) ListFile(req *pfs.ListFileRequest, serv pfs.API_ListFil
 	}
 	return errors.Errorf("unhandled pachd mock pfs.ListFile")
 }
+func (api *pfsServerAPI) ListFileHistory(ctx context.Context, req *pfs.ListFileHistoryRequest) (*pfs.ListFileHistoryResponse, error) {
+	if api.mock.ListFileHistory.handler != nil {
+		return api.mock.ListFileHistory.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.ListFileHistory")
+}
 func (api *pfsServerAPI) WalkFile(req *pfs.WalkFileRequest, serv pfs.API_WalkFileServer) error {
 	if api.mock.WalkFile.handler != nil {
 		return api.mock.WalkFile.handler(req, serv)
@@ -686,6 +834,24 @@ func (api *pfsServerAPI) Fsck(req *pfs.FsckRequest, serv pfs.API_FsckServer) err
 	}
 	return errors.Errorf("unhandled pachd mock pfs.Fsck")
 }
+func (api *pfsServerAPI) InspectStorage(ctx context.Context, req *pfs.InspectStorageRequest) (*pfs.StorageInfo, error) {
+	if api.mock.InspectStorage.handler != nil {
+		return api.mock.InspectStorage.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.InspectStorage")
+}
+func (api *pfsServerAPI) RehydrateCommit(ctx context.Context, req *pfs.RehydrateCommitRequest) (*pfs.RehydrateCommitResponse, error) {
+	if api.mock.RehydrateCommit.handler != nil {
+		return api.mock.RehydrateCommit.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.RehydrateCommit")
+}
+func (api *pfsServerAPI) VerifyMirror(req *pfs.VerifyMirrorRequest, serv pfs.API_VerifyMirrorServer) error {
+	if api.mock.VerifyMirror.handler != nil {
+		return api.mock.VerifyMirror.handler(req, serv)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.VerifyMirror")
+}
 func (api *pfsServerAPI) CreateFileset(srv pfs.API_CreateFilesetServer) error {
 	if api.mock.CreateFileset.handler != nil {
 		return api.mock.CreateFileset.handler(srv)
@@ -716,6 +882,60 @@ func (api *pfsServerAPI) RunLoadTest(ctx context.Context, req *pfs.RunLoadTestRe
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.RunLoadTest")
 }
+func (api *pfsServerAPI) CreateRemote(ctx context.Context, req *pfs.CreateRemoteRequest) (*types.Empty, error) {
+	if api.mock.CreateRemote.handler != nil {
+		return api.mock.CreateRemote.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.CreateRemote")
+}
+func (api *pfsServerAPI) ListRemote(ctx context.Context, req *pfs.ListRemoteRequest) (*pfs.ListRemoteResponse, error) {
+	if api.mock.ListRemote.handler != nil {
+		return api.mock.ListRemote.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.ListRemote")
+}
+func (api *pfsServerAPI) DeleteRemote(ctx context.Context, req *pfs.DeleteRemoteRequest) (*types.Empty, error) {
+	if api.mock.DeleteRemote.handler != nil {
+		return api.mock.DeleteRemote.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteRemote")
+}
+func (api *pfsServerAPI) PushBranch(ctx context.Context, req *pfs.PushBranchRequest) (*pfs.TransferStats, error) {
+	if api.mock.PushBranch.handler != nil {
+		return api.mock.PushBranch.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.PushBranch")
+}
+func (api *pfsServerAPI) PullBranch(ctx context.Context, req *pfs.PullBranchRequest) (*pfs.TransferStats, error) {
+	if api.mock.PullBranch.handler != nil {
+		return api.mock.PullBranch.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.PullBranch")
+}
+func (api *pfsServerAPI) MissingChunks(ctx context.Context, req *pfs.MissingChunksRequest) (*pfs.MissingChunksResponse, error) {
+	if api.mock.MissingChunks.handler != nil {
+		return api.mock.MissingChunks.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.MissingChunks")
+}
+func (api *pfsServerAPI) ExportCommit(ctx context.Context, req *pfs.ExportCommitRequest) (*pfs.ExportCommitResponse, error) {
+	if api.mock.ExportCommit.handler != nil {
+		return api.mock.ExportCommit.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.ExportCommit")
+}
+func (api *pfsServerAPI) GetChunk(req *pfs.GetChunkRequest, serv pfs.API_GetChunkServer) error {
+	if api.mock.GetChunk.handler != nil {
+		return api.mock.GetChunk.handler(req, serv)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.GetChunk")
+}
+func (api *pfsServerAPI) ReceiveCommit(srv pfs.API_ReceiveCommitServer) error {
+	if api.mock.ReceiveCommit.handler != nil {
+		return api.mock.ReceiveCommit.handler(srv)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.ReceiveCommit")
+}
 
 /* PPS Server Mocks */
 
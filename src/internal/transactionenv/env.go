@@ -31,6 +31,8 @@ type PfsWrites interface {
 
 	CreateBranch(*pfs.CreateBranchRequest) error
 	DeleteBranch(*pfs.DeleteBranchRequest) error
+
+	AddFileset(*pfs.AddFilesetRequest) error
 }
 
 // PpsWrites is an interface providing a wrapper for each operation that
@@ -148,6 +150,11 @@ func (t *directTransaction) DeleteBranch(original *pfs.DeleteBranchRequest) erro
 	return t.txnEnv.serviceEnv.PfsServer().DeleteBranchInTransaction(t.txnCtx, req)
 }
 
+func (t *directTransaction) AddFileset(original *pfs.AddFilesetRequest) error {
+	req := proto.Clone(original).(*pfs.AddFilesetRequest)
+	return t.txnEnv.serviceEnv.PfsServer().AddFilesetInTransaction(t.txnCtx, req)
+}
+
 func (t *directTransaction) StopPipelineJob(original *pps.StopPipelineJobRequest) error {
 	req := proto.Clone(original).(*pps.StopPipelineJobRequest)
 	return t.txnEnv.serviceEnv.PpsServer().StopPipelineJobInTransaction(t.txnCtx, req)
@@ -225,6 +232,11 @@ func (t *appendTransaction) DeleteBranch(req *pfs.DeleteBranchRequest) error {
 	return err
 }
 
+func (t *appendTransaction) AddFileset(req *pfs.AddFilesetRequest) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{AddFileset: req})
+	return err
+}
+
 func (t *appendTransaction) StopPipelineJob(req *pps.StopPipelineJobRequest) error {
 	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{StopPipelineJob: req})
 	return err
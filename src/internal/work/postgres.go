@@ -0,0 +1,444 @@
+package work
+
+import (
+	"context"
+	"crypto/md5"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/jmoiron/sqlx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+)
+
+var (
+	// postgresLease is how long a worker's claim on a subtask lasts without
+	// being renewed. A subtask claimed by a worker that crashed is claimed by
+	// another worker once the lease expires. Lease expiry sends no
+	// notification, so tasks and subtasks are also checked once per lease.
+	postgresLease = 15 * time.Second
+)
+
+const postgresSchema = `
+	CREATE TABLE IF NOT EXISTS work.tasks (
+		namespace VARCHAR(4096) NOT NULL,
+		id VARCHAR(4096) NOT NULL,
+		task_pb BYTEA NOT NULL,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY(namespace, id)
+	);
+
+	CREATE TABLE IF NOT EXISTS work.subtasks (
+		namespace VARCHAR(4096) NOT NULL,
+		task_id VARCHAR(4096) NOT NULL,
+		id VARCHAR(4096) NOT NULL,
+		info_pb BYTEA NOT NULL,
+		state INT NOT NULL,
+		claim_id VARCHAR(64),
+		lease_expires_at TIMESTAMP,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY(namespace, task_id, id)
+	);
+`
+
+// SetupPostgresTaskQueueV0 sets up the tables for task queues and workers
+// backed by Postgres. The work schema must already exist.
+func SetupPostgresTaskQueueV0(ctx context.Context, tx *sqlx.Tx) error {
+	_, err := tx.ExecContext(ctx, postgresSchema)
+	return errors.EnsureStack(err)
+}
+
+// Changes to a namespace's tasks are notified on 'work_' || md5(namespace), and
+// changes to a task's subtasks on 'work_' || md5(namespace || ' ' || task_id).
+// Channel names are hashed because Postgres truncates them to 63 bytes.
+// Claiming a subtask and renewing its lease notify no one, only releasing the
+// claim or finishing the subtask does.
+const postgresNotifySchema = `
+	CREATE OR REPLACE FUNCTION work.notify_tasks_trigger_fn() RETURNS TRIGGER AS $$
+	BEGIN
+		IF tg_op = 'DELETE' THEN
+			PERFORM pg_notify('work_' || md5(old.namespace), '');
+			RETURN old;
+		END IF;
+		PERFORM pg_notify('work_' || md5(new.namespace), '');
+		RETURN new;
+	END;
+	$$ LANGUAGE plpgsql;
+
+	CREATE OR REPLACE FUNCTION work.notify_subtasks_trigger_fn() RETURNS TRIGGER AS $$
+	BEGIN
+		IF tg_op = 'DELETE' THEN
+			PERFORM pg_notify('work_' || md5(old.namespace || ' ' || old.task_id), '');
+			RETURN old;
+		END IF;
+		PERFORM pg_notify('work_' || md5(new.namespace || ' ' || new.task_id), '');
+		RETURN new;
+	END;
+	$$ LANGUAGE plpgsql;
+
+	CREATE TRIGGER notify_tasks
+		AFTER INSERT OR UPDATE OR DELETE ON work.tasks
+		FOR EACH ROW EXECUTE PROCEDURE work.notify_tasks_trigger_fn();
+
+	CREATE TRIGGER notify_subtasks
+		AFTER INSERT OR DELETE ON work.subtasks
+		FOR EACH ROW EXECUTE PROCEDURE work.notify_subtasks_trigger_fn();
+
+	CREATE TRIGGER notify_subtasks_update
+		AFTER UPDATE ON work.subtasks
+		FOR EACH ROW WHEN (new.claim_id IS NULL OR old.state IS DISTINCT FROM new.state)
+		EXECUTE PROCEDURE work.notify_subtasks_trigger_fn();
+`
+
+// SetupPostgresTaskQueueNotificationsV0 sets up the triggers that notify
+// workers and task queues backed by Postgres of changes to their tasks.
+func SetupPostgresTaskQueueNotificationsV0(ctx context.Context, tx *sqlx.Tx) error {
+	_, err := tx.ExecContext(ctx, postgresNotifySchema)
+	return errors.EnsureStack(err)
+}
+
+func tasksChannel(namespace string) string {
+	return fmt.Sprintf("work_%x", md5.Sum([]byte(namespace)))
+}
+
+func subtasksChannel(namespace, taskID string) string {
+	return fmt.Sprintf("work_%x", md5.Sum([]byte(namespace+" "+taskID)))
+}
+
+// taskPostgres is a task store on top of Postgres. Workers claim subtasks by
+// leasing their rows with SELECT ... FOR UPDATE SKIP LOCKED, and renew the
+// lease while they process the subtask. Workers and task queues wait for
+// changes with LISTEN/NOTIFY.
+type taskPostgres struct {
+	db        *sqlx.DB
+	listener  *col.PostgresListener
+	namespace string
+}
+
+// NewPostgresTaskQueue sets up a new task queue backed by Postgres.
+func NewPostgresTaskQueue(ctx context.Context, db *sqlx.DB, listener *col.PostgresListener, taskNamespace string) (*TaskQueue, error) {
+	return newTaskQueueWithStore(ctx, &taskPostgres{db: db, listener: listener, namespace: taskNamespace}, taskNamespace)
+}
+
+// NewPostgresWorker creates a new worker for a task queue backed by Postgres.
+func NewPostgresWorker(db *sqlx.DB, listener *col.PostgresListener, taskNamespace string) *Worker {
+	return &Worker{store: &taskPostgres{db: db, listener: listener, namespace: taskNamespace}}
+}
+
+// postgresNotifier waits for notifications on a channel.
+type postgresNotifier struct {
+	listener *col.PostgresListener
+	channel  string
+	c        <-chan struct{}
+	cancel   func()
+}
+
+// notifier subscribes to a channel. It must be called before reading the
+// state the channel notifies changes to, so that no change is missed.
+func (tp *taskPostgres) notifier(channel string) *postgresNotifier {
+	n := &postgresNotifier{listener: tp.listener, channel: channel}
+	n.subscribe()
+	return n
+}
+
+// subscribe (re)subscribes to the channel. If that fails, wait falls back to
+// waiting for the lease interval until the next subscription succeeds.
+func (n *postgresNotifier) subscribe() {
+	c, cancel, err := n.listener.Notify(n.channel)
+	if err != nil {
+		n.c, n.cancel = nil, func() {}
+		return
+	}
+	n.c, n.cancel = c, cancel
+}
+
+// wait blocks until a notification arrives, the lease interval passes or ctx
+// is done. If notifications may have been missed, it returns right away.
+func (n *postgresNotifier) wait(ctx context.Context) error {
+	if n.c == nil {
+		n.subscribe()
+	}
+	select {
+	case _, ok := <-n.c:
+		if !ok {
+			// The listener lost its connection to the database.
+			n.subscribe()
+		}
+		return nil
+	case <-time.After(postgresLease):
+		return nil
+	case <-ctx.Done():
+		return errors.EnsureStack(ctx.Err())
+	}
+}
+
+func (n *postgresNotifier) close() {
+	n.cancel()
+}
+
+func (tp *taskPostgres) createTask(ctx context.Context, task *Task) error {
+	data, err := proto.Marshal(task)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	_, err = tp.db.ExecContext(ctx, `INSERT INTO work.tasks (namespace, id, task_pb) VALUES ($1, $2, $3)`, tp.namespace, task.ID, data)
+	return errors.EnsureStack(err)
+}
+
+func (tp *taskPostgres) deleteTask(taskID string) error {
+	return col.NewSQLTx(context.Background(), tp.db, func(tx *sqlx.Tx) error {
+		if _, err := tx.Exec(`DELETE FROM work.subtasks WHERE namespace = $1 AND task_id = $2`, tp.namespace, taskID); err != nil {
+			return errors.EnsureStack(err)
+		}
+		_, err := tx.Exec(`DELETE FROM work.tasks WHERE namespace = $1 AND id = $2`, tp.namespace, taskID)
+		return errors.EnsureStack(err)
+	})
+}
+
+func (tp *taskPostgres) deleteAllTasks() error {
+	return col.NewSQLTx(context.Background(), tp.db, func(tx *sqlx.Tx) error {
+		if _, err := tx.Exec(`DELETE FROM work.subtasks WHERE namespace = $1`, tp.namespace); err != nil {
+			return errors.EnsureStack(err)
+		}
+		_, err := tx.Exec(`DELETE FROM work.tasks WHERE namespace = $1`, tp.namespace)
+		return errors.EnsureStack(err)
+	})
+}
+
+func (tp *taskPostgres) createSubtask(ctx context.Context, taskID string, subtaskInfo *TaskInfo) error {
+	data, err := proto.Marshal(subtaskInfo)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	_, err = tp.db.ExecContext(ctx, `
+		INSERT INTO work.subtasks (namespace, task_id, id, info_pb, state) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (namespace, task_id, id) DO UPDATE SET info_pb = $4, state = $5, claim_id = NULL, lease_expires_at = NULL
+	`, tp.namespace, taskID, subtaskInfo.Task.ID, data, subtaskInfo.State)
+	return errors.EnsureStack(err)
+}
+
+func (tp *taskPostgres) deleteSubtasks(taskID string) error {
+	_, err := tp.db.Exec(`DELETE FROM work.subtasks WHERE namespace = $1 AND task_id = $2`, tp.namespace, taskID)
+	return errors.EnsureStack(err)
+}
+
+// watchSubtasks waits for the subtasks of the task to finish. Each finished
+// subtask is deleted once cb has been called with it, so that it is only
+// reported once.
+func (tp *taskPostgres) watchSubtasks(ctx context.Context, taskID string, cb func(*TaskInfo) error) error {
+	n := tp.notifier(subtasksChannel(tp.namespace, taskID))
+	defer n.close()
+	for {
+		var exists bool
+		if err := tp.db.GetContext(ctx, &exists, `SELECT EXISTS(SELECT 1 FROM work.tasks WHERE namespace = $1 AND id = $2)`, tp.namespace, taskID); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if !exists {
+			return errors.New("task was deleted while waiting for results")
+		}
+		var rows []struct {
+			ID     string `db:"id"`
+			InfoPB []byte `db:"info_pb"`
+		}
+		if err := tp.db.SelectContext(ctx, &rows, `
+			SELECT id, info_pb FROM work.subtasks
+			WHERE namespace = $1 AND task_id = $2 AND state != $3
+			ORDER BY created_at
+		`, tp.namespace, taskID, State_RUNNING); err != nil {
+			return errors.EnsureStack(err)
+		}
+		for _, row := range rows {
+			subtaskInfo := &TaskInfo{}
+			if err := proto.Unmarshal(row.InfoPB, subtaskInfo); err != nil {
+				return errors.EnsureStack(err)
+			}
+			if err := cb(subtaskInfo); err != nil {
+				return err
+			}
+			if _, err := tp.db.ExecContext(ctx, `DELETE FROM work.subtasks WHERE namespace = $1 AND task_id = $2 AND id = $3`, tp.namespace, taskID, row.ID); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		if err := n.wait(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+// watchTasks waits for changes to the tasks in the namespace, and calls cb
+// for the tasks that were created or deleted since it last looked, oldest
+// first.
+func (tp *taskPostgres) watchTasks(ctx context.Context, cb func(string, *Task) error) error {
+	n := tp.notifier(tasksChannel(tp.namespace))
+	defer n.close()
+	known := make(map[string]bool)
+	for {
+		var rows []struct {
+			ID     string `db:"id"`
+			TaskPB []byte `db:"task_pb"`
+		}
+		if err := tp.db.SelectContext(ctx, &rows, `SELECT id, task_pb FROM work.tasks WHERE namespace = $1 ORDER BY created_at`, tp.namespace); err != nil {
+			return errors.EnsureStack(err)
+		}
+		current := make(map[string]bool)
+		for _, row := range rows {
+			current[row.ID] = true
+			if known[row.ID] {
+				continue
+			}
+			task := &Task{}
+			if err := proto.Unmarshal(row.TaskPB, task); err != nil {
+				return errors.EnsureStack(err)
+			}
+			if err := cb(row.ID, task); err != nil {
+				return err
+			}
+		}
+		for taskID := range known {
+			if !current[taskID] {
+				if err := cb(taskID, nil); err != nil {
+					return err
+				}
+			}
+		}
+		known = current
+		if err := n.wait(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+func (tp *taskPostgres) processSubtasks(taskEntry *taskEntry, task *Task, processFunc ProcessFunc) error {
+	n := tp.notifier(subtasksChannel(tp.namespace, task.ID))
+	defer n.close()
+	for {
+		var claimed bool
+		done := make(chan struct{})
+		taskEntry.runSubtask(func(ctx context.Context) {
+			defer close(done)
+			var err error
+			if claimed, err = tp.processSubtask(ctx, task.ID, processFunc); err != nil {
+				// If the task context was canceled, then no error should be logged.
+				if !errors.Is(ctx.Err(), context.Canceled) {
+					fmt.Printf("errored in subtask callback: %v\n", err)
+				}
+			}
+		})
+		select {
+		case <-done:
+		case <-taskEntry.ctx.Done():
+			return taskEntry.ctx.Err()
+		}
+		if claimed {
+			continue
+		}
+		if err := n.wait(taskEntry.ctx); err != nil {
+			return taskEntry.ctx.Err()
+		}
+	}
+}
+
+// processSubtask claims a subtask of the task, if there are any left, and
+// processes it. It returns false if there was no subtask to claim.
+func (tp *taskPostgres) processSubtask(ctx context.Context, taskID string, processFunc ProcessFunc) (bool, error) {
+	claimID := uuid.NewWithoutDashes()
+	var row struct {
+		ID     string `db:"id"`
+		InfoPB []byte `db:"info_pb"`
+	}
+	if err := tp.db.GetContext(ctx, &row, `
+		UPDATE work.subtasks SET claim_id = $1, lease_expires_at = CURRENT_TIMESTAMP + $2 * INTERVAL '1 millisecond'
+		WHERE (namespace, task_id, id) = (
+			SELECT namespace, task_id, id FROM work.subtasks
+			WHERE namespace = $3 AND task_id = $4 AND state = $5
+			AND (lease_expires_at IS NULL OR lease_expires_at < CURRENT_TIMESTAMP)
+			ORDER BY created_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, info_pb
+	`, claimID, postgresLease.Milliseconds(), tp.namespace, taskID, State_RUNNING); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, errors.EnsureStack(err)
+	}
+	subtaskInfo := &TaskInfo{}
+	if err := proto.Unmarshal(row.InfoPB, subtaskInfo); err != nil {
+		return true, errors.EnsureStack(err)
+	}
+	claimCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go tp.renewLease(claimCtx, cancel, taskID, row.ID, claimID)
+	result, err := processFunc(claimCtx, subtaskInfo.Task)
+	// If the task context was canceled or the claim was lost, release the
+	// claim so another worker can take the subtask right away.
+	if claimCtx.Err() != nil {
+		_, err := tp.db.Exec(`
+			UPDATE work.subtasks SET claim_id = NULL, lease_expires_at = NULL
+			WHERE namespace = $1 AND task_id = $2 AND id = $3 AND claim_id = $4
+		`, tp.namespace, taskID, row.ID, claimID)
+		return true, errors.EnsureStack(err)
+	}
+	subtaskInfo.State = State_SUCCESS
+	subtaskInfo.Result = result
+	if err != nil {
+		subtaskInfo.State = State_FAILURE
+		subtaskInfo.Reason = err.Error()
+	}
+	data, err := proto.Marshal(subtaskInfo)
+	if err != nil {
+		return true, errors.EnsureStack(err)
+	}
+	_, err = tp.db.ExecContext(claimCtx, `
+		UPDATE work.subtasks SET info_pb = $1, state = $2, claim_id = NULL, lease_expires_at = NULL
+		WHERE namespace = $3 AND task_id = $4 AND id = $5 AND claim_id = $6 AND state = $7
+	`, data, subtaskInfo.State, tp.namespace, taskID, row.ID, claimID, State_RUNNING)
+	return true, errors.EnsureStack(err)
+}
+
+// renewLease renews the lease on a claimed subtask until ctx is done, and
+// calls cancel if the claim is lost. Failed renewals are retried until the
+// lease has expired, since another worker may claim the subtask after that.
+func (tp *taskPostgres) renewLease(ctx context.Context, cancel func(), taskID, subtaskID, claimID string) {
+	defer cancel()
+	renewed := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(postgresLease / 3):
+		}
+		var lost bool
+		b := backoff.NewInfiniteBackOff()
+		b.MaxInterval = postgresLease / 3
+		if err := backoff.RetryUntilCancel(ctx, func() error {
+			attempt := time.Now()
+			res, err := tp.db.ExecContext(ctx, `
+				UPDATE work.subtasks SET lease_expires_at = CURRENT_TIMESTAMP + $1 * INTERVAL '1 millisecond'
+				WHERE namespace = $2 AND task_id = $3 AND id = $4 AND claim_id = $5
+			`, postgresLease.Milliseconds(), tp.namespace, taskID, subtaskID, claimID)
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			n, err := res.RowsAffected()
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			lost = n == 0
+			renewed = attempt
+			return nil
+		}, b, func(err error, _ time.Duration) error {
+			if time.Since(renewed) >= postgresLease {
+				return errors.Wrap(err, "subtask lease expired")
+			}
+			return nil
+		}); err != nil || lost {
+			return
+		}
+	}
+}
@@ -23,11 +23,32 @@ const (
 	claimPrefix   = "/claim"
 )
 
+// taskStore stores the tasks and subtasks of a task queue, and claims
+// subtasks for workers. There is an implementation on top of etcd and one on
+// top of Postgres.
+type taskStore interface {
+	createTask(ctx context.Context, task *Task) error
+	deleteTask(taskID string) error
+	deleteAllTasks() error
+	createSubtask(ctx context.Context, taskID string, subtaskInfo *TaskInfo) error
+	deleteSubtasks(taskID string) error
+	// watchSubtasks calls cb with the info of the subtasks of a task as they
+	// change, until cb returns an error or the task is deleted.
+	watchSubtasks(ctx context.Context, taskID string, cb func(*TaskInfo) error) error
+	// watchTasks calls cb with each task as it is created, and with a nil task
+	// when it is deleted.
+	watchTasks(ctx context.Context, cb func(taskID string, task *Task) error) error
+	// processSubtasks claims the subtasks of a task, one at a time, and
+	// processes them with processFunc in the task entry until the task entry's
+	// context is done.
+	processSubtasks(taskEntry *taskEntry, task *Task, processFunc ProcessFunc) error
+}
+
 // TaskQueue manages a set of parallel tasks, and provides an interface for running tasks.
 // Priority of tasks (and therefore subtasks) is based on task creation time, so tasks created
 // earlier will be prioritized over tasks that were created later.
 type TaskQueue struct {
-	*taskEtcd
+	store     taskStore
 	taskQueue *taskQueue
 }
 
@@ -38,14 +59,18 @@ type taskEtcd struct {
 
 // NewTaskQueue sets up a new task queue.
 func NewTaskQueue(ctx context.Context, etcdClient *etcd.Client, etcdPrefix string, taskNamespace string) (*TaskQueue, error) {
+	return newTaskQueueWithStore(ctx, newTaskEtcd(etcdClient, etcdPrefix, taskNamespace), taskNamespace)
+}
+
+func newTaskQueueWithStore(ctx context.Context, store taskStore, taskNamespace string) (*TaskQueue, error) {
 	tq := &TaskQueue{
-		taskEtcd:  newTaskEtcd(etcdClient, etcdPrefix, taskNamespace),
+		store:     store,
 		taskQueue: newTaskQueue(ctx),
 	}
-	// Clear etcd key space.
+	// Clear the task namespace.
 	// TODO: Multiple storage task queues are setup, so deleting the existing tasks is problematic.
 	if taskNamespace != "storage" {
-		if err := tq.deleteAllTasks(); err != nil {
+		if err := tq.store.deleteAllTasks(); err != nil {
 			return nil, err
 		}
 	}
@@ -78,26 +103,24 @@ func newCollection(etcdClient *etcd.Client, etcdPrefix string, template proto.Me
 // The task state will be cleaned up upon return of the callback.
 func (tq *TaskQueue) RunTask(ctx context.Context, f func(*Master)) (retErr error) {
 	task := &Task{ID: uuid.NewWithoutDashes()}
-	if _, err := col.NewSTM(ctx, tq.etcdClient, func(stm col.STM) error {
-		return tq.taskCol.ReadWrite(stm).Put(task.ID, task)
-	}); err != nil {
+	if err := tq.store.createTask(ctx, task); err != nil {
 		return err
 	}
 	defer func() {
 		if retErr != nil {
-			if err := tq.deleteTask(task.ID); err != nil {
+			if err := tq.store.deleteTask(task.ID); err != nil {
 				fmt.Printf("errored deleting task %v: %v\n", task.ID, err)
 			}
 		}
 	}()
 	return tq.taskQueue.runTask(ctx, task.ID, func(te *taskEntry) {
 		defer func() {
-			if err := tq.deleteTask(task.ID); err != nil {
+			if err := tq.store.deleteTask(task.ID); err != nil {
 				fmt.Printf("errored deleting task %v: %v\n", task.ID, err)
 			}
 		}()
 		f(&Master{
-			taskEtcd:  tq.taskEtcd,
+			store:     tq.store,
 			taskID:    task.ID,
 			taskEntry: te,
 		})
@@ -117,7 +140,7 @@ func (tq *TaskQueue) RunTaskBlock(ctx context.Context, f func(*Master) error) er
 
 // Master manages subtasks in the task queue, and provides an interface for running subtasks.
 type Master struct {
-	*taskEtcd
+	store     taskStore
 	taskID    string
 	taskEntry *taskEntry
 }
@@ -161,15 +184,7 @@ func (m *Master) RunSubtasksChan(subtaskChan chan *Task, collectFunc CollectFunc
 	done := make(chan struct{})
 	ctx, cancel := context.WithCancel(m.taskEntry.ctx)
 	eg.Go(func() error {
-		return m.subtaskCol.ReadOnly(ctx).WatchOneF(m.taskID, func(e *watch.Event) error {
-			var key string
-			subtaskInfo := &TaskInfo{}
-			if e.Type == watch.EventDelete {
-				return errors.New("task was deleted while waiting for results")
-			}
-			if err := e.Unmarshal(&key, subtaskInfo); err != nil {
-				return err
-			}
+		return m.store.watchSubtasks(ctx, m.taskID, func(subtaskInfo *TaskInfo) error {
 			// Check that the subtask state is terminal.
 			if subtaskInfo.State == State_RUNNING {
 				return nil
@@ -202,13 +217,16 @@ func (m *Master) RunSubtasksChan(subtaskChan chan *Task, collectFunc CollectFunc
 		if err := eg.Wait(); retErr == nil && !errors.Is(ctx.Err(), context.Canceled) {
 			retErr = err
 		}
-		if err := m.deleteSubtasks(); err != nil {
+		if err := m.store.deleteSubtasks(m.taskID); err != nil {
 			fmt.Printf("errored deleting subtasks for task %v: %v\n", m.taskID, err)
 		}
 	}()
 
 	for subtask := range subtaskChan {
-		if err := m.createSubtask(subtask); err != nil {
+		if subtask.ID == "" {
+			subtask.ID = uuid.NewWithoutDashes()
+		}
+		if err := m.store.createSubtask(m.taskEntry.ctx, m.taskID, &TaskInfo{Task: subtask}); err != nil {
 			return err
 		}
 		atomic.AddInt64(&count, 1)
@@ -216,45 +234,74 @@ func (m *Master) RunSubtasksChan(subtaskChan chan *Task, collectFunc CollectFunc
 	return nil
 }
 
-func (m *Master) createSubtask(subtask *Task) error {
-	if subtask.ID == "" {
-		subtask.ID = uuid.NewWithoutDashes()
-	}
-	subtaskKey := path.Join(m.taskID, subtask.ID)
-	subtaskInfo := &TaskInfo{Task: subtask}
-	if _, err := col.NewSTM(m.taskEntry.ctx, m.etcdClient, func(stm col.STM) error {
-		return m.subtaskCol.ReadWrite(stm).Put(subtaskKey, subtaskInfo)
-	}); err != nil {
-		return err
-	}
-	return nil
+func (te *taskEtcd) createTask(ctx context.Context, task *Task) error {
+	_, err := col.NewSTM(ctx, te.etcdClient, func(stm col.STM) error {
+		return te.taskCol.ReadWrite(stm).Put(task.ID, task)
+	})
+	return err
 }
 
-func (m *Master) deleteSubtasks() error {
-	_, err := col.NewSTM(context.Background(), m.etcdClient, func(stm col.STM) error {
-		m.subtaskCol.ReadWrite(stm).DeleteAllPrefix(m.taskID)
+func (te *taskEtcd) createSubtask(ctx context.Context, taskID string, subtaskInfo *TaskInfo) error {
+	subtaskKey := path.Join(taskID, subtaskInfo.Task.ID)
+	_, err := col.NewSTM(ctx, te.etcdClient, func(stm col.STM) error {
+		return te.subtaskCol.ReadWrite(stm).Put(subtaskKey, subtaskInfo)
+	})
+	return err
+}
+
+func (te *taskEtcd) deleteSubtasks(taskID string) error {
+	_, err := col.NewSTM(context.Background(), te.etcdClient, func(stm col.STM) error {
+		te.subtaskCol.ReadWrite(stm).DeleteAllPrefix(taskID)
 		return nil
 	})
 	return err
 }
 
-func (tq *TaskQueue) deleteTask(taskID string) error {
-	_, err := col.NewSTM(context.Background(), tq.etcdClient, func(stm col.STM) error {
-		tq.subtaskCol.ReadWrite(stm).DeleteAllPrefix(taskID)
-		return tq.taskCol.ReadWrite(stm).Delete(taskID)
+func (te *taskEtcd) deleteTask(taskID string) error {
+	_, err := col.NewSTM(context.Background(), te.etcdClient, func(stm col.STM) error {
+		te.subtaskCol.ReadWrite(stm).DeleteAllPrefix(taskID)
+		return te.taskCol.ReadWrite(stm).Delete(taskID)
 	})
 	return err
 }
 
-func (tq *TaskQueue) deleteAllTasks() error {
-	_, err := col.NewSTM(context.Background(), tq.etcdClient, func(stm col.STM) error {
-		tq.subtaskCol.ReadWrite(stm).DeleteAll()
-		tq.taskCol.ReadWrite(stm).DeleteAll()
+func (te *taskEtcd) deleteAllTasks() error {
+	_, err := col.NewSTM(context.Background(), te.etcdClient, func(stm col.STM) error {
+		te.subtaskCol.ReadWrite(stm).DeleteAll()
+		te.taskCol.ReadWrite(stm).DeleteAll()
 		return nil
 	})
 	return err
 }
 
+func (te *taskEtcd) watchSubtasks(ctx context.Context, taskID string, cb func(*TaskInfo) error) error {
+	return te.subtaskCol.ReadOnly(ctx).WatchOneF(taskID, func(e *watch.Event) error {
+		var key string
+		subtaskInfo := &TaskInfo{}
+		if e.Type == watch.EventDelete {
+			return errors.New("task was deleted while waiting for results")
+		}
+		if err := e.Unmarshal(&key, subtaskInfo); err != nil {
+			return err
+		}
+		return cb(subtaskInfo)
+	})
+}
+
+func (te *taskEtcd) watchTasks(ctx context.Context, cb func(string, *Task) error) error {
+	return te.taskCol.ReadOnly(ctx).WatchF(func(e *watch.Event) error {
+		taskID := string(e.Key)
+		if e.Type == watch.EventDelete {
+			return cb(taskID, nil)
+		}
+		task := &Task{}
+		if err := e.Unmarshal(&taskID, task); err != nil {
+			return err
+		}
+		return cb(taskID, task)
+	})
+}
+
 // Worker is a worker that will process subtasks in a task.
 // A worker watches the task collection for tasks to be created / deleted and appropriately
 // runs / deletes tasks in the internal task queue with a function that watches the
@@ -262,12 +309,12 @@ func (tq *TaskQueue) deleteAllTasks() error {
 // The processFunc callback will be called for each subtask that needs to be processed
 // in the task.
 type Worker struct {
-	*taskEtcd
+	store taskStore
 }
 
 // NewWorker creates a new worker.
 func NewWorker(etcdClient *etcd.Client, etcdPrefix string, taskNamespace string) *Worker {
-	return &Worker{taskEtcd: newTaskEtcd(etcdClient, etcdPrefix, taskNamespace)}
+	return &Worker{store: newTaskEtcd(etcdClient, etcdPrefix, taskNamespace)}
 }
 
 // ProcessFunc is a callback that is used for processing a subtask in a task.
@@ -277,31 +324,26 @@ type ProcessFunc func(context.Context, *Task) (*types.Any, error)
 // The worker will continue to watch the task collection until the context is canceled.
 func (w *Worker) Run(ctx context.Context, processFunc ProcessFunc) error {
 	taskQueue := newTaskQueue(ctx)
-	return w.taskCol.ReadOnly(ctx).WatchF(func(e *watch.Event) error {
-		taskID := string(e.Key)
-		task := &Task{}
-		if e.Type == watch.EventDelete {
+	return w.store.watchTasks(ctx, func(taskID string, task *Task) error {
+		if task == nil {
 			taskQueue.deleteTask(taskID)
 			return nil
 		}
-		if err := e.Unmarshal(&taskID, task); err != nil {
-			return err
-		}
 		return taskQueue.runTask(ctx, taskID, func(taskEntry *taskEntry) {
-			if err := w.taskFunc(task, taskEntry, processFunc); err != nil && !errors.Is(taskEntry.ctx.Err(), context.Canceled) {
+			if err := w.store.processSubtasks(taskEntry, task, processFunc); err != nil && !errors.Is(taskEntry.ctx.Err(), context.Canceled) {
 				fmt.Printf("errored in task callback: %v\n", err)
 			}
 		})
 	})
 }
 
-func (w *Worker) taskFunc(task *Task, taskEntry *taskEntry, processFunc ProcessFunc) error {
-	claimWatch, err := w.claimCol.ReadOnly(taskEntry.ctx).WatchOne(task.ID, watch.IgnorePut)
+func (te *taskEtcd) processSubtasks(taskEntry *taskEntry, task *Task, processFunc ProcessFunc) error {
+	claimWatch, err := te.claimCol.ReadOnly(taskEntry.ctx).WatchOne(task.ID, watch.IgnorePut)
 	if err != nil {
 		return err
 	}
 	defer claimWatch.Close()
-	subtaskWatch, err := w.subtaskCol.ReadOnly(taskEntry.ctx).WatchOne(task.ID, watch.IgnoreDelete)
+	subtaskWatch, err := te.subtaskCol.ReadOnly(taskEntry.ctx).WatchOne(task.ID, watch.IgnoreDelete)
 	if err != nil {
 		return err
 	}
@@ -313,7 +355,7 @@ func (w *Worker) taskFunc(task *Task, taskEntry *taskEntry, processFunc ProcessF
 				return e.Err
 			}
 			subtaskKey := string(e.Key)
-			taskEntry.runSubtask(w.subtaskFunc(subtaskKey, processFunc))
+			taskEntry.runSubtask(te.subtaskFunc(subtaskKey, processFunc))
 		case e := <-subtaskWatch.Watch():
 			if e.Type == watch.EventError {
 				return e.Err
@@ -322,29 +364,29 @@ func (w *Worker) taskFunc(task *Task, taskEntry *taskEntry, processFunc ProcessF
 			if err := e.Unmarshal(&subtaskKey, &TaskInfo{}); err != nil {
 				return err
 			}
-			taskEntry.runSubtask(w.subtaskFunc(subtaskKey, processFunc))
+			taskEntry.runSubtask(te.subtaskFunc(subtaskKey, processFunc))
 		case <-taskEntry.ctx.Done():
 			return taskEntry.ctx.Err()
 		}
 	}
 }
 
-func (w *Worker) subtaskFunc(subtaskKey string, processFunc ProcessFunc) subtaskFunc {
+func (te *taskEtcd) subtaskFunc(subtaskKey string, processFunc ProcessFunc) subtaskFunc {
 	return func(ctx context.Context) {
 		if err := func() error {
 			// (bryce) this should be refactored to have the check and claim in the same stm.
 			// there is a rare race condition that does not affect correctness, but it is less
 			// than ideal because a subtask could get run once more than necessary.
 			subtaskInfo := &TaskInfo{}
-			if _, err := col.NewSTM(ctx, w.etcdClient, func(stm col.STM) error {
-				return w.subtaskCol.ReadWrite(stm).Get(subtaskKey, subtaskInfo)
+			if _, err := col.NewSTM(ctx, te.etcdClient, func(stm col.STM) error {
+				return te.subtaskCol.ReadWrite(stm).Get(subtaskKey, subtaskInfo)
 			}); err != nil {
 				return err
 			}
 			if subtaskInfo.State != State_RUNNING {
 				return nil
 			}
-			return w.claimCol.Claim(ctx, subtaskKey, &Claim{}, func(claimCtx context.Context) (retErr error) {
+			return te.claimCol.Claim(ctx, subtaskKey, &Claim{}, func(claimCtx context.Context) (retErr error) {
 				subtask := subtaskInfo.Task
 				var result *types.Any
 				defer func() {
@@ -354,8 +396,8 @@ func (w *Worker) subtaskFunc(subtaskKey string, processFunc ProcessFunc) subtask
 						return
 					}
 					subtaskInfo := &TaskInfo{}
-					if _, err := col.NewSTM(claimCtx, w.etcdClient, func(stm col.STM) error {
-						return w.subtaskCol.ReadWrite(stm).Update(subtaskKey, subtaskInfo, func() error {
+					if _, err := col.NewSTM(claimCtx, te.etcdClient, func(stm col.STM) error {
+						return te.subtaskCol.ReadWrite(stm).Update(subtaskKey, subtaskInfo, func() error {
 							// (bryce) remove when check and claim are in the same stm.
 							if subtaskInfo.State != State_RUNNING {
 								return nil
@@ -1,4 +1,4 @@
-package work
+package work_test
 
 import (
 	"context"
@@ -10,9 +10,14 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/testetcd"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/work"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -24,7 +29,7 @@ func seedStr(seed int64) string {
 	return fmt.Sprint("seed: ", strconv.FormatInt(seed, 10))
 }
 
-func serializeTestData(testData *TestData) (*types.Any, error) {
+func serializeTestData(testData *work.TestData) (*types.Any, error) {
 	serializedTestData, err := proto.Marshal(testData)
 	if err != nil {
 		return nil, err
@@ -35,15 +40,15 @@ func serializeTestData(testData *TestData) (*types.Any, error) {
 	}, nil
 }
 
-func deserializeTestData(testDataAny *types.Any) (*TestData, error) {
-	testData := &TestData{}
+func deserializeTestData(testDataAny *types.Any) (*work.TestData, error) {
+	testData := &work.TestData{}
 	if err := types.UnmarshalAny(testDataAny, testData); err != nil {
 		return nil, err
 	}
 	return testData, nil
 }
 
-func processSubtask(t *testing.T, subtask *Task) error {
+func processSubtask(t *testing.T, subtask *work.Task) error {
 	testData, err := deserializeTestData(subtask.Data)
 	if err != nil {
 		return err
@@ -60,7 +65,7 @@ func processSubtask(t *testing.T, subtask *Task) error {
 	return nil
 }
 
-func collectSubtask(subtaskInfo *TaskInfo, collected map[string]bool) error {
+func collectSubtask(subtaskInfo *work.TaskInfo, collected map[string]bool) error {
 	testData, err := deserializeTestData(subtaskInfo.Task.Data)
 	if err != nil {
 		return err
@@ -69,17 +74,82 @@ func collectSubtask(subtaskInfo *TaskInfo, collected map[string]bool) error {
 		return errors.Errorf("collected subtask should be processed")
 	}
 	collected[subtaskInfo.Task.ID] = true
-	if subtaskInfo.State == State_FAILURE && subtaskInfo.Reason != errSubtaskFailure.Error() {
+	if subtaskInfo.State == work.State_FAILURE && subtaskInfo.Reason != errSubtaskFailure.Error() {
 		return errors.Errorf("subtask failure reason does not equal subtask failure error message")
 	}
 	return nil
 }
 
+// backend creates task queues and workers in one of the task stores.
+type backend struct {
+	newTaskQueue func(context.Context) (*work.TaskQueue, error)
+	newWorker    func() *work.Worker
+}
+
+func etcdBackend(t *testing.T) backend {
+	env := testetcd.NewEnv(t)
+	return backend{
+		newTaskQueue: func(ctx context.Context) (*work.TaskQueue, error) {
+			return work.NewTaskQueue(ctx, env.EtcdClient, "", "")
+		},
+		newWorker: func() *work.Worker {
+			return work.NewWorker(env.EtcdClient, "", "")
+		},
+	}
+}
+
+func postgresBackend(t *testing.T) backend {
+	config := serviceenv.ConfigFromOptions(testutil.NewTestDBConfig(t))
+	options := []dbutil.Option{
+		dbutil.WithHostPort(config.PostgresServiceHost, config.PostgresServicePort),
+		dbutil.WithDBName(config.PostgresDBName),
+	}
+	db, err := dbutil.NewDB(options...)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+	listener := col.NewPostgresListener(dbutil.GetDSN(options...))
+	t.Cleanup(func() {
+		require.NoError(t, listener.Close())
+	})
+	tx := db.MustBegin()
+	tx.MustExec(`CREATE SCHEMA work`)
+	require.NoError(t, work.SetupPostgresTaskQueueV0(context.Background(), tx))
+	require.NoError(t, work.SetupPostgresTaskQueueNotificationsV0(context.Background(), tx))
+	require.NoError(t, tx.Commit())
+	return backend{
+		newTaskQueue: func(ctx context.Context) (*work.TaskQueue, error) {
+			return work.NewPostgresTaskQueue(ctx, db, listener, "")
+		},
+		newWorker: func() *work.Worker {
+			return work.NewPostgresWorker(db, listener, "")
+		},
+	}
+}
+
+// withBackends runs the test against each task store.
+func withBackends(t *testing.T, f func(*testing.T, backend)) {
+	t.Run("Etcd", func(t *testing.T) {
+		t.Parallel()
+		f(t, etcdBackend(t))
+	})
+	t.Run("Postgres", func(t *testing.T) {
+		t.Parallel()
+		f(t, postgresBackend(t))
+	})
+}
+
 func test(t *testing.T, workerFailProb, taskCancelProb, subtaskFailProb float64) {
+	withBackends(t, func(t *testing.T, b backend) {
+		testBackend(t, b, workerFailProb, taskCancelProb, subtaskFailProb)
+	})
+}
+
+func testBackend(t *testing.T, b backend, workerFailProb, taskCancelProb, subtaskFailProb float64) {
 	seed := time.Now().UTC().UnixNano()
 	rand.Seed(seed)
 	msg := seedStr(seed)
-	env := testetcd.NewEnv(t)
 
 	numTasks := 10
 	numSubtasks := 10
@@ -89,10 +159,10 @@ func test(t *testing.T, workerFailProb, taskCancelProb, subtaskFailProb float64)
 	workerEg, errCtx := errgroup.WithContext(workerCtx)
 	for i := 0; i < numWorkers; i++ {
 		workerEg.Go(func() error {
-			w := NewWorker(env.EtcdClient, "", "")
+			w := b.newWorker()
 			for {
 				ctx, cancel := context.WithCancel(errCtx)
-				if err := w.Run(ctx, func(_ context.Context, subtask *Task) (*types.Any, error) {
+				if err := w.Run(ctx, func(_ context.Context, subtask *work.Task) (*types.Any, error) {
 					if rand.Float64() < workerFailProb {
 						cancel()
 						return nil, nil
@@ -116,7 +186,7 @@ func test(t *testing.T, workerFailProb, taskCancelProb, subtaskFailProb float64)
 			}
 		})
 	}
-	tq, err := NewTaskQueue(errCtx, env.EtcdClient, "", "")
+	tq, err := b.newTaskQueue(errCtx)
 	require.NoError(t, err)
 	taskMapsFunc := func() []map[string]bool {
 		var taskMaps []map[string]bool
@@ -132,26 +202,26 @@ func test(t *testing.T, workerFailProb, taskCancelProb, subtaskFailProb float64)
 		i := i
 		taskEg.Go(func() error {
 			ctx, cancel := context.WithCancel(errCtx)
-			if err := tq.RunTaskBlock(ctx, func(m *Master) error {
+			if err := tq.RunTaskBlock(ctx, func(m *work.Master) error {
 				if rand.Float64() < taskCancelProb {
 					cancel()
 					return nil
 				}
 				// Create subtasks.
-				var subtasks []*Task
+				var subtasks []*work.Task
 				for j := 0; j < numSubtasks; j++ {
 					ID := strconv.Itoa(j)
-					data, err := serializeTestData(&TestData{})
+					data, err := serializeTestData(&work.TestData{})
 					if err != nil {
 						return err
 					}
-					subtasks = append(subtasks, &Task{
+					subtasks = append(subtasks, &work.Task{
 						ID:   ID,
 						Data: data,
 					})
 					created[i][ID] = true
 				}
-				return m.RunSubtasks(subtasks, func(_ context.Context, subtaskInfo *TaskInfo) error {
+				return m.RunSubtasks(subtasks, func(_ context.Context, subtaskInfo *work.TaskInfo) error {
 					return collectSubtask(subtaskInfo, collected[i])
 				})
 			}); err != nil && !errors.Is(ctx.Err(), context.Canceled) {
@@ -193,15 +263,15 @@ func TestEverything(t *testing.T) {
 
 func TestRunZeroSubtasks(t *testing.T) {
 	t.Parallel()
-	env := testetcd.NewEnv(t)
-
-	tq, err := NewTaskQueue(context.Background(), env.EtcdClient, "", "")
-	require.NoError(t, err)
+	withBackends(t, func(t *testing.T, b backend) {
+		tq, err := b.newTaskQueue(context.Background())
+		require.NoError(t, err)
 
-	err = tq.RunTaskBlock(context.Background(), func(m *Master) error {
-		return m.RunSubtasks(nil, func(_ context.Context, _ *TaskInfo) error {
-			return nil
+		err = tq.RunTaskBlock(context.Background(), func(m *work.Master) error {
+			return m.RunSubtasks(nil, func(_ context.Context, _ *work.TaskInfo) error {
+				return nil
+			})
 		})
+		require.NoError(t, err)
 	})
-	require.NoError(t, err)
 }
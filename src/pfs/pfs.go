@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"hash"
+	"strings"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachhash"
@@ -20,11 +21,55 @@ const (
 	MetaRepoType  = "meta"
 	BuildRepoType = "build"
 	SpecRepoType  = "spec"
+
+	// DefaultProjectName is the name of the project that repos and pipelines
+	// belong to if they aren't given one.
+	DefaultProjectName = "default"
 )
 
 // FullID prints repoName@CommitID
 func (c *Commit) FullID() string {
-	return fmt.Sprintf("%s@%s", c.Branch.Repo.Name, c.ID)
+	return fmt.Sprintf("%s@%s", c.Branch.Repo.QualifiedName(), c.ID)
+}
+
+// IsDefaultProject returns true if project is the name of the default project,
+// which includes the empty string.
+func IsDefaultProject(project string) bool {
+	return project == "" || project == DefaultProjectName
+}
+
+// SplitProject splits a repo name that may be qualified with its project, as
+// in "project/repo", into the project and the repo. The project is empty if
+// the name isn't qualified. A leading "/" doesn't qualify the name, so that
+// such names are rejected as invalid rather than put in the default project.
+func SplitProject(name string) (string, string) {
+	if i := strings.Index(name, "/"); i > 0 {
+		return name[:i], name[i+1:]
+	}
+	return "", name
+}
+
+// QualifyName qualifies the name of a repo or pipeline with its project, as
+// in "project/name". Names in the default project aren't qualified.
+func QualifyName(project, name string) string {
+	if IsDefaultProject(project) {
+		return name
+	}
+	return project + "/" + name
+}
+
+// QualifiedName returns the repo's name qualified with its project, as in
+// "project/repo". Repos in the default project aren't qualified.
+func (r *Repo) QualifiedName() string {
+	return QualifyName(r.GetProject().GetName(), r.Name)
+}
+
+// ProjectName returns the name of the repo's project.
+func (r *Repo) ProjectName() string {
+	if IsDefaultProject(r.GetProject().GetName()) {
+		return DefaultProjectName
+	}
+	return r.Project.Name
 }
 
 // NewHash returns a hash that PFS uses internally to compute checksums.
@@ -129,6 +129,72 @@ func (WorkerState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_beade573c128ccc7, []int{2}
 }
 
+// QueueOverflowPolicy determines what a pipeline does with new input commits
+// once max_queue_size jobs are outstanding.
+type QueueOverflowPolicy int32
+
+const (
+	// Hold new input commits until a running job finishes, processing every
+	// commit in order.
+	QueueOverflowPolicy_QUEUE_BLOCK QueueOverflowPolicy = 0
+	// Hold only the latest input commit, killing the job for any commit it
+	// replaces.
+	QueueOverflowPolicy_QUEUE_COALESCE QueueOverflowPolicy = 1
+	// Kill the jobs for input commits that arrive while the queue is full.
+	QueueOverflowPolicy_QUEUE_DROP QueueOverflowPolicy = 2
+)
+
+var QueueOverflowPolicy_name = map[int32]string{
+	0: "QUEUE_BLOCK",
+	1: "QUEUE_COALESCE",
+	2: "QUEUE_DROP",
+}
+
+var QueueOverflowPolicy_value = map[string]int32{
+	"QUEUE_BLOCK":    0,
+	"QUEUE_COALESCE": 1,
+	"QUEUE_DROP":     2,
+}
+
+func (x QueueOverflowPolicy) String() string {
+	return proto.EnumName(QueueOverflowPolicy_name, int32(x))
+}
+
+func (QueueOverflowPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{3}
+}
+
+// DatumFailurePolicy determines what a job does when a datum fails after
+// exhausting its datum_tries.
+type DatumFailurePolicy int32
+
+const (
+	// Fail the job.
+	DatumFailurePolicy_FAIL_JOB DatumFailurePolicy = 0
+	// Leave the datum out of the output and let the job succeed. The datum is
+	// recorded as failed in the job's meta commit, and is processed again by
+	// the next job.
+	DatumFailurePolicy_QUARANTINE DatumFailurePolicy = 1
+)
+
+var DatumFailurePolicy_name = map[int32]string{
+	0: "FAIL_JOB",
+	1: "QUARANTINE",
+}
+
+var DatumFailurePolicy_value = map[string]int32{
+	"FAIL_JOB":   0,
+	"QUARANTINE": 1,
+}
+
+func (x DatumFailurePolicy) String() string {
+	return proto.EnumName(DatumFailurePolicy_name, int32(x))
+}
+
+func (DatumFailurePolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{4}
+}
+
 type PipelineState int32
 
 const (
@@ -182,7 +248,7 @@ func (x PipelineState) String() string {
 }
 
 func (PipelineState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{3}
+	return fileDescriptor_beade573c128ccc7, []int{5}
 }
 
 type SecretMount struct {
@@ -259,23 +325,28 @@ func (m *SecretMount) GetEnvVar() string {
 }
 
 type Transform struct {
-	Image                string            `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-	Cmd                  []string          `protobuf:"bytes,2,rep,name=cmd,proto3" json:"cmd,omitempty"`
-	ErrCmd               []string          `protobuf:"bytes,3,rep,name=err_cmd,json=errCmd,proto3" json:"err_cmd,omitempty"`
-	Env                  map[string]string `protobuf:"bytes,4,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Secrets              []*SecretMount    `protobuf:"bytes,5,rep,name=secrets,proto3" json:"secrets,omitempty"`
-	ImagePullSecrets     []string          `protobuf:"bytes,6,rep,name=image_pull_secrets,json=imagePullSecrets,proto3" json:"image_pull_secrets,omitempty"`
-	Stdin                []string          `protobuf:"bytes,7,rep,name=stdin,proto3" json:"stdin,omitempty"`
-	ErrStdin             []string          `protobuf:"bytes,8,rep,name=err_stdin,json=errStdin,proto3" json:"err_stdin,omitempty"`
-	AcceptReturnCode     []int64           `protobuf:"varint,9,rep,packed,name=accept_return_code,json=acceptReturnCode,proto3" json:"accept_return_code,omitempty"`
-	Debug                bool              `protobuf:"varint,10,opt,name=debug,proto3" json:"debug,omitempty"`
-	User                 string            `protobuf:"bytes,11,opt,name=user,proto3" json:"user,omitempty"`
-	WorkingDir           string            `protobuf:"bytes,12,opt,name=working_dir,json=workingDir,proto3" json:"working_dir,omitempty"`
-	Dockerfile           string            `protobuf:"bytes,13,opt,name=dockerfile,proto3" json:"dockerfile,omitempty"`
-	Build                *BuildSpec        `protobuf:"bytes,14,opt,name=build,proto3" json:"build,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Image            string            `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Cmd              []string          `protobuf:"bytes,2,rep,name=cmd,proto3" json:"cmd,omitempty"`
+	ErrCmd           []string          `protobuf:"bytes,3,rep,name=err_cmd,json=errCmd,proto3" json:"err_cmd,omitempty"`
+	Env              map[string]string `protobuf:"bytes,4,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Secrets          []*SecretMount    `protobuf:"bytes,5,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	ImagePullSecrets []string          `protobuf:"bytes,6,rep,name=image_pull_secrets,json=imagePullSecrets,proto3" json:"image_pull_secrets,omitempty"`
+	Stdin            []string          `protobuf:"bytes,7,rep,name=stdin,proto3" json:"stdin,omitempty"`
+	ErrStdin         []string          `protobuf:"bytes,8,rep,name=err_stdin,json=errStdin,proto3" json:"err_stdin,omitempty"`
+	AcceptReturnCode []int64           `protobuf:"varint,9,rep,packed,name=accept_return_code,json=acceptReturnCode,proto3" json:"accept_return_code,omitempty"`
+	Debug            bool              `protobuf:"varint,10,opt,name=debug,proto3" json:"debug,omitempty"`
+	User             string            `protobuf:"bytes,11,opt,name=user,proto3" json:"user,omitempty"`
+	WorkingDir       string            `protobuf:"bytes,12,opt,name=working_dir,json=workingDir,proto3" json:"working_dir,omitempty"`
+	Dockerfile       string            `protobuf:"bytes,13,opt,name=dockerfile,proto3" json:"dockerfile,omitempty"`
+	Build            *BuildSpec        `protobuf:"bytes,14,opt,name=build,proto3" json:"build,omitempty"`
+	// datum_batching runs cmd once per datum set, rather than once per datum,
+	// and hands it the datums one at a time. See the pipeline spec docs for
+	// the protocol.
+	DatumBatching        bool       `protobuf:"varint,15,opt,name=datum_batching,json=datumBatching,proto3" json:"datum_batching,omitempty"`
+	RetrySpec            *RetrySpec `protobuf:"bytes,16,opt,name=retry_spec,json=retrySpec,proto3" json:"retry_spec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *Transform) Reset()         { *m = Transform{} }
@@ -409,6 +480,140 @@ func (m *Transform) GetBuild() *BuildSpec {
 	return nil
 }
 
+func (m *Transform) GetDatumBatching() bool {
+	if m != nil {
+		return m.DatumBatching
+	}
+	return false
+}
+
+func (m *Transform) GetRetrySpec() *RetrySpec {
+	if m != nil {
+		return m.RetrySpec
+	}
+	return nil
+}
+
+// RetrySpec configures how datums that fail are retried. The number of tries
+// is set by the pipeline's datum_tries.
+type RetrySpec struct {
+	// initial_backoff is how long to wait before the first retry of a datum.
+	// Each later retry waits multiplier times longer, up to max_backoff. The
+	// datum is retried immediately if initial_backoff isn't set.
+	InitialBackoff *types.Duration `protobuf:"bytes,1,opt,name=initial_backoff,json=initialBackoff,proto3" json:"initial_backoff,omitempty"`
+	// max_backoff defaults to an hour.
+	MaxBackoff *types.Duration `protobuf:"bytes,2,opt,name=max_backoff,json=maxBackoff,proto3" json:"max_backoff,omitempty"`
+	// multiplier defaults to 2.
+	Multiplier float64 `protobuf:"fixed64,3,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	// jitter randomizes each backoff by up to this fraction of it, between 0
+	// and 1.
+	Jitter float64 `protobuf:"fixed64,4,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	// retry_exit_codes, if set, are the only exit codes of cmd that a datum is
+	// retried for. Failures that aren't an exit of cmd are always retried.
+	RetryExitCodes []int64 `protobuf:"varint,5,rep,packed,name=retry_exit_codes,json=retryExitCodes,proto3" json:"retry_exit_codes,omitempty"`
+	// fail_exit_codes fail a datum without retrying it.
+	FailExitCodes []int64 `protobuf:"varint,6,rep,packed,name=fail_exit_codes,json=failExitCodes,proto3" json:"fail_exit_codes,omitempty"`
+	// skip_exit_codes skip a datum without retrying it. A skipped datum has no
+	// output, doesn't fail the job, and isn't processed again by later jobs.
+	SkipExitCodes []int64 `protobuf:"varint,7,rep,packed,name=skip_exit_codes,json=skipExitCodes,proto3" json:"skip_exit_codes,omitempty"`
+	// job_retry_budget is the maximum number of retries that all of a job's
+	// workers make for its datums, after which failed datums aren't retried. 0
+	// means no limit.
+	JobRetryBudget       int64    `protobuf:"varint,8,opt,name=job_retry_budget,json=jobRetryBudget,proto3" json:"job_retry_budget,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetrySpec) Reset()         { *m = RetrySpec{} }
+func (m *RetrySpec) String() string { return proto.CompactTextString(m) }
+func (*RetrySpec) ProtoMessage()    {}
+func (*RetrySpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{2}
+}
+func (m *RetrySpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetrySpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetrySpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetrySpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetrySpec.Merge(m, src)
+}
+func (m *RetrySpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetrySpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetrySpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetrySpec proto.InternalMessageInfo
+
+func (m *RetrySpec) GetInitialBackoff() *types.Duration {
+	if m != nil {
+		return m.InitialBackoff
+	}
+	return nil
+}
+
+func (m *RetrySpec) GetMaxBackoff() *types.Duration {
+	if m != nil {
+		return m.MaxBackoff
+	}
+	return nil
+}
+
+func (m *RetrySpec) GetMultiplier() float64 {
+	if m != nil {
+		return m.Multiplier
+	}
+	return 0
+}
+
+func (m *RetrySpec) GetJitter() float64 {
+	if m != nil {
+		return m.Jitter
+	}
+	return 0
+}
+
+func (m *RetrySpec) GetRetryExitCodes() []int64 {
+	if m != nil {
+		return m.RetryExitCodes
+	}
+	return nil
+}
+
+func (m *RetrySpec) GetFailExitCodes() []int64 {
+	if m != nil {
+		return m.FailExitCodes
+	}
+	return nil
+}
+
+func (m *RetrySpec) GetSkipExitCodes() []int64 {
+	if m != nil {
+		return m.SkipExitCodes
+	}
+	return nil
+}
+
+func (m *RetrySpec) GetJobRetryBudget() int64 {
+	if m != nil {
+		return m.JobRetryBudget
+	}
+	return 0
+}
+
 type BuildSpec struct {
 	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 	Language             string   `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
@@ -422,7 +627,7 @@ func (m *BuildSpec) Reset()         { *m = BuildSpec{} }
 func (m *BuildSpec) String() string { return proto.CompactTextString(m) }
 func (*BuildSpec) ProtoMessage()    {}
 func (*BuildSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{2}
+	return fileDescriptor_beade573c128ccc7, []int{3}
 }
 func (m *BuildSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -486,7 +691,7 @@ func (m *TFJob) Reset()         { *m = TFJob{} }
 func (m *TFJob) String() string { return proto.CompactTextString(m) }
 func (*TFJob) ProtoMessage()    {}
 func (*TFJob) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{3}
+	return fileDescriptor_beade573c128ccc7, []int{4}
 }
 func (m *TFJob) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -533,7 +738,7 @@ func (m *Egress) Reset()         { *m = Egress{} }
 func (m *Egress) String() string { return proto.CompactTextString(m) }
 func (*Egress) ProtoMessage()    {}
 func (*Egress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{4}
+	return fileDescriptor_beade573c128ccc7, []int{5}
 }
 func (m *Egress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,7 +785,7 @@ func (m *PipelineJob) Reset()         { *m = PipelineJob{} }
 func (m *PipelineJob) String() string { return proto.CompactTextString(m) }
 func (*PipelineJob) ProtoMessage()    {}
 func (*PipelineJob) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{5}
+	return fileDescriptor_beade573c128ccc7, []int{6}
 }
 func (m *PipelineJob) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -628,7 +833,7 @@ func (m *Metadata) Reset()         { *m = Metadata{} }
 func (m *Metadata) String() string { return proto.CompactTextString(m) }
 func (*Metadata) ProtoMessage()    {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{6}
+	return fileDescriptor_beade573c128ccc7, []int{7}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -685,7 +890,7 @@ func (m *Service) Reset()         { *m = Service{} }
 func (m *Service) String() string { return proto.CompactTextString(m) }
 func (*Service) ProtoMessage()    {}
 func (*Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{7}
+	return fileDescriptor_beade573c128ccc7, []int{8}
 }
 func (m *Service) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -753,7 +958,7 @@ func (m *Spout) Reset()         { *m = Spout{} }
 func (m *Spout) String() string { return proto.CompactTextString(m) }
 func (*Spout) ProtoMessage()    {}
 func (*Spout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{8}
+	return fileDescriptor_beade573c128ccc7, []int{9}
 }
 func (m *Spout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -790,7 +995,10 @@ func (m *Spout) GetService() *Service {
 }
 
 type PFSInput struct {
-	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// repo is the name of the input repo, which may be qualified with its
+	// project, e.g. "project/repo". Unqualified repos belong to the pipeline's
+	// project.
 	Repo      string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
 	RepoType  string `protobuf:"bytes,13,opt,name=repo_type,json=repoType,proto3" json:"repo_type,omitempty"`
 	Branch    string `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
@@ -822,7 +1030,7 @@ func (m *PFSInput) Reset()         { *m = PFSInput{} }
 func (m *PFSInput) String() string { return proto.CompactTextString(m) }
 func (*PFSInput) ProtoMessage()    {}
 func (*PFSInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{9}
+	return fileDescriptor_beade573c128ccc7, []int{10}
 }
 func (m *PFSInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -961,7 +1169,7 @@ func (m *CronInput) Reset()         { *m = CronInput{} }
 func (m *CronInput) String() string { return proto.CompactTextString(m) }
 func (*CronInput) ProtoMessage()    {}
 func (*CronInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{10}
+	return fileDescriptor_beade573c128ccc7, []int{11}
 }
 func (m *CronInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1053,7 +1261,7 @@ func (m *GitInput) Reset()         { *m = GitInput{} }
 func (m *GitInput) String() string { return proto.CompactTextString(m) }
 func (*GitInput) ProtoMessage()    {}
 func (*GitInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{11}
+	return fileDescriptor_beade573c128ccc7, []int{12}
 }
 func (m *GitInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1127,7 +1335,7 @@ func (m *Input) Reset()         { *m = Input{} }
 func (m *Input) String() string { return proto.CompactTextString(m) }
 func (*Input) ProtoMessage()    {}
 func (*Input) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{12}
+	return fileDescriptor_beade573c128ccc7, []int{13}
 }
 func (m *Input) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1219,7 +1427,7 @@ func (m *PipelineJobInput) Reset()         { *m = PipelineJobInput{} }
 func (m *PipelineJobInput) String() string { return proto.CompactTextString(m) }
 func (*PipelineJobInput) ProtoMessage()    {}
 func (*PipelineJobInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{13}
+	return fileDescriptor_beade573c128ccc7, []int{14}
 }
 func (m *PipelineJobInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1300,7 +1508,7 @@ func (m *ParallelismSpec) Reset()         { *m = ParallelismSpec{} }
 func (m *ParallelismSpec) String() string { return proto.CompactTextString(m) }
 func (*ParallelismSpec) ProtoMessage()    {}
 func (*ParallelismSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{14}
+	return fileDescriptor_beade573c128ccc7, []int{15}
 }
 func (m *ParallelismSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1357,7 +1565,7 @@ func (m *InputFile) Reset()         { *m = InputFile{} }
 func (m *InputFile) String() string { return proto.CompactTextString(m) }
 func (*InputFile) ProtoMessage()    {}
 func (*InputFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{15}
+	return fileDescriptor_beade573c128ccc7, []int{16}
 }
 func (m *InputFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1413,7 +1621,7 @@ func (m *Datum) Reset()         { *m = Datum{} }
 func (m *Datum) String() string { return proto.CompactTextString(m) }
 func (*Datum) ProtoMessage()    {}
 func (*Datum) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{16}
+	return fileDescriptor_beade573c128ccc7, []int{17}
 }
 func (m *Datum) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1462,6 +1670,7 @@ type DatumInfo struct {
 	Stats                *ProcessStats   `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
 	PfsState             *pfs.File       `protobuf:"bytes,4,opt,name=pfs_state,json=pfsState,proto3" json:"pfs_state,omitempty"`
 	Data                 []*pfs.FileInfo `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty"`
+	Retries              []*DatumRetry   `protobuf:"bytes,6,rep,name=retries,proto3" json:"retries,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
@@ -1471,7 +1680,7 @@ func (m *DatumInfo) Reset()         { *m = DatumInfo{} }
 func (m *DatumInfo) String() string { return proto.CompactTextString(m) }
 func (*DatumInfo) ProtoMessage()    {}
 func (*DatumInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{17}
+	return fileDescriptor_beade573c128ccc7, []int{18}
 }
 func (m *DatumInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1535,6 +1744,88 @@ func (m *DatumInfo) GetData() []*pfs.FileInfo {
 	return nil
 }
 
+func (m *DatumInfo) GetRetries() []*DatumRetry {
+	if m != nil {
+		return m.Retries
+	}
+	return nil
+}
+
+// DatumRetry records a failed attempt to process a datum that was retried.
+type DatumRetry struct {
+	Failed *types.Timestamp `protobuf:"bytes,1,opt,name=failed,proto3" json:"failed,omitempty"`
+	// backoff is how long the worker waited before the retry.
+	Backoff *types.Duration `protobuf:"bytes,2,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	Reason  string          `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// exit_code is the exit code of cmd, or 0 if the attempt failed for another
+	// reason.
+	ExitCode             int64    `protobuf:"varint,4,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DatumRetry) Reset()         { *m = DatumRetry{} }
+func (m *DatumRetry) String() string { return proto.CompactTextString(m) }
+func (*DatumRetry) ProtoMessage()    {}
+func (*DatumRetry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{19}
+}
+func (m *DatumRetry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DatumRetry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DatumRetry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DatumRetry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatumRetry.Merge(m, src)
+}
+func (m *DatumRetry) XXX_Size() int {
+	return m.Size()
+}
+func (m *DatumRetry) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatumRetry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatumRetry proto.InternalMessageInfo
+
+func (m *DatumRetry) GetFailed() *types.Timestamp {
+	if m != nil {
+		return m.Failed
+	}
+	return nil
+}
+
+func (m *DatumRetry) GetBackoff() *types.Duration {
+	if m != nil {
+		return m.Backoff
+	}
+	return nil
+}
+
+func (m *DatumRetry) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *DatumRetry) GetExitCode() int64 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
 type Aggregate struct {
 	Count                 int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 	Mean                  float64  `protobuf:"fixed64,2,opt,name=mean,proto3" json:"mean,omitempty"`
@@ -1550,7 +1841,7 @@ func (m *Aggregate) Reset()         { *m = Aggregate{} }
 func (m *Aggregate) String() string { return proto.CompactTextString(m) }
 func (*Aggregate) ProtoMessage()    {}
 func (*Aggregate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{18}
+	return fileDescriptor_beade573c128ccc7, []int{20}
 }
 func (m *Aggregate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1629,7 +1920,7 @@ func (m *ProcessStats) Reset()         { *m = ProcessStats{} }
 func (m *ProcessStats) String() string { return proto.CompactTextString(m) }
 func (*ProcessStats) ProtoMessage()    {}
 func (*ProcessStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{19}
+	return fileDescriptor_beade573c128ccc7, []int{21}
 }
 func (m *ProcessStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1708,7 +1999,7 @@ func (m *AggregateProcessStats) Reset()         { *m = AggregateProcessStats{} }
 func (m *AggregateProcessStats) String() string { return proto.CompactTextString(m) }
 func (*AggregateProcessStats) ProtoMessage()    {}
 func (*AggregateProcessStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{20}
+	return fileDescriptor_beade573c128ccc7, []int{22}
 }
 func (m *AggregateProcessStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1785,7 +2076,7 @@ func (m *WorkerStatus) Reset()         { *m = WorkerStatus{} }
 func (m *WorkerStatus) String() string { return proto.CompactTextString(m) }
 func (*WorkerStatus) ProtoMessage()    {}
 func (*WorkerStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{21}
+	return fileDescriptor_beade573c128ccc7, []int{23}
 }
 func (m *WorkerStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1848,7 +2139,7 @@ func (m *DatumStatus) Reset()         { *m = DatumStatus{} }
 func (m *DatumStatus) String() string { return proto.CompactTextString(m) }
 func (*DatumStatus) ProtoMessage()    {}
 func (*DatumStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{22}
+	return fileDescriptor_beade573c128ccc7, []int{24}
 }
 func (m *DatumStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1914,7 +2205,7 @@ func (m *ResourceSpec) Reset()         { *m = ResourceSpec{} }
 func (m *ResourceSpec) String() string { return proto.CompactTextString(m) }
 func (*ResourceSpec) ProtoMessage()    {}
 func (*ResourceSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{23}
+	return fileDescriptor_beade573c128ccc7, []int{25}
 }
 func (m *ResourceSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1985,7 +2276,7 @@ func (m *GPUSpec) Reset()         { *m = GPUSpec{} }
 func (m *GPUSpec) String() string { return proto.CompactTextString(m) }
 func (*GPUSpec) ProtoMessage()    {}
 func (*GPUSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{24}
+	return fileDescriptor_beade573c128ccc7, []int{26}
 }
 func (m *GPUSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2044,22 +2335,27 @@ type StoredPipelineJobInfo struct {
 	DataFailed    int64 `protobuf:"varint,8,opt,name=data_failed,json=dataFailed,proto3" json:"data_failed,omitempty"`
 	DataRecovered int64 `protobuf:"varint,9,opt,name=data_recovered,json=dataRecovered,proto3" json:"data_recovered,omitempty"`
 	// Download/process/upload time and download/upload bytes
-	Stats                *ProcessStats    `protobuf:"bytes,10,opt,name=stats,proto3" json:"stats,omitempty"`
-	StatsCommit          *pfs.Commit      `protobuf:"bytes,11,opt,name=stats_commit,json=statsCommit,proto3" json:"stats_commit,omitempty"`
-	State                PipelineJobState `protobuf:"varint,12,opt,name=state,proto3,enum=pps.PipelineJobState" json:"state,omitempty"`
-	Reason               string           `protobuf:"bytes,13,opt,name=reason,proto3" json:"reason,omitempty"`
-	Started              *types.Timestamp `protobuf:"bytes,14,opt,name=started,proto3" json:"started,omitempty"`
-	Finished             *types.Timestamp `protobuf:"bytes,15,opt,name=finished,proto3" json:"finished,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Stats           *ProcessStats    `protobuf:"bytes,10,opt,name=stats,proto3" json:"stats,omitempty"`
+	StatsCommit     *pfs.Commit      `protobuf:"bytes,11,opt,name=stats_commit,json=statsCommit,proto3" json:"stats_commit,omitempty"`
+	State           PipelineJobState `protobuf:"varint,12,opt,name=state,proto3,enum=pps.PipelineJobState" json:"state,omitempty"`
+	Reason          string           `protobuf:"bytes,13,opt,name=reason,proto3" json:"reason,omitempty"`
+	Started         *types.Timestamp `protobuf:"bytes,14,opt,name=started,proto3" json:"started,omitempty"`
+	Finished        *types.Timestamp `protobuf:"bytes,15,opt,name=finished,proto3" json:"finished,omitempty"`
+	DataQuarantined int64            `protobuf:"varint,16,opt,name=data_quarantined,json=dataQuarantined,proto3" json:"data_quarantined,omitempty"`
+	// datum_retries counts the retries taken from the job's retry budget by
+	// all of its workers.
+	DatumRetries          int64    `protobuf:"varint,17,opt,name=datum_retries,json=datumRetries,proto3" json:"datum_retries,omitempty"`
+	DataSkippedByExitCode int64    `protobuf:"varint,18,opt,name=data_skipped_by_exit_code,json=dataSkippedByExitCode,proto3" json:"data_skipped_by_exit_code,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
 func (m *StoredPipelineJobInfo) Reset()         { *m = StoredPipelineJobInfo{} }
 func (m *StoredPipelineJobInfo) String() string { return proto.CompactTextString(m) }
 func (*StoredPipelineJobInfo) ProtoMessage()    {}
 func (*StoredPipelineJobInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{25}
+	return fileDescriptor_beade573c128ccc7, []int{27}
 }
 func (m *StoredPipelineJobInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2193,6 +2489,27 @@ func (m *StoredPipelineJobInfo) GetFinished() *types.Timestamp {
 	return nil
 }
 
+func (m *StoredPipelineJobInfo) GetDataQuarantined() int64 {
+	if m != nil {
+		return m.DataQuarantined
+	}
+	return 0
+}
+
+func (m *StoredPipelineJobInfo) GetDatumRetries() int64 {
+	if m != nil {
+		return m.DatumRetries
+	}
+	return 0
+}
+
+func (m *StoredPipelineJobInfo) GetDataSkippedByExitCode() int64 {
+	if m != nil {
+		return m.DataSkippedByExitCode
+	}
+	return 0
+}
+
 type PipelineJobInfo struct {
 	PipelineJob           *PipelineJob     `protobuf:"bytes,1,opt,name=pipeline_job,json=pipelineJob,proto3" json:"pipeline_job,omitempty"`
 	Transform             *Transform       `protobuf:"bytes,2,opt,name=transform,proto3" json:"transform,omitempty"`
@@ -2234,16 +2551,25 @@ type PipelineJobInfo struct {
 	SchedulingSpec        *SchedulingSpec  `protobuf:"bytes,38,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
 	PodSpec               string           `protobuf:"bytes,39,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
 	PodPatch              string           `protobuf:"bytes,40,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}         `json:"-"`
-	XXX_unrecognized      []byte           `json:"-"`
-	XXX_sizecache         int32            `json:"-"`
+	// data_quarantined counts the datums that failed, but were left out of the
+	// output rather than failing the job, due to the pipeline's
+	// datum_failure_policy.
+	DataQuarantined int64 `protobuf:"varint,41,opt,name=data_quarantined,json=dataQuarantined,proto3" json:"data_quarantined,omitempty"`
+	// data_skipped_by_exit_code counts the datums that were skipped because
+	// cmd exited with one of the retry spec's skip_exit_codes. They're not
+	// counted in data_skipped, which counts the datums that were processed by
+	// an earlier job.
+	DataSkippedByExitCode int64    `protobuf:"varint,42,opt,name=data_skipped_by_exit_code,json=dataSkippedByExitCode,proto3" json:"data_skipped_by_exit_code,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
 func (m *PipelineJobInfo) Reset()         { *m = PipelineJobInfo{} }
 func (m *PipelineJobInfo) String() string { return proto.CompactTextString(m) }
 func (*PipelineJobInfo) ProtoMessage()    {}
 func (*PipelineJobInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{26}
+	return fileDescriptor_beade573c128ccc7, []int{28}
 }
 func (m *PipelineJobInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2552,6 +2878,20 @@ func (m *PipelineJobInfo) GetPodPatch() string {
 	return ""
 }
 
+func (m *PipelineJobInfo) GetDataQuarantined() int64 {
+	if m != nil {
+		return m.DataQuarantined
+	}
+	return 0
+}
+
+func (m *PipelineJobInfo) GetDataSkippedByExitCode() int64 {
+	if m != nil {
+		return m.DataSkippedByExitCode
+	}
+	return 0
+}
+
 type Worker struct {
 	Name                 string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	State                WorkerState `protobuf:"varint,2,opt,name=state,proto3,enum=pps.WorkerState" json:"state,omitempty"`
@@ -2564,7 +2904,7 @@ func (m *Worker) Reset()         { *m = Worker{} }
 func (m *Worker) String() string { return proto.CompactTextString(m) }
 func (*Worker) ProtoMessage()    {}
 func (*Worker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{27}
+	return fileDescriptor_beade573c128ccc7, []int{29}
 }
 func (m *Worker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2608,17 +2948,21 @@ func (m *Worker) GetState() WorkerState {
 }
 
 type Pipeline struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
+	// name is unique within the pipeline's project. Pipelines in different
+	// projects may share a name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// project is the project that the pipeline and its output repo belong to.
+	Project              *pfs.Project `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
 func (m *Pipeline) Reset()         { *m = Pipeline{} }
 func (m *Pipeline) String() string { return proto.CompactTextString(m) }
 func (*Pipeline) ProtoMessage()    {}
 func (*Pipeline) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{28}
+	return fileDescriptor_beade573c128ccc7, []int{30}
 }
 func (m *Pipeline) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2654,6 +2998,89 @@ func (m *Pipeline) GetName() string {
 	return ""
 }
 
+func (m *Pipeline) GetProject() *pfs.Project {
+	if m != nil {
+		return m.Project
+	}
+	return nil
+}
+
+// PipelineQueueState reports the state of a pipeline's job queue.
+type PipelineQueueState struct {
+	// running_jobs is the number of jobs currently outstanding.
+	RunningJobs int64 `protobuf:"varint,1,opt,name=running_jobs,json=runningJobs,proto3" json:"running_jobs,omitempty"`
+	// held_commits is the number of input commits waiting for a free slot.
+	HeldCommits int64 `protobuf:"varint,2,opt,name=held_commits,json=heldCommits,proto3" json:"held_commits,omitempty"`
+	// coalesced_commits and dropped_commits count the input commits that were
+	// skipped because the queue was full.
+	CoalescedCommits     int64    `protobuf:"varint,3,opt,name=coalesced_commits,json=coalescedCommits,proto3" json:"coalesced_commits,omitempty"`
+	DroppedCommits       int64    `protobuf:"varint,4,opt,name=dropped_commits,json=droppedCommits,proto3" json:"dropped_commits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PipelineQueueState) Reset()         { *m = PipelineQueueState{} }
+func (m *PipelineQueueState) String() string { return proto.CompactTextString(m) }
+func (*PipelineQueueState) ProtoMessage()    {}
+func (*PipelineQueueState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{31}
+}
+func (m *PipelineQueueState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PipelineQueueState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PipelineQueueState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PipelineQueueState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PipelineQueueState.Merge(m, src)
+}
+func (m *PipelineQueueState) XXX_Size() int {
+	return m.Size()
+}
+func (m *PipelineQueueState) XXX_DiscardUnknown() {
+	xxx_messageInfo_PipelineQueueState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PipelineQueueState proto.InternalMessageInfo
+
+func (m *PipelineQueueState) GetRunningJobs() int64 {
+	if m != nil {
+		return m.RunningJobs
+	}
+	return 0
+}
+
+func (m *PipelineQueueState) GetHeldCommits() int64 {
+	if m != nil {
+		return m.HeldCommits
+	}
+	return 0
+}
+
+func (m *PipelineQueueState) GetCoalescedCommits() int64 {
+	if m != nil {
+		return m.CoalescedCommits
+	}
+	return 0
+}
+
+func (m *PipelineQueueState) GetDroppedCommits() int64 {
+	if m != nil {
+		return m.DroppedCommits
+	}
+	return 0
+}
+
 // StoredPipelineInfo is proto for each pipeline that Pachd stores in the
 // database. It tracks the state of the pipeline, and points to its metadata in
 // PFS (and, by pointing to a PFS commit, de facto tracks the pipeline's
@@ -2672,18 +3099,19 @@ type StoredPipelineInfo struct {
 	// pachd). This allows the worker master to shard work correctly without
 	// k8s privileges and without knowing the number of cluster nodes in the
 	// Coefficient case.
-	Parallelism          uint64    `protobuf:"varint,7,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
-	Pipeline             *Pipeline `protobuf:"bytes,8,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Parallelism          uint64              `protobuf:"varint,7,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	Pipeline             *Pipeline           `protobuf:"bytes,8,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	QueueState           *PipelineQueueState `protobuf:"bytes,9,opt,name=queue_state,json=queueState,proto3" json:"queue_state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *StoredPipelineInfo) Reset()         { *m = StoredPipelineInfo{} }
 func (m *StoredPipelineInfo) String() string { return proto.CompactTextString(m) }
 func (*StoredPipelineInfo) ProtoMessage()    {}
 func (*StoredPipelineInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{29}
+	return fileDescriptor_beade573c128ccc7, []int{32}
 }
 func (m *StoredPipelineInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2768,6 +3196,13 @@ func (m *StoredPipelineInfo) GetPipeline() *Pipeline {
 	return nil
 }
 
+func (m *StoredPipelineInfo) GetQueueState() *PipelineQueueState {
+	if m != nil {
+		return m.QueueState
+	}
+	return nil
+}
+
 type PipelineInfo struct {
 	Pipeline  *Pipeline  `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
 	Version   uint64     `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
@@ -2804,33 +3239,40 @@ type PipelineInfo struct {
 	EnableStats           bool             `protobuf:"varint,22,opt,name=enable_stats,json=enableStats,proto3" json:"enable_stats,omitempty"`
 	Salt                  string           `protobuf:"bytes,23,opt,name=salt,proto3" json:"salt,omitempty"`
 	// reason includes any error messages associated with a failed pipeline
-	Reason               string          `protobuf:"bytes,24,opt,name=reason,proto3" json:"reason,omitempty"`
-	MaxQueueSize         int64           `protobuf:"varint,25,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty"`
-	Service              *Service        `protobuf:"bytes,26,opt,name=service,proto3" json:"service,omitempty"`
-	Spout                *Spout          `protobuf:"bytes,27,opt,name=spout,proto3" json:"spout,omitempty"`
-	ChunkSpec            *ChunkSpec      `protobuf:"bytes,28,opt,name=chunk_spec,json=chunkSpec,proto3" json:"chunk_spec,omitempty"`
-	DatumTimeout         *types.Duration `protobuf:"bytes,29,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
-	JobTimeout           *types.Duration `protobuf:"bytes,30,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
-	GithookURL           string          `protobuf:"bytes,31,opt,name=githook_url,json=githookUrl,proto3" json:"githook_url,omitempty"`
-	SpecCommit           *pfs.Commit     `protobuf:"bytes,32,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
-	Standby              bool            `protobuf:"varint,33,opt,name=standby,proto3" json:"standby,omitempty"`
-	DatumTries           int64           `protobuf:"varint,34,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
-	SchedulingSpec       *SchedulingSpec `protobuf:"bytes,35,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
-	PodSpec              string          `protobuf:"bytes,36,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
-	PodPatch             string          `protobuf:"bytes,37,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
-	S3Out                bool            `protobuf:"varint,38,opt,name=s3_out,json=s3Out,proto3" json:"s3_out,omitempty"`
-	Metadata             *Metadata       `protobuf:"bytes,39,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	ReprocessSpec        string          `protobuf:"bytes,40,opt,name=reprocess_spec,json=reprocessSpec,proto3" json:"reprocess_spec,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Reason              string              `protobuf:"bytes,24,opt,name=reason,proto3" json:"reason,omitempty"`
+	MaxQueueSize        int64               `protobuf:"varint,25,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty"`
+	Service             *Service            `protobuf:"bytes,26,opt,name=service,proto3" json:"service,omitempty"`
+	Spout               *Spout              `protobuf:"bytes,27,opt,name=spout,proto3" json:"spout,omitempty"`
+	ChunkSpec           *ChunkSpec          `protobuf:"bytes,28,opt,name=chunk_spec,json=chunkSpec,proto3" json:"chunk_spec,omitempty"`
+	DatumTimeout        *types.Duration     `protobuf:"bytes,29,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
+	JobTimeout          *types.Duration     `protobuf:"bytes,30,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
+	GithookURL          string              `protobuf:"bytes,31,opt,name=githook_url,json=githookUrl,proto3" json:"githook_url,omitempty"`
+	SpecCommit          *pfs.Commit         `protobuf:"bytes,32,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
+	Standby             bool                `protobuf:"varint,33,opt,name=standby,proto3" json:"standby,omitempty"`
+	DatumTries          int64               `protobuf:"varint,34,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	SchedulingSpec      *SchedulingSpec     `protobuf:"bytes,35,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
+	PodSpec             string              `protobuf:"bytes,36,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
+	PodPatch            string              `protobuf:"bytes,37,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
+	S3Out               bool                `protobuf:"varint,38,opt,name=s3_out,json=s3Out,proto3" json:"s3_out,omitempty"`
+	Metadata            *Metadata           `protobuf:"bytes,39,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ReprocessSpec       string              `protobuf:"bytes,40,opt,name=reprocess_spec,json=reprocessSpec,proto3" json:"reprocess_spec,omitempty"`
+	QueueOverflowPolicy QueueOverflowPolicy `protobuf:"varint,41,opt,name=queue_overflow_policy,json=queueOverflowPolicy,proto3,enum=pps.QueueOverflowPolicy" json:"queue_overflow_policy,omitempty"`
+	QueueState          *PipelineQueueState `protobuf:"bytes,42,opt,name=queue_state,json=queueState,proto3" json:"queue_state,omitempty"`
+	DatumFailurePolicy  DatumFailurePolicy  `protobuf:"varint,43,opt,name=datum_failure_policy,json=datumFailurePolicy,proto3,enum=pps.DatumFailurePolicy" json:"datum_failure_policy,omitempty"`
+	// max_queue_size_enforced is set for pipelines created or updated once
+	// max_queue_size was enforced. Older pipelines stored a max_queue_size of 1
+	// by default, so it's ignored for them.
+	MaxQueueSizeEnforced bool     `protobuf:"varint,44,opt,name=max_queue_size_enforced,json=maxQueueSizeEnforced,proto3" json:"max_queue_size_enforced,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *PipelineInfo) Reset()         { *m = PipelineInfo{} }
 func (m *PipelineInfo) String() string { return proto.CompactTextString(m) }
 func (*PipelineInfo) ProtoMessage()    {}
 func (*PipelineInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{30}
+	return fileDescriptor_beade573c128ccc7, []int{33}
 }
 func (m *PipelineInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3139,6 +3581,34 @@ func (m *PipelineInfo) GetReprocessSpec() string {
 	return ""
 }
 
+func (m *PipelineInfo) GetQueueOverflowPolicy() QueueOverflowPolicy {
+	if m != nil {
+		return m.QueueOverflowPolicy
+	}
+	return QueueOverflowPolicy_QUEUE_BLOCK
+}
+
+func (m *PipelineInfo) GetQueueState() *PipelineQueueState {
+	if m != nil {
+		return m.QueueState
+	}
+	return nil
+}
+
+func (m *PipelineInfo) GetDatumFailurePolicy() DatumFailurePolicy {
+	if m != nil {
+		return m.DatumFailurePolicy
+	}
+	return DatumFailurePolicy_FAIL_JOB
+}
+
+func (m *PipelineInfo) GetMaxQueueSizeEnforced() bool {
+	if m != nil {
+		return m.MaxQueueSizeEnforced
+	}
+	return false
+}
+
 type PipelineInfos struct {
 	PipelineInfo         []*PipelineInfo `protobuf:"bytes,1,rep,name=pipeline_info,json=pipelineInfo,proto3" json:"pipeline_info,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -3150,7 +3620,7 @@ func (m *PipelineInfos) Reset()         { *m = PipelineInfos{} }
 func (m *PipelineInfos) String() string { return proto.CompactTextString(m) }
 func (*PipelineInfos) ProtoMessage()    {}
 func (*PipelineInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{31}
+	return fileDescriptor_beade573c128ccc7, []int{34}
 }
 func (m *PipelineInfos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3204,6 +3674,7 @@ type CreatePipelineJobRequest struct {
 	Reason               string           `protobuf:"bytes,12,opt,name=reason,proto3" json:"reason,omitempty"`
 	Started              *types.Timestamp `protobuf:"bytes,13,opt,name=started,proto3" json:"started,omitempty"`
 	Finished             *types.Timestamp `protobuf:"bytes,14,opt,name=finished,proto3" json:"finished,omitempty"`
+	DataQuarantined      int64            `protobuf:"varint,15,opt,name=data_quarantined,json=dataQuarantined,proto3" json:"data_quarantined,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -3213,7 +3684,7 @@ func (m *CreatePipelineJobRequest) Reset()         { *m = CreatePipelineJobReque
 func (m *CreatePipelineJobRequest) String() string { return proto.CompactTextString(m) }
 func (*CreatePipelineJobRequest) ProtoMessage()    {}
 func (*CreatePipelineJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{32}
+	return fileDescriptor_beade573c128ccc7, []int{35}
 }
 func (m *CreatePipelineJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3340,6 +3811,13 @@ func (m *CreatePipelineJobRequest) GetFinished() *types.Timestamp {
 	return nil
 }
 
+func (m *CreatePipelineJobRequest) GetDataQuarantined() int64 {
+	if m != nil {
+		return m.DataQuarantined
+	}
+	return 0
+}
+
 type InspectPipelineJobRequest struct {
 	// Callers should set either PipelineJob or OutputCommit, not both.
 	PipelineJob          *PipelineJob `protobuf:"bytes,1,opt,name=pipeline_job,json=pipelineJob,proto3" json:"pipeline_job,omitempty"`
@@ -3355,7 +3833,7 @@ func (m *InspectPipelineJobRequest) Reset()         { *m = InspectPipelineJobReq
 func (m *InspectPipelineJobRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectPipelineJobRequest) ProtoMessage()    {}
 func (*InspectPipelineJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{33}
+	return fileDescriptor_beade573c128ccc7, []int{36}
 }
 func (m *InspectPipelineJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3431,7 +3909,15 @@ type ListPipelineJobRequest struct {
 	// Note that if 'input_commit' is set, this field is coerced to "true"
 	Full bool `protobuf:"varint,5,opt,name=full,proto3" json:"full,omitempty"`
 	// A jq program string for additional result filtering
-	JqFilter             string   `protobuf:"bytes,6,opt,name=jqFilter,proto3" json:"jqFilter,omitempty"`
+	JqFilter string `protobuf:"bytes,6,opt,name=jqFilter,proto3" json:"jqFilter,omitempty"`
+	// page_size is the maximum number of jobs returned, 0 means no limit. If
+	// there may be more jobs, the next page's token is returned in the
+	// "pach-next-page-token" response trailer.
+	PageSize int64 `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is the next page token returned by a previous call, it
+	// continues the listing after the last of the jobs that call returned.
+	// Jobs are listed newest first by creation time, with or without pages.
+	PageToken            string   `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3441,7 +3927,7 @@ func (m *ListPipelineJobRequest) Reset()         { *m = ListPipelineJobRequest{}
 func (m *ListPipelineJobRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPipelineJobRequest) ProtoMessage()    {}
 func (*ListPipelineJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{34}
+	return fileDescriptor_beade573c128ccc7, []int{37}
 }
 func (m *ListPipelineJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3512,6 +3998,20 @@ func (m *ListPipelineJobRequest) GetJqFilter() string {
 	return ""
 }
 
+func (m *ListPipelineJobRequest) GetPageSize() int64 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListPipelineJobRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
 type FlushPipelineJobRequest struct {
 	Commits              []*pfs.Commit `protobuf:"bytes,1,rep,name=commits,proto3" json:"commits,omitempty"`
 	ToPipelines          []*Pipeline   `protobuf:"bytes,2,rep,name=to_pipelines,json=toPipelines,proto3" json:"to_pipelines,omitempty"`
@@ -3524,7 +4024,7 @@ func (m *FlushPipelineJobRequest) Reset()         { *m = FlushPipelineJobRequest
 func (m *FlushPipelineJobRequest) String() string { return proto.CompactTextString(m) }
 func (*FlushPipelineJobRequest) ProtoMessage()    {}
 func (*FlushPipelineJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{35}
+	return fileDescriptor_beade573c128ccc7, []int{38}
 }
 func (m *FlushPipelineJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3578,7 +4078,7 @@ func (m *DeletePipelineJobRequest) Reset()         { *m = DeletePipelineJobReque
 func (m *DeletePipelineJobRequest) String() string { return proto.CompactTextString(m) }
 func (*DeletePipelineJobRequest) ProtoMessage()    {}
 func (*DeletePipelineJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{36}
+	return fileDescriptor_beade573c128ccc7, []int{39}
 }
 func (m *DeletePipelineJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3627,7 +4127,7 @@ func (m *StopPipelineJobRequest) Reset()         { *m = StopPipelineJobRequest{}
 func (m *StopPipelineJobRequest) String() string { return proto.CompactTextString(m) }
 func (*StopPipelineJobRequest) ProtoMessage()    {}
 func (*StopPipelineJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{37}
+	return fileDescriptor_beade573c128ccc7, []int{40}
 }
 func (m *StopPipelineJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3678,26 +4178,28 @@ func (m *StopPipelineJobRequest) GetReason() string {
 }
 
 type UpdatePipelineJobStateRequest struct {
-	PipelineJob          *PipelineJob     `protobuf:"bytes,1,opt,name=pipeline_job,json=pipelineJob,proto3" json:"pipeline_job,omitempty"`
-	State                PipelineJobState `protobuf:"varint,2,opt,name=state,proto3,enum=pps.PipelineJobState" json:"state,omitempty"`
-	Reason               string           `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	Restart              uint64           `protobuf:"varint,4,opt,name=restart,proto3" json:"restart,omitempty"`
-	DataProcessed        int64            `protobuf:"varint,5,opt,name=data_processed,json=dataProcessed,proto3" json:"data_processed,omitempty"`
-	DataSkipped          int64            `protobuf:"varint,6,opt,name=data_skipped,json=dataSkipped,proto3" json:"data_skipped,omitempty"`
-	DataFailed           int64            `protobuf:"varint,7,opt,name=data_failed,json=dataFailed,proto3" json:"data_failed,omitempty"`
-	DataRecovered        int64            `protobuf:"varint,8,opt,name=data_recovered,json=dataRecovered,proto3" json:"data_recovered,omitempty"`
-	DataTotal            int64            `protobuf:"varint,9,opt,name=data_total,json=dataTotal,proto3" json:"data_total,omitempty"`
-	Stats                *ProcessStats    `protobuf:"bytes,10,opt,name=stats,proto3" json:"stats,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	PipelineJob           *PipelineJob     `protobuf:"bytes,1,opt,name=pipeline_job,json=pipelineJob,proto3" json:"pipeline_job,omitempty"`
+	State                 PipelineJobState `protobuf:"varint,2,opt,name=state,proto3,enum=pps.PipelineJobState" json:"state,omitempty"`
+	Reason                string           `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Restart               uint64           `protobuf:"varint,4,opt,name=restart,proto3" json:"restart,omitempty"`
+	DataProcessed         int64            `protobuf:"varint,5,opt,name=data_processed,json=dataProcessed,proto3" json:"data_processed,omitempty"`
+	DataSkipped           int64            `protobuf:"varint,6,opt,name=data_skipped,json=dataSkipped,proto3" json:"data_skipped,omitempty"`
+	DataFailed            int64            `protobuf:"varint,7,opt,name=data_failed,json=dataFailed,proto3" json:"data_failed,omitempty"`
+	DataRecovered         int64            `protobuf:"varint,8,opt,name=data_recovered,json=dataRecovered,proto3" json:"data_recovered,omitempty"`
+	DataTotal             int64            `protobuf:"varint,9,opt,name=data_total,json=dataTotal,proto3" json:"data_total,omitempty"`
+	Stats                 *ProcessStats    `protobuf:"bytes,10,opt,name=stats,proto3" json:"stats,omitempty"`
+	DataQuarantined       int64            `protobuf:"varint,11,opt,name=data_quarantined,json=dataQuarantined,proto3" json:"data_quarantined,omitempty"`
+	DataSkippedByExitCode int64            `protobuf:"varint,12,opt,name=data_skipped_by_exit_code,json=dataSkippedByExitCode,proto3" json:"data_skipped_by_exit_code,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}         `json:"-"`
+	XXX_unrecognized      []byte           `json:"-"`
+	XXX_sizecache         int32            `json:"-"`
 }
 
 func (m *UpdatePipelineJobStateRequest) Reset()         { *m = UpdatePipelineJobStateRequest{} }
 func (m *UpdatePipelineJobStateRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdatePipelineJobStateRequest) ProtoMessage()    {}
 func (*UpdatePipelineJobStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{38}
+	return fileDescriptor_beade573c128ccc7, []int{41}
 }
 func (m *UpdatePipelineJobStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3796,6 +4298,20 @@ func (m *UpdatePipelineJobStateRequest) GetStats() *ProcessStats {
 	return nil
 }
 
+func (m *UpdatePipelineJobStateRequest) GetDataQuarantined() int64 {
+	if m != nil {
+		return m.DataQuarantined
+	}
+	return 0
+}
+
+func (m *UpdatePipelineJobStateRequest) GetDataSkippedByExitCode() int64 {
+	if m != nil {
+		return m.DataSkippedByExitCode
+	}
+	return 0
+}
+
 type GetLogsRequest struct {
 	// The pipeline from which we want to get logs (required if the job in 'job'
 	// was created as part of a pipeline. To get logs from a non-orphan job
@@ -3832,7 +4348,7 @@ func (m *GetLogsRequest) Reset()         { *m = GetLogsRequest{} }
 func (m *GetLogsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetLogsRequest) ProtoMessage()    {}
 func (*GetLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{39}
+	return fileDescriptor_beade573c128ccc7, []int{42}
 }
 func (m *GetLogsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3950,7 +4466,7 @@ func (m *LogMessage) Reset()         { *m = LogMessage{} }
 func (m *LogMessage) String() string { return proto.CompactTextString(m) }
 func (*LogMessage) ProtoMessage()    {}
 func (*LogMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{40}
+	return fileDescriptor_beade573c128ccc7, []int{43}
 }
 func (m *LogMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4054,7 +4570,7 @@ func (m *RestartDatumRequest) Reset()         { *m = RestartDatumRequest{} }
 func (m *RestartDatumRequest) String() string { return proto.CompactTextString(m) }
 func (*RestartDatumRequest) ProtoMessage()    {}
 func (*RestartDatumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{41}
+	return fileDescriptor_beade573c128ccc7, []int{44}
 }
 func (m *RestartDatumRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4108,7 +4624,7 @@ func (m *InspectDatumRequest) Reset()         { *m = InspectDatumRequest{} }
 func (m *InspectDatumRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectDatumRequest) ProtoMessage()    {}
 func (*InspectDatumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{42}
+	return fileDescriptor_beade573c128ccc7, []int{45}
 }
 func (m *InspectDatumRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4152,7 +4668,17 @@ type ListDatumRequest struct {
 	// Input is the input to list datums from.
 	// The datums listed are the ones that would be run if a pipeline was created
 	// with the provided input.
-	Input                *Input   `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	Input *Input `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	// page_size is the maximum number of datums returned, 0 means no limit. If
+	// there may be more datums, the next page's token is returned in the
+	// "pach-next-page-token" response trailer.
+	PageSize int64 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is the next page token returned by a previous call, it
+	// continues the listing after the last of the datums that call returned.
+	// Datums are listed in datum ID order. The first page of an input's datums
+	// snapshots them, and its tokens expire if the next page isn't requested
+	// within about 10 minutes.
+	PageToken            string   `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4162,7 +4688,7 @@ func (m *ListDatumRequest) Reset()         { *m = ListDatumRequest{} }
 func (m *ListDatumRequest) String() string { return proto.CompactTextString(m) }
 func (*ListDatumRequest) ProtoMessage()    {}
 func (*ListDatumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{43}
+	return fileDescriptor_beade573c128ccc7, []int{46}
 }
 func (m *ListDatumRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4205,6 +4731,20 @@ func (m *ListDatumRequest) GetInput() *Input {
 	return nil
 }
 
+func (m *ListDatumRequest) GetPageSize() int64 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListDatumRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
 // ChunkSpec specifies how a pipeline should chunk its datums.
 type ChunkSpec struct {
 	// number, if nonzero, specifies that each chunk should contain `number`
@@ -4224,7 +4764,7 @@ func (m *ChunkSpec) Reset()         { *m = ChunkSpec{} }
 func (m *ChunkSpec) String() string { return proto.CompactTextString(m) }
 func (*ChunkSpec) ProtoMessage()    {}
 func (*ChunkSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{44}
+	return fileDescriptor_beade573c128ccc7, []int{47}
 }
 func (m *ChunkSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4279,7 +4819,7 @@ func (m *SchedulingSpec) Reset()         { *m = SchedulingSpec{} }
 func (m *SchedulingSpec) String() string { return proto.CompactTextString(m) }
 func (*SchedulingSpec) ProtoMessage()    {}
 func (*SchedulingSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{45}
+	return fileDescriptor_beade573c128ccc7, []int{48}
 }
 func (m *SchedulingSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4348,32 +4888,34 @@ type CreatePipelineRequest struct {
 	EnableStats           bool          `protobuf:"varint,15,opt,name=enable_stats,json=enableStats,proto3" json:"enable_stats,omitempty"`
 	// Reprocess forces the pipeline to reprocess all datums.
 	// It only has meaning if Update is true
-	Reprocess            bool            `protobuf:"varint,16,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
-	MaxQueueSize         int64           `protobuf:"varint,17,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty"`
-	Service              *Service        `protobuf:"bytes,18,opt,name=service,proto3" json:"service,omitempty"`
-	Spout                *Spout          `protobuf:"bytes,19,opt,name=spout,proto3" json:"spout,omitempty"`
-	ChunkSpec            *ChunkSpec      `protobuf:"bytes,20,opt,name=chunk_spec,json=chunkSpec,proto3" json:"chunk_spec,omitempty"`
-	DatumTimeout         *types.Duration `protobuf:"bytes,21,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
-	JobTimeout           *types.Duration `protobuf:"bytes,22,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
-	Salt                 string          `protobuf:"bytes,23,opt,name=salt,proto3" json:"salt,omitempty"`
-	Standby              bool            `protobuf:"varint,24,opt,name=standby,proto3" json:"standby,omitempty"`
-	DatumTries           int64           `protobuf:"varint,25,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
-	SchedulingSpec       *SchedulingSpec `protobuf:"bytes,26,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
-	PodSpec              string          `protobuf:"bytes,27,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
-	PodPatch             string          `protobuf:"bytes,28,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
-	SpecCommit           *pfs.Commit     `protobuf:"bytes,29,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
-	Metadata             *Metadata       `protobuf:"bytes,30,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	ReprocessSpec        string          `protobuf:"bytes,31,opt,name=reprocess_spec,json=reprocessSpec,proto3" json:"reprocess_spec,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Reprocess            bool                `protobuf:"varint,16,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
+	MaxQueueSize         int64               `protobuf:"varint,17,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty"`
+	Service              *Service            `protobuf:"bytes,18,opt,name=service,proto3" json:"service,omitempty"`
+	Spout                *Spout              `protobuf:"bytes,19,opt,name=spout,proto3" json:"spout,omitempty"`
+	ChunkSpec            *ChunkSpec          `protobuf:"bytes,20,opt,name=chunk_spec,json=chunkSpec,proto3" json:"chunk_spec,omitempty"`
+	DatumTimeout         *types.Duration     `protobuf:"bytes,21,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
+	JobTimeout           *types.Duration     `protobuf:"bytes,22,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
+	Salt                 string              `protobuf:"bytes,23,opt,name=salt,proto3" json:"salt,omitempty"`
+	Standby              bool                `protobuf:"varint,24,opt,name=standby,proto3" json:"standby,omitempty"`
+	DatumTries           int64               `protobuf:"varint,25,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	SchedulingSpec       *SchedulingSpec     `protobuf:"bytes,26,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
+	PodSpec              string              `protobuf:"bytes,27,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
+	PodPatch             string              `protobuf:"bytes,28,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
+	SpecCommit           *pfs.Commit         `protobuf:"bytes,29,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
+	Metadata             *Metadata           `protobuf:"bytes,30,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ReprocessSpec        string              `protobuf:"bytes,31,opt,name=reprocess_spec,json=reprocessSpec,proto3" json:"reprocess_spec,omitempty"`
+	QueueOverflowPolicy  QueueOverflowPolicy `protobuf:"varint,32,opt,name=queue_overflow_policy,json=queueOverflowPolicy,proto3,enum=pps.QueueOverflowPolicy" json:"queue_overflow_policy,omitempty"`
+	DatumFailurePolicy   DatumFailurePolicy  `protobuf:"varint,33,opt,name=datum_failure_policy,json=datumFailurePolicy,proto3,enum=pps.DatumFailurePolicy" json:"datum_failure_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *CreatePipelineRequest) Reset()         { *m = CreatePipelineRequest{} }
 func (m *CreatePipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*CreatePipelineRequest) ProtoMessage()    {}
 func (*CreatePipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{46}
+	return fileDescriptor_beade573c128ccc7, []int{49}
 }
 func (m *CreatePipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4619,6 +5161,20 @@ func (m *CreatePipelineRequest) GetReprocessSpec() string {
 	return ""
 }
 
+func (m *CreatePipelineRequest) GetQueueOverflowPolicy() QueueOverflowPolicy {
+	if m != nil {
+		return m.QueueOverflowPolicy
+	}
+	return QueueOverflowPolicy_QUEUE_BLOCK
+}
+
+func (m *CreatePipelineRequest) GetDatumFailurePolicy() DatumFailurePolicy {
+	if m != nil {
+		return m.DatumFailurePolicy
+	}
+	return DatumFailurePolicy_FAIL_JOB
+}
+
 type InspectPipelineRequest struct {
 	Pipeline             *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
@@ -4630,7 +5186,7 @@ func (m *InspectPipelineRequest) Reset()         { *m = InspectPipelineRequest{}
 func (m *InspectPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectPipelineRequest) ProtoMessage()    {}
 func (*InspectPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{47}
+	return fileDescriptor_beade573c128ccc7, []int{50}
 }
 func (m *InspectPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4682,17 +5238,19 @@ type ListPipelineRequest struct {
 	// will have the fields present in StoredPipelineInfo.
 	AllowIncomplete bool `protobuf:"varint,3,opt,name=allow_incomplete,json=allowIncomplete,proto3" json:"allow_incomplete,omitempty"`
 	// A jq program string for additional result filtering
-	JqFilter             string   `protobuf:"bytes,4,opt,name=jqFilter,proto3" json:"jqFilter,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	JqFilter string `protobuf:"bytes,4,opt,name=jqFilter,proto3" json:"jqFilter,omitempty"`
+	// project, if set, only returns the pipelines in the project.
+	Project              *pfs.Project `protobuf:"bytes,5,opt,name=project,proto3" json:"project,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *ListPipelineRequest) Reset()         { *m = ListPipelineRequest{} }
 func (m *ListPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPipelineRequest) ProtoMessage()    {}
 func (*ListPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{48}
+	return fileDescriptor_beade573c128ccc7, []int{51}
 }
 func (m *ListPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4749,6 +5307,13 @@ func (m *ListPipelineRequest) GetJqFilter() string {
 	return ""
 }
 
+func (m *ListPipelineRequest) GetProject() *pfs.Project {
+	if m != nil {
+		return m.Project
+	}
+	return nil
+}
+
 type DeletePipelineRequest struct {
 	Pipeline             *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
 	All                  bool      `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
@@ -4763,7 +5328,7 @@ func (m *DeletePipelineRequest) Reset()         { *m = DeletePipelineRequest{} }
 func (m *DeletePipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*DeletePipelineRequest) ProtoMessage()    {}
 func (*DeletePipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{49}
+	return fileDescriptor_beade573c128ccc7, []int{52}
 }
 func (m *DeletePipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4831,7 +5396,7 @@ func (m *StartPipelineRequest) Reset()         { *m = StartPipelineRequest{} }
 func (m *StartPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*StartPipelineRequest) ProtoMessage()    {}
 func (*StartPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{50}
+	return fileDescriptor_beade573c128ccc7, []int{53}
 }
 func (m *StartPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4878,7 +5443,7 @@ func (m *StopPipelineRequest) Reset()         { *m = StopPipelineRequest{} }
 func (m *StopPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*StopPipelineRequest) ProtoMessage()    {}
 func (*StopPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{51}
+	return fileDescriptor_beade573c128ccc7, []int{54}
 }
 func (m *StopPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4927,7 +5492,7 @@ func (m *RunPipelineRequest) Reset()         { *m = RunPipelineRequest{} }
 func (m *RunPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*RunPipelineRequest) ProtoMessage()    {}
 func (*RunPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{52}
+	return fileDescriptor_beade573c128ccc7, []int{55}
 }
 func (m *RunPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4988,7 +5553,7 @@ func (m *RunCronRequest) Reset()         { *m = RunCronRequest{} }
 func (m *RunCronRequest) String() string { return proto.CompactTextString(m) }
 func (*RunCronRequest) ProtoMessage()    {}
 func (*RunCronRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{53}
+	return fileDescriptor_beade573c128ccc7, []int{56}
 }
 func (m *RunCronRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5035,7 +5600,7 @@ func (m *CreateSecretRequest) Reset()         { *m = CreateSecretRequest{} }
 func (m *CreateSecretRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateSecretRequest) ProtoMessage()    {}
 func (*CreateSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{54}
+	return fileDescriptor_beade573c128ccc7, []int{57}
 }
 func (m *CreateSecretRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5082,7 +5647,7 @@ func (m *DeleteSecretRequest) Reset()         { *m = DeleteSecretRequest{} }
 func (m *DeleteSecretRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteSecretRequest) ProtoMessage()    {}
 func (*DeleteSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{55}
+	return fileDescriptor_beade573c128ccc7, []int{58}
 }
 func (m *DeleteSecretRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5129,7 +5694,7 @@ func (m *InspectSecretRequest) Reset()         { *m = InspectSecretRequest{} }
 func (m *InspectSecretRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectSecretRequest) ProtoMessage()    {}
 func (*InspectSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{56}
+	return fileDescriptor_beade573c128ccc7, []int{59}
 }
 func (m *InspectSecretRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5176,7 +5741,7 @@ func (m *Secret) Reset()         { *m = Secret{} }
 func (m *Secret) String() string { return proto.CompactTextString(m) }
 func (*Secret) ProtoMessage()    {}
 func (*Secret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{57}
+	return fileDescriptor_beade573c128ccc7, []int{60}
 }
 func (m *Secret) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5225,7 +5790,7 @@ func (m *SecretInfo) Reset()         { *m = SecretInfo{} }
 func (m *SecretInfo) String() string { return proto.CompactTextString(m) }
 func (*SecretInfo) ProtoMessage()    {}
 func (*SecretInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{58}
+	return fileDescriptor_beade573c128ccc7, []int{61}
 }
 func (m *SecretInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5286,7 +5851,7 @@ func (m *SecretInfos) Reset()         { *m = SecretInfos{} }
 func (m *SecretInfos) String() string { return proto.CompactTextString(m) }
 func (*SecretInfos) ProtoMessage()    {}
 func (*SecretInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{59}
+	return fileDescriptor_beade573c128ccc7, []int{62}
 }
 func (m *SecretInfos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5332,7 +5897,7 @@ func (m *ActivateAuthRequest) Reset()         { *m = ActivateAuthRequest{} }
 func (m *ActivateAuthRequest) String() string { return proto.CompactTextString(m) }
 func (*ActivateAuthRequest) ProtoMessage()    {}
 func (*ActivateAuthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{60}
+	return fileDescriptor_beade573c128ccc7, []int{63}
 }
 func (m *ActivateAuthRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5371,7 +5936,7 @@ func (m *ActivateAuthResponse) Reset()         { *m = ActivateAuthResponse{} }
 func (m *ActivateAuthResponse) String() string { return proto.CompactTextString(m) }
 func (*ActivateAuthResponse) ProtoMessage()    {}
 func (*ActivateAuthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{61}
+	return fileDescriptor_beade573c128ccc7, []int{64}
 }
 func (m *ActivateAuthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5404,10 +5969,13 @@ func init() {
 	proto.RegisterEnum("pps.PipelineJobState", PipelineJobState_name, PipelineJobState_value)
 	proto.RegisterEnum("pps.DatumState", DatumState_name, DatumState_value)
 	proto.RegisterEnum("pps.WorkerState", WorkerState_name, WorkerState_value)
+	proto.RegisterEnum("pps.QueueOverflowPolicy", QueueOverflowPolicy_name, QueueOverflowPolicy_value)
+	proto.RegisterEnum("pps.DatumFailurePolicy", DatumFailurePolicy_name, DatumFailurePolicy_value)
 	proto.RegisterEnum("pps.PipelineState", PipelineState_name, PipelineState_value)
 	proto.RegisterType((*SecretMount)(nil), "pps.SecretMount")
 	proto.RegisterType((*Transform)(nil), "pps.Transform")
 	proto.RegisterMapType((map[string]string)(nil), "pps.Transform.EnvEntry")
+	proto.RegisterType((*RetrySpec)(nil), "pps.RetrySpec")
 	proto.RegisterType((*BuildSpec)(nil), "pps.BuildSpec")
 	proto.RegisterType((*TFJob)(nil), "pps.TFJob")
 	proto.RegisterType((*Egress)(nil), "pps.Egress")
@@ -5426,6 +5994,7 @@ func init() {
 	proto.RegisterType((*InputFile)(nil), "pps.InputFile")
 	proto.RegisterType((*Datum)(nil), "pps.Datum")
 	proto.RegisterType((*DatumInfo)(nil), "pps.DatumInfo")
+	proto.RegisterType((*DatumRetry)(nil), "pps.DatumRetry")
 	proto.RegisterType((*Aggregate)(nil), "pps.Aggregate")
 	proto.RegisterType((*ProcessStats)(nil), "pps.ProcessStats")
 	proto.RegisterType((*AggregateProcessStats)(nil), "pps.AggregateProcessStats")
@@ -5437,6 +6006,7 @@ func init() {
 	proto.RegisterType((*PipelineJobInfo)(nil), "pps.PipelineJobInfo")
 	proto.RegisterType((*Worker)(nil), "pps.Worker")
 	proto.RegisterType((*Pipeline)(nil), "pps.Pipeline")
+	proto.RegisterType((*PipelineQueueState)(nil), "pps.PipelineQueueState")
 	proto.RegisterType((*StoredPipelineInfo)(nil), "pps.StoredPipelineInfo")
 	proto.RegisterMapType((map[int32]int32)(nil), "pps.StoredPipelineInfo.JobCountsEntry")
 	proto.RegisterType((*PipelineInfo)(nil), "pps.PipelineInfo")
@@ -5478,309 +6048,348 @@ func init() {
 func init() { proto.RegisterFile("pps/pps.proto", fileDescriptor_beade573c128ccc7) }
 
 var fileDescriptor_beade573c128ccc7 = []byte{
-	// 4819 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3b, 0x4b, 0x73, 0x1c, 0x49,
-	0x5a, 0xee, 0xa7, 0xba, 0xbe, 0x7e, 0xa8, 0x95, 0x7a, 0xb8, 0xd5, 0xb6, 0x25, 0x4d, 0x79, 0xec,
-	0xb5, 0xbd, 0x83, 0xe4, 0xb5, 0xd9, 0xd9, 0x9d, 0x07, 0x33, 0xa3, 0x97, 0xbd, 0xf2, 0x6a, 0x6c,
-	0x6d, 0xb6, 0x3c, 0x04, 0x5c, 0x3a, 0x4a, 0x55, 0xd9, 0xad, 0x1a, 0x55, 0x57, 0xd5, 0xd4, 0x43,
-	0x1e, 0xcd, 0x05, 0x6e, 0x9c, 0x88, 0x20, 0xb8, 0x10, 0xc1, 0x8d, 0xe0, 0xc4, 0x81, 0x88, 0x85,
-	0x0b, 0x7f, 0x61, 0x0f, 0x40, 0x10, 0x01, 0x5c, 0x1d, 0x84, 0xff, 0x00, 0x07, 0x6e, 0x10, 0x04,
-	0x44, 0x7e, 0x99, 0x55, 0x5d, 0xd5, 0x5d, 0xdd, 0x7a, 0x39, 0xe0, 0xd4, 0x99, 0xdf, 0xf7, 0xe5,
-	0xeb, 0xcb, 0xef, 0x9d, 0xd5, 0x50, 0x77, 0x5d, 0x7f, 0xc3, 0x75, 0xfd, 0x75, 0xd7, 0x73, 0x02,
-	0x87, 0x14, 0x5c, 0xd7, 0x6f, 0xdf, 0xea, 0x3b, 0x4e, 0xdf, 0x62, 0x1b, 0x08, 0x3a, 0x0a, 0x7b,
-	0x1b, 0x6c, 0xe0, 0x06, 0x67, 0x82, 0xa2, 0xbd, 0x3a, 0x8a, 0x0c, 0xcc, 0x01, 0xf3, 0x03, 0x6d,
-	0xe0, 0x4a, 0x82, 0x95, 0x51, 0x02, 0x23, 0xf4, 0xb4, 0xc0, 0x74, 0x6c, 0x89, 0x5f, 0xe8, 0x3b,
-	0x7d, 0x07, 0x9b, 0x1b, 0xbc, 0x25, 0xa1, 0x75, 0xb7, 0xe7, 0x6f, 0xb8, 0x3d, 0xb9, 0x0f, 0xf5,
-	0x04, 0xaa, 0x1d, 0xa6, 0x7b, 0x2c, 0xf8, 0xda, 0x09, 0xed, 0x80, 0x10, 0x28, 0xda, 0xda, 0x80,
-	0xb5, 0x72, 0x6b, 0xb9, 0x07, 0x0a, 0xc5, 0x36, 0x69, 0x42, 0xe1, 0x84, 0x9d, 0xb5, 0xf2, 0x08,
-	0xe2, 0x4d, 0x72, 0x07, 0x60, 0xc0, 0xc9, 0xbb, 0xae, 0x16, 0x1c, 0xb7, 0x0a, 0x88, 0x50, 0x10,
-	0x72, 0xa0, 0x05, 0xc7, 0xe4, 0x26, 0xcc, 0x30, 0xfb, 0xb4, 0x7b, 0xaa, 0x79, 0xad, 0x22, 0xe2,
-	0xca, 0xcc, 0x3e, 0xfd, 0x46, 0xf3, 0xd4, 0xff, 0x2a, 0x80, 0x72, 0xe8, 0x69, 0xb6, 0xdf, 0x73,
-	0xbc, 0x01, 0x59, 0x80, 0x92, 0x39, 0xd0, 0xfa, 0xd1, 0x62, 0xa2, 0xc3, 0x57, 0xd3, 0x07, 0x46,
-	0x2b, 0xbf, 0x56, 0xe0, 0xab, 0xe9, 0x03, 0x03, 0xa7, 0xf3, 0xbc, 0x2e, 0x87, 0x16, 0x10, 0x5a,
-	0x66, 0x9e, 0xb7, 0x3d, 0x30, 0xc8, 0x43, 0x28, 0x30, 0xfb, 0xb4, 0x55, 0x5c, 0x2b, 0x3c, 0xa8,
-	0x3e, 0xb9, 0xb9, 0xce, 0x99, 0x1b, 0xcf, 0xbe, 0xbe, 0x6b, 0x9f, 0xee, 0xda, 0x81, 0x77, 0x46,
-	0x39, 0x0d, 0x79, 0x04, 0x33, 0x3e, 0x1e, 0xd3, 0x6f, 0x95, 0x90, 0xbc, 0x89, 0xe4, 0x89, 0xa3,
-	0xd3, 0x88, 0x80, 0x7c, 0x04, 0x04, 0xb7, 0xd2, 0x75, 0x43, 0xcb, 0xea, 0x46, 0xc3, 0xca, 0xb8,
-	0x74, 0x13, 0x31, 0x07, 0xa1, 0x65, 0x75, 0x24, 0xf5, 0x02, 0x94, 0xfc, 0xc0, 0x30, 0xed, 0xd6,
-	0x0c, 0x12, 0x88, 0x0e, 0xb9, 0x05, 0x0a, 0xdf, 0xb3, 0xc0, 0x54, 0x10, 0x53, 0x61, 0x9e, 0xd7,
-	0x41, 0xe4, 0x47, 0x40, 0x34, 0x5d, 0x67, 0x6e, 0xd0, 0xf5, 0x58, 0x10, 0x7a, 0x76, 0x57, 0x77,
-	0x0c, 0xd6, 0x52, 0xd6, 0x0a, 0x0f, 0x0a, 0xb4, 0x29, 0x30, 0x14, 0x11, 0xdb, 0x8e, 0xc1, 0xf8,
-	0x02, 0x06, 0x3b, 0x0a, 0xfb, 0x2d, 0x58, 0xcb, 0x3d, 0xa8, 0x50, 0xd1, 0xe1, 0x17, 0x15, 0xfa,
-	0xcc, 0x6b, 0x55, 0xc5, 0x45, 0xf1, 0x36, 0x59, 0x85, 0xea, 0x1b, 0xc7, 0x3b, 0x31, 0xed, 0x7e,
-	0xd7, 0x30, 0xbd, 0x56, 0x0d, 0x51, 0x20, 0x41, 0x3b, 0xa6, 0x47, 0x56, 0x00, 0x0c, 0x47, 0x3f,
-	0x61, 0x5e, 0xcf, 0xb4, 0x58, 0xab, 0x2e, 0xf0, 0x43, 0x08, 0xf9, 0x10, 0x4a, 0x47, 0xa1, 0x69,
-	0x19, 0xad, 0xc6, 0x5a, 0xee, 0x41, 0xf5, 0x49, 0x03, 0x79, 0xb4, 0xc5, 0x21, 0x1d, 0x97, 0xe9,
-	0x54, 0x20, 0xdb, 0x1f, 0x43, 0x25, 0x62, 0x6e, 0x24, 0x1b, 0xb9, 0xa1, 0x6c, 0x2c, 0x40, 0xe9,
-	0x54, 0xb3, 0x42, 0x26, 0xe5, 0x45, 0x74, 0x3e, 0xcd, 0xff, 0x3c, 0xa7, 0xfe, 0x0a, 0x94, 0x78,
-	0x2e, 0xbe, 0x7f, 0x14, 0x1e, 0x29, 0x68, 0xbc, 0x4d, 0xda, 0x50, 0xb1, 0x34, 0xbb, 0x1f, 0x72,
-	0x99, 0x10, 0xa3, 0xe3, 0xfe, 0x50, 0x58, 0x0a, 0x09, 0x61, 0x51, 0x1f, 0x42, 0xe9, 0xf0, 0xd9,
-	0x0b, 0xe7, 0x88, 0xac, 0x41, 0x39, 0xe8, 0x75, 0xbf, 0x75, 0x8e, 0xc4, 0x84, 0x5b, 0xca, 0xbb,
-	0xb7, 0xab, 0x02, 0x45, 0x4b, 0x41, 0xef, 0x85, 0x73, 0xa4, 0xb6, 0xa1, 0xbc, 0xdb, 0xf7, 0x98,
-	0xef, 0xf3, 0x3d, 0xbf, 0xa6, 0xfb, 0xd1, 0x9e, 0x5f, 0xd3, 0x7d, 0xf5, 0x1e, 0x54, 0x0f, 0x4c,
-	0x97, 0x59, 0xa6, 0xcd, 0xf8, 0x64, 0x4b, 0x90, 0x37, 0x0d, 0x39, 0x51, 0xf9, 0xdd, 0xdb, 0xd5,
-	0xfc, 0xde, 0x0e, 0xcd, 0x9b, 0x86, 0xfa, 0x9f, 0x39, 0xa8, 0x7c, 0xcd, 0x02, 0xcd, 0xd0, 0x02,
-	0x8d, 0x7c, 0x05, 0x55, 0xcd, 0xb6, 0x9d, 0x00, 0x35, 0xce, 0x6f, 0xe5, 0x50, 0xaa, 0x56, 0x90,
-	0x63, 0x11, 0xcd, 0xfa, 0xe6, 0x90, 0x40, 0xc8, 0x62, 0x72, 0x08, 0xf9, 0x09, 0x94, 0x2d, 0xed,
-	0x88, 0x59, 0x3e, 0x0a, 0x7b, 0xf5, 0xc9, 0x72, 0x7a, 0xf0, 0x3e, 0xe2, 0xc4, 0x38, 0x49, 0xd8,
-	0xfe, 0x02, 0x9a, 0xa3, 0x73, 0x5e, 0xe6, 0x0a, 0xda, 0x9f, 0x40, 0x35, 0x31, 0xed, 0xa5, 0x6e,
-	0xef, 0x0f, 0x60, 0xa6, 0xc3, 0xbc, 0x53, 0x53, 0x67, 0xe4, 0x2e, 0xd4, 0x4d, 0x3b, 0x60, 0x9e,
-	0xad, 0x59, 0x5d, 0xd7, 0xf1, 0x02, 0x9c, 0xa0, 0x44, 0x6b, 0x11, 0xf0, 0xc0, 0xf1, 0x02, 0x4e,
-	0xc4, 0xbe, 0x4f, 0x12, 0xe5, 0x05, 0x51, 0x04, 0x44, 0x22, 0xce, 0x69, 0x57, 0x5c, 0xa9, 0xe4,
-	0xf4, 0x01, 0xcd, 0x9b, 0x2e, 0x97, 0x8e, 0xe0, 0xcc, 0x65, 0xd2, 0x7c, 0x60, 0x5b, 0xdd, 0x80,
-	0x52, 0xc7, 0x75, 0xc2, 0x80, 0xdc, 0xe7, 0xba, 0x8c, 0x3b, 0xc1, 0x85, 0xab, 0x4f, 0x6a, 0x52,
-	0x97, 0x11, 0x46, 0x23, 0xa4, 0xfa, 0xcf, 0x79, 0xa8, 0x1c, 0x3c, 0xeb, 0xec, 0xd9, 0x6e, 0x98,
-	0x6d, 0xd8, 0x08, 0x14, 0x3d, 0xe6, 0x3a, 0xf2, 0xac, 0xd8, 0xe6, 0x8a, 0xcb, 0x7f, 0xbb, 0xb8,
-	0xbc, 0xd0, 0x90, 0x0a, 0x07, 0x1c, 0x9e, 0xb9, 0x8c, 0x2c, 0x41, 0xf9, 0xc8, 0xd3, 0x6c, 0x3d,
-	0xb2, 0x79, 0xb2, 0xc7, 0xe1, 0xba, 0x33, 0x18, 0x98, 0x41, 0x64, 0xef, 0x44, 0x8f, 0x2f, 0xd0,
-	0xb7, 0x9c, 0xa3, 0x56, 0x49, 0x2c, 0xc0, 0xdb, 0xdc, 0x9a, 0x7d, 0xeb, 0x98, 0x76, 0xd7, 0xb1,
-	0x5b, 0x65, 0x41, 0xcc, 0xbb, 0xaf, 0x6c, 0x6e, 0x54, 0x9d, 0x30, 0x60, 0x5e, 0x97, 0xf7, 0x5b,
-	0x33, 0xa8, 0xec, 0x0a, 0x42, 0x5e, 0x38, 0xa6, 0x4d, 0x96, 0xa1, 0xd2, 0xf7, 0x9c, 0xd0, 0xed,
-	0x1e, 0x9d, 0xb5, 0x2a, 0x38, 0x70, 0x06, 0xfb, 0x5b, 0x67, 0x7c, 0x19, 0x4b, 0xfb, 0xe1, 0xac,
-	0xa5, 0xe0, 0x18, 0x6c, 0x73, 0x5b, 0x80, 0xce, 0xa4, 0xcb, 0x15, 0xdb, 0x97, 0xb6, 0x03, 0x10,
-	0xf4, 0x8c, 0x43, 0x48, 0x03, 0xf2, 0xfe, 0x53, 0x34, 0x1f, 0x15, 0x9a, 0xf7, 0x9f, 0x72, 0xae,
-	0x06, 0x9e, 0xd9, 0xef, 0x33, 0x61, 0x38, 0x90, 0xab, 0x3d, 0x6e, 0x50, 0x11, 0x46, 0x23, 0xa4,
-	0xfa, 0xf7, 0x39, 0x50, 0xb6, 0x3d, 0xc7, 0x7e, 0xbf, 0x6c, 0x95, 0xec, 0x2b, 0x8c, 0xb2, 0xcf,
-	0x77, 0x99, 0x1e, 0x49, 0x01, 0x6f, 0x93, 0xdb, 0xa0, 0x38, 0xa7, 0xcc, 0x7b, 0xe3, 0x99, 0x01,
-	0x43, 0xbe, 0x72, 0x26, 0x45, 0x00, 0xf2, 0x98, 0x1b, 0x63, 0xcd, 0x0b, 0x90, 0xb5, 0xd5, 0x27,
-	0xed, 0x75, 0xe1, 0x22, 0xd7, 0x23, 0x17, 0xb9, 0x7e, 0x18, 0xf9, 0x50, 0x2a, 0x08, 0x55, 0x13,
-	0x2a, 0xcf, 0xcd, 0x60, 0xf2, 0x61, 0x96, 0xa1, 0x10, 0x7a, 0x96, 0x38, 0xcb, 0xd6, 0xcc, 0xbb,
-	0xb7, 0xab, 0xdc, 0x60, 0x50, 0x0e, 0xbb, 0xac, 0x34, 0xa8, 0xff, 0x91, 0x83, 0x92, 0x58, 0x68,
-	0x15, 0x0a, 0x6e, 0xcf, 0x97, 0xd2, 0x5b, 0x47, 0xe9, 0x8d, 0x04, 0x95, 0x72, 0x0c, 0x59, 0x81,
-	0x22, 0x4a, 0x81, 0x30, 0x0c, 0x80, 0x14, 0x02, 0x8d, 0x70, 0xb2, 0x06, 0x25, 0xbc, 0x7c, 0x74,
-	0x88, 0x69, 0x02, 0x81, 0xe0, 0x14, 0xba, 0xe7, 0xf8, 0xbe, 0xf4, 0x8e, 0x29, 0x0a, 0x44, 0x70,
-	0x8a, 0xd0, 0x36, 0x1d, 0x5b, 0x3a, 0xc4, 0x14, 0x05, 0x22, 0x88, 0x0a, 0x45, 0xdd, 0x93, 0x72,
-	0x1a, 0x79, 0x83, 0xf8, 0xea, 0x29, 0xe2, 0xf8, 0x51, 0xfa, 0x66, 0x80, 0xe2, 0x1a, 0x1d, 0x25,
-	0xe2, 0x27, 0xe5, 0x18, 0xd5, 0x87, 0x66, 0xc2, 0xb6, 0x4e, 0x66, 0xf4, 0xdd, 0x98, 0x6b, 0x79,
-	0x9c, 0xab, 0x8a, 0xe2, 0xb7, 0x8d, 0xa0, 0x31, 0x85, 0x2a, 0x24, 0x14, 0x2a, 0x92, 0xfe, 0xe2,
-	0x50, 0xfa, 0xd5, 0x57, 0x30, 0x7b, 0xa0, 0x79, 0x9a, 0x65, 0x31, 0xcb, 0xf4, 0x07, 0xe8, 0x70,
-	0xda, 0x50, 0xd1, 0x1d, 0xdb, 0x0f, 0x34, 0x5b, 0xd8, 0xab, 0x22, 0x8d, 0xfb, 0x64, 0x0d, 0xaa,
-	0xba, 0xc3, 0x7a, 0x3d, 0x53, 0x37, 0x99, 0x2d, 0x36, 0x90, 0xa3, 0x49, 0x90, 0xfa, 0x14, 0x14,
-	0xdc, 0x3a, 0xd7, 0x9d, 0x4c, 0xdf, 0x45, 0xa0, 0x78, 0xac, 0xf9, 0xc7, 0x38, 0xb6, 0x46, 0xb1,
-	0xad, 0x1e, 0x42, 0x69, 0x47, 0x0b, 0xc2, 0xc1, 0x24, 0x87, 0x42, 0x9e, 0x42, 0xcd, 0x95, 0xbc,
-	0x41, 0xdf, 0x25, 0x4e, 0x2e, 0x42, 0x93, 0x04, 0xd3, 0x68, 0xd5, 0x1d, 0x76, 0xd4, 0xdf, 0xe4,
-	0x40, 0xc1, 0x69, 0xf7, 0xec, 0x9e, 0xc3, 0x6f, 0xd1, 0xe0, 0x1d, 0x29, 0x4c, 0xe2, 0x16, 0x11,
-	0x4d, 0x05, 0x82, 0xdc, 0x43, 0x9d, 0x08, 0x84, 0x49, 0x6f, 0x3c, 0x99, 0x1d, 0x52, 0x74, 0x38,
-	0x98, 0x0a, 0x2c, 0xf9, 0x91, 0x20, 0xf3, 0x91, 0xb7, 0xd5, 0x27, 0x73, 0x62, 0x13, 0x9e, 0xa3,
-	0x33, 0xdf, 0xe7, 0x84, 0xbe, 0x20, 0xf4, 0xc9, 0x7d, 0x50, 0xdc, 0x9e, 0xdf, 0x15, 0x73, 0x16,
-	0x91, 0x58, 0xc1, 0xbb, 0xe2, 0xbc, 0xa1, 0x15, 0xb7, 0x87, 0xe4, 0x8c, 0x7c, 0x00, 0x45, 0xee,
-	0xc7, 0xa4, 0x78, 0xd5, 0x63, 0x12, 0xbe, 0x6d, 0x8a, 0x28, 0xf5, 0xd7, 0x39, 0x50, 0x36, 0xfb,
-	0x7d, 0x8f, 0xf5, 0xf9, 0x80, 0x05, 0x28, 0xe9, 0x3c, 0x12, 0xc3, 0xa3, 0x14, 0xa8, 0xe8, 0x70,
-	0xc6, 0x0e, 0x98, 0x66, 0xcb, 0x4b, 0xc1, 0x36, 0xd7, 0x30, 0x3f, 0x30, 0x0c, 0x76, 0x8a, 0x9b,
-	0xcd, 0x51, 0xd9, 0x23, 0x0f, 0xa1, 0xd9, 0x33, 0x7b, 0xc1, 0x71, 0xd7, 0x65, 0x9e, 0xce, 0xec,
-	0x80, 0x47, 0x39, 0x45, 0xa4, 0x98, 0x45, 0xf8, 0x41, 0x0c, 0x26, 0x1f, 0xc3, 0x4d, 0xdb, 0xb4,
-	0x19, 0x1a, 0xc8, 0x91, 0x11, 0x25, 0x1c, 0xb1, 0x28, 0xd0, 0xcf, 0xd2, 0xe3, 0xd4, 0x3f, 0xcd,
-	0x43, 0x2d, 0xc9, 0x15, 0xf2, 0x05, 0xd4, 0x0d, 0xe7, 0x8d, 0x6d, 0x39, 0x9a, 0xd1, 0xe5, 0x11,
-	0xba, 0xbc, 0x88, 0xe5, 0x31, 0xd3, 0xb3, 0x23, 0xa3, 0x73, 0x5a, 0x8b, 0xe8, 0xb9, 0x31, 0x22,
-	0x9f, 0x43, 0xcd, 0x15, 0xf3, 0x89, 0xe1, 0xf9, 0xf3, 0x86, 0x57, 0x25, 0x39, 0x8e, 0xfe, 0x14,
-	0xaa, 0xa1, 0x3b, 0x5c, 0xbb, 0x70, 0xde, 0x60, 0x10, 0xd4, 0x38, 0xf6, 0x1e, 0x34, 0xe2, 0x9d,
-	0x1f, 0x9d, 0x05, 0xcc, 0x47, 0x5e, 0x15, 0x69, 0x7c, 0x9e, 0x2d, 0x0e, 0x24, 0x1f, 0x40, 0x4d,
-	0x2e, 0x21, 0x88, 0x4a, 0x48, 0x24, 0x97, 0x45, 0x12, 0xf5, 0xcf, 0xf3, 0xb0, 0x18, 0xdf, 0x63,
-	0x8a, 0x3b, 0x4f, 0xb3, 0xb9, 0x23, 0x6c, 0x49, 0x3c, 0x64, 0x84, 0x25, 0x3f, 0xc9, 0x64, 0xc9,
-	0xe8, 0x98, 0x14, 0x1f, 0x36, 0xb2, 0xf8, 0x30, 0x3a, 0x22, 0x79, 0xf8, 0x9f, 0x66, 0x1e, 0x7e,
-	0x7c, 0xcc, 0x08, 0x33, 0x7e, 0x92, 0xc1, 0x8c, 0x8c, 0xad, 0x25, 0x99, 0xf3, 0x57, 0x39, 0xa8,
-	0xfd, 0xae, 0xe3, 0x9d, 0x30, 0x8f, 0xb3, 0x24, 0xf4, 0xc9, 0x43, 0x50, 0xde, 0x60, 0xbf, 0x1b,
-	0x1b, 0x85, 0xda, 0xbb, 0xb7, 0xab, 0x15, 0x41, 0xb4, 0xb7, 0x43, 0x2b, 0x02, 0xbd, 0x67, 0x90,
-	0x4f, 0x60, 0x36, 0x69, 0x20, 0xf8, 0x00, 0xe1, 0x89, 0xe6, 0xde, 0xbd, 0x5d, 0xad, 0x27, 0xed,
-	0xea, 0x0e, 0xad, 0x27, 0x8c, 0xc4, 0x1e, 0xda, 0x16, 0xd4, 0x7f, 0x54, 0xd4, 0x30, 0x52, 0xeb,
-	0x66, 0x5a, 0xfb, 0x43, 0x9f, 0x56, 0x8d, 0x61, 0x47, 0xed, 0x43, 0x35, 0x81, 0x23, 0xbf, 0x0d,
-	0x33, 0xe8, 0x25, 0x99, 0x21, 0xef, 0x6d, 0x9a, 0x43, 0x8d, 0x48, 0xb9, 0xdb, 0x40, 0xc5, 0x17,
-	0xce, 0xab, 0x31, 0xf4, 0x2b, 0x68, 0x20, 0x84, 0xe6, 0x5b, 0x50, 0xa3, 0xcc, 0x77, 0x42, 0x4f,
-	0x67, 0x68, 0x9d, 0x79, 0xd6, 0xe7, 0x86, 0xb8, 0x4a, 0x9e, 0xf2, 0x26, 0xd7, 0xf1, 0x01, 0x1b,
-	0x38, 0x5e, 0x94, 0x78, 0xca, 0x1e, 0x59, 0x81, 0x42, 0xdf, 0x0d, 0xe5, 0x71, 0x44, 0xe4, 0xf7,
-	0xfc, 0xe0, 0x35, 0xe6, 0x27, 0x1c, 0xc1, 0xed, 0x85, 0x61, 0xfa, 0x27, 0x51, 0xd0, 0xc0, 0xdb,
-	0xea, 0x4f, 0x61, 0x46, 0xd2, 0xc4, 0x91, 0x65, 0x6e, 0x18, 0x59, 0xf2, 0xa5, 0xec, 0x70, 0x70,
-	0xc4, 0x3c, 0x5c, 0xaa, 0x40, 0x65, 0x4f, 0xfd, 0xc3, 0x12, 0x2c, 0x76, 0x02, 0xc7, 0x63, 0x46,
-	0xca, 0x83, 0xf5, 0x9c, 0x31, 0xc3, 0x9d, 0xbb, 0x80, 0xe1, 0x26, 0x0f, 0xa1, 0x12, 0x75, 0xa5,
-	0x48, 0xd7, 0x53, 0x03, 0x68, 0x8c, 0x26, 0x8f, 0xa1, 0xee, 0x84, 0x81, 0x1b, 0x06, 0xdd, 0x44,
-	0x60, 0x34, 0xe2, 0x13, 0x6b, 0x82, 0x42, 0xf4, 0x48, 0x0b, 0x66, 0x3c, 0x26, 0x62, 0x1f, 0xa1,
-	0xc5, 0x51, 0x17, 0xd5, 0x5c, 0x0b, 0xb4, 0xae, 0x54, 0x17, 0x66, 0xa0, 0xd0, 0x16, 0x68, 0x9d,
-	0x43, 0x0f, 0x22, 0x20, 0x57, 0x73, 0x24, 0xf3, 0x4f, 0x4c, 0xd7, 0x65, 0x06, 0x3a, 0xfd, 0x02,
-	0x4a, 0x87, 0xd6, 0x11, 0x20, 0x1e, 0xa1, 0x22, 0x49, 0xe0, 0x04, 0x9a, 0x85, 0x2e, 0xbf, 0x40,
-	0x15, 0x0e, 0x39, 0xe4, 0x00, 0x1e, 0x72, 0x22, 0xba, 0xa7, 0x99, 0x16, 0x33, 0x30, 0x48, 0x2d,
-	0x50, 0x1c, 0xf1, 0x0c, 0x21, 0xf1, 0x4e, 0x3c, 0xa6, 0xf3, 0x90, 0x8d, 0x19, 0x18, 0xb1, 0xca,
-	0x9d, 0xd0, 0x08, 0x38, 0xf4, 0x44, 0x70, 0x8e, 0x27, 0x5a, 0x87, 0x1a, 0x36, 0x22, 0x26, 0x55,
-	0xc7, 0x99, 0x54, 0x45, 0x02, 0xc9, 0xa3, 0x1f, 0x47, 0x9e, 0xb0, 0x86, 0x9e, 0x70, 0x71, 0xf4,
-	0xba, 0x52, 0xfe, 0x70, 0x09, 0xca, 0x1e, 0xd3, 0x7c, 0xc7, 0x96, 0xe1, 0xaa, 0xec, 0x25, 0x75,
-	0xa2, 0x71, 0x71, 0x9d, 0xf8, 0x18, 0x2a, 0x3d, 0xd3, 0x36, 0xfd, 0x63, 0x66, 0xb4, 0x66, 0xcf,
-	0x1d, 0x16, 0xd3, 0xaa, 0xff, 0x5d, 0x87, 0xd9, 0xf7, 0x22, 0x7c, 0x1f, 0x81, 0x12, 0x44, 0xb5,
-	0x91, 0x94, 0x41, 0x8d, 0x2b, 0x26, 0x74, 0x48, 0x90, 0x12, 0xd5, 0xc2, 0x74, 0x51, 0x7d, 0x08,
-	0xcd, 0x78, 0x37, 0xa7, 0xcc, 0xf3, 0x79, 0x44, 0x29, 0x24, 0x30, 0x36, 0x5d, 0xdf, 0x08, 0x30,
-	0xf9, 0x08, 0xaa, 0x3c, 0x86, 0x8f, 0xae, 0xab, 0x34, 0x7e, 0x5d, 0xc0, 0xf1, 0xf2, 0xb6, 0xbe,
-	0x84, 0xa6, 0x3b, 0x8c, 0xe1, 0xba, 0x98, 0x09, 0x88, 0x48, 0x74, 0x41, 0xec, 0x25, 0x1d, 0xe0,
-	0xd1, 0x59, 0x77, 0x24, 0xe2, 0xbb, 0x0b, 0x65, 0x86, 0x19, 0xbf, 0x8c, 0x4e, 0xab, 0x38, 0x4c,
-	0x14, 0x01, 0xa8, 0x44, 0x91, 0x0d, 0x00, 0x57, 0xf3, 0x98, 0x1d, 0x20, 0x2b, 0x2b, 0x13, 0x58,
-	0xa9, 0x08, 0x1a, 0xce, 0xc8, 0xc4, 0xfd, 0x2b, 0x57, 0xbb, 0x7f, 0xb8, 0xf8, 0xfd, 0x8f, 0x1b,
-	0x82, 0xea, 0x79, 0x86, 0xe0, 0xbd, 0x08, 0x79, 0x22, 0xc5, 0x6e, 0x4c, 0x49, 0xb1, 0x79, 0xf4,
-	0xe9, 0xf3, 0x9c, 0x5c, 0xca, 0xb4, 0x88, 0x3e, 0x31, 0x4b, 0xa7, 0x02, 0x41, 0x1e, 0x41, 0x55,
-	0x1e, 0x00, 0x73, 0xc2, 0x66, 0x22, 0x5e, 0xa4, 0xcc, 0x75, 0x28, 0x08, 0x2c, 0x6f, 0x93, 0xbb,
-	0xf1, 0x61, 0x65, 0x5e, 0x35, 0x87, 0x9b, 0x92, 0xe7, 0xdb, 0x12, 0xd9, 0x55, 0xc2, 0xd0, 0x91,
-	0xf3, 0x0c, 0xdd, 0xfc, 0x45, 0x0c, 0xdd, 0xc2, 0xb8, 0xa1, 0x1b, 0xb1, 0x64, 0x8b, 0x17, 0xb0,
-	0x64, 0x4b, 0x59, 0x96, 0x2c, 0x6d, 0x30, 0x6f, 0x8e, 0x1a, 0xcc, 0xd8, 0xd0, 0xb5, 0xce, 0x31,
-	0x74, 0x1f, 0x43, 0x5d, 0x46, 0x0c, 0xd2, 0x99, 0x2f, 0xa3, 0x6b, 0x15, 0x03, 0x92, 0xb1, 0x05,
-	0xad, 0xbd, 0x49, 0x46, 0x1a, 0x5f, 0xc0, 0x9c, 0x27, 0xbd, 0x6c, 0xd7, 0x63, 0xdf, 0x85, 0xcc,
-	0x0f, 0xfc, 0x56, 0x3b, 0xb1, 0x58, 0xd2, 0x07, 0xd3, 0x66, 0x44, 0x4b, 0x25, 0x29, 0xf9, 0x14,
-	0x66, 0xe3, 0xf1, 0x96, 0x39, 0x30, 0x03, 0xbf, 0x75, 0x6b, 0xd2, 0xe8, 0x46, 0x44, 0xb9, 0x8f,
-	0x84, 0x64, 0x0f, 0x6e, 0xfa, 0xa6, 0xc1, 0x74, 0xcd, 0xeb, 0x8e, 0xce, 0x71, 0x7b, 0xd2, 0x1c,
-	0x8b, 0x72, 0x04, 0x4d, 0x4f, 0xb5, 0x06, 0x25, 0x93, 0xc7, 0x0f, 0xad, 0x3b, 0x09, 0x29, 0x93,
-	0x99, 0x2a, 0x22, 0xc8, 0x3a, 0x80, 0xcd, 0xde, 0x44, 0x62, 0xb3, 0x82, 0x64, 0xb3, 0x28, 0x64,
-	0x42, 0x6a, 0x30, 0xe7, 0x50, 0x6c, 0xf6, 0x46, 0x0a, 0xd1, 0xa8, 0xe7, 0x58, 0x3d, 0xc7, 0x73,
-	0x7c, 0x00, 0x35, 0x66, 0x6b, 0x47, 0x16, 0xeb, 0x8a, 0x0b, 0x5b, 0xc3, 0x5c, 0xb3, 0x2a, 0x60,
-	0x22, 0xd2, 0x25, 0x50, 0xf4, 0x35, 0x2b, 0x68, 0x7d, 0x20, 0x8b, 0x15, 0x9a, 0x15, 0x90, 0xdf,
-	0x02, 0xd0, 0x8f, 0x43, 0xfb, 0x44, 0x18, 0x2f, 0x35, 0x99, 0x46, 0x73, 0x30, 0x9e, 0x59, 0xd1,
-	0xa3, 0x26, 0xa6, 0x12, 0x18, 0xb2, 0xf1, 0x18, 0x96, 0x6b, 0xd5, 0xdd, 0xf3, 0x53, 0x09, 0x4e,
-	0x7f, 0x28, 0xc8, 0x79, 0x32, 0xc0, 0x83, 0xc4, 0x68, 0xf4, 0x87, 0xe7, 0x26, 0x03, 0xdf, 0x3a,
-	0x47, 0xd1, 0x58, 0x21, 0xf2, 0x7c, 0x6d, 0xcf, 0x64, 0x7e, 0xeb, 0x5e, 0x2c, 0xf2, 0xe1, 0xe0,
-	0x90, 0x43, 0xc8, 0xe7, 0x30, 0xeb, 0xeb, 0xc7, 0xcc, 0x08, 0x2d, 0xd3, 0xee, 0x8b, 0x03, 0xdd,
-	0xc7, 0x05, 0xe6, 0x85, 0xd2, 0xc7, 0x38, 0x21, 0x0d, 0x7e, 0xaa, 0x4f, 0x96, 0xa1, 0xe2, 0x3a,
-	0x86, 0x18, 0xf6, 0x23, 0x51, 0xbd, 0x72, 0x1d, 0x51, 0x09, 0xbe, 0x05, 0x0a, 0x47, 0xb9, 0x5a,
-	0xa0, 0x1f, 0xb7, 0x1e, 0x88, 0xd2, 0x90, 0xeb, 0x18, 0x07, 0xbc, 0xaf, 0xee, 0x40, 0x59, 0xc8,
-	0x77, 0x66, 0xcd, 0xe0, 0x7e, 0x3a, 0xb5, 0x6d, 0x8e, 0xe8, 0x43, 0x64, 0xe6, 0xd4, 0x15, 0xa8,
-	0x44, 0x16, 0x30, 0x6b, 0x1e, 0xf5, 0x6f, 0x0b, 0x40, 0xd2, 0x81, 0x1e, 0x3a, 0xda, 0x07, 0xd1,
-	0xf4, 0x39, 0x9c, 0x9e, 0xa4, 0x4c, 0xe9, 0x04, 0x3b, 0x9a, 0x4f, 0xd9, 0xd1, 0x11, 0x8f, 0x57,
-	0x98, 0xee, 0xf1, 0x76, 0x81, 0xdf, 0x48, 0x17, 0xf3, 0xde, 0xa8, 0x70, 0x73, 0x5f, 0x70, 0x77,
-	0x6c, 0x73, 0xeb, 0x2f, 0x9c, 0xa3, 0x6d, 0x24, 0x14, 0x15, 0x62, 0xe5, 0xdb, 0xa8, 0xcf, 0xad,
-	0x8e, 0x16, 0x06, 0xc7, 0xdd, 0xc0, 0x39, 0x61, 0xb6, 0xac, 0x3d, 0x2a, 0x1c, 0x72, 0xc8, 0x01,
-	0xe4, 0x33, 0x68, 0x58, 0x9a, 0x8f, 0xfe, 0x4e, 0x26, 0xf1, 0xe5, 0x69, 0x9e, 0xa2, 0xc6, 0x89,
-	0xa3, 0x1e, 0x59, 0x83, 0x6a, 0xc2, 0xcd, 0xa2, 0x63, 0x2d, 0xd2, 0x24, 0x28, 0x15, 0x3a, 0x54,
-	0xa6, 0x86, 0x0e, 0xed, 0xcf, 0xa1, 0x91, 0x3e, 0x45, 0xb2, 0x20, 0x5d, 0xca, 0x28, 0x48, 0x97,
-	0x92, 0x05, 0xe9, 0xff, 0xa9, 0x43, 0x2d, 0x75, 0x5d, 0xc9, 0x95, 0x73, 0xd3, 0x83, 0x96, 0x16,
-	0xcc, 0x44, 0xb1, 0x4a, 0x5e, 0x38, 0x91, 0xd3, 0x38, 0x46, 0x49, 0xc4, 0x49, 0x85, 0xf3, 0xe2,
-	0xa4, 0x8f, 0xe2, 0x67, 0x87, 0x62, 0xc2, 0x34, 0xe1, 0xbb, 0xc3, 0xf8, 0x13, 0x44, 0x66, 0x44,
-	0x53, 0xba, 0x5a, 0x44, 0x53, 0x9e, 0x1c, 0xd1, 0x7c, 0x02, 0xa0, 0x7b, 0x4c, 0x0b, 0x98, 0xd1,
-	0xd5, 0xa2, 0xc2, 0xdc, 0xb4, 0x60, 0x43, 0x91, 0xd4, 0x9b, 0xc1, 0x50, 0xe0, 0x2b, 0xe7, 0x09,
-	0x7c, 0x8b, 0x47, 0x41, 0x0e, 0xfa, 0x4f, 0x51, 0x75, 0x8e, 0xba, 0xdc, 0x54, 0x7a, 0x4c, 0xe7,
-	0x01, 0x15, 0xf3, 0x3c, 0xc7, 0xc3, 0x68, 0x47, 0xa1, 0x55, 0x01, 0xdb, 0xe5, 0x20, 0xf2, 0x63,
-	0x98, 0x13, 0x6e, 0xca, 0x8f, 0xbc, 0x12, 0x33, 0x30, 0xb0, 0x29, 0xd0, 0xa6, 0x44, 0xd0, 0x08,
-	0x9e, 0x24, 0xd6, 0x4e, 0x35, 0xd3, 0xe2, 0x16, 0x17, 0x63, 0x9b, 0x21, 0xf1, 0x66, 0x04, 0x27,
-	0x5f, 0xa6, 0x34, 0xa8, 0x8e, 0x1a, 0xb4, 0x96, 0x3a, 0xc5, 0x39, 0xba, 0x33, 0xae, 0x1c, 0x8d,
-	0x8b, 0x2b, 0xc7, 0x58, 0xfc, 0x32, 0x9b, 0x11, 0xbf, 0x64, 0xfa, 0xe4, 0xe6, 0xb5, 0x7c, 0xf2,
-	0xdc, 0x7b, 0xf0, 0xc9, 0xe4, 0xaa, 0x3e, 0x79, 0x7e, 0x92, 0x4f, 0x5e, 0x83, 0xaa, 0xc1, 0x7c,
-	0xdd, 0x33, 0x5d, 0xee, 0x6c, 0x30, 0xcc, 0x52, 0x68, 0x12, 0xc4, 0x0d, 0x95, 0xae, 0xe9, 0xc7,
-	0xac, 0xeb, 0x9b, 0x3f, 0x30, 0x8c, 0xb2, 0x14, 0xaa, 0x20, 0xa4, 0x63, 0xfe, 0xc0, 0xc6, 0x9c,
-	0xee, 0xd2, 0x64, 0xa7, 0x7b, 0x33, 0xe1, 0x74, 0x87, 0xb6, 0xb8, 0x95, 0xb2, 0xc5, 0x1f, 0x42,
-	0x63, 0xa0, 0x7d, 0xdf, 0xfd, 0x2e, 0x64, 0xa1, 0x5c, 0x71, 0x19, 0xa5, 0xa8, 0x36, 0xd0, 0xbe,
-	0xff, 0x15, 0x07, 0xe2, 0xa2, 0x89, 0xc8, 0xb7, 0x7d, 0xa1, 0xc8, 0xf7, 0xd6, 0xa4, 0xc8, 0x37,
-	0xed, 0xfc, 0x6f, 0x5f, 0xda, 0xf9, 0xdf, 0xb9, 0x96, 0xf3, 0x5f, 0xb9, 0x8c, 0xf3, 0xdf, 0x80,
-	0x6a, 0xdf, 0x0c, 0x8e, 0x1d, 0xe7, 0xa4, 0x1b, 0x7a, 0x16, 0x46, 0x43, 0xca, 0x56, 0xe3, 0xdd,
-	0xdb, 0x55, 0x78, 0x2e, 0xc0, 0xaf, 0xe9, 0x3e, 0x05, 0x49, 0xf2, 0xda, 0xb3, 0x46, 0xfd, 0xda,
-	0xda, 0x74, 0xbf, 0x86, 0xc6, 0x42, 0xb3, 0x8d, 0xa3, 0x33, 0x8c, 0x8e, 0xd0, 0x58, 0x60, 0x77,
-	0x34, 0xea, 0x50, 0x2f, 0x12, 0x75, 0xdc, 0xbd, 0x5a, 0xd4, 0xf1, 0xe1, 0x94, 0xa8, 0xe3, 0x5e,
-	0x3a, 0xea, 0x20, 0x8b, 0x50, 0xf6, 0x9f, 0x76, 0x39, 0x1b, 0xef, 0x8b, 0x37, 0x77, 0xff, 0xe9,
-	0xab, 0x30, 0xe0, 0x0e, 0x66, 0x20, 0x5f, 0x67, 0x31, 0x88, 0x89, 0x1c, 0x4c, 0xf4, 0x64, 0x4b,
-	0x63, 0x34, 0x4f, 0x10, 0x3c, 0x16, 0x15, 0x31, 0x71, 0x7d, 0x11, 0xd9, 0xd4, 0x63, 0x28, 0xdf,
-	0xc5, 0x35, 0x3d, 0xe0, 0x73, 0xa8, 0x27, 0xcd, 0x1a, 0xe6, 0x09, 0x71, 0x2e, 0x6e, 0xda, 0x3d,
-	0x47, 0xbe, 0x4a, 0xcf, 0x8d, 0x59, 0x40, 0x1a, 0x57, 0x10, 0x78, 0x4f, 0xfd, 0xc7, 0x22, 0xb4,
-	0xb6, 0xd1, 0x0b, 0x24, 0x93, 0x5e, 0x61, 0x71, 0x2e, 0xe3, 0x56, 0xc7, 0xb2, 0xd5, 0xfc, 0x25,
-	0xca, 0x56, 0x85, 0xf3, 0xb2, 0xb9, 0xe2, 0x45, 0xb2, 0xb9, 0xd2, 0x79, 0x65, 0xab, 0xf2, 0x39,
-	0x65, 0xab, 0x99, 0x0b, 0x24, 0x7b, 0x95, 0xa9, 0x65, 0x2b, 0xe5, 0x92, 0x65, 0x2b, 0xb8, 0x68,
-	0xd9, 0xaa, 0x7a, 0xa9, 0x8c, 0xbe, 0x36, 0xa9, 0x6c, 0x55, 0xbf, 0x5a, 0xd9, 0xa2, 0x71, 0x89,
-	0xb2, 0xd5, 0xdf, 0xe5, 0x60, 0x79, 0xcf, 0xe6, 0x72, 0x1f, 0x64, 0x48, 0xd4, 0x95, 0x0a, 0x58,
-	0x97, 0x97, 0xad, 0x55, 0xa8, 0x1e, 0x59, 0x8e, 0x7e, 0x22, 0x1d, 0x79, 0x41, 0x3c, 0x81, 0x23,
-	0x48, 0xf8, 0x6b, 0x02, 0xc5, 0x5e, 0x68, 0x59, 0xd1, 0xcb, 0x21, 0x6f, 0xab, 0xff, 0x9e, 0x83,
-	0xa5, 0x7d, 0xd3, 0x0f, 0xae, 0xa7, 0x08, 0xeb, 0x50, 0x43, 0x27, 0x38, 0xdc, 0x6b, 0x61, 0xec,
-	0x8a, 0x91, 0x40, 0x6e, 0xf5, 0x4a, 0xf5, 0xde, 0x63, 0xd3, 0x0f, 0x1c, 0xef, 0x4c, 0xea, 0x45,
-	0xd4, 0x8d, 0x4f, 0x55, 0x1a, 0x9e, 0x8a, 0xb4, 0xa1, 0xf2, 0xed, 0x77, 0xcf, 0x4c, 0x2b, 0x60,
-	0x9e, 0xfc, 0xea, 0x20, 0xee, 0xab, 0x1e, 0xdc, 0x7c, 0x66, 0x85, 0xfe, 0x71, 0xc6, 0x89, 0xef,
-	0xc1, 0x8c, 0xd8, 0x4f, 0xf4, 0x7d, 0x4b, 0x6a, 0x43, 0x11, 0x8e, 0x3c, 0x86, 0x5a, 0xe0, 0x74,
-	0xa3, 0xc3, 0x47, 0x9f, 0xb3, 0x8c, 0x30, 0xa7, 0x1a, 0x38, 0x51, 0xdb, 0x57, 0x5f, 0x41, 0x6b,
-	0x87, 0x59, 0x2c, 0xd3, 0xde, 0x5c, 0x45, 0x3a, 0xd4, 0x3f, 0xcb, 0xc1, 0x52, 0x27, 0x70, 0xdc,
-	0xff, 0x3f, 0x69, 0x1b, 0x2a, 0x5e, 0x21, 0xa9, 0x78, 0xea, 0x1f, 0x17, 0xe0, 0xce, 0x6b, 0xd7,
-	0x48, 0xdb, 0x56, 0xa1, 0xb2, 0xd7, 0xd9, 0xe0, 0x8f, 0xd3, 0xa9, 0xef, 0x45, 0x8d, 0x42, 0x6a,
-	0x6f, 0xff, 0x27, 0x8f, 0x06, 0xef, 0xcb, 0xbc, 0xa6, 0xad, 0xb8, 0x32, 0xb1, 0x96, 0x76, 0xce,
-	0xa3, 0x81, 0xfa, 0x2f, 0x79, 0x68, 0x3c, 0x67, 0xc1, 0xbe, 0xd3, 0xf7, 0xaf, 0xa0, 0xd8, 0x57,
-	0x79, 0xb1, 0x8f, 0xb9, 0xd4, 0x43, 0x85, 0xf3, 0xe5, 0x57, 0x8c, 0xc8, 0x16, 0xa1, 0x83, 0xfe,
-	0xf0, 0x19, 0xbf, 0x38, 0xe9, 0x19, 0x7f, 0x09, 0xca, 0x03, 0xcd, 0xe7, 0x0a, 0x2c, 0x14, 0x5b,
-	0xf6, 0x38, 0xbc, 0xe7, 0x58, 0x96, 0xf3, 0x06, 0x99, 0x5f, 0xa1, 0xb2, 0x87, 0x0f, 0x5d, 0x9a,
-	0x19, 0x3d, 0xd3, 0x60, 0x9b, 0x3c, 0x80, 0x66, 0xe8, 0xb3, 0xae, 0xe5, 0x9c, 0x98, 0xdd, 0x23,
-	0x4d, 0x3f, 0x61, 0xb6, 0x60, 0x76, 0x85, 0x36, 0x42, 0x9f, 0xed, 0x3b, 0x27, 0xe6, 0x96, 0x80,
-	0x92, 0x0d, 0x28, 0xf9, 0xa6, 0xad, 0x33, 0xe9, 0xcc, 0xa6, 0xc4, 0x91, 0x82, 0x4e, 0xfd, 0xd7,
-	0x3c, 0xc0, 0xbe, 0xd3, 0xff, 0x9a, 0xf9, 0xbe, 0xd6, 0xc7, 0x54, 0x28, 0xe6, 0x53, 0xa2, 0xdc,
-	0x12, 0x33, 0xef, 0xa5, 0x36, 0x60, 0xd7, 0x79, 0xdd, 0x4c, 0xbd, 0xa1, 0x16, 0xa6, 0xbe, 0xa1,
-	0xde, 0x87, 0x8a, 0x88, 0x31, 0x4d, 0x11, 0x42, 0x28, 0x5b, 0xd5, 0x77, 0x6f, 0x57, 0x67, 0xc4,
-	0x27, 0x14, 0x3b, 0x74, 0x06, 0x91, 0x7b, 0xc6, 0x44, 0x06, 0x47, 0xcf, 0x99, 0xe5, 0xc9, 0xcf,
-	0x99, 0xf1, 0xd7, 0x98, 0xe2, 0xab, 0x2d, 0xf1, 0x35, 0xe6, 0x23, 0xc8, 0x07, 0xbe, 0x2c, 0x81,
-	0x4c, 0xf3, 0x9a, 0xf9, 0xc0, 0xe7, 0x8a, 0x38, 0x10, 0x9c, 0x43, 0x86, 0x2b, 0x34, 0xea, 0xaa,
-	0x03, 0x98, 0xa7, 0x42, 0x27, 0x85, 0x34, 0x5c, 0xc7, 0x66, 0x8c, 0xca, 0x61, 0x7e, 0x4c, 0x0e,
-	0xd5, 0x9f, 0xc1, 0xbc, 0xf4, 0xdb, 0xa9, 0xe5, 0xce, 0xfd, 0xca, 0x44, 0x35, 0xa1, 0xc9, 0xdd,
-	0xe6, 0xf5, 0x37, 0x19, 0x27, 0x96, 0xf9, 0x09, 0x89, 0xa5, 0xba, 0x05, 0x4a, 0x9c, 0x41, 0x25,
-	0xde, 0x6e, 0x73, 0xc9, 0xb7, 0x5b, 0x6e, 0x2e, 0x78, 0x8e, 0x27, 0x9f, 0xe9, 0xc5, 0xbb, 0xae,
-	0xc2, 0x21, 0xe2, 0x51, 0xfe, 0x1f, 0x72, 0xd0, 0x48, 0x27, 0x0f, 0xe4, 0x05, 0xd4, 0x6d, 0xc7,
-	0x60, 0x5d, 0x9f, 0x59, 0x4c, 0x0f, 0x1c, 0x4f, 0xba, 0xbc, 0x7b, 0x19, 0x89, 0xc6, 0xfa, 0x4b,
-	0xc7, 0x60, 0x1d, 0x49, 0x27, 0x6a, 0x08, 0x35, 0x3b, 0x01, 0x22, 0xeb, 0x30, 0xef, 0x7a, 0xa6,
-	0xe3, 0x99, 0xc1, 0x59, 0x57, 0xb7, 0x34, 0xdf, 0x17, 0x4a, 0x20, 0x8a, 0x83, 0x73, 0x11, 0x6a,
-	0x9b, 0x63, 0xb8, 0x26, 0xb4, 0xbf, 0x84, 0xb9, 0xb1, 0x29, 0x2f, 0xf5, 0x75, 0xe6, 0x5f, 0x02,
-	0x2c, 0xa6, 0x23, 0xf8, 0x2b, 0x18, 0xb7, 0x61, 0x35, 0x2b, 0x7f, 0x81, 0x6a, 0xd6, 0xe5, 0x2a,
-	0x65, 0x59, 0xb5, 0xaf, 0xe2, 0xd5, 0x6a, 0x5f, 0xa5, 0xc9, 0xb5, 0xaf, 0x25, 0x28, 0x87, 0xe8,
-	0x6b, 0x23, 0x63, 0x28, 0x7a, 0xe3, 0x95, 0x99, 0x99, 0x8c, 0xca, 0xcc, 0x30, 0xeb, 0xab, 0x24,
-	0xb3, 0xbe, 0xcc, 0x82, 0x8d, 0x72, 0xad, 0x82, 0x0d, 0xbc, 0x87, 0x82, 0x4d, 0xf5, 0xaa, 0x05,
-	0x9b, 0xda, 0x05, 0x0b, 0x36, 0xf5, 0xf3, 0x0a, 0x36, 0x8d, 0xf3, 0x0a, 0x36, 0xb3, 0xe3, 0x05,
-	0x9b, 0xdb, 0xf8, 0x1d, 0xa8, 0x70, 0xcb, 0x58, 0xf5, 0xaa, 0xd0, 0x21, 0x20, 0xa3, 0x44, 0x33,
-	0x37, 0xbd, 0x44, 0x43, 0x2e, 0x54, 0xa2, 0x99, 0xbf, 0x58, 0x89, 0x66, 0xe1, 0xd2, 0x25, 0x9a,
-	0xc5, 0x6b, 0x95, 0x68, 0x96, 0x2e, 0x53, 0xa2, 0xc9, 0xaa, 0x74, 0x25, 0xea, 0x2a, 0xad, 0xa9,
-	0x75, 0x95, 0xe5, 0x8b, 0xd4, 0x55, 0xda, 0x57, 0xab, 0xab, 0xdc, 0x9a, 0x52, 0x57, 0xb9, 0x3d,
-	0x52, 0x57, 0x19, 0x29, 0x1b, 0xdd, 0x99, 0x5e, 0x36, 0x4a, 0x96, 0x5b, 0x56, 0x2e, 0x5b, 0x6e,
-	0x59, 0xcd, 0x28, 0xb7, 0xa8, 0xdb, 0xb0, 0x34, 0x92, 0x95, 0x5e, 0xde, 0x4a, 0xaa, 0x7f, 0x91,
-	0x83, 0xf9, 0x64, 0x86, 0x78, 0x05, 0x43, 0x9b, 0x48, 0xde, 0xf2, 0xe9, 0xe4, 0xed, 0x21, 0x34,
-	0x35, 0x1e, 0xbe, 0x75, 0x4d, 0x5b, 0x77, 0x06, 0x2e, 0x4f, 0x91, 0x64, 0xe2, 0x3a, 0x8b, 0xf0,
-	0xbd, 0x18, 0x9c, 0xca, 0xe9, 0x8a, 0x23, 0x39, 0xdd, 0x1f, 0xe5, 0x60, 0x31, 0x9d, 0x60, 0x5d,
-	0x61, 0x97, 0x4d, 0x28, 0x68, 0x96, 0xf8, 0xf4, 0xb9, 0x42, 0x79, 0x93, 0xfb, 0x9f, 0x9e, 0xe3,
-	0xe9, 0xd1, 0x96, 0x44, 0x87, 0x5f, 0xf9, 0x09, 0x63, 0xae, 0x78, 0xe0, 0x17, 0xb9, 0x74, 0x85,
-	0x03, 0x28, 0x73, 0x1d, 0x75, 0x13, 0x16, 0x3a, 0x3c, 0x7a, 0xb9, 0x06, 0xc3, 0xbf, 0x82, 0xf9,
-	0x64, 0x6a, 0x77, 0x85, 0x19, 0xfe, 0x26, 0x07, 0x84, 0x86, 0xf6, 0x35, 0x78, 0xf1, 0x53, 0x00,
-	0xd7, 0x73, 0x4e, 0x99, 0xad, 0xf1, 0xa0, 0x58, 0x24, 0xb8, 0x8b, 0x09, 0xc1, 0x3d, 0x88, 0x91,
-	0x34, 0x41, 0x98, 0x15, 0xe1, 0x16, 0x2e, 0x16, 0xe1, 0xaa, 0x9f, 0x41, 0x83, 0x86, 0xf6, 0xb6,
-	0xe7, 0xd8, 0x57, 0x38, 0xf0, 0x43, 0x98, 0x17, 0xd1, 0x80, 0xf8, 0x97, 0x52, 0x34, 0x03, 0x81,
-	0x22, 0xfe, 0xf3, 0x27, 0x27, 0x3e, 0x52, 0xe6, 0x6d, 0xf5, 0x53, 0x98, 0x17, 0x92, 0x92, 0x26,
-	0xbd, 0x0b, 0x65, 0xf1, 0xcf, 0x27, 0xb9, 0x54, 0x35, 0xf1, 0x7f, 0x29, 0x2a, 0x51, 0xea, 0x67,
-	0xb0, 0x20, 0xf5, 0xe9, 0x0a, 0x83, 0x6f, 0x43, 0x59, 0x40, 0x32, 0x9f, 0x64, 0xff, 0x24, 0x07,
-	0x20, 0xd0, 0xf8, 0xb6, 0x77, 0x91, 0x19, 0xe3, 0x6f, 0xfb, 0xf2, 0x89, 0x6f, 0xfb, 0xf6, 0x80,
-	0xe0, 0xfb, 0x96, 0xe9, 0xd8, 0xdd, 0xf8, 0x0f, 0x74, 0x32, 0x5c, 0x99, 0x16, 0x95, 0xcf, 0x45,
-	0xa3, 0x62, 0x90, 0xfa, 0x65, 0xf4, 0x57, 0x39, 0x51, 0x6c, 0x7d, 0x0c, 0x55, 0xb1, 0x6e, 0xb2,
-	0xd4, 0x3a, 0x9b, 0xd8, 0x17, 0x16, 0x5a, 0xc1, 0x8f, 0xdb, 0xea, 0x22, 0xcc, 0x6f, 0xea, 0x81,
-	0x79, 0xaa, 0x05, 0x6c, 0x33, 0x0c, 0x8e, 0x25, 0xb7, 0xd4, 0x25, 0x58, 0x48, 0x83, 0x7d, 0xd7,
-	0xb1, 0x7d, 0xf6, 0xe8, 0x87, 0xd4, 0x97, 0xf3, 0xa2, 0x64, 0xd5, 0x84, 0xda, 0x8b, 0x57, 0x5b,
-	0xdd, 0xce, 0xe1, 0x26, 0x3d, 0xdc, 0x7b, 0xf9, 0xbc, 0x79, 0x83, 0xcc, 0x42, 0x95, 0x43, 0xe8,
-	0xeb, 0x97, 0x2f, 0x39, 0x20, 0x17, 0x01, 0x9e, 0x6d, 0xee, 0xed, 0xbf, 0xa6, 0xbb, 0xcd, 0x7c,
-	0x04, 0xe8, 0xbc, 0xde, 0xde, 0xde, 0xed, 0x74, 0x9a, 0x05, 0xd2, 0x00, 0xe0, 0x80, 0x5f, 0xee,
-	0xed, 0xef, 0xef, 0xee, 0x34, 0x8b, 0x64, 0x0e, 0xea, 0xbc, 0xbf, 0xfb, 0x9c, 0xee, 0x76, 0x3a,
-	0x7c, 0x92, 0xd2, 0xa3, 0x57, 0x00, 0xc3, 0x4f, 0xc4, 0x09, 0x40, 0x99, 0x4f, 0xb7, 0xbb, 0xd3,
-	0xbc, 0x41, 0xaa, 0x30, 0x13, 0xcd, 0x94, 0xc3, 0xce, 0x2f, 0xf7, 0x0e, 0x0e, 0x76, 0x77, 0x9a,
-	0x79, 0x52, 0x83, 0x4a, 0xbc, 0xaf, 0x02, 0xa9, 0x83, 0x42, 0x77, 0xb7, 0x5f, 0x7d, 0xb3, 0x4b,
-	0xf9, 0x1a, 0x8f, 0xbe, 0x84, 0x6a, 0xe2, 0x61, 0x9e, 0xef, 0xe9, 0xe0, 0xd5, 0x4e, 0xbc, 0xeb,
-	0x1b, 0x11, 0x60, 0x38, 0x75, 0x03, 0x80, 0x03, 0xe4, 0xba, 0xf9, 0x47, 0x7f, 0x9d, 0x1b, 0x56,
-	0xbb, 0xc5, 0x1c, 0x8b, 0x30, 0x77, 0xb0, 0x77, 0xb0, 0xbb, 0xbf, 0xf7, 0x72, 0x37, 0xc9, 0x90,
-	0x05, 0x68, 0xc6, 0xe0, 0x21, 0x57, 0x6e, 0xc2, 0xfc, 0x10, 0xba, 0x1b, 0x93, 0xe7, 0x53, 0xe4,
-	0x11, 0xcf, 0x0a, 0x64, 0x1e, 0x66, 0x63, 0xe8, 0xc1, 0xe6, 0xeb, 0x0e, 0xf2, 0x29, 0x49, 0xda,
-	0x39, 0xdc, 0x7c, 0xb9, 0xb3, 0xf5, 0x7b, 0xcd, 0x52, 0x6a, 0x1b, 0xdb, 0x74, 0xb3, 0xf3, 0x0b,
-	0x3e, 0x6f, 0xf9, 0xc9, 0xaf, 0xeb, 0x50, 0xd8, 0x3c, 0xd8, 0x23, 0xcf, 0x60, 0x6e, 0xac, 0xb4,
-	0x4e, 0xee, 0xc8, 0xff, 0x52, 0x64, 0x97, 0xdc, 0xdb, 0x63, 0x29, 0x92, 0x7a, 0x83, 0xec, 0x03,
-	0x19, 0xaf, 0xa8, 0x92, 0x15, 0x19, 0xc6, 0x4d, 0x28, 0xb5, 0xb6, 0x17, 0x46, 0x67, 0x42, 0x41,
-	0xbc, 0x41, 0x7e, 0x01, 0xb3, 0x23, 0x55, 0x4e, 0x72, 0x0b, 0x49, 0xb3, 0x6b, 0x9f, 0x93, 0xe6,
-	0x79, 0x9c, 0x23, 0x2f, 0xa0, 0x39, 0x5a, 0x3e, 0x24, 0xb7, 0x91, 0x7a, 0x42, 0x55, 0x71, 0xca,
-	0x5c, 0xfb, 0x30, 0x37, 0x56, 0x16, 0x94, 0xbc, 0x9a, 0x54, 0x2e, 0x6c, 0x2f, 0x8d, 0x29, 0xf1,
-	0xee, 0xc0, 0x0d, 0xce, 0xc4, 0x19, 0x47, 0x4a, 0x82, 0xf2, 0x8c, 0xd9, 0x85, 0xc2, 0x29, 0x33,
-	0x7d, 0x0a, 0xb5, 0x64, 0x56, 0x4c, 0x5a, 0x49, 0xae, 0x27, 0x53, 0xde, 0x76, 0x63, 0x98, 0x19,
-	0x4b, 0x4e, 0x7f, 0x0c, 0x4a, 0x9c, 0x18, 0x93, 0xc5, 0x98, 0xc7, 0xd3, 0x47, 0x3d, 0xce, 0x91,
-	0x2d, 0xfc, 0x42, 0x3a, 0x4e, 0xfc, 0xe5, 0x9a, 0x19, 0xb5, 0x80, 0x29, 0xfb, 0x7e, 0x06, 0x8d,
-	0xb4, 0x8c, 0x91, 0x76, 0x86, 0xe0, 0x9d, 0x3f, 0xcf, 0x36, 0xcc, 0x8e, 0x88, 0x98, 0xe4, 0x64,
-	0x76, 0x34, 0xd5, 0x1e, 0x7f, 0x70, 0x52, 0x6f, 0x90, 0x2f, 0xa0, 0x96, 0x14, 0x2e, 0x79, 0xa0,
-	0x8c, 0x48, 0xaa, 0x4d, 0xc6, 0x86, 0xfb, 0xe2, 0x30, 0x69, 0x21, 0x90, 0x87, 0xc9, 0x8c, 0x73,
-	0xa6, 0x1c, 0x66, 0x07, 0xea, 0xa9, 0x88, 0x84, 0x2c, 0x4b, 0xa1, 0x18, 0x8f, 0x52, 0xa6, 0xcc,
-	0xb2, 0x05, 0xb5, 0xa4, 0x18, 0xc9, 0xd3, 0x64, 0xc4, 0x29, 0x53, 0xe6, 0xf8, 0x0a, 0xaa, 0x89,
-	0xa8, 0x84, 0x88, 0x7f, 0x30, 0x8f, 0xc7, 0x29, 0x53, 0x66, 0xf8, 0x39, 0xcc, 0xc8, 0x20, 0x81,
-	0xcc, 0x47, 0xa3, 0x13, 0x21, 0xc3, 0xf4, 0xfd, 0x27, 0x23, 0x04, 0xb9, 0xff, 0x8c, 0xa0, 0x61,
-	0xfa, 0x1c, 0xc9, 0xd0, 0x41, 0xce, 0x91, 0x11, 0x4d, 0x4c, 0x3d, 0x01, 0x70, 0x11, 0x90, 0x33,
-	0x4c, 0xa0, 0x6b, 0x37, 0x47, 0xdc, 0x2a, 0x97, 0x87, 0xdf, 0x81, 0x7a, 0x2a, 0xf8, 0x90, 0xf7,
-	0x98, 0x15, 0x90, 0xb4, 0x47, 0xdd, 0x32, 0x0e, 0x57, 0xc4, 0x4e, 0x37, 0x2d, 0x6b, 0xe2, 0xba,
-	0x93, 0xf7, 0xfd, 0x14, 0x66, 0x64, 0x15, 0x59, 0x72, 0x3e, 0x5d, 0x53, 0x96, 0x2b, 0x0e, 0x2b,
-	0xa2, 0xa8, 0xd3, 0xbb, 0x50, 0x4b, 0x7a, 0x7a, 0xc9, 0xb0, 0x8c, 0x98, 0xa0, 0xbd, 0x9c, 0x81,
-	0x11, 0x61, 0x81, 0x7a, 0x83, 0x7c, 0x03, 0x4b, 0xd9, 0x2f, 0x0a, 0x44, 0xc5, 0x61, 0x53, 0x9f,
-	0x1b, 0x26, 0x9f, 0x69, 0xeb, 0x67, 0xbf, 0x79, 0xb7, 0x92, 0xfb, 0xa7, 0x77, 0x2b, 0xb9, 0x7f,
-	0x7b, 0xb7, 0x92, 0xfb, 0xfd, 0x87, 0x7d, 0x33, 0x38, 0x0e, 0x8f, 0xd6, 0x75, 0x67, 0xb0, 0xe1,
-	0x6a, 0xfa, 0xf1, 0x99, 0xc1, 0xbc, 0x64, 0xeb, 0xf4, 0xc9, 0x86, 0xef, 0xe9, 0x1b, 0xae, 0xeb,
-	0x1f, 0x95, 0x71, 0xaa, 0xa7, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x28, 0x72, 0xab, 0xe9, 0xe4,
-	0x40, 0x00, 0x00,
+	// 5453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x7c, 0xcb, 0x73, 0x1c, 0xc9,
+	0x56, 0xb7, 0xbb, 0x4b, 0x2d, 0x75, 0x9f, 0x7e, 0x2a, 0x25, 0xd9, 0x65, 0xd9, 0x96, 0x34, 0x35,
+	0x63, 0x8f, 0xed, 0x99, 0x4f, 0x9e, 0x6b, 0x7f, 0x33, 0x77, 0xee, 0xdc, 0xcb, 0xcc, 0xd5, 0xa3,
+	0xed, 0x2b, 0x5f, 0x5d, 0x4b, 0x53, 0x2d, 0x0f, 0x01, 0x9b, 0x8e, 0xea, 0xee, 0xec, 0x56, 0x59,
+	0xd5, 0x55, 0x35, 0xf5, 0x90, 0xad, 0xd9, 0xb0, 0x24, 0x60, 0x45, 0xb0, 0x21, 0x82, 0x3d, 0x0b,
+	0x20, 0x88, 0xb8, 0xac, 0xd8, 0xf0, 0x07, 0x40, 0x04, 0x44, 0x40, 0x00, 0x5b, 0x07, 0xe1, 0x0d,
+	0x1b, 0x56, 0xb0, 0x82, 0x15, 0x71, 0x4e, 0x66, 0x55, 0x57, 0xf5, 0x53, 0x0f, 0x07, 0xac, 0x54,
+	0x79, 0xf2, 0xe4, 0xa3, 0x4e, 0x9d, 0x3c, 0xe7, 0x97, 0xbf, 0xcc, 0x16, 0x94, 0x5d, 0xd7, 0x7f,
+	0xe4, 0xba, 0xfe, 0xa6, 0xeb, 0x39, 0x81, 0xc3, 0x14, 0xd7, 0xf5, 0x57, 0x6f, 0xf5, 0x1c, 0xa7,
+	0x67, 0xf1, 0x47, 0x24, 0x6a, 0x85, 0xdd, 0x47, 0xbc, 0xef, 0x06, 0x67, 0x42, 0x63, 0x75, 0x7d,
+	0xb8, 0x32, 0x30, 0xfb, 0xdc, 0x0f, 0x8c, 0xbe, 0x2b, 0x15, 0xd6, 0x86, 0x15, 0x3a, 0xa1, 0x67,
+	0x04, 0xa6, 0x63, 0xcb, 0xfa, 0xe5, 0x9e, 0xd3, 0x73, 0xe8, 0xf1, 0x11, 0x3e, 0x49, 0x69, 0xd9,
+	0xed, 0xfa, 0x8f, 0xdc, 0xae, 0x9c, 0x87, 0x76, 0x02, 0xc5, 0x06, 0x6f, 0x7b, 0x3c, 0xf8, 0x95,
+	0x13, 0xda, 0x01, 0x63, 0x30, 0x67, 0x1b, 0x7d, 0xae, 0x66, 0x36, 0x32, 0xf7, 0x0b, 0x3a, 0x3d,
+	0xb3, 0x1a, 0x28, 0x27, 0xfc, 0x4c, 0xcd, 0x92, 0x08, 0x1f, 0xd9, 0x1d, 0x80, 0x3e, 0xaa, 0x37,
+	0x5d, 0x23, 0x38, 0x56, 0x15, 0xaa, 0x28, 0x90, 0xe4, 0xd0, 0x08, 0x8e, 0xd9, 0x0d, 0x58, 0xe0,
+	0xf6, 0x69, 0xf3, 0xd4, 0xf0, 0xd4, 0x39, 0xaa, 0x9b, 0xe7, 0xf6, 0xe9, 0x77, 0x86, 0xa7, 0xfd,
+	0xe3, 0x1c, 0x14, 0x8e, 0x3c, 0xc3, 0xf6, 0xbb, 0x8e, 0xd7, 0x67, 0xcb, 0x90, 0x33, 0xfb, 0x46,
+	0x2f, 0x1a, 0x4c, 0x14, 0x70, 0xb4, 0x76, 0xbf, 0xa3, 0x66, 0x37, 0x14, 0x1c, 0xad, 0xdd, 0xef,
+	0x50, 0x77, 0x9e, 0xd7, 0x44, 0xa9, 0x42, 0xd2, 0x79, 0xee, 0x79, 0x3b, 0xfd, 0x0e, 0x7b, 0x00,
+	0x0a, 0xb7, 0x4f, 0xd5, 0xb9, 0x0d, 0xe5, 0x7e, 0xf1, 0xf1, 0x8d, 0x4d, 0x34, 0x6e, 0xdc, 0xfb,
+	0x66, 0xdd, 0x3e, 0xad, 0xdb, 0x81, 0x77, 0xa6, 0xa3, 0x0e, 0x7b, 0x08, 0x0b, 0x3e, 0xbd, 0xa6,
+	0xaf, 0xe6, 0x48, 0xbd, 0x46, 0xea, 0x89, 0x57, 0xd7, 0x23, 0x05, 0xf6, 0x29, 0x30, 0x9a, 0x4a,
+	0xd3, 0x0d, 0x2d, 0xab, 0x19, 0x35, 0x9b, 0xa7, 0xa1, 0x6b, 0x54, 0x73, 0x18, 0x5a, 0x56, 0x43,
+	0x6a, 0x2f, 0x43, 0xce, 0x0f, 0x3a, 0xa6, 0xad, 0x2e, 0x90, 0x82, 0x28, 0xb0, 0x5b, 0x50, 0xc0,
+	0x39, 0x8b, 0x9a, 0x3c, 0xd5, 0xe4, 0xb9, 0xe7, 0x35, 0xa8, 0xf2, 0x53, 0x60, 0x46, 0xbb, 0xcd,
+	0xdd, 0xa0, 0xe9, 0xf1, 0x20, 0xf4, 0xec, 0x66, 0xdb, 0xe9, 0x70, 0xb5, 0xb0, 0xa1, 0xdc, 0x57,
+	0xf4, 0x9a, 0xa8, 0xd1, 0xa9, 0x62, 0xc7, 0xe9, 0x70, 0x1c, 0xa0, 0xc3, 0x5b, 0x61, 0x4f, 0x85,
+	0x8d, 0xcc, 0xfd, 0xbc, 0x2e, 0x0a, 0xf8, 0xa1, 0x42, 0x9f, 0x7b, 0x6a, 0x51, 0x7c, 0x28, 0x7c,
+	0x66, 0xeb, 0x50, 0x7c, 0xed, 0x78, 0x27, 0xa6, 0xdd, 0x6b, 0x76, 0x4c, 0x4f, 0x2d, 0x51, 0x15,
+	0x48, 0xd1, 0xae, 0xe9, 0xb1, 0x35, 0x80, 0x8e, 0xd3, 0x3e, 0xe1, 0x5e, 0xd7, 0xb4, 0xb8, 0x5a,
+	0x16, 0xf5, 0x03, 0x09, 0xfb, 0x08, 0x72, 0xad, 0xd0, 0xb4, 0x3a, 0x6a, 0x65, 0x23, 0x73, 0xbf,
+	0xf8, 0xb8, 0x42, 0x36, 0xda, 0x46, 0x49, 0xc3, 0xe5, 0x6d, 0x5d, 0x54, 0xb2, 0xbb, 0x50, 0xe9,
+	0x18, 0x41, 0xd8, 0x6f, 0xb6, 0x8c, 0xa0, 0x7d, 0x6c, 0xda, 0x3d, 0xb5, 0x4a, 0x33, 0x2b, 0x93,
+	0x74, 0x5b, 0x0a, 0xd9, 0xff, 0x03, 0xf0, 0x78, 0xe0, 0x9d, 0x35, 0x7d, 0x97, 0xb7, 0xd5, 0x5a,
+	0xa2, 0x47, 0x1d, 0xc5, 0xd4, 0x63, 0xc1, 0x8b, 0x1e, 0x57, 0xbf, 0x80, 0x7c, 0xf4, 0xc9, 0x22,
+	0x8f, 0xcb, 0x0c, 0x3c, 0x6e, 0x19, 0x72, 0xa7, 0x86, 0x15, 0x72, 0xe9, 0x85, 0xa2, 0xf0, 0x55,
+	0xf6, 0xcb, 0x8c, 0xf6, 0x6f, 0x59, 0x28, 0xc4, 0x1d, 0xb2, 0x6d, 0xa8, 0x9a, 0xb6, 0x19, 0x98,
+	0x86, 0xd5, 0x6c, 0x19, 0xed, 0x13, 0xa7, 0xdb, 0xa5, 0x5e, 0x8a, 0x8f, 0x6f, 0x6e, 0x8a, 0xd5,
+	0xb2, 0x19, 0xad, 0x96, 0xcd, 0x5d, 0xb9, 0x5a, 0xf4, 0x8a, 0x6c, 0xb1, 0x2d, 0x1a, 0xb0, 0xaf,
+	0xa0, 0xd8, 0x37, 0xde, 0xc4, 0xed, 0xb3, 0xb3, 0xda, 0x43, 0xdf, 0x78, 0x13, 0xb5, 0x5d, 0x03,
+	0xe8, 0x87, 0x56, 0x60, 0xba, 0x96, 0xc9, 0x3d, 0x5a, 0x19, 0x19, 0x3d, 0x21, 0x61, 0xd7, 0x61,
+	0xfe, 0x95, 0x19, 0x04, 0x5c, 0xac, 0x8c, 0x8c, 0x2e, 0x4b, 0xec, 0x3e, 0xd4, 0x84, 0xb1, 0xf8,
+	0x1b, 0x33, 0x20, 0x7f, 0x10, 0x8e, 0xaa, 0xe8, 0x15, 0x92, 0xd7, 0xdf, 0x98, 0x01, 0x7a, 0x83,
+	0xcf, 0xee, 0x41, 0xb5, 0x6b, 0x98, 0x56, 0x52, 0x71, 0x9e, 0x14, 0xcb, 0x28, 0x4e, 0xe9, 0xf9,
+	0x27, 0xa6, 0x9b, 0xd4, 0x5b, 0x10, 0x7a, 0x28, 0x1e, 0xe8, 0xdd, 0x87, 0xda, 0x2b, 0xa7, 0xd5,
+	0x14, 0xa3, 0xb7, 0xc2, 0x4e, 0x8f, 0x07, 0x6a, 0x7e, 0x23, 0x83, 0x23, 0xbf, 0x72, 0x5a, 0x64,
+	0xd9, 0x6d, 0x92, 0x6a, 0xdf, 0x42, 0x21, 0xf6, 0x05, 0xf4, 0x3f, 0x5a, 0xfc, 0x32, 0x50, 0xe0,
+	0x33, 0x5b, 0x85, 0xbc, 0x65, 0xd8, 0xbd, 0x10, 0xd7, 0xb4, 0xf8, 0x4e, 0x71, 0x79, 0xb0, 0xd8,
+	0x95, 0xc4, 0x62, 0xd7, 0x1e, 0x40, 0xee, 0xe8, 0xe9, 0x73, 0xa7, 0xc5, 0x36, 0x60, 0x3e, 0xe8,
+	0x36, 0x5f, 0x39, 0x2d, 0xd1, 0xe1, 0x76, 0xe1, 0xdd, 0xdb, 0x75, 0x51, 0xa5, 0xe7, 0x82, 0xee,
+	0x73, 0xa7, 0xa5, 0xad, 0xc2, 0x7c, 0xbd, 0xe7, 0x71, 0xdf, 0x47, 0xef, 0x78, 0xa9, 0xef, 0x47,
+	0xde, 0xf1, 0x52, 0xdf, 0xd7, 0xee, 0x42, 0xf1, 0xd0, 0x74, 0xb9, 0x65, 0xda, 0x1c, 0x3b, 0xbb,
+	0x0e, 0x59, 0xb3, 0x23, 0x3b, 0x9a, 0x7f, 0xf7, 0x76, 0x3d, 0xbb, 0xb7, 0xab, 0x67, 0xcd, 0x8e,
+	0xf6, 0xdf, 0x19, 0xc8, 0xff, 0x8a, 0x07, 0x46, 0xc7, 0x08, 0x0c, 0xf6, 0x73, 0x28, 0x1a, 0xb6,
+	0xed, 0x04, 0xf4, 0x0d, 0x7d, 0x35, 0x43, 0x51, 0x61, 0x8d, 0xfc, 0x33, 0xd2, 0xd9, 0xdc, 0x1a,
+	0x28, 0x88, 0x58, 0x92, 0x6c, 0xc2, 0x7e, 0x04, 0xf3, 0x96, 0xd1, 0xe2, 0x96, 0x4f, 0xc1, 0x0a,
+	0x5d, 0x24, 0xd5, 0x78, 0x9f, 0xea, 0x44, 0x3b, 0xa9, 0xb8, 0xfa, 0x35, 0xd4, 0x86, 0xfb, 0xbc,
+	0x88, 0xb3, 0xaf, 0xfe, 0x04, 0x8a, 0x89, 0x6e, 0x2f, 0xb4, 0x4e, 0x7e, 0x07, 0x16, 0x1a, 0xdc,
+	0x3b, 0x35, 0xdb, 0x9c, 0x7d, 0x08, 0x65, 0xd3, 0x0e, 0xb8, 0x67, 0x1b, 0x56, 0xd3, 0x75, 0xbc,
+	0x80, 0x3a, 0xc8, 0xe9, 0xa5, 0x48, 0x78, 0xe8, 0x78, 0x01, 0x2a, 0xf1, 0x37, 0x49, 0xa5, 0xac,
+	0x50, 0xe2, 0x6f, 0x12, 0x4a, 0x68, 0x69, 0x57, 0x55, 0x12, 0x96, 0x3e, 0xd4, 0xb3, 0xa6, 0x8b,
+	0xde, 0x11, 0x9c, 0xb9, 0x5c, 0x86, 0x7f, 0x7a, 0xd6, 0x1e, 0x41, 0xae, 0xe1, 0x3a, 0x61, 0xc0,
+	0xee, 0x61, 0x2c, 0xa6, 0x99, 0xc8, 0xb5, 0x59, 0x92, 0xb1, 0x98, 0x64, 0x7a, 0x54, 0xa9, 0xfd,
+	0x53, 0x16, 0xf2, 0x87, 0x4f, 0x1b, 0x7b, 0xb6, 0x1b, 0x8e, 0x4f, 0x4c, 0x0c, 0xe6, 0x3c, 0xee,
+	0x3a, 0xf2, 0x5d, 0xe9, 0x19, 0x03, 0x2f, 0xfe, 0x6d, 0xd2, 0xf0, 0x22, 0xc2, 0xe5, 0x51, 0x70,
+	0x74, 0xe6, 0x72, 0x5c, 0x7d, 0x2d, 0xcf, 0xb0, 0xdb, 0x51, 0xce, 0x92, 0x25, 0x94, 0xb7, 0x9d,
+	0x7e, 0xdf, 0x0c, 0xa2, 0x7c, 0x25, 0x4a, 0x38, 0x40, 0xcf, 0x72, 0x5a, 0x6a, 0x4e, 0x0c, 0x80,
+	0xcf, 0x98, 0x8d, 0x5e, 0x39, 0xa6, 0xdd, 0x74, 0x6c, 0x75, 0x5e, 0x28, 0x63, 0xf1, 0xc0, 0xc6,
+	0xa4, 0xe8, 0x84, 0x01, 0xf7, 0x9a, 0x58, 0x56, 0x17, 0x28, 0x24, 0x16, 0x48, 0xf2, 0xdc, 0x31,
+	0x6d, 0x76, 0x13, 0xf2, 0x3d, 0xcf, 0x09, 0xdd, 0x66, 0xeb, 0x8c, 0xd6, 0x57, 0x41, 0x5f, 0xa0,
+	0xf2, 0xf6, 0x19, 0x0e, 0x63, 0x19, 0x3f, 0x9c, 0xa9, 0x05, 0x6a, 0x43, 0xcf, 0x18, 0xcb, 0x09,
+	0x0c, 0x34, 0x31, 0x30, 0xfb, 0x32, 0xf6, 0x03, 0x89, 0x9e, 0xa2, 0x84, 0x55, 0x20, 0xeb, 0x3f,
+	0xa1, 0xf0, 0x9f, 0xd7, 0xb3, 0xfe, 0x13, 0xb4, 0x6a, 0xe0, 0x99, 0xbd, 0x1e, 0x17, 0x81, 0x9f,
+	0xac, 0xda, 0xc5, 0x84, 0x48, 0x32, 0x3d, 0xaa, 0xd4, 0xfe, 0x2e, 0x03, 0x85, 0x1d, 0xcf, 0xb1,
+	0xdf, 0xaf, 0x59, 0xa5, 0xf9, 0x94, 0x61, 0xf3, 0x51, 0xec, 0x97, 0x5e, 0x80, 0xcf, 0xec, 0x36,
+	0x14, 0x9c, 0x53, 0xee, 0xbd, 0xf6, 0xcc, 0x80, 0xab, 0x39, 0x69, 0xa4, 0x48, 0xc0, 0x3e, 0xc3,
+	0x64, 0x6a, 0x78, 0x01, 0x99, 0xb6, 0xf8, 0x78, 0x75, 0x24, 0xe8, 0x1e, 0x45, 0x18, 0x48, 0x17,
+	0x8a, 0x9a, 0x09, 0xf9, 0x67, 0x66, 0x30, 0xf9, 0x65, 0x6e, 0x82, 0x12, 0x7a, 0x96, 0x78, 0x97,
+	0xed, 0x85, 0x77, 0x6f, 0xd7, 0x31, 0x60, 0xe8, 0x28, 0xbb, 0xa8, 0x37, 0x68, 0xff, 0x99, 0x81,
+	0x9c, 0x18, 0x68, 0x1d, 0x14, 0xb7, 0xeb, 0x4b, 0xef, 0x2d, 0x93, 0xf7, 0x46, 0x8e, 0xaa, 0x63,
+	0x0d, 0x5b, 0x83, 0x39, 0xf2, 0x02, 0x11, 0x18, 0x80, 0x34, 0x44, 0x35, 0xc9, 0xd9, 0x06, 0xe4,
+	0xe8, 0xe3, 0xab, 0xca, 0x88, 0x82, 0xa8, 0x40, 0x8d, 0xb6, 0xe7, 0xf8, 0xbe, 0x3a, 0x37, 0xaa,
+	0x41, 0x15, 0xa8, 0x11, 0xda, 0xa6, 0x63, 0xab, 0xb9, 0x51, 0x0d, 0xaa, 0x60, 0x1a, 0xcc, 0xb5,
+	0x3d, 0xe9, 0xa7, 0x51, 0xee, 0x8d, 0x3f, 0xbd, 0x4e, 0x75, 0xf8, 0x2a, 0x3d, 0x33, 0x50, 0x17,
+	0x12, 0xaf, 0x12, 0xd9, 0x53, 0xc7, 0x1a, 0xcd, 0x87, 0x5a, 0x22, 0xb6, 0x4e, 0x36, 0xf4, 0x87,
+	0xb1, 0xd5, 0x44, 0xc2, 0x2c, 0x92, 0xfb, 0xed, 0x90, 0x68, 0x64, 0x41, 0x29, 0x89, 0x05, 0x15,
+	0x79, 0xff, 0xdc, 0xc0, 0xfb, 0xb5, 0x03, 0xa8, 0x1e, 0x1a, 0x9e, 0x61, 0x59, 0xdc, 0x32, 0xfd,
+	0x3e, 0x25, 0x9c, 0x55, 0xc8, 0xb7, 0x1d, 0xdb, 0x0f, 0x0c, 0x5b, 0xc4, 0xab, 0x39, 0x3d, 0x2e,
+	0xb3, 0x0d, 0x28, 0xb6, 0x1d, 0xde, 0xed, 0x9a, 0x6d, 0x93, 0xdb, 0x62, 0x02, 0x19, 0x3d, 0x29,
+	0xd2, 0x9e, 0x40, 0x81, 0xa6, 0x8e, 0x6b, 0x67, 0x6c, 0xee, 0x62, 0x30, 0x77, 0x6c, 0xf8, 0xc7,
+	0xd4, 0xb6, 0xa4, 0xd3, 0xb3, 0x76, 0x04, 0xb9, 0x5d, 0x84, 0x34, 0x93, 0x12, 0x0a, 0x7b, 0x02,
+	0x25, 0x57, 0xda, 0x86, 0x72, 0x97, 0x78, 0x73, 0x01, 0x2d, 0x13, 0x46, 0xd3, 0x8b, 0xee, 0xa0,
+	0x80, 0x6e, 0x54, 0xa0, 0x6e, 0xf7, 0xec, 0xae, 0x83, 0x5f, 0x91, 0x60, 0x93, 0x74, 0x26, 0xf1,
+	0x15, 0xa9, 0x5a, 0x17, 0x15, 0xec, 0x2e, 0xad, 0x89, 0x40, 0x84, 0xf4, 0xca, 0xe3, 0xea, 0x40,
+	0xa3, 0x81, 0x62, 0x5d, 0xd4, 0xb2, 0x8f, 0x85, 0x9a, 0x4f, 0xb6, 0x2d, 0x3e, 0x5e, 0x14, 0x93,
+	0xf0, 0x9c, 0x36, 0xf7, 0x7d, 0x54, 0xf4, 0x85, 0x22, 0x02, 0x83, 0x82, 0xdb, 0xf5, 0x9b, 0xa2,
+	0xcf, 0x39, 0x52, 0x2e, 0xd0, 0xb7, 0x42, 0xdb, 0xe8, 0x79, 0xb7, 0x4b, 0xea, 0x9c, 0x7d, 0x00,
+	0x73, 0x98, 0xc7, 0xa4, 0x7b, 0x95, 0x63, 0x15, 0x9c, 0xb6, 0x4e, 0x55, 0xec, 0x01, 0x2c, 0x20,
+	0x6e, 0x30, 0x25, 0x06, 0x29, 0x26, 0x27, 0x47, 0xc8, 0x41, 0x8f, 0xea, 0xb5, 0x3f, 0xcb, 0x00,
+	0x0c, 0xe4, 0xec, 0x31, 0xcc, 0x23, 0x5c, 0xe1, 0x1d, 0x35, 0x33, 0x73, 0xa5, 0x4b, 0x4d, 0xf6,
+	0x04, 0x16, 0xce, 0x8d, 0xc9, 0x22, 0x4d, 0x5c, 0xcc, 0x1e, 0x37, 0x7c, 0xc7, 0x8e, 0x16, 0xb9,
+	0x28, 0x11, 0x40, 0x8f, 0x90, 0x11, 0x59, 0x41, 0xd1, 0xf3, 0x5c, 0x82, 0x22, 0xed, 0xd7, 0x19,
+	0x28, 0x6c, 0xf5, 0x7a, 0x1e, 0xef, 0xa1, 0x21, 0x96, 0x21, 0xd7, 0xc6, 0x1d, 0x02, 0x4d, 0x55,
+	0xd1, 0x45, 0x01, 0x1d, 0xa6, 0xcf, 0x0d, 0x5b, 0x3a, 0x1b, 0x3d, 0xe3, 0x60, 0x7e, 0xd0, 0xe9,
+	0xf0, 0x53, 0x89, 0xfc, 0x64, 0x89, 0x3d, 0x80, 0x5a, 0xd7, 0xec, 0x06, 0xc7, 0x4d, 0x97, 0x7b,
+	0x6d, 0x6e, 0x07, 0xa6, 0x25, 0xc6, 0xcc, 0xe8, 0x55, 0x92, 0x1f, 0xc6, 0x62, 0xf6, 0x05, 0xdc,
+	0xb0, 0x4d, 0x9b, 0x53, 0xe0, 0x1f, 0x6a, 0x91, 0xa3, 0x16, 0x2b, 0xa2, 0xfa, 0x69, 0xba, 0x9d,
+	0xf6, 0x87, 0x59, 0x28, 0x25, 0xbf, 0x36, 0xfb, 0x1a, 0xca, 0x1d, 0xe7, 0xb5, 0x6d, 0x39, 0x46,
+	0xa7, 0x89, 0x3b, 0xc7, 0xd9, 0x38, 0xb8, 0x14, 0xe9, 0xa3, 0xe9, 0xd9, 0xcf, 0xa0, 0xe4, 0x8a,
+	0xfe, 0x44, 0xf3, 0x99, 0x26, 0x2f, 0x4a, 0x75, 0x6a, 0xfd, 0x15, 0x14, 0x43, 0x77, 0x30, 0xb6,
+	0x32, 0xab, 0x31, 0x08, 0x6d, 0x6a, 0x8b, 0xfb, 0x8b, 0x68, 0xe6, 0xad, 0xb3, 0x80, 0xfb, 0x64,
+	0xab, 0x39, 0x3d, 0x7e, 0x9f, 0x6d, 0x14, 0xb2, 0x0f, 0xa0, 0x14, 0xba, 0x09, 0xa5, 0x1c, 0x29,
+	0xc9, 0x61, 0x49, 0x45, 0xfb, 0xe3, 0x2c, 0xac, 0xc4, 0xdf, 0x31, 0x65, 0x9d, 0x27, 0xe3, 0xad,
+	0x23, 0x62, 0x64, 0xdc, 0x64, 0xc8, 0x24, 0x3f, 0x1a, 0x6b, 0x92, 0xe1, 0x36, 0x29, 0x3b, 0x3c,
+	0x1a, 0x67, 0x87, 0xe1, 0x16, 0xc9, 0x97, 0xff, 0x7c, 0xec, 0xcb, 0x8f, 0xb6, 0x19, 0x32, 0xc6,
+	0x8f, 0xc6, 0x18, 0x63, 0xcc, 0xd4, 0x92, 0xc6, 0xf9, 0xd3, 0x0c, 0x94, 0x7e, 0xd3, 0xf1, 0x4e,
+	0xb8, 0x87, 0x26, 0x09, 0x7d, 0xf6, 0x00, 0x0a, 0xaf, 0xa9, 0xdc, 0x8c, 0x83, 0x5d, 0xe9, 0xdd,
+	0xdb, 0xf5, 0xbc, 0x50, 0xda, 0xdb, 0xd5, 0xf3, 0xa2, 0x7a, 0xaf, 0xc3, 0x7e, 0x02, 0xd5, 0x64,
+	0xe0, 0xc3, 0x06, 0x22, 0xc3, 0x2e, 0xbe, 0x7b, 0xbb, 0x5e, 0x4e, 0xe6, 0x8b, 0x5d, 0xbd, 0x9c,
+	0x08, 0x7e, 0x7b, 0x14, 0x33, 0xc5, 0xee, 0xd1, 0xa7, 0x51, 0x55, 0x25, 0x11, 0x33, 0xe3, 0xa8,
+	0x16, 0xfa, 0x7a, 0xb1, 0x33, 0x28, 0x68, 0x3d, 0x28, 0x26, 0xea, 0xd8, 0xff, 0x87, 0x05, 0xca,
+	0xfe, 0xe7, 0x0a, 0x1f, 0x91, 0x2a, 0xa6, 0x43, 0x0a, 0x68, 0x22, 0x29, 0x57, 0x06, 0xf9, 0x92,
+	0x02, 0x1f, 0xd5, 0x69, 0x16, 0x94, 0x74, 0xee, 0x3b, 0xa1, 0xd7, 0xe6, 0x94, 0x75, 0x90, 0x8d,
+	0x70, 0x43, 0x1a, 0x25, 0xab, 0xe3, 0x23, 0xae, 0xf1, 0x3e, 0xef, 0x3b, 0x5e, 0x44, 0x88, 0xc8,
+	0x12, 0x5b, 0x03, 0xa5, 0xe7, 0x86, 0xaa, 0x92, 0x40, 0xb4, 0xcf, 0x0e, 0x5f, 0x62, 0x27, 0x3a,
+	0x56, 0x60, 0xbc, 0xe8, 0x98, 0xfe, 0x49, 0x04, 0x86, 0xf0, 0x59, 0xfb, 0x1c, 0x16, 0xa4, 0x4e,
+	0x8c, 0x98, 0x33, 0x03, 0xc4, 0x8c, 0x43, 0xd9, 0x61, 0xbf, 0xc5, 0x3d, 0x1a, 0x4a, 0xd1, 0x65,
+	0x49, 0xfb, 0xfd, 0x79, 0x58, 0x69, 0x04, 0x8e, 0xc7, 0x3b, 0xa9, 0xcc, 0xdc, 0x75, 0x46, 0x12,
+	0x52, 0xe6, 0x1c, 0x09, 0x89, 0x3d, 0x80, 0x7c, 0x54, 0x54, 0xb3, 0x09, 0x1c, 0x10, 0x35, 0xd0,
+	0xe3, 0x6a, 0xf6, 0x19, 0x94, 0x9d, 0x30, 0x70, 0xc3, 0xa0, 0x29, 0x12, 0xba, 0xaa, 0x8c, 0xe6,
+	0xfa, 0x92, 0xd0, 0x10, 0x25, 0xa6, 0x62, 0x8a, 0x10, 0x98, 0x4e, 0xac, 0xe2, 0xa8, 0x28, 0x69,
+	0x04, 0xa3, 0x29, 0x97, 0x0b, 0xef, 0x90, 0xd3, 0x2a, 0x44, 0x23, 0x18, 0x87, 0x91, 0x10, 0x97,
+	0x39, 0xa9, 0xe1, 0xae, 0xd5, 0xe5, 0x1d, 0x02, 0x33, 0x0a, 0x79, 0x87, 0xd1, 0x10, 0x22, 0x44,
+	0xde, 0xa4, 0x12, 0x38, 0x81, 0x61, 0x11, 0x94, 0x51, 0xf4, 0x02, 0x4a, 0x8e, 0x50, 0x80, 0x50,
+	0x9a, 0xaa, 0x65, 0xc2, 0x11, 0x9b, 0x5b, 0x6a, 0xf1, 0x94, 0x24, 0xf1, 0x4c, 0x3c, 0xde, 0x46,
+	0x28, 0xca, 0x3b, 0x6a, 0x61, 0x30, 0x13, 0x3d, 0x12, 0x0e, 0x32, 0x2c, 0xcc, 0xc8, 0xb0, 0x9b,
+	0x50, 0xa2, 0x87, 0xc8, 0x48, 0xc5, 0x51, 0x23, 0x15, 0x49, 0x41, 0x14, 0xd8, 0x27, 0x51, 0x86,
+	0x2f, 0x51, 0x86, 0x5f, 0x19, 0xfe, 0x5c, 0xa9, 0x3c, 0x3f, 0x48, 0x68, 0xe5, 0x54, 0x42, 0x4b,
+	0xac, 0x89, 0xca, 0xf9, 0xd7, 0xc4, 0x17, 0x90, 0xef, 0x9a, 0xb6, 0xe9, 0x1f, 0xf3, 0x8e, 0x5a,
+	0x9d, 0xd9, 0x2c, 0xd6, 0xc5, 0x8c, 0x46, 0x26, 0xfb, 0x3e, 0x34, 0x3c, 0xc3, 0x0e, 0x4c, 0x9b,
+	0x77, 0x88, 0xe2, 0x51, 0xf4, 0x2a, 0xca, 0xbf, 0x1d, 0x88, 0x71, 0x23, 0x29, 0x16, 0x7c, 0x04,
+	0x15, 0x16, 0x49, 0xaf, 0xd4, 0x89, 0xd0, 0x80, 0xc9, 0x7d, 0xf6, 0x25, 0xdc, 0x4c, 0x7e, 0xe5,
+	0x66, 0x2b, 0xc1, 0x84, 0xa8, 0x8c, 0x1a, 0xac, 0x24, 0x3e, 0xf9, 0x76, 0x4c, 0x88, 0x68, 0xef,
+	0x2a, 0x50, 0x7d, 0x2f, 0xcb, 0xe0, 0x53, 0x28, 0x04, 0x11, 0x7b, 0x98, 0x0a, 0xed, 0x31, 0xa7,
+	0xa8, 0x0f, 0x14, 0x52, 0x8b, 0x46, 0x99, 0xbe, 0x68, 0x1e, 0x40, 0x2d, 0x9e, 0xcd, 0x29, 0xf7,
+	0x7c, 0xc4, 0xec, 0x62, 0x2d, 0xc4, 0x41, 0xf4, 0x3b, 0x21, 0x66, 0x9f, 0x42, 0x11, 0x77, 0x49,
+	0x91, 0xe3, 0xe4, 0x46, 0x1d, 0x07, 0xb0, 0x5e, 0x3c, 0xb3, 0x6f, 0xa0, 0xe6, 0x0e, 0x50, 0xb2,
+	0xe0, 0xd9, 0x04, 0xd6, 0x5f, 0x16, 0x73, 0x49, 0x43, 0x68, 0xbd, 0xea, 0xa6, 0x05, 0x88, 0xd9,
+	0x39, 0x71, 0x2a, 0x12, 0xff, 0x17, 0xa9, 0x99, 0xa0, 0x59, 0x74, 0x59, 0xc5, 0x1e, 0x01, 0xb8,
+	0x86, 0xc7, 0xed, 0x80, 0x4c, 0x99, 0x9f, 0x60, 0xca, 0x82, 0xd0, 0x41, 0x43, 0x26, 0x3c, 0xb1,
+	0x70, 0x39, 0x4f, 0x84, 0x0b, 0x78, 0xe2, 0x48, 0x48, 0x2a, 0xce, 0x0a, 0x49, 0xef, 0x65, 0xb9,
+	0x25, 0x48, 0x8c, 0xca, 0x14, 0x12, 0x03, 0xf1, 0xbd, 0xef, 0x3a, 0x61, 0xa0, 0x56, 0x13, 0xf8,
+	0x9e, 0x78, 0x10, 0x5d, 0x54, 0xb0, 0x87, 0x50, 0x94, 0x2f, 0x40, 0xbb, 0xee, 0x5a, 0x02, 0x91,
+	0xeb, 0xdc, 0x75, 0x74, 0x10, 0xb5, 0xf8, 0x8c, 0x6b, 0x49, 0xea, 0xca, 0x9d, 0xeb, 0x22, 0x4d,
+	0x4a, 0xbe, 0xdf, 0x36, 0xc9, 0x92, 0x21, 0x97, 0xcd, 0x0a, 0xb9, 0x4b, 0xe7, 0x09, 0xb9, 0xcb,
+	0xa3, 0x21, 0x77, 0x28, 0xa6, 0xae, 0x9c, 0x23, 0xa6, 0x5e, 0x1f, 0x17, 0x53, 0xd3, 0xa1, 0xfb,
+	0xc6, 0x70, 0xe8, 0x8e, 0x43, 0xae, 0x3a, 0x23, 0xe4, 0x7e, 0x01, 0x65, 0x89, 0x5d, 0x24, 0xac,
+	0xb8, 0xb9, 0xa1, 0xc4, 0x0d, 0x92, 0x28, 0x47, 0x2f, 0xbd, 0x4e, 0x94, 0xd8, 0xd7, 0xb0, 0xe8,
+	0xc9, 0x7c, 0xdf, 0xf4, 0xf8, 0xf7, 0x21, 0xf7, 0x03, 0x5f, 0x5d, 0x4d, 0x0c, 0x96, 0x44, 0x03,
+	0x7a, 0x2d, 0xd2, 0xd5, 0xa5, 0x2a, 0xfb, 0x0a, 0xaa, 0x71, 0x7b, 0xcb, 0xec, 0x9b, 0x81, 0xaf,
+	0xde, 0x9a, 0xd4, 0xba, 0x12, 0x69, 0xee, 0x93, 0x22, 0xdb, 0x83, 0x1b, 0xbe, 0xd9, 0xe1, 0x6d,
+	0xc3, 0x6b, 0x0e, 0xf7, 0x71, 0x7b, 0x52, 0x1f, 0x2b, 0xb2, 0x85, 0x9e, 0xee, 0x6a, 0x03, 0x72,
+	0x26, 0x22, 0x19, 0xf5, 0x4e, 0xc2, 0xcb, 0x24, 0x17, 0x40, 0x15, 0x6c, 0x13, 0xc0, 0xe6, 0xaf,
+	0x23, 0xb7, 0x59, 0x23, 0xb5, 0x2a, 0x39, 0x99, 0xf0, 0x1a, 0xda, 0xd5, 0x15, 0x6c, 0xfe, 0x5a,
+	0x14, 0x47, 0x72, 0xd8, 0xfa, 0x8c, 0x1c, 0xf6, 0x01, 0x94, 0xb8, 0x6d, 0xb4, 0x2c, 0xde, 0x14,
+	0x1f, 0x6c, 0x83, 0x76, 0xf3, 0x45, 0x21, 0x13, 0x98, 0x1b, 0xe9, 0x20, 0xc3, 0x0a, 0xd4, 0x0f,
+	0x24, 0x1d, 0x64, 0x58, 0x01, 0x1e, 0x12, 0xb4, 0x8f, 0x43, 0xfb, 0x44, 0x04, 0x2f, 0x2d, 0x49,
+	0x54, 0xa0, 0x58, 0x1c, 0x12, 0xb4, 0xa3, 0x47, 0xda, 0xd4, 0x50, 0x2e, 0x41, 0x34, 0x8d, 0xab,
+	0xea, 0xc3, 0xd9, 0x9b, 0x1a, 0xd4, 0x3f, 0x12, 0xea, 0xb8, 0x2d, 0x41, 0xb8, 0x1a, 0xb5, 0xfe,
+	0x68, 0x56, 0x6b, 0x78, 0xe5, 0xb4, 0xa2, 0xb6, 0xc2, 0xe5, 0x71, 0x6c, 0xca, 0x62, 0x77, 0x63,
+	0x97, 0x0f, 0xfb, 0x47, 0x28, 0x61, 0x3f, 0x83, 0xaa, 0xdf, 0x3e, 0xe6, 0x9d, 0xd0, 0xc2, 0x13,
+	0x18, 0x7a, 0xa1, 0x7b, 0x34, 0xc0, 0x92, 0x58, 0xf4, 0x71, 0x9d, 0xf0, 0x06, 0x3f, 0x55, 0x46,
+	0x7e, 0xd0, 0x75, 0x3a, 0xa2, 0xd9, 0xc7, 0x82, 0x1f, 0x74, 0x1d, 0xc1, 0xb5, 0xdf, 0x82, 0x02,
+	0x56, 0xb9, 0x78, 0xb2, 0xa2, 0xde, 0xa7, 0x3a, 0xd4, 0x3d, 0xc4, 0xf2, 0xd8, 0x4c, 0xfc, 0x60,
+	0x7c, 0x26, 0x9e, 0x9a, 0x64, 0x1f, 0x4e, 0x4b, 0xb2, 0xbb, 0x30, 0x2f, 0x16, 0xd1, 0x58, 0xea,
+	0xe7, 0x5e, 0x9a, 0xa1, 0xa8, 0x0d, 0x2d, 0xba, 0x28, 0x96, 0x6a, 0x4f, 0x21, 0x1f, 0x85, 0xd9,
+	0x09, 0xfd, 0x2c, 0xb8, 0x9e, 0xf3, 0x8a, 0xb7, 0x23, 0x0e, 0x49, 0x50, 0x98, 0x87, 0x42, 0xa6,
+	0x47, 0x95, 0xb8, 0x3d, 0x67, 0x51, 0x47, 0xdf, 0x86, 0x3c, 0xe4, 0x11, 0x61, 0x51, 0xf2, 0x42,
+	0xdb, 0x46, 0xe3, 0xbf, 0x72, 0x5a, 0xbe, 0xdc, 0xae, 0x17, 0xa5, 0xec, 0xb9, 0xd3, 0xa2, 0x3d,
+	0xe3, 0x31, 0xb7, 0x3a, 0xd2, 0xa9, 0x7d, 0x89, 0xab, 0x8b, 0x28, 0x13, 0x7e, 0xec, 0xb3, 0x4f,
+	0x60, 0xb1, 0xed, 0x18, 0x16, 0xf7, 0xdb, 0x7c, 0xa0, 0xa7, 0x90, 0x5e, 0x2d, 0xae, 0x88, 0x94,
+	0x3f, 0x86, 0x6a, 0xc7, 0x73, 0x5c, 0x37, 0xa1, 0x2a, 0xb8, 0x84, 0x8a, 0x14, 0x4b, 0x45, 0xed,
+	0xdf, 0x15, 0x60, 0x69, 0xc8, 0x4e, 0x40, 0xe5, 0x7e, 0x64, 0xb9, 0x0c, 0x59, 0x8e, 0xa5, 0x52,
+	0xd1, 0x84, 0x3c, 0x94, 0x4d, 0xe5, 0xa1, 0x21, 0xc4, 0xa0, 0x4c, 0x47, 0x0c, 0x75, 0x40, 0x8f,
+	0x6e, 0x12, 0x83, 0x11, 0x51, 0x8b, 0xf7, 0x84, 0x77, 0x8e, 0x4c, 0x6e, 0xf3, 0xb9, 0xd3, 0xda,
+	0x21, 0x45, 0x71, 0x86, 0x51, 0x78, 0x15, 0x95, 0x31, 0x6a, 0x1b, 0x61, 0x70, 0xdc, 0x0c, 0x9c,
+	0x13, 0x6e, 0x4b, 0x76, 0xbc, 0x80, 0x92, 0x23, 0x14, 0xb0, 0x9f, 0x42, 0xc5, 0x32, 0x7c, 0xc2,
+	0x0b, 0x92, 0x66, 0x9a, 0x9f, 0x96, 0x69, 0x4b, 0xa8, 0x1c, 0x95, 0x90, 0xcb, 0x4b, 0xc0, 0x14,
+	0x02, 0x26, 0x73, 0x7a, 0x52, 0x94, 0x82, 0x5e, 0xf9, 0xe9, 0xd0, 0xeb, 0x4b, 0x28, 0x7e, 0x8f,
+	0x0e, 0x22, 0xa7, 0x21, 0xe0, 0xc8, 0x8d, 0x94, 0xf6, 0xc0, 0x81, 0x74, 0xf8, 0x3e, 0x7e, 0x5e,
+	0xfd, 0x19, 0x54, 0xd2, 0xef, 0x9f, 0x3c, 0x6c, 0xc9, 0x8d, 0x39, 0x6c, 0xc9, 0x25, 0x0f, 0x5b,
+	0x7e, 0xaf, 0x06, 0xa5, 0xd4, 0x87, 0x4e, 0xce, 0x39, 0x33, 0x7d, 0xce, 0x2a, 0x2c, 0x44, 0x28,
+	0x31, 0x2b, 0xd2, 0xf7, 0x69, 0x8c, 0x0e, 0x13, 0x08, 0x55, 0x99, 0x85, 0x50, 0x3f, 0x8d, 0x8f,
+	0xd4, 0xe6, 0x12, 0x49, 0x81, 0xce, 0xd4, 0x46, 0x8f, 0xd7, 0xc6, 0x62, 0xc9, 0xdc, 0xe5, 0xb0,
+	0xe4, 0xfc, 0x64, 0x2c, 0xf9, 0x13, 0x80, 0xb6, 0xc7, 0x8d, 0x80, 0x77, 0x9a, 0x46, 0x44, 0x3a,
+	0x4f, 0x83, 0x79, 0x05, 0xa9, 0xbd, 0x15, 0x0c, 0x96, 0x4a, 0x7e, 0xd6, 0x52, 0x51, 0x11, 0x7f,
+	0xd2, 0xea, 0x93, 0x27, 0x2a, 0x51, 0x91, 0x22, 0x04, 0x47, 0xc6, 0xac, 0xc9, 0x3d, 0xcf, 0xf1,
+	0x08, 0x67, 0x16, 0xf4, 0xa2, 0x90, 0xd5, 0x51, 0x84, 0xcb, 0x5f, 0x00, 0x04, 0x3f, 0xc2, 0x03,
+	0xbc, 0x43, 0x90, 0x52, 0xd1, 0x6b, 0xb2, 0x42, 0x8f, 0xe4, 0x49, 0x65, 0xe3, 0xd4, 0x30, 0x2d,
+	0xcc, 0x75, 0x6a, 0x29, 0xa5, 0xbc, 0x15, 0xc9, 0xd9, 0x37, 0xa9, 0xb5, 0x57, 0xa6, 0xb5, 0xb7,
+	0x91, 0x7a, 0x8b, 0x19, 0xab, 0x6e, 0x74, 0x59, 0x55, 0xce, 0xbf, 0xac, 0x46, 0x90, 0x63, 0x75,
+	0x0c, 0x72, 0x1c, 0x8b, 0x86, 0x6a, 0x57, 0x42, 0x43, 0x8b, 0xef, 0x01, 0x0d, 0xb1, 0xcb, 0xa2,
+	0xa1, 0xa5, 0x49, 0x68, 0x68, 0x03, 0x8a, 0x1d, 0xee, 0xb7, 0x3d, 0xd3, 0xc5, 0x34, 0x4f, 0x00,
+	0xb7, 0xa0, 0x27, 0x45, 0x18, 0xe2, 0xda, 0x46, 0xfb, 0x98, 0x37, 0x7d, 0xf3, 0x07, 0x4e, 0xf8,
+	0xb6, 0xa0, 0x17, 0x48, 0xd2, 0x30, 0x7f, 0xe0, 0x23, 0x70, 0xe7, 0xfa, 0x64, 0xb8, 0x73, 0x23,
+	0x01, 0x77, 0x06, 0x51, 0x5c, 0x4d, 0x45, 0xf1, 0x8f, 0xa0, 0x82, 0x57, 0x0e, 0x64, 0xac, 0xc2,
+	0x11, 0x6f, 0x8a, 0x4d, 0x72, 0xdf, 0x78, 0x23, 0x02, 0x14, 0x0e, 0x9a, 0xd8, 0x73, 0xac, 0x9e,
+	0x6b, 0xcf, 0x71, 0x6b, 0xd2, 0x9e, 0x23, 0x0d, 0xbb, 0x6e, 0x5f, 0x18, 0x76, 0xdd, 0xb9, 0x12,
+	0xec, 0x5a, 0xbb, 0x08, 0xec, 0x7a, 0x04, 0xc5, 0x9e, 0x19, 0x1c, 0x3b, 0xce, 0x49, 0x13, 0x0f,
+	0xf2, 0xd6, 0x89, 0x66, 0xac, 0xbc, 0x7b, 0xbb, 0x0e, 0xcf, 0x84, 0x18, 0xcf, 0xf3, 0x40, 0xaa,
+	0xbc, 0xf4, 0xac, 0xe1, 0x8c, 0xb8, 0x31, 0x3d, 0x23, 0x52, 0xb0, 0x30, 0xec, 0x4e, 0xeb, 0x4c,
+	0xfd, 0x20, 0x0a, 0x16, 0x54, 0x1c, 0xc6, 0x7b, 0xda, 0x79, 0xf0, 0xde, 0x87, 0x97, 0xc3, 0x7b,
+	0x1f, 0x4d, 0xc1, 0x7b, 0x77, 0x87, 0xf0, 0xde, 0x0a, 0xcc, 0xfb, 0x4f, 0x9a, 0x68, 0xc6, 0x7b,
+	0xe2, 0x3e, 0x90, 0xff, 0xe4, 0x20, 0x0c, 0x30, 0xc1, 0xf4, 0xe5, 0xcd, 0x03, 0xf5, 0xe3, 0x44,
+	0x82, 0x89, 0xae, 0x23, 0xe8, 0x71, 0x35, 0x6e, 0xcd, 0x3c, 0x1e, 0x11, 0xd9, 0x34, 0xbe, 0xc0,
+	0x94, 0xe5, 0x58, 0x4a, 0xb3, 0xd8, 0x87, 0x15, 0xe1, 0x8f, 0xb8, 0x55, 0xeb, 0x5a, 0xce, 0xeb,
+	0xa6, 0xeb, 0x58, 0x66, 0xfb, 0x8c, 0xd0, 0x65, 0xe5, 0xb1, 0x4a, 0xdd, 0x93, 0x73, 0x1e, 0x48,
+	0x85, 0x43, 0xaa, 0xd7, 0x97, 0xbe, 0x1f, 0x15, 0x0e, 0x67, 0xe2, 0x87, 0xe7, 0xce, 0xc4, 0x6c,
+	0x0f, 0x96, 0xc5, 0x77, 0xc0, 0xbd, 0x66, 0xe8, 0xf1, 0x68, 0x1a, 0x9f, 0xd0, 0x34, 0x6e, 0x0c,
+	0x88, 0xe3, 0xa7, 0xa2, 0x5e, 0xce, 0x82, 0x75, 0x46, 0x64, 0xec, 0x73, 0xb8, 0x91, 0x5e, 0x66,
+	0x4d, 0x6e, 0x77, 0x1d, 0xaf, 0xcd, 0x3b, 0xea, 0xa7, 0x64, 0xcc, 0xe5, 0xe4, 0x7a, 0xab, 0xcb,
+	0xba, 0x2b, 0x62, 0x81, 0x67, 0x50, 0x4e, 0x06, 0x78, 0xda, 0xab, 0xc6, 0x7c, 0x90, 0x69, 0x77,
+	0x1d, 0x79, 0xf7, 0x64, 0x71, 0x24, 0x17, 0xe8, 0x25, 0x37, 0x51, 0xd2, 0xfe, 0x6b, 0x0e, 0xd4,
+	0x1d, 0xca, 0x87, 0x49, 0xe2, 0x45, 0xc4, 0xde, 0x8b, 0x00, 0x8c, 0x11, 0xc6, 0x24, 0x7b, 0x01,
+	0x12, 0x57, 0x99, 0xc5, 0x28, 0xcc, 0x9d, 0x87, 0x51, 0xc8, 0xcd, 0x22, 0x71, 0xe7, 0x67, 0x90,
+	0xb8, 0x0b, 0xe7, 0x20, 0x1c, 0xf2, 0x53, 0x49, 0xdc, 0xc2, 0x05, 0x49, 0x5c, 0x38, 0x2f, 0x89,
+	0x5b, 0xbc, 0x10, 0xab, 0x54, 0x9a, 0x44, 0xe2, 0x96, 0x2f, 0x47, 0x9d, 0x55, 0xae, 0x48, 0xe2,
+	0x56, 0xc7, 0x6e, 0x1d, 0xb5, 0xbf, 0xca, 0xc0, 0xcd, 0x3d, 0x1b, 0x83, 0x45, 0x30, 0xc6, 0xf9,
+	0x2e, 0xc5, 0xb7, 0x5e, 0xdc, 0x0d, 0xd7, 0xa1, 0xd8, 0xb2, 0x9c, 0xf6, 0x89, 0x8c, 0x21, 0x8a,
+	0xb8, 0x13, 0x43, 0x22, 0x11, 0x2a, 0x18, 0xcc, 0x75, 0x43, 0xcb, 0x8a, 0xae, 0x12, 0xe0, 0xb3,
+	0xf6, 0xe7, 0x59, 0xb8, 0xbe, 0x6f, 0xfa, 0xc1, 0xd5, 0xd6, 0xcc, 0x26, 0x94, 0x4c, 0x3b, 0x35,
+	0x57, 0x65, 0xc4, 0x1b, 0x48, 0x41, 0x4e, 0xf5, 0x52, 0x07, 0x25, 0xc7, 0xa6, 0x1f, 0xe0, 0xc1,
+	0x92, 0x58, 0x42, 0x51, 0x31, 0x7e, 0xab, 0xdc, 0xe0, 0xad, 0xf0, 0x36, 0xc4, 0xab, 0xef, 0x9f,
+	0x9a, 0x56, 0xc0, 0x3d, 0x79, 0x0d, 0x29, 0x2e, 0x53, 0xfa, 0x30, 0x7a, 0x22, 0xc0, 0xc9, 0x85,
+	0x92, 0x47, 0x01, 0x61, 0x88, 0x3b, 0xc8, 0xe6, 0xf6, 0xb8, 0xdc, 0xba, 0x89, 0x8b, 0x48, 0xa4,
+	0x4e, 0x5b, 0x37, 0xcd, 0x83, 0x1b, 0x4f, 0xad, 0xd0, 0x3f, 0x1e, 0x63, 0xad, 0xbb, 0xb0, 0x10,
+	0xed, 0x71, 0x33, 0xa3, 0x6f, 0x1f, 0xd5, 0xb1, 0xcf, 0xa0, 0x14, 0x38, 0xcd, 0xc8, 0x70, 0xd1,
+	0xdd, 0xb8, 0x21, 0xc3, 0x16, 0x03, 0x27, 0x7a, 0xf6, 0xb5, 0x03, 0x50, 0x77, 0xb9, 0xc5, 0x03,
+	0xfe, 0x9e, 0x3c, 0x4b, 0xfb, 0xa3, 0x0c, 0x5c, 0x6f, 0x04, 0x8e, 0xfb, 0x7f, 0xe7, 0xa9, 0x13,
+	0x6e, 0x1d, 0x68, 0xff, 0xa1, 0xc0, 0x9d, 0x97, 0x6e, 0x27, 0x1d, 0xc2, 0x45, 0x64, 0xb8, 0xca,
+	0x04, 0x3f, 0x49, 0x13, 0x30, 0xe7, 0x8d, 0x3d, 0xa9, 0xb9, 0xfd, 0xaf, 0x9c, 0xd4, 0xbd, 0xaf,
+	0x28, 0x9e, 0x4e, 0x16, 0x85, 0x89, 0xb4, 0xf1, 0xac, 0x93, 0xba, 0x71, 0x11, 0xb0, 0x78, 0x09,
+	0xf2, 0xac, 0x34, 0x8d, 0x3c, 0xfb, 0xe7, 0x2c, 0x54, 0x9e, 0xf1, 0x60, 0xdf, 0xe9, 0xf9, 0x97,
+	0x88, 0x3c, 0x97, 0xb9, 0x63, 0x14, 0x7f, 0x8a, 0x2e, 0x45, 0x04, 0x5f, 0xde, 0x9b, 0x27, 0xdb,
+	0x8b, 0x20, 0xe1, 0x0f, 0x2e, 0x1e, 0xcd, 0x4d, 0xba, 0x78, 0x84, 0x27, 0xdd, 0x86, 0x8f, 0x11,
+	0x46, 0x44, 0x1e, 0x59, 0x42, 0x79, 0xd7, 0xb1, 0x2c, 0xe7, 0x35, 0x7d, 0xe1, 0xbc, 0x2e, 0x4b,
+	0x74, 0x84, 0x6d, 0x98, 0xd1, 0x01, 0x2c, 0x3d, 0xe3, 0xed, 0xe2, 0xd0, 0xe7, 0x4d, 0xcb, 0x39,
+	0x31, 0xe9, 0x42, 0x35, 0xb7, 0xc5, 0x17, 0xcd, 0xeb, 0x95, 0xd0, 0xe7, 0xfb, 0xce, 0x89, 0xb9,
+	0x2d, 0xa4, 0xec, 0x11, 0xe4, 0x7c, 0xd3, 0x6e, 0x47, 0x24, 0xcd, 0x94, 0xdd, 0x81, 0xd0, 0xd3,
+	0xfe, 0x25, 0x0b, 0xb0, 0xef, 0xf4, 0x7e, 0xc5, 0x7d, 0x1f, 0x2f, 0x18, 0x7f, 0x98, 0x40, 0x55,
+	0x09, 0x66, 0x31, 0x36, 0xde, 0x0b, 0x64, 0x18, 0xaf, 0x70, 0x6f, 0x21, 0x75, 0x3b, 0x42, 0x99,
+	0x7a, 0x3b, 0xe2, 0x1e, 0xe4, 0x05, 0x62, 0x35, 0x05, 0x1c, 0x2a, 0x6c, 0x17, 0xdf, 0xbd, 0x5d,
+	0x5f, 0x10, 0x97, 0xbe, 0x76, 0xf5, 0x05, 0xaa, 0xdc, 0xeb, 0x4c, 0x34, 0x70, 0x74, 0x51, 0x61,
+	0x7e, 0xf2, 0x45, 0x85, 0xf8, 0xfe, 0xbf, 0xb8, 0x67, 0x4a, 0xcf, 0xec, 0x21, 0x64, 0x03, 0x5f,
+	0xcd, 0xcf, 0x44, 0x00, 0xd9, 0xc0, 0xc7, 0xd5, 0xde, 0x17, 0x96, 0x23, 0x83, 0x17, 0xf4, 0xa8,
+	0xa8, 0xf5, 0x61, 0x49, 0x17, 0x0b, 0x5f, 0x78, 0xc3, 0x55, 0x02, 0xd3, 0xb0, 0x1f, 0x66, 0x47,
+	0xfc, 0x50, 0xfb, 0x31, 0x2c, 0x49, 0x60, 0x91, 0x1a, 0x6e, 0xe6, 0xbd, 0x38, 0xed, 0x4f, 0x32,
+	0x50, 0xc3, 0xc4, 0x7e, 0xf5, 0x59, 0xc6, 0x7c, 0x41, 0x76, 0x12, 0x5f, 0x90, 0x4a, 0xa9, 0xca,
+	0xd4, 0x94, 0x3a, 0x37, 0x9c, 0x52, 0xb7, 0xa1, 0x10, 0x6f, 0xaa, 0x13, 0x57, 0x3a, 0x32, 0xc9,
+	0x2b, 0x1d, 0xd8, 0x07, 0xed, 0x47, 0xc4, 0xed, 0x1d, 0x41, 0x4b, 0x17, 0x50, 0x22, 0xee, 0xea,
+	0xfc, 0x7d, 0x06, 0x2a, 0xe9, 0xfd, 0x24, 0x7b, 0x0e, 0x65, 0xdb, 0xe9, 0xf0, 0xa6, 0xcf, 0x2d,
+	0xde, 0x0e, 0x1c, 0x4f, 0x26, 0xe5, 0xbb, 0x63, 0xf6, 0x9e, 0x9b, 0x2f, 0x9c, 0x0e, 0x6f, 0x48,
+	0x3d, 0x41, 0x2b, 0x95, 0xec, 0x84, 0x88, 0x6d, 0xc2, 0x92, 0xeb, 0x99, 0x8e, 0x67, 0x06, 0x67,
+	0xcd, 0xb6, 0x65, 0xf8, 0xbe, 0x58, 0x41, 0x82, 0x69, 0x5e, 0x8c, 0xaa, 0x76, 0xb0, 0x06, 0x97,
+	0xd1, 0xea, 0x37, 0xb0, 0x38, 0xd2, 0xe5, 0x85, 0x2e, 0xa3, 0xff, 0x75, 0x11, 0x56, 0xd2, 0x5b,
+	0x99, 0x4b, 0x44, 0xc6, 0x01, 0xc1, 0x99, 0x3d, 0x07, 0xc1, 0x79, 0x31, 0xf2, 0x74, 0x1c, 0x1d,
+	0x3a, 0x77, 0x39, 0x3a, 0x34, 0x37, 0x99, 0x0e, 0xbd, 0x0e, 0xf3, 0x21, 0xa1, 0x81, 0x28, 0x92,
+	0x8a, 0xd2, 0x28, 0x59, 0xb7, 0x30, 0x86, 0xac, 0x1b, 0x10, 0x01, 0xf9, 0x24, 0x11, 0x30, 0x96,
+	0xc3, 0x2b, 0x5c, 0x89, 0xc3, 0x83, 0xf7, 0xc0, 0xe1, 0x15, 0x2f, 0xcb, 0xe1, 0x95, 0xce, 0xc9,
+	0xe1, 0x95, 0x67, 0x71, 0x78, 0x95, 0x59, 0x1c, 0x5e, 0x75, 0x94, 0xc3, 0xbb, 0x4d, 0xd7, 0xde,
+	0x05, 0x70, 0x20, 0x22, 0x34, 0xaf, 0x0f, 0x04, 0x63, 0x58, 0xbb, 0xc5, 0xe9, 0xac, 0x1d, 0x3b,
+	0x17, 0x6b, 0xb7, 0x74, 0x3e, 0xd6, 0x6e, 0xf9, 0xc2, 0xac, 0xdd, 0xca, 0x95, 0x58, 0xbb, 0xeb,
+	0x17, 0x61, 0xed, 0xc6, 0x91, 0x9f, 0x09, 0xaa, 0x4d, 0x9d, 0x4a, 0xb5, 0xdd, 0x3c, 0x0f, 0xd5,
+	0xb6, 0x7a, 0x39, 0xaa, 0xed, 0xd6, 0x14, 0xaa, 0xed, 0xf6, 0x10, 0xd5, 0x36, 0xc4, 0x24, 0xde,
+	0x99, 0xce, 0x24, 0x26, 0x19, 0xb8, 0xb5, 0x8b, 0x32, 0x70, 0xeb, 0x17, 0x62, 0xe0, 0x36, 0x2e,
+	0xc3, 0xc0, 0x4d, 0xe2, 0xd1, 0x3e, 0xb8, 0x30, 0x8f, 0xa6, 0xed, 0xc0, 0xf5, 0x21, 0x32, 0xe0,
+	0xe2, 0xe1, 0x5b, 0xfb, 0xdb, 0x0c, 0x2c, 0x25, 0x37, 0xe6, 0x97, 0xc8, 0x00, 0x89, 0x3d, 0x73,
+	0x36, 0xbd, 0x67, 0x7e, 0x00, 0x35, 0x03, 0x41, 0x69, 0xd3, 0xb4, 0xdb, 0x4e, 0xdf, 0xb5, 0x78,
+	0xcc, 0x17, 0x54, 0x49, 0xbe, 0x17, 0x8b, 0x53, 0x5b, 0xe9, 0xb9, 0xa1, 0xad, 0x74, 0xe2, 0x44,
+	0x3a, 0x37, 0xed, 0x44, 0xfa, 0x77, 0x33, 0xb0, 0x92, 0xde, 0xc3, 0x5e, 0xe2, 0x6d, 0x6a, 0xa0,
+	0x18, 0x96, 0xf8, 0xa9, 0x4a, 0x5e, 0xc7, 0x47, 0x4c, 0xa0, 0x44, 0x41, 0xca, 0xa9, 0x8b, 0x02,
+	0xfa, 0xec, 0x09, 0xe7, 0xae, 0xb8, 0x2e, 0x24, 0xa8, 0x8e, 0x3c, 0x0a, 0x74, 0xee, 0x3a, 0xda,
+	0x16, 0x2c, 0x37, 0x10, 0xbb, 0x5d, 0xe1, 0xc3, 0xfc, 0x1c, 0x96, 0x92, 0xbb, 0xe7, 0x4b, 0xf4,
+	0xf0, 0x97, 0x19, 0x60, 0x7a, 0x68, 0x5f, 0xc1, 0x16, 0x9f, 0x03, 0xb8, 0x9e, 0x73, 0xca, 0x6d,
+	0x03, 0xb7, 0x04, 0x82, 0x43, 0x58, 0x49, 0xac, 0xbc, 0xc3, 0xb8, 0x52, 0x4f, 0x28, 0x8e, 0xc3,
+	0xf7, 0xca, 0xf9, 0xf0, 0xbd, 0xf6, 0x53, 0xa8, 0xe8, 0xa1, 0x8d, 0x3f, 0x8f, 0xb9, 0xc4, 0x0b,
+	0x3f, 0x80, 0x25, 0x01, 0x67, 0xc4, 0xaf, 0x82, 0xa3, 0x1e, 0x90, 0xb9, 0x31, 0x2d, 0xd1, 0xba,
+	0xa4, 0xd3, 0xb3, 0xf6, 0x15, 0x2c, 0x09, 0x4f, 0x49, 0xab, 0x7e, 0x08, 0xf3, 0xe2, 0x97, 0xc6,
+	0x6a, 0x26, 0x81, 0x17, 0xa4, 0x8e, 0xac, 0xd2, 0x7e, 0x0a, 0xcb, 0x72, 0xdd, 0x5d, 0xa2, 0xf1,
+	0x6d, 0x98, 0x17, 0x92, 0x71, 0x77, 0x2f, 0xb4, 0x3f, 0xc8, 0x00, 0x88, 0x6a, 0x3a, 0xaf, 0x3e,
+	0x4f, 0x8f, 0xf1, 0x9d, 0xe5, 0x6c, 0xe2, 0xce, 0xf2, 0x1e, 0x30, 0x3a, 0xb3, 0x35, 0x1d, 0xbb,
+	0x19, 0xff, 0x60, 0x5d, 0x55, 0x66, 0xee, 0x49, 0x16, 0xa3, 0x56, 0xb1, 0x48, 0xfb, 0x06, 0x8a,
+	0x83, 0x19, 0x21, 0xb1, 0x54, 0x14, 0xe3, 0x26, 0x49, 0xf3, 0x6a, 0x62, 0x5e, 0xa8, 0xa6, 0x83,
+	0x1f, 0x3f, 0x6b, 0x2b, 0xb0, 0xb4, 0xd5, 0x0e, 0xcc, 0x53, 0x23, 0xe0, 0x5b, 0x61, 0x70, 0x2c,
+	0xad, 0xa5, 0x5d, 0x87, 0xe5, 0xb4, 0xd8, 0x77, 0x1d, 0xdb, 0xe7, 0x0f, 0x7f, 0x48, 0xfd, 0xd2,
+	0x49, 0x30, 0x8a, 0x35, 0x28, 0x3d, 0x3f, 0xd8, 0x6e, 0x36, 0x8e, 0xb6, 0xf4, 0xa3, 0xbd, 0x17,
+	0xcf, 0x6a, 0xd7, 0x58, 0x15, 0x8a, 0x28, 0xd1, 0x5f, 0xbe, 0x78, 0x81, 0x82, 0x4c, 0x24, 0x78,
+	0xba, 0xb5, 0xb7, 0xff, 0x52, 0xaf, 0xd7, 0xb2, 0x91, 0xa0, 0xf1, 0x72, 0x67, 0xa7, 0xde, 0x68,
+	0xd4, 0x14, 0x56, 0x01, 0x40, 0xc1, 0x2f, 0xf7, 0xf6, 0xf7, 0xeb, 0xbb, 0xb5, 0x39, 0xb6, 0x08,
+	0x65, 0x2c, 0xd7, 0x9f, 0xe9, 0xf5, 0x46, 0x03, 0x3b, 0xc9, 0x3d, 0x3c, 0x90, 0xbf, 0x8e, 0x11,
+	0xa3, 0x02, 0xcc, 0x63, 0x77, 0xf5, 0xdd, 0xda, 0x35, 0x56, 0x84, 0x85, 0xa8, 0xa7, 0x0c, 0x15,
+	0x7e, 0xb9, 0x77, 0x78, 0x58, 0xdf, 0xad, 0x65, 0x59, 0x09, 0xf2, 0xf1, 0xbc, 0x14, 0x56, 0x86,
+	0x82, 0x5e, 0xdf, 0x39, 0xf8, 0xae, 0xae, 0xe3, 0x18, 0x0f, 0xbf, 0x81, 0x62, 0xe2, 0x06, 0x0e,
+	0xce, 0xe9, 0xf0, 0x60, 0x37, 0x9e, 0xf5, 0xb5, 0x48, 0x30, 0xe8, 0xba, 0x02, 0x80, 0x02, 0x39,
+	0x6e, 0xf6, 0xe1, 0x73, 0x58, 0x1a, 0x93, 0x5a, 0xb0, 0xdd, 0xb7, 0x2f, 0xeb, 0x2f, 0xeb, 0xcd,
+	0xed, 0xfd, 0x83, 0x9d, 0x5f, 0xd6, 0xae, 0x31, 0x06, 0x15, 0x21, 0xd8, 0x39, 0xd8, 0xda, 0xaf,
+	0x37, 0x76, 0xea, 0xa2, 0x2f, 0x21, 0xdb, 0xd5, 0x0f, 0x0e, 0x6b, 0xd9, 0x87, 0x8f, 0x81, 0x8d,
+	0x66, 0x16, 0x9c, 0x3f, 0x8e, 0xd6, 0x7c, 0x7e, 0xb0, 0x5d, 0xbb, 0x26, 0xda, 0x6c, 0xe9, 0x5b,
+	0x2f, 0x8e, 0xf6, 0x5e, 0xd4, 0x6b, 0x99, 0x87, 0x7f, 0x91, 0x19, 0x9c, 0x9b, 0x88, 0x77, 0x58,
+	0x81, 0xc5, 0xc3, 0xbd, 0xc3, 0xfa, 0xfe, 0xde, 0x8b, 0x7a, 0xf2, 0x83, 0x2c, 0x43, 0x2d, 0x16,
+	0x0f, 0xbe, 0xca, 0x0d, 0x58, 0x1a, 0x48, 0xeb, 0xb1, 0x7a, 0x36, 0xa5, 0x1e, 0x7d, 0x33, 0x85,
+	0x2d, 0x41, 0x35, 0x96, 0x1e, 0x6e, 0xbd, 0x6c, 0xd0, 0x77, 0x4a, 0xaa, 0x36, 0x8e, 0xb6, 0x5e,
+	0xec, 0x6e, 0xff, 0x56, 0x2d, 0x97, 0x9a, 0xc6, 0x8e, 0xbe, 0xd5, 0xf8, 0x05, 0xf6, 0x3b, 0xff,
+	0xf8, 0xd7, 0x65, 0x50, 0xb6, 0x0e, 0xf7, 0xd8, 0x53, 0x58, 0x1c, 0x39, 0xa4, 0x61, 0x77, 0xe4,
+	0x6f, 0xef, 0xc6, 0x1f, 0xde, 0xac, 0x8e, 0xec, 0x4f, 0xb5, 0x6b, 0x6c, 0x1f, 0xd8, 0x28, 0xe1,
+	0xce, 0xd6, 0x24, 0x0e, 0x9e, 0xc0, 0xc4, 0xaf, 0x2e, 0x0f, 0xf7, 0x44, 0x0b, 0xe1, 0x1a, 0xfb,
+	0x05, 0x54, 0x87, 0x48, 0x70, 0x76, 0x8b, 0x54, 0xc7, 0x53, 0xe3, 0x93, 0xfa, 0xf9, 0x2c, 0xc3,
+	0x9e, 0x43, 0x6d, 0x98, 0x21, 0x66, 0xb7, 0x49, 0x7b, 0x02, 0x71, 0x3c, 0xa5, 0xaf, 0x7d, 0x58,
+	0x1c, 0x61, 0x7e, 0xa5, 0xad, 0x26, 0x31, 0xc2, 0xab, 0xd7, 0x47, 0x82, 0x48, 0x1d, 0x7f, 0x14,
+	0x2b, 0xde, 0x71, 0x88, 0xf5, 0x95, 0xef, 0x38, 0x9e, 0x0b, 0x9e, 0xd2, 0xd3, 0x57, 0x50, 0x4a,
+	0x72, 0x12, 0x4c, 0x4d, 0x5a, 0x3d, 0xc9, 0x37, 0xac, 0x56, 0x06, 0xb0, 0x49, 0x5a, 0xfa, 0x0b,
+	0x28, 0xc4, 0xac, 0x04, 0x5b, 0x89, 0x6d, 0x3c, 0xbd, 0xd5, 0x67, 0x19, 0xb6, 0x4d, 0xbf, 0x3c,
+	0x89, 0x69, 0x17, 0x39, 0xe6, 0x18, 0x26, 0x66, 0xca, 0xbc, 0x9f, 0x42, 0x25, 0xed, 0x63, 0x6c,
+	0x75, 0x8c, 0xe3, 0xcd, 0xee, 0x67, 0x07, 0xaa, 0x43, 0x2e, 0x26, 0x2d, 0x39, 0x1e, 0xf5, 0xad,
+	0x8e, 0x1e, 0x5d, 0x6a, 0xd7, 0xd8, 0xd7, 0x50, 0x4a, 0x3a, 0x97, 0x7c, 0xa1, 0x31, 0x88, 0x6f,
+	0x95, 0x8d, 0x34, 0xf7, 0xc5, 0xcb, 0xa4, 0x9d, 0x40, 0xbe, 0xcc, 0x58, 0x9c, 0x35, 0xe5, 0x65,
+	0x76, 0xa1, 0x9c, 0x42, 0x44, 0xec, 0xa6, 0x74, 0x8a, 0x51, 0x94, 0x34, 0xa5, 0x97, 0x6d, 0x28,
+	0x25, 0xdd, 0x48, 0xbe, 0xcd, 0x18, 0x9c, 0x34, 0xa5, 0x8f, 0x9f, 0x43, 0x31, 0x81, 0x8a, 0x98,
+	0x80, 0xdc, 0xa3, 0x38, 0x69, 0x4a, 0x0f, 0x5f, 0xc2, 0x82, 0x04, 0x29, 0x6c, 0x29, 0x6a, 0x9d,
+	0x80, 0x2c, 0xd3, 0xe7, 0x9f, 0x44, 0x28, 0x72, 0xfe, 0x63, 0x40, 0xcb, 0xf4, 0x3e, 0x92, 0xd0,
+	0x45, 0xf6, 0x31, 0x06, 0xcd, 0x4c, 0x7d, 0x03, 0x40, 0x17, 0x90, 0x3d, 0x4c, 0xd0, 0x5b, 0xad,
+	0x0d, 0xa5, 0x75, 0xf4, 0x87, 0xdf, 0x80, 0x72, 0x0a, 0xfc, 0xc8, 0xef, 0x38, 0x0e, 0x10, 0xad,
+	0x0e, 0xc3, 0x02, 0x6a, 0x5e, 0x10, 0x33, 0xdd, 0xb2, 0xac, 0x89, 0xe3, 0x4e, 0x9e, 0xf7, 0x13,
+	0x58, 0x90, 0x1c, 0xbe, 0xb4, 0x7c, 0x9a, 0xd1, 0x97, 0x23, 0x0e, 0xf8, 0x68, 0x5a, 0xd3, 0x75,
+	0x28, 0x25, 0x91, 0x86, 0x34, 0xd8, 0x18, 0x4c, 0xb2, 0x7a, 0x73, 0x4c, 0x8d, 0x80, 0x25, 0xda,
+	0x35, 0xf6, 0x1d, 0x5c, 0x1f, 0x7f, 0x68, 0xc4, 0x34, 0x6a, 0x36, 0xf5, 0x44, 0x69, 0xf2, 0x3b,
+	0x6d, 0xff, 0xf8, 0x6f, 0xde, 0xad, 0x65, 0xfe, 0xe1, 0xdd, 0x5a, 0xe6, 0x5f, 0xdf, 0xad, 0x65,
+	0x7e, 0xfb, 0x01, 0xde, 0xa1, 0x09, 0x5b, 0x9b, 0x6d, 0xa7, 0xff, 0xc8, 0x35, 0xda, 0xc7, 0x67,
+	0x1d, 0xee, 0x25, 0x9f, 0x4e, 0x1f, 0x3f, 0xf2, 0xbd, 0x36, 0xfe, 0x0b, 0xa3, 0xd6, 0x3c, 0x75,
+	0xf5, 0xe4, 0x7f, 0x06, 0x00, 0x03, 0x96, 0x74, 0xcd, 0xd4, 0x48, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -6927,6 +7536,30 @@ func (m *Transform) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.RetrySpec != nil {
+		{
+			size, err := m.RetrySpec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.DatumBatching {
+		i--
+		if m.DatumBatching {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.Build != nil {
 		{
 			size, err := m.Build.MarshalToSizedBuffer(dAtA[:i])
@@ -6971,21 +7604,21 @@ func (m *Transform) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x50
 	}
 	if len(m.AcceptReturnCode) > 0 {
-		dAtA3 := make([]byte, len(m.AcceptReturnCode)*10)
-		var j2 int
+		dAtA4 := make([]byte, len(m.AcceptReturnCode)*10)
+		var j3 int
 		for _, num1 := range m.AcceptReturnCode {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j2++
+				j3++
 			}
-			dAtA3[j2] = uint8(num)
-			j2++
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintPps(dAtA, i, uint64(j2))
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintPps(dAtA, i, uint64(j3))
 		i--
 		dAtA[i] = 0x4a
 	}
@@ -7077,7 +7710,7 @@ func (m *Transform) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *BuildSpec) Marshal() (dAtA []byte, err error) {
+func (m *RetrySpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7087,12 +7720,12 @@ func (m *BuildSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BuildSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *RetrySpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BuildSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RetrySpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7101,19 +7734,144 @@ func (m *BuildSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Image) > 0 {
-		i -= len(m.Image)
-		copy(dAtA[i:], m.Image)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Image)))
+	if m.JobRetryBudget != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.JobRetryBudget))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x40
 	}
-	if len(m.Language) > 0 {
-		i -= len(m.Language)
-		copy(dAtA[i:], m.Language)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Language)))
+	if len(m.SkipExitCodes) > 0 {
+		dAtA6 := make([]byte, len(m.SkipExitCodes)*10)
+		var j5 int
+		for _, num1 := range m.SkipExitCodes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintPps(dAtA, i, uint64(j5))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x3a
+	}
+	if len(m.FailExitCodes) > 0 {
+		dAtA8 := make([]byte, len(m.FailExitCodes)*10)
+		var j7 int
+		for _, num1 := range m.FailExitCodes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintPps(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RetryExitCodes) > 0 {
+		dAtA10 := make([]byte, len(m.RetryExitCodes)*10)
+		var j9 int
+		for _, num1 := range m.RetryExitCodes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintPps(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Jitter != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Jitter))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.Multiplier != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Multiplier))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.MaxBackoff != nil {
+		{
+			size, err := m.MaxBackoff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.InitialBackoff != nil {
+		{
+			size, err := m.InitialBackoff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BuildSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuildSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuildSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Image) > 0 {
+		i -= len(m.Image)
+		copy(dAtA[i:], m.Image)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Image)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Language) > 0 {
+		i -= len(m.Language)
+		copy(dAtA[i:], m.Language)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Language)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if len(m.Path) > 0 {
 		i -= len(m.Path)
@@ -7987,6 +8745,20 @@ func (m *DatumInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Retries) > 0 {
+		for iNdEx := len(m.Retries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Retries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Data) > 0 {
 		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -8045,6 +8817,69 @@ func (m *DatumInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DatumRetry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DatumRetry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DatumRetry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExitCode != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.ExitCode))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Backoff != nil {
+		{
+			size, err := m.Backoff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Failed != nil {
+		{
+			size, err := m.Failed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Aggregate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8489,6 +9324,27 @@ func (m *StoredPipelineJobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DataSkippedByExitCode != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DataSkippedByExitCode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.DatumRetries != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumRetries))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.DataQuarantined != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DataQuarantined))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if m.Finished != nil {
 		{
 			size, err := m.Finished.MarshalToSizedBuffer(dAtA[:i])
@@ -8642,6 +9498,20 @@ func (m *PipelineJobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DataSkippedByExitCode != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DataSkippedByExitCode))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.DataQuarantined != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DataQuarantined))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc8
+	}
 	if len(m.PodPatch) > 0 {
 		i -= len(m.PodPatch)
 		copy(dAtA[i:], m.PodPatch)
@@ -9150,6 +10020,18 @@ func (m *Pipeline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Project != nil {
+		{
+			size, err := m.Project.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
 		copy(dAtA[i:], m.Name)
@@ -9160,6 +10042,53 @@ func (m *Pipeline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PipelineQueueState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PipelineQueueState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PipelineQueueState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DroppedCommits != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DroppedCommits))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CoalescedCommits != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.CoalescedCommits))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HeldCommits != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.HeldCommits))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RunningJobs != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.RunningJobs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *StoredPipelineInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9184,6 +10113,18 @@ func (m *StoredPipelineInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.QueueState != nil {
+		{
+			size, err := m.QueueState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.Pipeline != nil {
 		{
 			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
@@ -9279,18 +10220,28 @@ func (m *PipelineInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ReprocessSpec) > 0 {
-		i -= len(m.ReprocessSpec)
-		copy(dAtA[i:], m.ReprocessSpec)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.ReprocessSpec)))
+	if m.MaxQueueSizeEnforced {
+		i--
+		if m.MaxQueueSizeEnforced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0xc2
+		dAtA[i] = 0xe0
 	}
-	if m.Metadata != nil {
+	if m.DatumFailurePolicy != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumFailurePolicy))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.QueueState != nil {
 		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.QueueState.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9300,15 +10251,45 @@ func (m *PipelineInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0xba
+		dAtA[i] = 0xd2
 	}
-	if m.S3Out {
-		i--
-		if m.S3Out {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.QueueOverflowPolicy != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.QueueOverflowPolicy))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc8
+	}
+	if len(m.ReprocessSpec) > 0 {
+		i -= len(m.ReprocessSpec)
+		copy(dAtA[i:], m.ReprocessSpec)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.ReprocessSpec)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.S3Out {
+		i--
+		if m.S3Out {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -9773,6 +10754,11 @@ func (m *CreatePipelineJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DataQuarantined != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DataQuarantined))
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.Finished != nil {
 		{
 			size, err := m.Finished.MarshalToSizedBuffer(dAtA[:i])
@@ -9985,6 +10971,18 @@ func (m *ListPipelineJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.JqFilter) > 0 {
 		i -= len(m.JqFilter)
 		copy(dAtA[i:], m.JqFilter)
@@ -10224,6 +11222,16 @@ func (m *UpdatePipelineJobStateRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DataSkippedByExitCode != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DataSkippedByExitCode))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.DataQuarantined != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DataQuarantined))
+		i--
+		dAtA[i] = 0x58
+	}
 	if m.Stats != nil {
 		{
 			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
@@ -10631,6 +11639,18 @@ func (m *ListDatumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Input != nil {
 		{
 			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
@@ -10772,6 +11792,20 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DatumFailurePolicy != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumFailurePolicy))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.QueueOverflowPolicy != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.QueueOverflowPolicy))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
 	if len(m.ReprocessSpec) > 0 {
 		i -= len(m.ReprocessSpec)
 		copy(dAtA[i:], m.ReprocessSpec)
@@ -11183,6 +12217,18 @@ func (m *ListPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Project != nil {
+		{
+			size, err := m.Project.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.JqFilter) > 0 {
 		i -= len(m.JqFilter)
 		copy(dAtA[i:], m.JqFilter)
@@ -11884,6 +12930,63 @@ func (m *Transform) Size() (n int) {
 		l = m.Build.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.DatumBatching {
+		n += 2
+	}
+	if m.RetrySpec != nil {
+		l = m.RetrySpec.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RetrySpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InitialBackoff != nil {
+		l = m.InitialBackoff.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.MaxBackoff != nil {
+		l = m.MaxBackoff.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Multiplier != 0 {
+		n += 9
+	}
+	if m.Jitter != 0 {
+		n += 9
+	}
+	if len(m.RetryExitCodes) > 0 {
+		l = 0
+		for _, e := range m.RetryExitCodes {
+			l += sovPps(uint64(e))
+		}
+		n += 1 + sovPps(uint64(l)) + l
+	}
+	if len(m.FailExitCodes) > 0 {
+		l = 0
+		for _, e := range m.FailExitCodes {
+			l += sovPps(uint64(e))
+		}
+		n += 1 + sovPps(uint64(l)) + l
+	}
+	if len(m.SkipExitCodes) > 0 {
+		l = 0
+		for _, e := range m.SkipExitCodes {
+			l += sovPps(uint64(e))
+		}
+		n += 1 + sovPps(uint64(l)) + l
+	}
+	if m.JobRetryBudget != 0 {
+		n += 1 + sovPps(uint64(m.JobRetryBudget))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12319,6 +13422,39 @@ func (m *DatumInfo) Size() (n int) {
 			n += 1 + l + sovPps(uint64(l))
 		}
 	}
+	if len(m.Retries) > 0 {
+		for _, e := range m.Retries {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DatumRetry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Failed != nil {
+		l = m.Failed.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Backoff != nil {
+		l = m.Backoff.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ExitCode != 0 {
+		n += 1 + sovPps(uint64(m.ExitCode))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12565,6 +13701,15 @@ func (m *StoredPipelineJobInfo) Size() (n int) {
 		l = m.Finished.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.DataQuarantined != 0 {
+		n += 2 + sovPps(uint64(m.DataQuarantined))
+	}
+	if m.DatumRetries != 0 {
+		n += 2 + sovPps(uint64(m.DatumRetries))
+	}
+	if m.DataSkippedByExitCode != 0 {
+		n += 2 + sovPps(uint64(m.DataSkippedByExitCode))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12729,6 +13874,12 @@ func (m *PipelineJobInfo) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovPps(uint64(l))
 	}
+	if m.DataQuarantined != 0 {
+		n += 2 + sovPps(uint64(m.DataQuarantined))
+	}
+	if m.DataSkippedByExitCode != 0 {
+		n += 2 + sovPps(uint64(m.DataSkippedByExitCode))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12764,6 +13915,34 @@ func (m *Pipeline) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.Project != nil {
+		l = m.Project.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PipelineQueueState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RunningJobs != 0 {
+		n += 1 + sovPps(uint64(m.RunningJobs))
+	}
+	if m.HeldCommits != 0 {
+		n += 1 + sovPps(uint64(m.HeldCommits))
+	}
+	if m.CoalescedCommits != 0 {
+		n += 1 + sovPps(uint64(m.CoalescedCommits))
+	}
+	if m.DroppedCommits != 0 {
+		n += 1 + sovPps(uint64(m.DroppedCommits))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12809,6 +13988,10 @@ func (m *StoredPipelineInfo) Size() (n int) {
 		l = m.Pipeline.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.QueueState != nil {
+		l = m.QueueState.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12974,6 +14157,19 @@ func (m *PipelineInfo) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovPps(uint64(l))
 	}
+	if m.QueueOverflowPolicy != 0 {
+		n += 2 + sovPps(uint64(m.QueueOverflowPolicy))
+	}
+	if m.QueueState != nil {
+		l = m.QueueState.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DatumFailurePolicy != 0 {
+		n += 2 + sovPps(uint64(m.DatumFailurePolicy))
+	}
+	if m.MaxQueueSizeEnforced {
+		n += 3
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13053,6 +14249,9 @@ func (m *CreatePipelineJobRequest) Size() (n int) {
 		l = m.Finished.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.DataQuarantined != 0 {
+		n += 1 + sovPps(uint64(m.DataQuarantined))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13115,6 +14314,13 @@ func (m *ListPipelineJobRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.PageSize != 0 {
+		n += 1 + sovPps(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13224,6 +14430,12 @@ func (m *UpdatePipelineJobStateRequest) Size() (n int) {
 		l = m.Stats.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.DataQuarantined != 0 {
+		n += 1 + sovPps(uint64(m.DataQuarantined))
+	}
+	if m.DataSkippedByExitCode != 0 {
+		n += 1 + sovPps(uint64(m.DataSkippedByExitCode))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13376,6 +14588,13 @@ func (m *ListDatumRequest) Size() (n int) {
 		l = m.Input.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.PageSize != 0 {
+		n += 1 + sovPps(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13547,6 +14766,12 @@ func (m *CreatePipelineRequest) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovPps(uint64(l))
 	}
+	if m.QueueOverflowPolicy != 0 {
+		n += 2 + sovPps(uint64(m.QueueOverflowPolicy))
+	}
+	if m.DatumFailurePolicy != 0 {
+		n += 2 + sovPps(uint64(m.DatumFailurePolicy))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13589,6 +14814,10 @@ func (m *ListPipelineRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.Project != nil {
+		l = m.Project.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -14619,6 +15848,62 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumBatching", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DatumBatching = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetrySpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetrySpec == nil {
+				m.RetrySpec = &RetrySpec{}
+			}
+			if err := m.RetrySpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -14641,7 +15926,7 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BuildSpec) Unmarshal(dAtA []byte) error {
+func (m *RetrySpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14664,17 +15949,17 @@ func (m *BuildSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BuildSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetrySpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BuildSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetrySpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBackoff", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14684,29 +15969,33 @@ func (m *BuildSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			if m.InitialBackoff == nil {
+				m.InitialBackoff = &types.Duration{}
+			}
+			if err := m.InitialBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Language", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBackoff", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14716,7 +16005,395 @@ func (m *BuildSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxBackoff == nil {
+				m.MaxBackoff = &types.Duration{}
+			}
+			if err := m.MaxBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Multiplier = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jitter", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Jitter = float64(math.Float64frombits(v))
+		case 5:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RetryExitCodes = append(m.RetryExitCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPps
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPps
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RetryExitCodes) == 0 {
+					m.RetryExitCodes = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RetryExitCodes = append(m.RetryExitCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryExitCodes", wireType)
+			}
+		case 6:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.FailExitCodes = append(m.FailExitCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPps
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPps
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.FailExitCodes) == 0 {
+					m.FailExitCodes = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.FailExitCodes = append(m.FailExitCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailExitCodes", wireType)
+			}
+		case 7:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SkipExitCodes = append(m.SkipExitCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPps
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPps
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SkipExitCodes) == 0 {
+					m.SkipExitCodes = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SkipExitCodes = append(m.SkipExitCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipExitCodes", wireType)
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobRetryBudget", wireType)
+			}
+			m.JobRetryBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobRetryBudget |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuildSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BuildSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BuildSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Language", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -17205,10 +18882,256 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PipelineJob == nil {
-				m.PipelineJob = &PipelineJob{}
-			}
-			if err := m.PipelineJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PipelineJob == nil {
+				m.PipelineJob = &PipelineJob{}
+			}
+			if err := m.PipelineJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DatumInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatumInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatumInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Datum == nil {
+				m.Datum = &Datum{}
+			}
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= DatumState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PfsState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PfsState == nil {
+				m.PfsState = &pfs.File{}
+			}
+			if err := m.PfsState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &pfs.FileInfo{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Retries = append(m.Retries, &DatumRetry{})
+			if err := m.Retries[len(m.Retries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17234,7 +19157,7 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DatumInfo) Unmarshal(dAtA []byte) error {
+func (m *DatumRetry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17257,15 +19180,15 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DatumInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DatumRetry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DatumInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DatumRetry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17292,35 +19215,16 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datum == nil {
-				m.Datum = &Datum{}
+			if m.Failed == nil {
+				m.Failed = &types.Timestamp{}
 			}
-			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Failed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= DatumState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17347,18 +19251,18 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
+			if m.Backoff == nil {
+				m.Backoff = &types.Duration{}
 			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PfsState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17368,33 +19272,29 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PfsState == nil {
-				m.PfsState = &pfs.File{}
-			}
-			if err := m.PfsState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
 			}
-			var msglen int
+			m.ExitCode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17404,26 +19304,11 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ExitCode |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data, &pfs.FileInfo{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -18970,6 +20855,63 @@ func (m *StoredPipelineJobInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataQuarantined", wireType)
+			}
+			m.DataQuarantined = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataQuarantined |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumRetries", wireType)
+			}
+			m.DatumRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatumRetries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSkippedByExitCode", wireType)
+			}
+			m.DataSkippedByExitCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSkippedByExitCode |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -20202,13 +22144,166 @@ func (m *PipelineJobInfo) Unmarshal(dAtA []byte) error {
 			if m.SchedulingSpec == nil {
 				m.SchedulingSpec = &SchedulingSpec{}
 			}
-			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodSpec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodPatch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 41:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataQuarantined", wireType)
+			}
+			m.DataQuarantined = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataQuarantined |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 42:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSkippedByExitCode", wireType)
+			}
+			m.DataSkippedByExitCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSkippedByExitCode |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Worker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 39:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Worker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Worker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20236,13 +22331,13 @@ func (m *PipelineJobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSpec = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 40:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var stringLen uint64
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20252,24 +22347,11 @@ func (m *PipelineJobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.State |= WorkerState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PodPatch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -20292,7 +22374,7 @@ func (m *PipelineJobInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Worker) Unmarshal(dAtA []byte) error {
+func (m *Pipeline) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20315,10 +22397,10 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Worker: wiretype end group for non-group")
+			return fmt.Errorf("proto: Pipeline: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Worker: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Pipeline: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -20354,10 +22436,10 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
-			m.State = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20367,11 +22449,28 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= WorkerState(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Project == nil {
+				m.Project = &pfs.Project{}
+			}
+			if err := m.Project.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -20394,7 +22493,7 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pipeline) Unmarshal(dAtA []byte) error {
+func (m *PipelineQueueState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20417,17 +22516,17 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pipeline: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineQueueState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pipeline: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineQueueState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunningJobs", wireType)
 			}
-			var stringLen uint64
+			m.RunningJobs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20437,24 +22536,68 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RunningJobs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldCommits", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
+			m.HeldCommits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeldCommits |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoalescedCommits", wireType)
+			}
+			m.CoalescedCommits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoalescedCommits |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DroppedCommits", wireType)
+			}
+			m.DroppedCommits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DroppedCommits |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -20798,6 +22941,42 @@ func (m *StoredPipelineInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QueueState == nil {
+				m.QueueState = &PipelineQueueState{}
+			}
+			if err := m.QueueState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -22007,11 +24186,99 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSpec = string(dAtA[iNdEx:postIndex])
+			m.PodSpec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodPatch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S3Out", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.S3Out = bool(v != 0)
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 37:
+		case 40:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReprocessSpec", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22039,13 +24306,13 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodPatch = string(dAtA[iNdEx:postIndex])
+			m.ReprocessSpec = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 38:
+		case 41:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3Out", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueOverflowPolicy", wireType)
 			}
-			var v int
+			m.QueueOverflowPolicy = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22055,15 +24322,14 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.QueueOverflowPolicy |= QueueOverflowPolicy(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.S3Out = bool(v != 0)
-		case 39:
+		case 42:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22090,18 +24356,18 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &Metadata{}
+			if m.QueueState == nil {
+				m.QueueState = &PipelineQueueState{}
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QueueState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 40:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReprocessSpec", wireType)
+		case 43:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumFailurePolicy", wireType)
 			}
-			var stringLen uint64
+			m.DatumFailurePolicy = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22111,24 +24377,31 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DatumFailurePolicy |= DatumFailurePolicy(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
+		case 44:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueueSizeEnforced", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ReprocessSpec = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.MaxQueueSizeEnforced = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -22646,6 +24919,25 @@ func (m *CreatePipelineJobRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataQuarantined", wireType)
+			}
+			m.DataQuarantined = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataQuarantined |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -23037,6 +25329,57 @@ func (m *ListPipelineJobRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.JqFilter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -23686,6 +26029,44 @@ func (m *UpdatePipelineJobStateRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataQuarantined", wireType)
+			}
+			m.DataQuarantined = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataQuarantined |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSkippedByExitCode", wireType)
+			}
+			m.DataSkippedByExitCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSkippedByExitCode |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -24642,6 +27023,57 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -25966,6 +28398,44 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ReprocessSpec = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueOverflowPolicy", wireType)
+			}
+			m.QueueOverflowPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueueOverflowPolicy |= QueueOverflowPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumFailurePolicy", wireType)
+			}
+			m.DatumFailurePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatumFailurePolicy |= DatumFailurePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -26211,6 +28681,42 @@ func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.JqFilter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Project == nil {
+				m.Project = &pfs.Project{}
+			}
+			if err := m.Project.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
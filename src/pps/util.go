@@ -63,6 +63,28 @@ func visitInput(input *Input, f func(*Input) error) error {
 	return f(input)
 }
 
+// ProjectName returns the name of the pipeline's project.
+func (p *Pipeline) ProjectName() string {
+	if pfs.IsDefaultProject(p.GetProject().GetName()) {
+		return pfs.DefaultProjectName
+	}
+	return p.Project.Name
+}
+
+// QualifiedName returns the pipeline's name qualified with its project, as in
+// "project/pipeline". Pipelines in the default project aren't qualified.
+// Pipeline names are only unique within their project, so PPS keys pipelines
+// by their qualified name.
+func (p *Pipeline) QualifiedName() string {
+	return pfs.QualifyName(p.GetProject().GetName(), p.Name)
+}
+
+// OutputRepoName returns the name of the pipeline's output repo, which shares
+// the pipeline's qualified name.
+func (p *Pipeline) OutputRepoName() string {
+	return p.QualifiedName()
+}
+
 // InputName computes the name of an Input.
 func InputName(input *Input) string {
 	switch {
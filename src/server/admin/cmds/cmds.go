@@ -2,9 +2,16 @@ package cmds
 
 import (
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
 
+	"github.com/pachyderm/pachyderm/v2/src/admin"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tabwriter"
+	"github.com/pachyderm/pachyderm/v2/src/server/admin/pretty"
 
 	"github.com/spf13/cobra"
 )
@@ -32,5 +39,143 @@ func Cmds() []*cobra.Command {
 	}
 	commands = append(commands, cmdutil.CreateAlias(inspectCluster, "inspect cluster"))
 
+	adminDocs := &cobra.Command{
+		Short: "Administers the pachyderm cluster.",
+		Long:  "Administers the pachyderm cluster.",
+	}
+	commands = append(commands, cmdutil.CreateDocsAlias(adminDocs, "admin", " admin "))
+
+	migrationsRoot := &cobra.Command{
+		Short: "Commands for managing the database migrations",
+		Long:  "Commands for managing the migrations of the cluster's database",
+	}
+	commands = append(commands, cmdutil.CreateAlias(migrationsRoot, "admin migrations"))
+
+	var fullTimestamps bool
+	migrationStatus := &cobra.Command{
+		Short: "Return the applied and pending database migrations.",
+		Long:  "Return the applied and pending migrations of the cluster's database, with the time each one was applied.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			ms, err := c.MigrationStatus()
+			if err != nil {
+				return err
+			}
+			return printMigrations(ms, fullTimestamps)
+		}),
+	}
+	migrationStatus.Flags().BoolVar(&fullTimestamps, "full-timestamps", false, "Return absolute timestamps (as opposed to the default, relative timestamps).")
+	commands = append(commands, cmdutil.CreateAlias(migrationStatus, "admin migrations status"))
+
+	var dryRun bool
+	planMigrations := &cobra.Command{
+		Short: "Return the database migrations that haven't been applied.",
+		Long: `Return the migrations that haven't been applied to the cluster's database.
+
+With --dry-run, the pending migrations are also run in a transaction that's
+rolled back, to check that they would succeed. The migrations hold the same
+locks they would when pachd applies them, so a dry run can block the cluster
+while it runs.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			ms, err := c.PlanMigrations(dryRun)
+			if err != nil {
+				return err
+			}
+			if len(ms) == 0 {
+				fmt.Println("No pending migrations.")
+				return nil
+			}
+			if err := printMigrations(ms, false); err != nil {
+				return err
+			}
+			if dryRun {
+				fmt.Printf("Dry run of %d migrations succeeded.\n", len(ms))
+			}
+			return nil
+		}),
+	}
+	planMigrations.Flags().BoolVar(&dryRun, "dry-run", false, "Run the pending migrations in a transaction that's rolled back.")
+	commands = append(commands, cmdutil.CreateAlias(planMigrations, "admin migrations plan"))
+
+	var force bool
+	rollbackMigrations := &cobra.Command{
+		Use:   "{{alias}} <id>",
+		Short: "Roll back the database migrations newer than a migration.",
+		Long: `Roll back the migrations applied to the cluster's database after the migration with the given id, e.g. before downgrading pachd.
+
+Only migrations that define how to revert them can be rolled back, and nothing is rolled back unless all of them can be.
+Pachd applies the migrations again when it restarts, so the older version of pachd should be deployed right after rolling back.`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			to, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrapf(err, "invalid migration id %q", args[0])
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			if !force {
+				ms, err := c.MigrationStatus()
+				if err != nil {
+					return err
+				}
+				var revert []*admin.Migration
+				var irreversible []string
+				for _, m := range ms {
+					if m.Applied && m.ID > to {
+						revert = append(revert, m)
+						if !m.Reversible {
+							irreversible = append(irreversible, m.Name)
+						}
+					}
+				}
+				if len(revert) == 0 {
+					fmt.Println("No migrations to roll back.")
+					return nil
+				}
+				if len(irreversible) > 0 {
+					return errors.Errorf("migrations cannot be rolled back: %s", strings.Join(irreversible, ", "))
+				}
+				fmt.Println("The following migrations will be rolled back:")
+				if err := printMigrations(revert, false); err != nil {
+					return err
+				}
+				if ok, err := cmdutil.InteractiveConfirm(); err != nil {
+					return err
+				} else if !ok {
+					return errors.New("rollback aborted")
+				}
+			}
+			ms, err := c.RollbackMigrations(to)
+			if err != nil {
+				return err
+			}
+			for _, m := range ms {
+				fmt.Printf("Rolled back migration %d %s\n", m.ID, m.Name)
+			}
+			return nil
+		}),
+	}
+	rollbackMigrations.Flags().BoolVarP(&force, "force", "f", false, "Roll back without asking for confirmation.")
+	commands = append(commands, cmdutil.CreateAlias(rollbackMigrations, "admin migrations rollback"))
+
 	return commands
 }
+
+func printMigrations(ms []*admin.Migration, fullTimestamps bool) error {
+	writer := tabwriter.NewWriter(os.Stdout, pretty.MigrationHeader)
+	for _, m := range ms {
+		pretty.PrintMigration(writer, m, fullTimestamps)
+	}
+	return writer.Flush()
+}
@@ -0,0 +1,38 @@
+package pretty
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/v2/src/admin"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pretty"
+)
+
+const (
+	// MigrationHeader is the header for migrations.
+	MigrationHeader = "ID\tNAME\tSTATUS\tSTARTED\tFINISHED\tREVERSIBLE\t\n"
+)
+
+// PrintMigration pretty-prints a migration.
+func PrintMigration(w io.Writer, m *admin.Migration, fullTimestamps bool) {
+	fmt.Fprintf(w, "%d\t%s\t", m.ID, m.Name)
+	if m.Applied {
+		fmt.Fprintf(w, "applied\t")
+	} else {
+		fmt.Fprintf(w, "pending\t")
+	}
+	fmt.Fprintf(w, "%s\t%s\t", timestamp(m.StartTime, fullTimestamps), timestamp(m.EndTime, fullTimestamps))
+	fmt.Fprintf(w, "%t\t\n", m.Reversible)
+}
+
+func timestamp(t *types.Timestamp, full bool) string {
+	switch {
+	case t == nil:
+		return "-"
+	case full:
+		return t.String()
+	default:
+		return pretty.Ago(t)
+	}
+}
@@ -1,9 +1,15 @@
 package server
 
 import (
+	"time"
+
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/v2/src/admin"
+	"github.com/pachyderm/pachyderm/v2/src/internal/clusterstate"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/migrations"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 
 	"golang.org/x/net/context"
 )
@@ -11,8 +17,93 @@ import (
 type apiServer struct {
 	log.Logger
 	clusterInfo *admin.ClusterInfo
+	env         serviceenv.ServiceEnv
 }
 
 func (a *apiServer) InspectCluster(ctx context.Context, request *types.Empty) (*admin.ClusterInfo, error) {
 	return a.clusterInfo, nil
 }
+
+// MigrationStatus implements the protobuf admin.MigrationStatus RPC
+func (a *apiServer) MigrationStatus(ctx context.Context, request *types.Empty) (response *admin.MigrationStatusResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	infos, err := migrations.Status(ctx, a.env.GetDBClient(), clusterstate.DesiredClusterState)
+	if err != nil {
+		return nil, err
+	}
+	ms, err := migrationsToProto(infos)
+	if err != nil {
+		return nil, err
+	}
+	return &admin.MigrationStatusResponse{Migrations: ms}, nil
+}
+
+// PlanMigrations implements the protobuf admin.PlanMigrations RPC
+func (a *apiServer) PlanMigrations(ctx context.Context, request *admin.PlanMigrationsRequest) (response *admin.PlanMigrationsResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	var infos []migrations.Info
+	if request.DryRun {
+		var err error
+		infos, err = migrations.DryRun(ctx, a.env.GetDBClient(), migrations.Env{}, clusterstate.DesiredClusterState)
+		if err != nil {
+			return nil, errors.Wrapf(err, "dry run failed")
+		}
+	} else {
+		all, err := migrations.Status(ctx, a.env.GetDBClient(), clusterstate.DesiredClusterState)
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range all {
+			if !info.Applied {
+				infos = append(infos, info)
+			}
+		}
+	}
+	pending, err := migrationsToProto(infos)
+	if err != nil {
+		return nil, err
+	}
+	return &admin.PlanMigrationsResponse{Pending: pending}, nil
+}
+
+// RollbackMigrations implements the protobuf admin.RollbackMigrations RPC
+func (a *apiServer) RollbackMigrations(ctx context.Context, request *admin.RollbackMigrationsRequest) (response *admin.RollbackMigrationsResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	infos, err := migrations.Rollback(ctx, a.env.GetDBClient(), migrations.Env{}, clusterstate.DesiredClusterState, int(request.To))
+	if err != nil {
+		return nil, err
+	}
+	rolledBack, err := migrationsToProto(infos)
+	if err != nil {
+		return nil, err
+	}
+	return &admin.RollbackMigrationsResponse{RolledBack: rolledBack}, nil
+}
+
+func migrationsToProto(infos []migrations.Info) ([]*admin.Migration, error) {
+	var result []*admin.Migration
+	for _, info := range infos {
+		m := &admin.Migration{
+			ID:         int64(info.Number),
+			Name:       info.Name,
+			Applied:    info.Applied,
+			Reversible: info.Reversible,
+		}
+		var err error
+		if info.StartTime != nil {
+			if m.StartTime, err = types.TimestampProto(*info.StartTime); err != nil {
+				return nil, errors.EnsureStack(err)
+			}
+		}
+		if info.EndTime != nil {
+			if m.EndTime, err = types.TimestampProto(*info.EndTime); err != nil {
+				return nil, errors.EnsureStack(err)
+			}
+		}
+		result = append(result, m)
+	}
+	return result, nil
+}
@@ -19,5 +19,6 @@ func NewAPIServer(env serviceenv.ServiceEnv) APIServer {
 			ID:           env.ClusterID(),
 			DeploymentID: env.Config().DeploymentID,
 		},
+		env: env,
 	}
 }
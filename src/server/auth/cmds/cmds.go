@@ -505,7 +505,7 @@ func CheckRepoCmd() *cobra.Command {
 		Short: "Check the permissions a user has on 'repo'",
 		Long:  "Check the permissions a user has on 'repo'",
 		Run: cmdutil.RunBoundedArgs(1, 2, func(args []string) error {
-			repo := args[0]
+			repo := cmdutil.ParseRepo(args[0]).QualifiedName()
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
 				return errors.Wrapf(err, "could not connect")
@@ -547,7 +547,7 @@ func SetRepoRoleBindingCmd() *cobra.Command {
 				roles = strings.Split(args[1], ",")
 			}
 
-			subject, repo := args[2], args[0]
+			subject, repo := args[2], cmdutil.ParseRepo(args[0]).QualifiedName()
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
 				return errors.Wrapf(err, "could not connect")
@@ -572,7 +572,7 @@ func GetRepoRoleBindingCmd() *cobra.Command {
 				return errors.Wrapf(err, "could not connect")
 			}
 			defer c.Close()
-			repo := args[0]
+			repo := cmdutil.ParseRepo(args[0]).QualifiedName()
 			resp, err := c.GetRepoRoleBinding(repo)
 			if err != nil {
 				return grpcutil.ScrubGRPC(err)
@@ -584,6 +584,58 @@ func GetRepoRoleBindingCmd() *cobra.Command {
 	return cmdutil.CreateAlias(get, "auth get repo")
 }
 
+// SetProjectRoleBindingCmd returns a cobra command that sets the roles for a
+// user on a project
+func SetProjectRoleBindingCmd() *cobra.Command {
+	setScope := &cobra.Command{
+		Use:   "{{alias}} <project> [role1,role2 | none ] <subject>",
+		Short: "Set the roles that 'username' has on every repo in 'project'",
+		Long:  "Set the roles that 'username' has on every repo in 'project'",
+		Run: cmdutil.RunFixedArgs(3, func(args []string) error {
+			var roles []string
+			if args[1] == "none" {
+				roles = []string{}
+			} else {
+				roles = strings.Split(args[1], ",")
+			}
+
+			subject, project := args[2], args[0]
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			err = c.ModifyProjectRoleBinding(project, subject, roles)
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	return cmdutil.CreateAlias(setScope, "auth set project")
+}
+
+// GetProjectRoleBindingCmd returns a cobra command that gets the role bindings
+// for a project
+func GetProjectRoleBindingCmd() *cobra.Command {
+	get := &cobra.Command{
+		Use:   "{{alias}} <project>",
+		Short: "Get the role bindings for 'project'",
+		Long:  "Get the role bindings for 'project'",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			resp, err := c.GetProjectRoleBinding(args[0])
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			printRoleBinding(resp)
+			return nil
+		}),
+	}
+	return cmdutil.CreateAlias(get, "auth get project")
+}
+
 // SetClusterRoleBindingCmd returns a cobra command that sets the roles for a user on a resource
 func SetClusterRoleBindingCmd() *cobra.Command {
 	setScope := &cobra.Command{
@@ -761,6 +813,8 @@ func Cmds() []*cobra.Command {
 	commands = append(commands, GetGroupsCmd())
 	commands = append(commands, GetRepoRoleBindingCmd())
 	commands = append(commands, SetRepoRoleBindingCmd())
+	commands = append(commands, GetProjectRoleBindingCmd())
+	commands = append(commands, SetProjectRoleBindingCmd())
 	commands = append(commands, GetClusterRoleBindingCmd())
 	commands = append(commands, SetClusterRoleBindingCmd())
 	commands = append(commands, GetEnterpriseRoleBindingCmd())
@@ -16,6 +16,7 @@ type APIServer interface {
 	CheckRepoIsAuthorized(context.Context, string, ...auth_client.Permission) error
 	CheckClusterIsAuthorizedInTransaction(*txncontext.TransactionContext, ...auth_client.Permission) error
 	CheckRepoIsAuthorizedInTransaction(*txncontext.TransactionContext, string, ...auth_client.Permission) error
+	CheckProjectIsAuthorizedInTransaction(*txncontext.TransactionContext, string, ...auth_client.Permission) error
 
 	AuthorizeInTransaction(*txncontext.TransactionContext, *auth_client.AuthorizeRequest) (*auth_client.AuthorizeResponse, error)
 	ModifyRoleBindingInTransaction(*txncontext.TransactionContext, *auth_client.ModifyRoleBindingRequest) (*auth_client.ModifyRoleBindingResponse, error)
@@ -23,6 +24,7 @@ type APIServer interface {
 
 	// Methods to add and remove pipelines from input and output repos. These do their own auth checks
 	// for specific permissions required to use a repo as a pipeline input/output.
+	// Pipelines are identified by their output repos, qualified with their project.
 	AddPipelineReaderToRepoInTransaction(*txncontext.TransactionContext, string, string) error
 	AddPipelineWriterToRepoInTransaction(*txncontext.TransactionContext, string) error
 	RemovePipelineReaderFromRepoInTransaction(*txncontext.TransactionContext, string, string) error
@@ -24,6 +24,7 @@ import (
 	txnenv "github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	authiface "github.com/pachyderm/pachyderm/v2/src/server/auth"
 
 	"github.com/gogo/protobuf/proto"
@@ -542,6 +543,29 @@ func (a *apiServer) evaluateRoleBindingInTransaction(txnCtx *txncontext.Transact
 		return request, nil
 	}
 
+	// Role bindings on a project apply to all the repos in the project, so
+	// check the repo's project before the repo itself
+	if resource.Type == auth.ResourceType_REPO {
+		project, _ := pfs.SplitProject(resource.Name)
+		if project == "" {
+			project = pfs.DefaultProjectName
+		}
+		var projectBinding auth.RoleBinding
+		projectResource := &auth.Resource{Type: auth.ResourceType_PROJECT, Name: project}
+		if err := a.roleBindings.ReadWrite(txnCtx.SqlTx).Get(resourceKey(projectResource), &projectBinding); err != nil {
+			if !col.IsErrNotFound(err) {
+				return nil, errors.Wrapf(err, "error getting role bindings for %s \"%s\"", projectResource.Type, projectResource.Name)
+			}
+		} else {
+			if err := request.evaluateRoleBinding(txnCtx.ClientContext, &projectBinding); err != nil {
+				return nil, err
+			}
+			if request.isSatisfied() {
+				return request, nil
+			}
+		}
+	}
+
 	// Get the role bindings for the resource to check
 	var roleBinding auth.RoleBinding
 	if err := a.roleBindings.ReadWrite(txnCtx.SqlTx).Get(resourceKey(resource), &roleBinding); err != nil {
@@ -735,6 +759,13 @@ func (a *apiServer) CreateRoleBindingInTransaction(txnCtx *txncontext.Transactio
 	return nil
 }
 
+// pipelinePrincipal returns the principal of the pipeline whose output repo is
+// outputRepo. Pipelines in different projects may share a name, so the
+// principal includes the pipeline's project, as the output repo's name does.
+func pipelinePrincipal(outputRepo string) string {
+	return auth.PipelinePrefix + outputRepo
+}
+
 // AddPipelineReaderToRepoInTransaction gives a pipeline access to read data from the specified source repo.
 // This is distinct from ModifyRoleBinding because AddPipelineReader is a less expansive permission
 // that is included in the repoReader role, versus being able to modify all role bindings which is
@@ -744,7 +775,7 @@ func (a *apiServer) AddPipelineReaderToRepoInTransaction(txnCtx *txncontext.Tran
 		return err
 	}
 
-	return a.setUserRoleBindingInTransaction(txnCtx, &auth.Resource{Type: auth.ResourceType_REPO, Name: sourceRepo}, auth.PipelinePrefix+pipeline, []string{auth.RepoReaderRole})
+	return a.setUserRoleBindingInTransaction(txnCtx, &auth.Resource{Type: auth.ResourceType_REPO, Name: sourceRepo}, pipelinePrincipal(pipeline), []string{auth.RepoReaderRole})
 }
 
 // AddPipelineWriterToRepoInTransaction gives a pipeline access to write to it's own output repo.
@@ -757,7 +788,7 @@ func (a *apiServer) AddPipelineWriterToRepoInTransaction(txnCtx *txncontext.Tran
 		return err
 	}
 
-	return a.setUserRoleBindingInTransaction(txnCtx, &auth.Resource{Type: auth.ResourceType_REPO, Name: pipeline}, auth.PipelinePrefix+pipeline, []string{auth.RepoWriterRole})
+	return a.setUserRoleBindingInTransaction(txnCtx, &auth.Resource{Type: auth.ResourceType_REPO, Name: pipeline}, pipelinePrincipal(pipeline), []string{auth.RepoWriterRole})
 }
 
 // RemovePipelineReaderFromRepo revokes a pipeline's access to read data from the specified source repo.
@@ -772,7 +803,7 @@ func (a *apiServer) RemovePipelineReaderFromRepoInTransaction(txnCtx *txncontext
 		return err
 	}
 
-	return a.setUserRoleBindingInTransaction(txnCtx, &auth.Resource{Type: auth.ResourceType_REPO, Name: sourceRepo}, auth.PipelinePrefix+pipeline, []string{})
+	return a.setUserRoleBindingInTransaction(txnCtx, &auth.Resource{Type: auth.ResourceType_REPO, Name: sourceRepo}, pipelinePrincipal(pipeline), []string{})
 }
 
 // ModifyRoleBindingInTransaction is identical to ModifyRoleBinding except that it can run inside
@@ -804,6 +835,10 @@ func (a *apiServer) ModifyRoleBindingInTransaction(
 		if err := a.CheckRepoIsAuthorizedInTransaction(txnCtx, req.Resource.Name, auth.Permission_REPO_MODIFY_BINDINGS); err != nil {
 			return nil, err
 		}
+	case auth.ResourceType_PROJECT:
+		if err := a.CheckProjectIsAuthorizedInTransaction(txnCtx, req.Resource.Name, auth.Permission_REPO_MODIFY_BINDINGS); err != nil {
+			return nil, err
+		}
 	default:
 		return nil, fmt.Errorf("unknown resource type %v", req.Resource.Type)
 	}
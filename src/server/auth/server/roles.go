@@ -117,6 +117,12 @@ var (
 			auth.Permission_CLUSTER_AUTH_EXTRACT_TOKENS,
 			auth.Permission_CLUSTER_AUTH_RESTORE_TOKEN,
 			auth.Permission_CLUSTER_AUTH_ROTATE_ROOT_TOKEN,
+			auth.Permission_CLUSTER_CREATE_REMOTE,
+			auth.Permission_CLUSTER_DELETE_REMOTE,
+			auth.Permission_CLUSTER_GET_MIGRATIONS,
+			auth.Permission_CLUSTER_ROLLBACK_MIGRATIONS,
+			auth.Permission_CLUSTER_INSPECT_STORAGE,
+			auth.Permission_CLUSTER_VERIFY_MIRROR,
 			auth.Permission_CLUSTER_AUTH_DELETE_EXPIRED_TOKENS,
 			auth.Permission_CLUSTER_AUTH_GET_PERMISSIONS_FOR_PRINCIPAL,
 			auth.Permission_CLUSTER_AUTH_REVOKE_USER_TOKENS,
@@ -2820,3 +2820,101 @@ func TestExpiredClusterLocksOutUsers(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 1, len(repoInfo))
 }
+
+// TestInspectStorage tests that only cluster admins can see the cluster's
+// storage usage, since it includes every repo's usage
+func TestInspectStorage(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	tu.DeleteAll(t)
+	defer tu.DeleteAll(t)
+	adminClient := tu.GetAuthenticatedPachClient(t, auth.RootUser)
+	alice := robot(tu.UniqueString("alice"))
+	aliceClient := tu.GetAuthenticatedPachClient(t, alice)
+
+	_, err := aliceClient.InspectStorage()
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+
+	_, err = adminClient.InspectStorage()
+	require.NoError(t, err)
+}
+
+// TestInspectFileLineage tests that the lineage of a file can only be
+// inspected by a user who can read every repo that it includes.
+func TestInspectFileLineage(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	tu.DeleteAll(t)
+	defer tu.DeleteAll(t)
+	alice, bob := robot(tu.UniqueString("alice")), robot(tu.UniqueString("bob"))
+	aliceClient, bobClient := tu.GetAuthenticatedPachClient(t, alice), tu.GetAuthenticatedPachClient(t, bob)
+
+	// alice creates a repo and a pipeline that copies it
+	repo := tu.UniqueString(t.Name())
+	require.NoError(t, aliceClient.CreateRepo(repo))
+	commit := client.NewCommit(repo, "master", "")
+	require.NoError(t, aliceClient.PutFile(commit, "/file", strings.NewReader("test")))
+	pipeline := tu.UniqueString("pipeline")
+	require.NoError(t, aliceClient.CreatePipeline(
+		pipeline,
+		"", // default image: DefaultUserImage
+		[]string{"bash"},
+		[]string{"cp /pfs/*/* /pfs/out/"},
+		&pps.ParallelismSpec{Constant: 1},
+		client.NewPFSInput(repo, "/*"),
+		"", // default output branch: master
+		false,
+	))
+	require.NoErrorWithinT(t, 45*time.Second, func() error {
+		_, err := aliceClient.FlushCommitAll([]*pfs.Commit{commit}, []*pfs.Repo{client.NewRepo(pipeline)})
+		return err
+	})
+	output := client.NewCommit(pipeline, "master", "")
+
+	// bob can't inspect the lineage of a file in a repo without read access
+	_, err := bobClient.InspectFileLineage(output, "/file")
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+
+	// bob can read the output repo, but not the input repo that it's
+	// derived from
+	require.NoError(t, aliceClient.ModifyRepoRoleBinding(pipeline, bob, []string{auth.RepoReaderRole}))
+	_, err = bobClient.InspectFileLineage(output, "/file")
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+
+	// bob can read both repos
+	require.NoError(t, aliceClient.ModifyRepoRoleBinding(repo, bob, []string{auth.RepoReaderRole}))
+	lineage, err := bobClient.InspectFileLineage(output, "/file")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(lineage.Datums))
+}
+
+// TestVerifyMirror tests that only cluster admins can verify the object store
+// mirror, since repairing it changes the whole cluster's storage
+func TestVerifyMirror(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	tu.DeleteAll(t)
+	defer tu.DeleteAll(t)
+	adminClient := tu.GetAuthenticatedPachClient(t, auth.RootUser)
+	alice := robot(tu.UniqueString("alice"))
+	aliceClient := tu.GetAuthenticatedPachClient(t, alice)
+
+	for _, repair := range []bool{false, true} {
+		err := aliceClient.VerifyMirror(repair, func(*pfs.MirrorDifference) error { return nil })
+		require.YesError(t, err)
+		require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+	}
+
+	// The test cluster may not have a mirror, but an admin is authorized to
+	// verify it
+	err := adminClient.VerifyMirror(false, func(*pfs.MirrorDifference) error { return nil })
+	if err != nil {
+		require.False(t, auth.IsErrNotAuthorized(err), err.Error())
+	}
+}
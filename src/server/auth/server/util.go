@@ -47,6 +47,26 @@ func (a *apiServer) CheckRepoIsAuthorizedInTransaction(txnCtx *txncontext.Transa
 	return nil
 }
 
+// CheckProjectIsAuthorizedInTransaction returns an error if the current user
+// doesn't have the permissions in `p` on the project `project`. The repo
+// permissions in a project's role binding apply to all of its repos.
+func (a *apiServer) CheckProjectIsAuthorizedInTransaction(txnCtx *txncontext.TransactionContext, project string, p ...auth.Permission) error {
+	me, err := a.WhoAmI(txnCtx.ClientContext, &auth.WhoAmIRequest{})
+	if auth.IsErrNotActivated(err) {
+		return nil
+	}
+
+	req := &auth.AuthorizeRequest{Resource: &auth.Resource{Type: auth.ResourceType_PROJECT, Name: project}, Permissions: p}
+	resp, err := a.AuthorizeInTransaction(txnCtx, req)
+	if err != nil {
+		return errors.Wrapf(grpcutil.ScrubGRPC(err), "error during authorization check for operation on project \"%s\"", project)
+	}
+	if !resp.Authorized {
+		return &auth.ErrNotAuthorized{Subject: me.Username, Resource: auth.Resource{Type: auth.ResourceType_PROJECT, Name: project}, Required: p}
+	}
+	return nil
+}
+
 // CheckRepoIsAuthorized returns an error if the current user doesn't have
 // the permissions in `p` on the repo `r`
 func (a *apiServer) CheckRepoIsAuthorized(ctx context.Context, r string, p ...auth.Permission) error {
@@ -198,3 +198,8 @@ func (a *InactiveAPIServer) CheckClusterIsAuthorizedInTransaction(*txncontext.Tr
 func (a *InactiveAPIServer) CheckRepoIsAuthorizedInTransaction(*txncontext.TransactionContext, string, ...auth.Permission) error {
 	return nil
 }
+
+// CheckProjectIsAuthorizedInTransaction returns nil when auth is not activated
+func (a *InactiveAPIServer) CheckProjectIsAuthorizedInTransaction(*txncontext.TransactionContext, string, ...auth.Permission) error {
+	return nil
+}
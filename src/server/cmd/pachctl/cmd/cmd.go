@@ -364,6 +364,13 @@ Environment variables:
 				))
 				cmdutil.PrintErrorStacks = true
 			}
+
+			// Repos named without a project are in the active context's project.
+			if cfg, err := config.Read(false, true); err == nil {
+				if _, context, err := cfg.ActiveContext(false); err == nil && context != nil {
+					cmdutil.CurrentProject = context.Project
+				}
+			}
 		},
 		BashCompletionFunction: bashCompletionFunc,
 	}
@@ -840,6 +847,24 @@ This resets the cluster to its initial state.`,
 	}
 	subcommands = append(subcommands, cmdutil.CreateAlias(diffDocs, "diff"))
 
+	mergeDocs := &cobra.Command{
+		Short: "Merge one Pachyderm resource into another.",
+		Long:  "Merge one Pachyderm resource into another.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(mergeDocs, "merge"))
+
+	pushDocs := &cobra.Command{
+		Short: "Push a Pachyderm resource to a remote pachd.",
+		Long:  "Push a Pachyderm resource to a remote pachd.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(pushDocs, "push"))
+
+	pullDocs := &cobra.Command{
+		Short: "Pull a Pachyderm resource from a remote pachd.",
+		Long:  "Pull a Pachyderm resource from a remote pachd.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(pullDocs, "pull"))
+
 	stopDocs := &cobra.Command{
 		Short: "Cancel an ongoing task.",
 		Long:  "Cancel an ongoing task.",
@@ -852,6 +877,12 @@ This resets the cluster to its initial state.`,
 	}
 	subcommands = append(subcommands, cmdutil.CreateAlias(restartDocs, "restart"))
 
+	redriveDocs := &cobra.Command{
+		Short: "Process work that was quarantined again.",
+		Long:  "Process work that was quarantined again.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(redriveDocs, "redrive"))
+
 	resumeDocs := &cobra.Command{
 		Short: "Resume a stopped task.",
 		Long:  "Resume a stopped task.",
@@ -870,6 +901,18 @@ This resets the cluster to its initial state.`,
 	}
 	subcommands = append(subcommands, cmdutil.CreateAlias(editDocs, "edit"))
 
+	rehydrateDocs := &cobra.Command{
+		Short: "Move a Pachyderm resource out of cold storage.",
+		Long:  "Move a Pachyderm resource out of cold storage.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(rehydrateDocs, "rehydrate"))
+
+	verifyDocs := &cobra.Command{
+		Short: "Check the consistency of a Pachyderm resource.",
+		Long:  "Check the consistency of a Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(verifyDocs, "verify"))
+
 	subcommands = append(subcommands, pfscmds.Cmds()...)
 	subcommands = append(subcommands, ppscmds.Cmds()...)
 	subcommands = append(subcommands, deploycmds.Cmds()...)
@@ -929,6 +972,7 @@ func applyRootUsageFunc(rootCmd *cobra.Command) {
 			"inspect",
 			"list",
 			"put",
+			"redrive",
 			"restart",
 			"start",
 			"stop",
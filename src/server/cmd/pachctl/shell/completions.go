@@ -79,13 +79,30 @@ func RepoCompletion(_, text string, maxCompletions int64) ([]prompt.Suggest, Cac
 	var result []prompt.Suggest
 	for _, ri := range ris {
 		result = append(result, prompt.Suggest{
-			Text:        ri.Repo.Name,
+			Text:        ri.Repo.QualifiedName(),
 			Description: fmt.Sprintf("%s (%s)", ri.Description, units.BytesSize(float64(ri.SizeBytes))),
 		})
 	}
 	return result, samePart(parsePart(text))
 }
 
+// ProjectCompletion completes project parameters of the form <project>
+func ProjectCompletion(_, text string, maxCompletions int64) ([]prompt.Suggest, CacheFunc) {
+	c := getPachClient()
+	pis, err := c.ListProject()
+	if err != nil {
+		return nil, CacheNone
+	}
+	var result []prompt.Suggest
+	for _, pi := range pis {
+		result = append(result, prompt.Suggest{
+			Text:        pi.Project.Name,
+			Description: pi.Description,
+		})
+	}
+	return result, CacheAll
+}
+
 // BranchCompletion completes branch parameters of the form <repo>@<branch>
 func BranchCompletion(flag, text string, maxCompletions int64) ([]prompt.Suggest, CacheFunc) {
 	c := getPachClient()
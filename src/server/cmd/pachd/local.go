@@ -0,0 +1,197 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/coreos/etcd/embed"
+	"github.com/coreos/pkg/capnslog"
+	"github.com/lib/pq"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+)
+
+const (
+	localMode = "local"
+	// localPodName stands in for the pod name that kubernetes would otherwise
+	// provide through the downward API
+	localPodName = "pachd-local"
+)
+
+// localDefaults is a cmdutil.Decoder that fills in the configuration that
+// kubernetes normally provides to pachd (service addresses, the pod name,
+// etc.), so that --mode=local works without any environment variables.
+// Environment variables still take precedence.
+type localDefaults struct{}
+
+func (localDefaults) Decode() (map[string]string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return map[string]string{
+		"PACH_ROOT":                    filepath.Join(home, ".pachyderm", "local"),
+		"STORAGE_BACKEND":              "LOCAL",
+		"ETCD_SERVICE_HOST":            "127.0.0.1",
+		"ETCD_SERVICE_PORT":            "32379",
+		"POSTGRES_SERVICE_HOST":        "127.0.0.1",
+		"POSTGRES_SERVICE_PORT":        "5432",
+		"POSTGRES_DATABASE_NAME":       dbutil.DefaultDBName,
+		"PORT":                         "30650",
+		"PEER_PORT":                    "30653",
+		"HTTP_PORT":                    "30652",
+		"S3GATEWAY_PORT":               "30600",
+		"PACHD_POD_NAME":               localPodName,
+		"KUBERNETES_PORT_443_TCP_ADDR": "none",
+		"METRICS":                      "false",
+		"WORKER_RUNTIME":               "local",
+	}, nil
+}
+
+// doLocalMode runs pachd without kubernetes. It starts an embedded etcd and a
+// Postgres server under PACH_ROOT, stores data on the local filesystem, and
+// runs pipeline workers as local processes.
+// Postgres can't be embedded, so pachd runs the initdb and pg_ctl binaries of
+// a local Postgres installation, which must be on the PATH (unless a Postgres
+// server is already listening on POSTGRES_SERVICE_HOST:POSTGRES_SERVICE_PORT,
+// in which case that server is used).
+func doLocalMode(config interface{}) error {
+	c := serviceenv.NewConfiguration(config)
+	if c.WorkerRuntime != localMode {
+		return errors.Errorf("pachd in local mode can't use worker runtime %q", c.WorkerRuntime)
+	}
+	etcd, err := startLocalEtcd(filepath.Join(c.StorageRoot, "etcd"), c.EtcdHost, c.EtcdPort)
+	if err != nil {
+		return err
+	}
+	defer etcd.Close()
+	stopPostgres, err := startLocalPostgres(filepath.Join(c.StorageRoot, "postgres"), c.PostgresServiceHost, c.PostgresServicePort,
+		c.PostgresDBName, c.IdentityServerDatabase)
+	if err != nil {
+		return err
+	}
+	defer stopPostgres()
+	return doFullMode(config)
+}
+
+// startLocalEtcd starts a single-member etcd server that stores its data in
+// 'dir' and serves clients on host:port.
+func startLocalEtcd(dir, host, port string) (*embed.Etcd, error) {
+	etcdConfig := embed.NewConfig()
+	etcdConfig.Dir = filepath.Join(dir, "data")
+	etcdConfig.WalDir = filepath.Join(dir, "wal")
+	etcdConfig.MaxTxnOps = 10000
+	clientURL, err := url.Parse(fmt.Sprintf("http://%s", net.JoinHostPort(host, port)))
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	etcdConfig.LCUrls = []url.URL{*clientURL}
+	etcdConfig.ACUrls = []url.URL{*clientURL}
+	// etcd is only reachable by this pachd and its workers, so it has no peers
+	etcdConfig.LPUrls = []url.URL{}
+	capnslog.SetGlobalLogLevel(capnslog.WARNING)
+
+	log.Printf("starting embedded etcd in %q", dir)
+	etcd, err := embed.StartEtcd(etcdConfig)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not start embedded etcd")
+	}
+	select {
+	case <-etcd.Server.ReadyNotify():
+		return etcd, nil
+	case err := <-etcd.Err():
+		etcd.Close()
+		return nil, errors.Wrapf(err, "embedded etcd failed to start")
+	}
+}
+
+// startLocalPostgres starts a Postgres server that stores its data in 'dir'
+// and serves clients on host:port, and creates the databases 'dbNames' in it.
+// If a server is already listening on host:port, it's used instead. The
+// returned function stops the server (if it was started by pachd).
+func startLocalPostgres(dir, host string, port int, dbNames ...string) (func(), error) {
+	addr := net.JoinHostPort(host, strconv.Itoa(port))
+	if conn, err := net.DialTimeout("tcp", addr, time.Second); err == nil {
+		conn.Close()
+		log.Printf("using the Postgres server at %s", addr)
+		return func() {}, createLocalDatabases(host, port, dbNames...)
+	}
+	initdb, err := exec.LookPath("initdb")
+	if err != nil {
+		return nil, errors.Errorf("pachd in local mode needs Postgres: install it so that initdb and pg_ctl are on the PATH, or run a Postgres server on %s", addr)
+	}
+	pgCtl, err := exec.LookPath("pg_ctl")
+	if err != nil {
+		return nil, errors.Errorf("pachd in local mode needs Postgres: install it so that initdb and pg_ctl are on the PATH, or run a Postgres server on %s", addr)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "PG_VERSION")); err != nil {
+		if !os.IsNotExist(err) {
+			return nil, errors.EnsureStack(err)
+		}
+		log.Printf("initializing Postgres in %q", dir)
+		if err := runPostgresCmd(initdb, "-D", dir, "-U", dbutil.DefaultUser, "--auth=trust"); err != nil {
+			return nil, err
+		}
+	}
+	log.Printf("starting Postgres in %q", dir)
+	// The unix socket goes in 'dir' too, since the default location usually
+	// isn't writable by the user running pachd.
+	if err := runPostgresCmd(pgCtl, "start", "-w", "-D", dir, "-l", filepath.Join(dir, "postgres.log"),
+		"-o", fmt.Sprintf("-h %s -p %d -k %s", host, port, dir)); err != nil {
+		return nil, err
+	}
+	stop := func() {
+		if err := runPostgresCmd(pgCtl, "stop", "-w", "-m", "fast", "-D", dir); err != nil {
+			log.Errorf("error stopping Postgres: %v", err)
+		}
+	}
+	if err := createLocalDatabases(host, port, dbNames...); err != nil {
+		stop()
+		return nil, err
+	}
+	return stop, nil
+}
+
+// createLocalDatabases creates each of the databases in 'dbNames' that
+// doesn't exist yet.
+func createLocalDatabases(host string, port int, dbNames ...string) (retErr error) {
+	db, err := dbutil.NewDB(dbutil.WithHostPort(host, port), dbutil.WithDBName("postgres"))
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := db.Close(); retErr == nil {
+			retErr = errors.EnsureStack(err)
+		}
+	}()
+	for _, name := range dbNames {
+		var exists bool
+		if err := db.Get(&exists, `SELECT EXISTS (SELECT FROM pg_database WHERE datname = $1)`, name); err != nil {
+			return errors.Wrapf(err, "could not connect to Postgres")
+		}
+		if exists {
+			continue
+		}
+		if _, err := db.Exec(`CREATE DATABASE ` + pq.QuoteIdentifier(name)); err != nil {
+			return errors.Wrapf(err, "could not create database %q", name)
+		}
+	}
+	return nil
+}
+
+func runPostgresCmd(name string, args ...string) error {
+	out, err := exec.Command(name, args...).CombinedOutput()
+	if err != nil {
+		return errors.Wrapf(err, "%s failed: %s", filepath.Base(name), out)
+	}
+	return nil
+}
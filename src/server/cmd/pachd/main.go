@@ -64,7 +64,7 @@ var mode string
 var readiness bool
 
 func init() {
-	flag.StringVar(&mode, "mode", "full", "Pachd currently supports three modes: full, enterprise and sidecar. full includes everything you need in a full pachd node. Enterprise runs the Enterprise Server. Sidecar runs only PFS, the Auth service, and a stripped-down version of PPS.")
+	flag.StringVar(&mode, "mode", "full", "Pachd currently supports four modes: full, enterprise, sidecar and local. full includes everything you need in a full pachd node. Enterprise runs the Enterprise Server. Sidecar runs only PFS, the Auth service, and a stripped-down version of PPS. Local runs a full pachd node without kubernetes, with an embedded etcd, local storage, and pipeline workers as local processes.")
 	flag.BoolVar(&readiness, "readiness", false, "Run readiness check.")
 	flag.Parse()
 }
@@ -82,6 +82,8 @@ func main() {
 		cmdutil.Main(doEnterpriseMode, &serviceenv.PachdFullConfiguration{})
 	case mode == "sidecar":
 		cmdutil.Main(doSidecarMode, &serviceenv.PachdFullConfiguration{})
+	case mode == localMode:
+		cmdutil.Main(doLocalMode, &serviceenv.PachdFullConfiguration{}, localDefaults{})
 	default:
 		fmt.Printf("unrecognized mode: %s\n", mode)
 	}
@@ -369,6 +371,9 @@ func doSidecarMode(config interface{}) (retErr error) {
 		log.Printf("no Jaeger collector found (JAEGER_COLLECTOR_SERVICE_HOST not set)")
 	}
 	env := serviceenv.InitWithKube(serviceenv.NewConfiguration(config))
+	if err := env.Config().CheckCoordinationBackend(); err != nil {
+		return err
+	}
 	debug.SetGCPercent(env.Config().GCPercent)
 	if env.Config().EtcdPrefix == "" {
 		env.Config().EtcdPrefix = col.DefaultPrefix
@@ -522,7 +527,15 @@ func doFullMode(config interface{}) (retErr error) {
 	} else {
 		log.Printf("no Jaeger collector found (JAEGER_COLLECTOR_SERVICE_HOST not set)")
 	}
-	env := serviceenv.InitWithKube(serviceenv.NewConfiguration(config))
+	var env *serviceenv.NonblockingServiceEnv
+	if mode == localMode {
+		env = serviceenv.InitServiceEnv(serviceenv.NewConfiguration(config))
+	} else {
+		env = serviceenv.InitWithKube(serviceenv.NewConfiguration(config))
+	}
+	if err := env.Config().CheckCoordinationBackend(); err != nil {
+		return err
+	}
 	debug.SetGCPercent(env.Config().GCPercent)
 	env.InitDexDB()
 	if env.Config().EtcdPrefix == "" {
@@ -857,9 +870,11 @@ func doFullMode(config interface{}) (retErr error) {
 
 		return server.ListenAndServeTLS(certPath, keyPath)
 	})
-	go waitForError("Githook Server", errChan, requireNoncriticalServers, func() error {
-		return githook.RunGitHookServer(env)
-	})
+	if mode != localMode {
+		go waitForError("Githook Server", errChan, requireNoncriticalServers, func() error {
+			return githook.RunGitHookServer(env)
+		})
+	}
 	go waitForError("S3 Server", errChan, requireNoncriticalServers, func() error {
 		server, err := s3.Server(env.Config().S3GatewayPort, s3.NewMasterDriver(), func() (*client.APIClient, error) {
 			return env.GetPachClient(context.Background()), nil
@@ -885,6 +900,19 @@ func doFullMode(config interface{}) (retErr error) {
 		http.Handle("/metrics", promhttp.Handler())
 		return http.ListenAndServe(fmt.Sprintf(":%v", assets.PrometheusPort), nil)
 	})
+	profilerClient := env.GetPachClient(context.Background())
+	profiler, err := debugserver.NewContinuousProfiler(profilerClient, env.Config(), path.Join("pachd", env.Config().PachdPodName), nil)
+	if err != nil {
+		return err
+	}
+	if profiler != nil {
+		go waitForError("Continuous Profiler", errChan, false, func() error {
+			if err := debugserver.AuthenticateAsPPS(context.Background(), profilerClient, env.GetEtcdClient()); err != nil {
+				return err
+			}
+			return profiler.Run(context.Background())
+		})
+	}
 	return <-errChan
 }
 
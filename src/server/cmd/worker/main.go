@@ -2,8 +2,10 @@ package main
 
 import (
 	"context"
+	"net"
 	"os"
 	"path"
+	"strconv"
 	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
@@ -16,11 +18,13 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 	"github.com/pachyderm/pachyderm/v2/src/server/cmd/worker/assets"
 	debugserver "github.com/pachyderm/pachyderm/v2/src/server/debug/server"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker"
 	workerserver "github.com/pachyderm/pachyderm/v2/src/server/worker/server"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/stats"
 	"github.com/pachyderm/pachyderm/v2/src/version"
 	"github.com/pachyderm/pachyderm/v2/src/version/versionpb"
 
@@ -56,7 +60,7 @@ func getPipelineInfo(pachClient *client.APIClient, env serviceenv.ServiceEnv) (*
 	defer cancel()
 	pipelines := ppsdb.Pipelines(env.GetDBClient(), env.GetPostgresListener())
 	pipelinePtr := &pps.StoredPipelineInfo{}
-	if err := pipelines.ReadOnly(ctx).Get(env.Config().PPSPipelineName, pipelinePtr); err != nil {
+	if err := pipelines.ReadOnly(ctx).Get(pfs.QualifyName(env.Config().PPSProjectName, env.Config().PPSPipelineName), pipelinePtr); err != nil {
 		return nil, err
 	}
 	pachClient.SetAuthToken(pipelinePtr.AuthToken)
@@ -72,6 +76,9 @@ func do(config interface{}) error {
 	// must run InstallJaegerTracer before InitWithKube/pach client initialization
 	tracing.InstallJaegerTracerFromEnv()
 	env := serviceenv.InitServiceEnv(serviceenv.NewConfiguration(config))
+	if err := env.Config().CheckCoordinationBackend(); err != nil {
+		return err
+	}
 
 	// Construct a client that connects to the sidecar.
 	pachClient := env.GetPachClient(context.Background())
@@ -81,8 +88,8 @@ func do(config interface{}) error {
 	}
 
 	// Construct worker API server.
-	workerRcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version)
-	workerInstance, err := worker.NewWorker(env, pachClient, pipelineInfo, "/")
+	workerRcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline, pipelineInfo.Version)
+	workerInstance, err := worker.NewWorker(env, pachClient, pipelineInfo, env.Config().PPSWorkerRoot)
 	if err != nil {
 		return err
 	}
@@ -97,8 +104,33 @@ func do(config interface{}) error {
 	versionpb.RegisterAPIServer(server.Server, version.NewAPIServer(version.Version, version.APIServerOptions{}))
 	debugclient.RegisterDebugServer(server.Server, debugserver.NewDebugServer(env, env.Config().PodName, pachClient))
 
-	// Put our IP address into etcd, so pachd can discover us
-	key := path.Join(env.Config().PPSEtcdPrefix, workerserver.WorkerEtcdPrefix, workerRcName, env.Config().PPSWorkerIP)
+	// Record profiles of this worker, if continuous profiling is enabled. The
+	// pipeline's token can't write to the profiles repo, so the profiler gets
+	// its own client.
+	profilerClient := env.GetPachClient(context.Background())
+	profiler, err := debugserver.NewContinuousProfiler(profilerClient, env.Config(), path.Join("pipelines", pipelineInfo.Pipeline.QualifiedName(), env.Config().PodName), stats.DatumDurations)
+	if err != nil {
+		return err
+	}
+	if profiler != nil {
+		go func() {
+			if err := debugserver.AuthenticateAsPPS(profilerClient.Ctx(), profilerClient, env.GetEtcdClient()); err != nil {
+				log.Errorf("error authenticating continuous profiler: %v", err)
+				return
+			}
+			if err := profiler.Run(profilerClient.Ctx()); err != nil {
+				log.Errorf("error running continuous profiler: %v", err)
+			}
+		}()
+	}
+
+	// Put our IP address into etcd, so pachd can discover us. Workers that share
+	// an IP (e.g. local workers) also advertise their port.
+	workerAddr := env.Config().PPSWorkerIP
+	if env.Config().PPSWorkerAdvertisePort {
+		workerAddr = net.JoinHostPort(workerAddr, strconv.Itoa(int(env.Config().PPSWorkerPort)))
+	}
+	key := path.Join(env.Config().PPSEtcdPrefix, workerserver.WorkerEtcdPrefix, workerRcName, workerAddr)
 
 	// Prepare to write "key" into etcd by creating lease -- if worker dies, our
 	// IP will be removed from etcd
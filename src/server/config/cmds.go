@@ -256,6 +256,7 @@ func Cmds() []*cobra.Command {
 	var authInfo string
 	var serverCAs string
 	var namespace string
+	var project string
 	var removeClusterDeploymentID bool
 	var updateContext *cobra.Command // standalone declaration so Run() can refer
 	updateContext = &cobra.Command{
@@ -314,6 +315,9 @@ func Cmds() []*cobra.Command {
 			if updateContext.Flags().Changed("namespace") {
 				context.Namespace = namespace
 			}
+			if updateContext.Flags().Changed("project") {
+				context.Project = project
+			}
 			if removeClusterDeploymentID {
 				context.ClusterDeploymentID = ""
 			}
@@ -326,6 +330,7 @@ func Cmds() []*cobra.Command {
 	updateContext.Flags().StringVar(&authInfo, "auth-info", "", "Set a new k8s auth info.")
 	updateContext.Flags().StringVar(&serverCAs, "server-cas", "", "Set new trusted CA certs.")
 	updateContext.Flags().StringVar(&namespace, "namespace", "", "Set a new namespace.")
+	updateContext.Flags().StringVar(&project, "project", "", "Set the current project, which repos and pipelines that aren't given a project belong to.")
 	updateContext.Flags().BoolVar(&removeClusterDeploymentID, "remove-cluster-deployment-id", false, "Remove the cluster deployment ID field, which will be repopulated on the next `pachctl` call using this context.")
 	shell.RegisterCompletionFunc(updateContext, contextCompletion)
 	commands = append(commands, cmdutil.CreateAlias(updateContext, "config update context"))
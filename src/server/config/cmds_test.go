@@ -137,6 +137,8 @@ func TestUpdateContext(t *testing.T) {
 		pachctl config get context foo | match '"pachd_address": "grpc://foobar:9000"'
 		pachctl config update context foo --pachd-address=""
 		pachctl config get context foo | match -v pachd_address
+		pachctl config update context foo --project=myproject
+		pachctl config get context foo | match '"project": "myproject"'
 	`))
 }
 
@@ -2,6 +2,7 @@ package cmds
 
 import (
 	"os"
+	"strings"
 	"time"
 
 	"github.com/gogo/protobuf/types"
@@ -79,10 +80,14 @@ func Cmds() []*cobra.Command {
 	commands = append(commands, cmdutil.CreateAlias(binary, "debug binary"))
 
 	var limit int64
+	var pipelines []string
+	var since, until string
+	var collectors []string
 	dump := &cobra.Command{
 		Use:   "{{alias}} <file>",
 		Short: "Collect a standard set of debugging information.",
-		Long:  "Collect a standard set of debugging information.",
+		Long: "Collect a standard set of debugging information. Secrets, tokens and environment variable values are redacted from the dump.\n\n" +
+			"The available collectors are: " + strings.Join(debug.Collectors, ", ") + ".",
 		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
 			client, err := client.NewOnUserMachine("debug-dump")
 			if err != nil {
@@ -93,8 +98,12 @@ func Cmds() []*cobra.Command {
 			if err != nil {
 				return err
 			}
+			selector, err := createSelector(pipelines, since, until, collectors, time.Now())
+			if err != nil {
+				return err
+			}
 			return withFile(args[0], func(f *os.File) error {
-				return client.Dump(filter, limit, f)
+				return client.DumpSelected(filter, limit, selector, f)
 			})
 		}),
 	}
@@ -102,6 +111,10 @@ func Cmds() []*cobra.Command {
 	dump.Flags().StringVarP(&pipeline, "pipeline", "p", "", "Only collect the dump from the worker pods for the given pipeline.")
 	dump.Flags().StringVarP(&worker, "worker", "w", "", "Only collect the dump from the given worker pod.")
 	dump.Flags().Int64VarP(&limit, "limit", "l", 0, "Limit sets the limit for the number of commits / jobs that are returned for each repo / pipeline in the dump.")
+	dump.Flags().StringSliceVar(&pipelines, "pipelines", nil, "Only collect pipeline information (and worker information) for the given pipelines, along with the information from pachd.")
+	dump.Flags().StringVar(&since, "since", "", "Only collect logs, commits and jobs more recent than \"since\" (a duration such as 2h, or an RFC 3339 time).")
+	dump.Flags().StringVar(&until, "until", "", "Only collect logs, commits and jobs older than \"until\" (a duration such as 2h, or an RFC 3339 time).")
+	dump.Flags().StringSliceVar(&collectors, "collect", nil, "Only run the given collectors (comma-separated). Defaults to all of them.")
 	commands = append(commands, cmdutil.CreateAlias(dump, "debug dump"))
 
 	debug := &cobra.Command{
@@ -133,6 +146,45 @@ func createFilter(pachd bool, pipeline, worker string) (*debug.Filter, error) {
 	return f, nil
 }
 
+// createSelector builds a DumpSelector from the dump command's flags, or
+// returns nil if no flags narrow down the dump.
+func createSelector(pipelines []string, since, until string, collectors []string, now time.Time) (*debug.DumpSelector, error) {
+	if len(pipelines) == 0 && since == "" && until == "" && len(collectors) == 0 {
+		return nil, nil
+	}
+	if err := debug.ValidateCollectors(collectors); err != nil {
+		return nil, err
+	}
+	selector := &debug.DumpSelector{Collectors: collectors}
+	for _, p := range pipelines {
+		selector.Pipelines = append(selector.Pipelines, &pps.Pipeline{Name: p})
+	}
+	var err error
+	if selector.Since, err = parseTime(since, now); err != nil {
+		return nil, errors.Wrapf(err, "error parsing since(%q)", since)
+	}
+	if selector.Until, err = parseTime(until, now); err != nil {
+		return nil, errors.Wrapf(err, "error parsing until(%q)", until)
+	}
+	return selector, nil
+}
+
+// parseTime parses 't', which is either a duration before 'now' or an
+// RFC 3339 time. An empty 't' yields nil.
+func parseTime(t string, now time.Time) (*types.Timestamp, error) {
+	if t == "" {
+		return nil, nil
+	}
+	if d, err := time.ParseDuration(t); err == nil {
+		return types.TimestampProto(now.Add(-d))
+	}
+	parsed, err := time.Parse(time.RFC3339, t)
+	if err != nil {
+		return nil, errors.Errorf("must be a duration or an RFC 3339 time")
+	}
+	return types.TimestampProto(parsed)
+}
+
 func withFile(file string, cb func(*os.File) error) (retErr error) {
 	f, err := os.Create(file)
 	if err != nil {
@@ -0,0 +1,262 @@
+package server
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"runtime"
+	"strings"
+	"time"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/debug"
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsconsts"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// profileTimeFormat is the format of the timestamp that begins each
+	// recorded profile's file name. It sorts chronologically.
+	profileTimeFormat = "20060102T150405Z"
+	// thresholdPollInterval is how often the profiler checks its thresholds.
+	thresholdPollInterval = 10 * time.Second
+	// triggerCooldown is the minimum time between two profiles recorded for
+	// the same threshold, so that a process that stays above a threshold
+	// doesn't record profiles continuously.
+	triggerCooldown = 10 * time.Minute
+	// slowDatumMinSamples is the number of datums that a worker must have
+	// processed before the profiler considers any datum slow.
+	slowDatumMinSamples = 20
+
+	reasonScheduled = "scheduled"
+	reasonHeap      = "heap"
+	reasonSlowDatum = "slow-datum"
+)
+
+// DatumTracker reports the durations of a worker's datums (it's implemented
+// by the worker's stats.DurationTracker).
+type DatumTracker interface {
+	// Percentile returns the p'th percentile of recent datum durations, along
+	// with the number of datums it's based on.
+	Percentile(p float64) (time.Duration, int)
+	// Longest returns how long the longest-running datum has been running.
+	Longest() time.Duration
+}
+
+// ContinuousProfiler records CPU, heap and goroutine profiles of the current
+// process into debug.ProfilesRepo, on a schedule and when the process crosses
+// the configured thresholds. Profiles are written to
+// <name>/<timestamp>-<reason>.tar.gz, in the same format as the Profile RPC.
+type ContinuousProfiler struct {
+	pachClient    *client.APIClient
+	name          string
+	interval      time.Duration
+	retention     time.Duration
+	cpuDuration   time.Duration
+	heapThreshold uint64
+	datums        DatumTracker
+}
+
+// NewContinuousProfiler returns a ContinuousProfiler that records profiles
+// under 'name' using 'pachClient'. 'datums' may be nil in processes that don't
+// run datums. It returns nil if 'config' doesn't enable continuous profiling.
+func NewContinuousProfiler(pachClient *client.APIClient, config *serviceenv.Configuration, name string, datums DatumTracker) (*ContinuousProfiler, error) {
+	p := &ContinuousProfiler{
+		pachClient: pachClient,
+		name:       name,
+	}
+	var err error
+	if config.ProfilingInterval != "" {
+		if p.interval, err = time.ParseDuration(config.ProfilingInterval); err != nil {
+			return nil, errors.Wrapf(err, "could not parse profiling interval")
+		}
+	}
+	if p.retention, err = time.ParseDuration(config.ProfilingRetention); err != nil {
+		return nil, errors.Wrapf(err, "could not parse profiling retention")
+	}
+	if p.cpuDuration, err = time.ParseDuration(config.ProfilingCPUDuration); err != nil {
+		return nil, errors.Wrapf(err, "could not parse profiling CPU duration")
+	}
+	if config.ProfilingHeapThreshold > 0 {
+		p.heapThreshold = uint64(config.ProfilingHeapThreshold)
+	}
+	if config.ProfilingSlowDatums {
+		p.datums = datums
+	}
+	if p.interval == 0 && p.heapThreshold == 0 && p.datums == nil {
+		return nil, nil
+	}
+	return p, nil
+}
+
+// AuthenticateAsPPS sets the auth token of 'pachClient' to PPS's superuser
+// token, so that a ContinuousProfiler using it can still write to
+// debug.ProfilesRepo once auth is activated (a pipeline's own token can't).
+// The auth server generates the token at startup and stores it in etcd, so
+// this retries until it's there or 'ctx' is cancelled.
+func AuthenticateAsPPS(ctx context.Context, pachClient *client.APIClient, etcdClient *etcd.Client) error {
+	var token types.StringValue
+	if err := backoff.RetryUntilCancel(ctx, func() error {
+		return col.NewEtcdCollection(etcdClient, ppsconsts.PPSTokenKey, nil, &types.StringValue{}, nil, nil).ReadOnly(ctx).Get("", &token)
+	}, backoff.NewExponentialBackOff(), func(err error, d time.Duration) error {
+		log.Errorf("error getting the PPS token, retrying in %v: %v", d, err)
+		return nil
+	}); err != nil {
+		return err
+	}
+	pachClient.SetAuthToken(token.Value)
+	return nil
+}
+
+// Run records profiles until 'ctx' is cancelled.
+func (p *ContinuousProfiler) Run(ctx context.Context) error {
+	if err := backoff.RetryUntilCancel(ctx, p.ensureRepo, backoff.NewInfiniteBackOff(),
+		func(err error, d time.Duration) error {
+			log.Errorf("error creating %s repo, retrying in %v: %v", debug.ProfilesRepo, d, err)
+			return nil
+		}); err != nil {
+		return err
+	}
+	var scheduled <-chan time.Time
+	if p.interval > 0 {
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+		scheduled = ticker.C
+	}
+	poll := time.NewTicker(thresholdPollInterval)
+	defer poll.Stop()
+	lastTriggered := make(map[string]time.Time)
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.EnsureStack(ctx.Err())
+		case <-scheduled:
+			p.record(reasonScheduled)
+		case <-poll.C:
+			for _, reason := range p.crossedThresholds() {
+				if time.Since(lastTriggered[reason]) < triggerCooldown {
+					continue
+				}
+				lastTriggered[reason] = time.Now()
+				p.record(reason)
+			}
+		}
+	}
+}
+
+func (p *ContinuousProfiler) ensureRepo() error {
+	if _, err := p.pachClient.InspectRepo(debug.ProfilesRepo); err == nil {
+		return nil
+	}
+	if err := p.pachClient.CreateRepo(debug.ProfilesRepo); err != nil && !errutil.IsAlreadyExistError(err) {
+		return err
+	}
+	return nil
+}
+
+// crossedThresholds returns the reasons for each threshold that the process
+// is currently over.
+func (p *ContinuousProfiler) crossedThresholds() []string {
+	var reasons []string
+	if p.heapThreshold > 0 {
+		var stats runtime.MemStats
+		runtime.ReadMemStats(&stats)
+		if stats.HeapAlloc > p.heapThreshold {
+			reasons = append(reasons, reasonHeap)
+		}
+	}
+	if p.datums != nil {
+		if p95, n := p.datums.Percentile(0.95); n >= slowDatumMinSamples && p.datums.Longest() > p95 {
+			reasons = append(reasons, reasonSlowDatum)
+		}
+	}
+	return reasons
+}
+
+// record records a set of profiles, logging any error (a failed recording
+// shouldn't stop future ones).
+func (p *ContinuousProfiler) record(reason string) {
+	log.Infof("recording profiles of %s (reason: %s)", p.name, reason)
+	if err := p.recordProfiles(reason, time.Now()); err != nil {
+		log.Errorf("error recording profiles of %s: %v", p.name, err)
+	}
+}
+
+func (p *ContinuousProfiler) recordProfiles(reason string, now time.Time) error {
+	buf := &bytes.Buffer{}
+	if err := withDebugWriter(buf, func(tw *tar.Writer) error {
+		for _, profile := range []*debug.Profile{
+			{Name: "cpu", Duration: types.DurationProto(p.cpuDuration)},
+			{Name: "heap"},
+			{Name: "goroutine"},
+		} {
+			if err := collectProfile(tw, profile); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	file := path.Join(p.name, profileFileName(now, reason))
+	commit := client.NewCommit(debug.ProfilesRepo, "master", "")
+	expired, err := p.expiredProfiles(commit, now)
+	if err != nil {
+		return err
+	}
+	return p.pachClient.WithModifyFileClient(commit, func(mf client.ModifyFile) error {
+		if err := mf.PutFile(file, buf); err != nil {
+			return err
+		}
+		for _, f := range expired {
+			if err := mf.DeleteFile(f); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// expiredProfiles returns the profiles recorded under this profiler's name
+// that are older than the retention period.
+func (p *ContinuousProfiler) expiredProfiles(commit *pfs.Commit, now time.Time) ([]string, error) {
+	var expired []string
+	if err := p.pachClient.ListFile(commit, p.name, func(fi *pfs.FileInfo) error {
+		recorded, ok := parseProfileFileName(path.Base(fi.File.Path))
+		if ok && now.Sub(recorded) > p.retention {
+			expired = append(expired, fi.File.Path)
+		}
+		return nil
+	}); err != nil && !errutil.IsNotFoundError(err) {
+		return nil, err
+	}
+	return expired, nil
+}
+
+func profileFileName(t time.Time, reason string) string {
+	return fmt.Sprintf("%s-%s.tar.gz", t.UTC().Format(profileTimeFormat), reason)
+}
+
+// parseProfileFileName returns the time at which the profiles in 'name' (a
+// file named by profileFileName) were recorded.
+func parseProfileFileName(name string) (time.Time, bool) {
+	i := strings.IndexByte(name, '-')
+	if i < 0 {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(profileTimeFormat, name[:i])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
@@ -0,0 +1,123 @@
+package server
+
+import (
+	"context"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/debug"
+	"github.com/pachyderm/pachyderm/v2/src/enterprise"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
+	tu "github.com/pachyderm/pachyderm/v2/src/internal/testutil"
+	txnenv "github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	authserver "github.com/pachyderm/pachyderm/v2/src/server/auth/server"
+)
+
+func profilingConfig(profiling serviceenv.ProfilingConfiguration) *serviceenv.Configuration {
+	profiling.ProfilingRetention = "168h"
+	profiling.ProfilingCPUDuration = "30s"
+	return &serviceenv.Configuration{
+		GlobalConfiguration: &serviceenv.GlobalConfiguration{ProfilingConfiguration: profiling},
+	}
+}
+
+type fakeDatumTracker struct {
+	p95, longest time.Duration
+	n            int
+}
+
+func (f *fakeDatumTracker) Percentile(float64) (time.Duration, int) { return f.p95, f.n }
+func (f *fakeDatumTracker) Longest() time.Duration                  { return f.longest }
+
+func TestNewContinuousProfiler(t *testing.T) {
+	// disabled by default
+	p, err := NewContinuousProfiler(nil, profilingConfig(serviceenv.ProfilingConfiguration{}), "pachd", &fakeDatumTracker{})
+	require.NoError(t, err)
+	require.Nil(t, p)
+
+	p, err = NewContinuousProfiler(nil, profilingConfig(serviceenv.ProfilingConfiguration{ProfilingInterval: "1h"}), "pachd", nil)
+	require.NoError(t, err)
+	require.Equal(t, time.Hour, p.interval)
+
+	_, err = NewContinuousProfiler(nil, profilingConfig(serviceenv.ProfilingConfiguration{ProfilingInterval: "often"}), "pachd", nil)
+	require.YesError(t, err)
+}
+
+func TestProfilerThresholds(t *testing.T) {
+	datums := &fakeDatumTracker{p95: time.Minute, longest: 2 * time.Minute, n: slowDatumMinSamples}
+	p, err := NewContinuousProfiler(nil, profilingConfig(serviceenv.ProfilingConfiguration{
+		ProfilingHeapThreshold: 1,
+		ProfilingSlowDatums:    true,
+	}), "pipelines/p/pod", datums)
+	require.NoError(t, err)
+	require.ElementsEqual(t, []string{reasonHeap, reasonSlowDatum}, p.crossedThresholds())
+
+	// too few datums to know what's slow
+	datums.n = slowDatumMinSamples - 1
+	require.ElementsEqual(t, []string{reasonHeap}, p.crossedThresholds())
+}
+
+func TestProfileFileName(t *testing.T) {
+	now := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	name := profileFileName(now, reasonSlowDatum)
+	require.Equal(t, "20210304T050607Z-slow-datum.tar.gz", name)
+	recorded, ok := parseProfileFileName(name)
+	require.True(t, ok)
+	require.True(t, now.Equal(recorded))
+	_, ok = parseProfileFileName("notes.txt")
+	require.False(t, ok)
+}
+
+// TestRecordProfilesWithAuth checks that a profiler authenticated as PPS can
+// record profiles once auth is activated, as workers' profilers do, while a
+// token without access to the profiles repo (like a pipeline's) can't.
+func TestRecordProfilesWithAuth(t *testing.T) {
+	env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+	env.MockPachd.Enterprise.GetState.Use(func(context.Context, *enterprise.GetStateRequest) (*enterprise.GetStateResponse, error) {
+		return &enterprise.GetStateResponse{State: enterprise.State_ACTIVE}, nil
+	})
+	txnEnv := &txnenv.TransactionEnv{}
+	txnEnv.Initialize(env.ServiceEnv, env.TransactionServer)
+	authServer, err := authserver.NewAuthServer(env.ServiceEnv, txnEnv, false, false, false)
+	require.NoError(t, err)
+	env.ServiceEnv.(*serviceenv.NonblockingServiceEnv).SetAuthServer(authServer)
+	env.MockPachd.Auth.Activate.Use(authServer.Activate)
+	env.MockPachd.Auth.GetRobotToken.Use(authServer.GetRobotToken)
+
+	rootClient := env.ServiceEnv.GetPachClient(context.Background())
+	activateResp, err := rootClient.AuthAPIClient.Activate(rootClient.Ctx(), &auth.ActivateRequest{})
+	require.NoError(t, err)
+	rootClient.SetAuthToken(activateResp.PachToken)
+	robotResp, err := rootClient.AuthAPIClient.GetRobotToken(rootClient.Ctx(), &auth.GetRobotTokenRequest{Robot: "pipeline"})
+	require.NoError(t, err)
+
+	config := profilingConfig(serviceenv.ProfilingConfiguration{ProfilingInterval: "1h"})
+	name := "pipelines/default/pipeline/pod"
+	now := time.Now()
+
+	profilerClient := env.ServiceEnv.GetPachClient(context.Background())
+	require.NoError(t, AuthenticateAsPPS(profilerClient.Ctx(), profilerClient, env.ServiceEnv.GetEtcdClient()))
+	p, err := NewContinuousProfiler(profilerClient, config, name, nil)
+	require.NoError(t, err)
+	require.NoError(t, p.ensureRepo())
+	require.NoError(t, p.recordProfiles(reasonScheduled, now))
+
+	robotClient := env.ServiceEnv.GetPachClient(context.Background())
+	robotClient.SetAuthToken(robotResp.Token)
+	p, err = NewContinuousProfiler(robotClient, config, name, nil)
+	require.NoError(t, err)
+	require.YesError(t, p.recordProfiles(reasonScheduled, now.Add(time.Minute)))
+
+	var files []string
+	require.NoError(t, rootClient.ListFile(client.NewCommit(debug.ProfilesRepo, "master", ""), name, func(fi *pfs.FileInfo) error {
+		files = append(files, fi.File.Path)
+		return nil
+	}))
+	require.Equal(t, []string{path.Join("/", name, profileFileName(now, reasonScheduled))}, files)
+}
@@ -0,0 +1,51 @@
+package server
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+func TestRedactLine(t *testing.T) {
+	for in, out := range map[string]string{
+		`AWS_SECRET_ACCESS_KEY=abc123`:                   `AWS_SECRET_ACCESS_KEY=[REDACTED]`,
+		`"authToken": "0123456789abcdef",`:               `"authToken": "[REDACTED]",`,
+		`password: hunter2`:                              `password: [REDACTED]`,
+		`Authorization: Bearer abc.def.ghi`:              `Authorization: Bearer [REDACTED]`,
+		`connecting to postgres://pachyderm:pw@db:5432/`: `connecting to postgres://pachyderm:[REDACTED]@db:5432/`,
+		`processed 10 datums in 2s`:                      `processed 10 datums in 2s`,
+	} {
+		require.Equal(t, out, string(redactLine([]byte(in))))
+	}
+}
+
+func TestRedactWriter(t *testing.T) {
+	in := "line one\nmy_token=abc\nsecret: xyz"
+	buf := &bytes.Buffer{}
+	rw := &redactWriter{w: buf}
+	// write one byte at a time to exercise partial lines
+	_, err := io.Copy(rw, iotest.OneByteReader(strings.NewReader(in)))
+	require.NoError(t, err)
+	require.NoError(t, rw.Flush())
+	require.Equal(t, "line one\nmy_token=[REDACTED]\nsecret: [REDACTED]", buf.String())
+}
+
+func TestRedactPipelineInfo(t *testing.T) {
+	pipelineInfo := &pps.PipelineInfo{
+		Pipeline: &pps.Pipeline{Name: "p"},
+		Transform: &pps.Transform{
+			Cmd: []string{"sh"},
+			Env: map[string]string{"DB_URL": "postgres://u:p@h"},
+		},
+	}
+	redactedInfo := redactPipelineInfo(pipelineInfo)
+	require.Equal(t, redacted, redactedInfo.Transform.Env["DB_URL"])
+	require.Equal(t, "sh", redactedInfo.Transform.Cmd[0])
+	// the original is untouched
+	require.Equal(t, "postgres://u:p@h", pipelineInfo.Transform.Env["DB_URL"])
+}
@@ -0,0 +1,76 @@
+package server
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/v2/src/debug"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+// dumpSelector decides which debugging information a dump collects, based on
+// a DumpRequest's selector. The zero value selects everything.
+type dumpSelector struct {
+	pipelines  []*pps.Pipeline
+	collectors map[string]bool
+	// since and until bound the time window of the dump. Zero values leave
+	// that end of the window open.
+	since, until time.Time
+}
+
+func newDumpSelector(selector *debug.DumpSelector) (*dumpSelector, error) {
+	s := &dumpSelector{}
+	if selector == nil {
+		return s, nil
+	}
+	if err := debug.ValidateCollectors(selector.Collectors); err != nil {
+		return nil, err
+	}
+	s.pipelines = selector.Pipelines
+	if len(selector.Collectors) > 0 {
+		s.collectors = make(map[string]bool)
+		for _, c := range selector.Collectors {
+			s.collectors[c] = true
+		}
+	}
+	var err error
+	if selector.Since != nil {
+		if s.since, err = types.TimestampFromProto(selector.Since); err != nil {
+			return nil, err
+		}
+	}
+	if selector.Until != nil {
+		if s.until, err = types.TimestampFromProto(selector.Until); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// collects returns true if the collector 'name' should run.
+func (s *dumpSelector) collects(name string) bool {
+	return s.collectors == nil || s.collectors[name]
+}
+
+// windowed returns true if the dump is restricted to a time window.
+func (s *dumpSelector) windowed() bool {
+	return !s.since.IsZero() || !s.until.IsZero()
+}
+
+// inWindow returns true if 't' falls within the dump's time window.
+func (s *dumpSelector) inWindow(t time.Time) bool {
+	return (s.since.IsZero() || !t.Before(s.since)) && (s.until.IsZero() || !t.After(s.until))
+}
+
+// inWindowProto is like inWindow, but for proto timestamps. Missing timestamps
+// (e.g. for jobs that haven't started) are only in an unbounded window.
+func (s *dumpSelector) inWindowProto(ts *types.Timestamp) bool {
+	if ts == nil {
+		return !s.windowed()
+	}
+	t, err := types.TimestampFromProto(ts)
+	if err != nil {
+		return false
+	}
+	return s.inWindow(t)
+}
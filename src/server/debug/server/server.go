@@ -2,16 +2,21 @@ package server
 
 import (
 	"archive/tar"
+	"bufio"
 	"context"
+	"fmt"
 	"io"
 	"math"
 	"os"
 	"runtime/pprof"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
+	"github.com/lib/pq"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/debug"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
@@ -24,6 +29,7 @@ import (
 	workerserver "github.com/pachyderm/pachyderm/v2/src/server/worker/server"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kube "k8s.io/client-go/kubernetes"
 )
 
 // TODO: Figure out how pipeline versions should come into play with this.
@@ -62,6 +68,7 @@ func (s *debugServer) handleRedirect(
 	pachClient *client.APIClient,
 	server grpcutil.StreamingBytesServer,
 	filter *debug.Filter,
+	pipelines []*pps.Pipeline,
 	collectPachd collectFunc,
 	collectPipeline collectPipelineFunc,
 	collectWorker collectWorkerFunc,
@@ -77,7 +84,7 @@ func (s *debugServer) handleRedirect(
 				case *debug.Filter_Pachd:
 					return collectPachd(tw, pachdContainerPrefix)
 				case *debug.Filter_Pipeline:
-					pipelineInfo, err := pachClient.InspectPipeline(f.Pipeline.Name)
+					pipelineInfo, err := pachClient.InspectPipeline(f.Pipeline.QualifiedName())
 					if err != nil {
 						return err
 					}
@@ -100,20 +107,36 @@ func (s *debugServer) handleRedirect(
 						return collectDebugStream(tw, r)
 
 					}
-					pod, err := s.env.GetKubeClient().CoreV1().Pods(s.env.Config().Namespace).Get(f.Worker.Pod, metav1.GetOptions{})
+					kubeClient, err := s.kubeClient()
+					if err != nil {
+						return err
+					}
+					pod, err := kubeClient.CoreV1().Pods(s.env.Config().Namespace).Get(f.Worker.Pod, metav1.GetOptions{})
 					if err != nil {
 						return err
 					}
 					return s.handleWorkerRedirect(tw, pod, collectWorker, redirect)
 				}
 			}
-			// No filter, collect everything.
+			// No filter, collect everything (or just the given pipelines).
 			if err := collectPachd(tw, pachdContainerPrefix); err != nil {
 				return err
 			}
-			pipelineInfos, err := pachClient.ListPipeline()
-			if err != nil {
-				return err
+			var pipelineInfos []*pps.PipelineInfo
+			if len(pipelines) > 0 {
+				for _, pipeline := range pipelines {
+					pipelineInfo, err := pachClient.InspectPipeline(pipeline.QualifiedName())
+					if err != nil {
+						return err
+					}
+					pipelineInfos = append(pipelineInfos, pipelineInfo)
+				}
+			} else {
+				var err error
+				pipelineInfos, err = pachClient.ListPipeline()
+				if err != nil {
+					return err
+				}
 			}
 			for _, pipelineInfo := range pipelineInfos {
 				if err := s.handlePipelineRedirect(tw, pipelineInfo, collectPipeline, collectWorker, redirect); err != nil {
@@ -132,7 +155,7 @@ func (s *debugServer) handlePipelineRedirect(
 	collectWorker collectWorkerFunc,
 	redirect redirectFunc,
 ) (retErr error) {
-	prefix := join(pipelinePrefix, pipelineInfo.Pipeline.Name)
+	prefix := join(pipelinePrefix, pipelineInfo.Pipeline.QualifiedName())
 	defer func() {
 		if retErr != nil {
 			retErr = writeErrorFile(tw, retErr, prefix)
@@ -154,7 +177,7 @@ func (s *debugServer) forEachWorker(tw *tar.Writer, pipelineInfo *pps.PipelineIn
 		return err
 	}
 	if len(pods) == 0 {
-		return errors.Errorf("no worker pods found for pipeline %v", pipelineInfo.Pipeline.Name)
+		return errors.Errorf("no worker pods found for pipeline %v", pipelineInfo.Pipeline.QualifiedName())
 	}
 	for _, pod := range pods {
 		if err := cb(&pod); err != nil {
@@ -164,8 +187,21 @@ func (s *debugServer) forEachWorker(tw *tar.Writer, pipelineInfo *pps.PipelineIn
 	return nil
 }
 
+// kubeClient returns the kubernetes client, or an error if pachd runs pipeline
+// workers as local processes and therefore has no kubernetes client.
+func (s *debugServer) kubeClient() (*kube.Clientset, error) {
+	if s.env.Config().WorkerRuntime == "local" {
+		return nil, errors.New("kubernetes is unavailable when pachd runs pipeline workers as local processes")
+	}
+	return s.env.GetKubeClient(), nil
+}
+
 func (s *debugServer) getWorkerPods(pipelineInfo *pps.PipelineInfo) ([]v1.Pod, error) {
-	podList, err := s.env.GetKubeClient().CoreV1().Pods(s.env.Config().Namespace).List(
+	kubeClient, err := s.kubeClient()
+	if err != nil {
+		return nil, err
+	}
+	podList, err := kubeClient.CoreV1().Pods(s.env.Config().Namespace).List(
 		metav1.ListOptions{
 			TypeMeta: metav1.TypeMeta{
 				Kind:       "ListOptions",
@@ -174,7 +210,7 @@ func (s *debugServer) getWorkerPods(pipelineInfo *pps.PipelineInfo) ([]v1.Pod, e
 			LabelSelector: metav1.FormatLabelSelector(
 				metav1.SetAsLabelSelector(
 					map[string]string{
-						"app": ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version),
+						"app": ppsutil.PipelineRcName(pipelineInfo.Pipeline, pipelineInfo.Version),
 					},
 				),
 			),
@@ -228,6 +264,7 @@ func (s *debugServer) Profile(request *debug.ProfileRequest, server debug.Debug_
 		pachClient,
 		server,
 		request.Filter,
+		nil,
 		collectProfileFunc(request.Profile),
 		nil,
 		nil,
@@ -291,6 +328,7 @@ func (s *debugServer) Binary(request *debug.BinaryRequest, server debug.Debug_Bi
 		pachClient,
 		server,
 		request.Filter,
+		nil,
 		collectBinary,
 		nil,
 		nil,
@@ -329,53 +367,69 @@ func (s *debugServer) Dump(request *debug.DumpRequest, server debug.Debug_DumpSe
 	if request.Limit == 0 {
 		request.Limit = math.MaxInt64
 	}
+	selector, err := newDumpSelector(request.Selector)
+	if err != nil {
+		return err
+	}
 	pachClient := s.env.GetPachClient(server.Context())
 	return s.handleRedirect(
 		pachClient,
 		server,
 		request.Filter,
-		s.collectPachdDumpFunc(pachClient, request.Limit),
-		s.collectPipelineDumpFunc(pachClient, request.Limit),
-		s.collectWorkerDump,
-		redirectDumpFunc(pachClient.Ctx()),
-		collectDump,
+		selector.pipelines,
+		s.collectPachdDumpFunc(pachClient, request.Limit, selector),
+		s.collectPipelineDumpFunc(pachClient, request.Limit, selector),
+		s.collectWorkerDumpFunc(selector),
+		redirectDumpFunc(pachClient.Ctx(), request),
+		collectDumpFunc(selector),
 	)
 }
 
-func (s *debugServer) collectPachdDumpFunc(pachClient *client.APIClient, limit int64) collectFunc {
+func (s *debugServer) collectPachdDumpFunc(pachClient *client.APIClient, limit int64, selector *dumpSelector) collectFunc {
 	return func(tw *tar.Writer, prefix ...string) error {
 		// Collect input repos.
-		if err := s.collectInputRepos(tw, pachClient, limit); err != nil {
-			return err
+		if selector.collects(debug.CollectCommits) {
+			if err := s.collectInputRepos(tw, pachClient, limit, selector); err != nil {
+				return err
+			}
 		}
 		// Collect the pachd version.
-		if err := s.collectPachdVersion(tw, pachClient, prefix...); err != nil {
-			return err
+		if selector.collects(debug.CollectVersion) {
+			if err := s.collectPachdVersion(tw, pachClient, prefix...); err != nil {
+				return err
+			}
 		}
 		// Collect the pachd container logs.
-		if err := s.collectLogs(tw, s.name, "pachd", prefix...); err != nil {
-			return err
+		if selector.collects(debug.CollectLogs) {
+			if err := s.collectLogs(tw, s.name, "pachd", selector, prefix...); err != nil {
+				return err
+			}
+		}
+		// Collect the state of pachd's database.
+		if selector.collects(debug.CollectDatabase) {
+			if err := s.collectDatabase(pachClient.Ctx(), tw, prefix...); err != nil {
+				return err
+			}
 		}
 		// Collect the pachd container dump.
-		return collectDump(tw, prefix...)
+		return collectDumpFunc(selector)(tw, prefix...)
 	}
 }
 
-func (s *debugServer) collectInputRepos(tw *tar.Writer, pachClient *client.APIClient, limit int64) error {
+func (s *debugServer) collectInputRepos(tw *tar.Writer, pachClient *client.APIClient, limit int64, selector *dumpSelector) error {
 	repoInfos, err := pachClient.ListRepo()
 	if err != nil {
 		return err
 	}
 	for _, repoInfo := range repoInfos {
-		if _, err := pachClient.InspectPipeline(repoInfo.Repo.Name); err != nil {
+		if _, err := pachClient.InspectPipeline(repoInfo.Repo.QualifiedName()); err != nil {
 			// TODO: It would be better for this to be a structured error.
 			if strings.Contains(err.Error(), "not found") {
-				repoPrefix := join("input-repos", repoInfo.Repo.Name)
-				return collectDebugFile(tw, "commits", func(w io.Writer) error {
-					return pachClient.ListCommitF(repoInfo.Repo.Name, "", "", "", "", uint64(limit), false, func(ci *pfs.CommitInfo) error {
-						return s.marshaller.Marshal(w, ci)
-					})
-				}, repoPrefix)
+				repoPrefix := join("input-repos", repoInfo.Repo.QualifiedName())
+				if err := s.collectCommits(tw, pachClient, repoInfo.Repo.QualifiedName(), limit, selector, repoPrefix); err != nil {
+					return err
+				}
+				continue
 			}
 			return err
 		}
@@ -383,8 +437,30 @@ func (s *debugServer) collectInputRepos(tw *tar.Writer, pachClient *client.APICl
 	return nil
 }
 
+func (s *debugServer) collectCommits(tw *tar.Writer, pachClient *client.APIClient, repo string, limit int64, selector *dumpSelector, prefix ...string) error {
+	return collectRedactedFile(tw, "commits", func(w io.Writer) error {
+		// Commits outside of the time window don't count towards the limit, so
+		// only ask PFS to apply it when there is no window.
+		var number uint64
+		if !selector.windowed() {
+			number = uint64(limit)
+		}
+		var count int64
+		return pachClient.ListCommitF(repo, "", "", "", "", number, false, func(ci *pfs.CommitInfo) error {
+			if !selector.inWindowProto(ci.Started) {
+				return nil
+			}
+			if count >= limit {
+				return errutil.ErrBreak
+			}
+			count++
+			return s.marshaller.Marshal(w, ci)
+		})
+	}, prefix...)
+}
+
 func (s *debugServer) collectPachdVersion(tw *tar.Writer, pachClient *client.APIClient, prefix ...string) error {
-	return collectDebugFile(tw, "version", func(w io.Writer) error {
+	return collectRedactedFile(tw, "version", func(w io.Writer) error {
 		version, err := pachClient.Version()
 		if err != nil {
 			return err
@@ -394,37 +470,118 @@ func (s *debugServer) collectPachdVersion(tw *tar.Writer, pachClient *client.API
 	}, prefix...)
 }
 
-func (s *debugServer) collectLogs(tw *tar.Writer, pod, container string, prefix ...string) error {
-	if err := collectDebugFile(tw, "logs", func(w io.Writer) (retErr error) {
-		stream, err := s.env.GetKubeClient().CoreV1().Pods(s.env.Config().Namespace).GetLogs(pod, &v1.PodLogOptions{Container: container}).Stream()
-		if err != nil {
-			return err
+func (s *debugServer) collectLogs(tw *tar.Writer, pod, container string, selector *dumpSelector, prefix ...string) error {
+	if err := collectRedactedFile(tw, "logs", func(w io.Writer) error {
+		return s.writeLogs(w, pod, &v1.PodLogOptions{Container: container}, selector)
+	}, prefix...); err != nil {
+		return err
+	}
+	return collectRedactedFile(tw, "logs-previous", func(w io.Writer) error {
+		return s.writeLogs(w, pod, &v1.PodLogOptions{Container: container, Previous: true}, selector)
+	}, prefix...)
+}
+
+// writeLogs writes the logs of 'pod' that fall within the selector's time
+// window to 'w'.
+func (s *debugServer) writeLogs(w io.Writer, pod string, opts *v1.PodLogOptions, selector *dumpSelector) (retErr error) {
+	kubeClient, err := s.kubeClient()
+	if err != nil {
+		return err
+	}
+	if !selector.since.IsZero() {
+		opts.SinceTime = &metav1.Time{Time: selector.since}
+	}
+	// Kubernetes can only filter by start time, so filter by end time using
+	// the timestamp that it prefixes each line with
+	opts.Timestamps = !selector.until.IsZero()
+	stream, err := kubeClient.CoreV1().Pods(s.env.Config().Namespace).GetLogs(pod, opts).Stream()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := stream.Close(); retErr == nil {
+			retErr = err
 		}
-		defer func() {
-			if err := stream.Close(); retErr == nil {
-				retErr = err
-			}
-		}()
+	}()
+	if !opts.Timestamps {
 		_, err = io.Copy(w, stream)
 		return err
-	}, prefix...); err != nil {
-		return err
 	}
-	return collectDebugFile(tw, "logs-previous", func(w io.Writer) (retErr error) {
-		stream, err := s.env.GetKubeClient().CoreV1().Pods(s.env.Config().Namespace).GetLogs(pod, &v1.PodLogOptions{Container: container, Previous: true}).Stream()
-		if err != nil {
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if ts, err := time.Parse(time.RFC3339Nano, strings.SplitN(line, " ", 2)[0]); err == nil && ts.After(selector.until) {
+			// Lines are in order, so no later line is in the window either
+			return nil
+		}
+		if _, err := io.WriteString(w, line+"\n"); err != nil {
 			return err
 		}
-		defer func() {
-			if err := stream.Close(); retErr == nil {
-				retErr = err
+	}
+	return scanner.Err()
+}
+
+// collectDatabase writes the size and last update of each of pachd's Postgres
+// collections, and the migrations that have been applied to the database.
+func (s *debugServer) collectDatabase(ctx context.Context, tw *tar.Writer, prefix ...string) error {
+	return collectRedactedFile(tw, "database", func(w io.Writer) error {
+		db := s.env.GetDBClient()
+		var tables []string
+		if err := db.SelectContext(ctx, &tables, `SELECT table_name FROM information_schema.tables WHERE table_schema = 'collections' ORDER BY table_name`); err != nil {
+			return errors.EnsureStack(err)
+		}
+		out := tabwriter.NewWriter(w, 0, 1, 1, ' ', 0)
+		fmt.Fprintln(out, "COLLECTION\tROWS\tLAST UPDATED\t")
+		for _, table := range tables {
+			var rows int64
+			var updatedAt *time.Time
+			if err := db.QueryRowContext(ctx, fmt.Sprintf(`SELECT count(*), max(updatedat) FROM collections.%s`, pq.QuoteIdentifier(table))).Scan(&rows, &updatedAt); err != nil {
+				return errors.EnsureStack(err)
 			}
-		}()
-		_, err = io.Copy(w, stream)
-		return err
+			lastUpdated := "-"
+			if updatedAt != nil {
+				lastUpdated = updatedAt.UTC().Format(time.RFC3339)
+			}
+			fmt.Fprintf(out, "%s\t%d\t%s\t\n", table, rows, lastUpdated)
+		}
+		fmt.Fprintln(out)
+		fmt.Fprintln(out, "MIGRATION\tNAME\tSTARTED\tFINISHED\t")
+		rows, err := db.QueryContext(ctx, `SELECT id, name, start_time, end_time FROM migrations ORDER BY id`)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var id int64
+			var name string
+			var start time.Time
+			var end *time.Time
+			if err := rows.Scan(&id, &name, &start, &end); err != nil {
+				return errors.EnsureStack(err)
+			}
+			finished := "-"
+			if end != nil {
+				finished = end.UTC().Format(time.RFC3339)
+			}
+			fmt.Fprintf(out, "%d\t%s\t%s\t%s\t\n", id, name, start.UTC().Format(time.RFC3339), finished)
+		}
+		if err := rows.Err(); err != nil {
+			return errors.EnsureStack(err)
+		}
+		return out.Flush()
 	}, prefix...)
 }
 
+func collectDumpFunc(selector *dumpSelector) collectFunc {
+	return func(tw *tar.Writer, prefix ...string) error {
+		if !selector.collects(debug.CollectProfiles) {
+			return nil
+		}
+		return collectDump(tw, prefix...)
+	}
+}
+
 func collectDump(tw *tar.Writer, prefix ...string) error {
 	if err := collectProfile(tw, &debug.Profile{Name: "goroutine"}, prefix...); err != nil {
 		return err
@@ -432,55 +589,100 @@ func collectDump(tw *tar.Writer, prefix ...string) error {
 	return collectProfile(tw, &debug.Profile{Name: "heap"}, prefix...)
 }
 
-func (s *debugServer) collectPipelineDumpFunc(pachClient *client.APIClient, limit int64) collectPipelineFunc {
+func (s *debugServer) collectPipelineDumpFunc(pachClient *client.APIClient, limit int64, selector *dumpSelector) collectPipelineFunc {
 	return func(tw *tar.Writer, pipelineInfo *pps.PipelineInfo, prefix ...string) error {
-		if err := collectDebugFile(tw, "spec", func(w io.Writer) error {
-			fullPipelineInfo, err := pachClient.InspectPipeline(pipelineInfo.Pipeline.Name)
-			if err != nil {
+		if selector.collects(debug.CollectSpecs) {
+			if err := collectRedactedFile(tw, "spec", func(w io.Writer) error {
+				fullPipelineInfo, err := pachClient.InspectPipeline(pipelineInfo.Pipeline.QualifiedName())
+				if err != nil {
+					return err
+				}
+				return s.marshaller.Marshal(w, redactPipelineInfo(fullPipelineInfo))
+			}, prefix...); err != nil {
 				return err
 			}
-			return s.marshaller.Marshal(w, fullPipelineInfo)
-		}, prefix...); err != nil {
-			return err
 		}
-		if err := collectDebugFile(tw, "commits", func(w io.Writer) error {
-			return pachClient.ListCommitF(pipelineInfo.Pipeline.Name, "", "", "", "", uint64(limit), false, func(ci *pfs.CommitInfo) error {
-				return s.marshaller.Marshal(w, ci)
-			})
-		}, prefix...); err != nil {
-			return err
+		if selector.collects(debug.CollectCommits) {
+			if err := s.collectCommits(tw, pachClient, pipelineInfo.Pipeline.QualifiedName(), limit, selector, prefix...); err != nil {
+				return err
+			}
 		}
-		return collectDebugFile(tw, "jobs", func(w io.Writer) error {
-			// TODO: The limiting should eventually be a feature of list job.
-			var count int64
-			return pachClient.ListPipelineJobF(pipelineInfo.Pipeline.Name, nil, nil, 0, false, func(pji *pps.PipelineJobInfo) error {
-				if count >= limit {
-					return errutil.ErrBreak
+		if selector.collects(debug.CollectJobs) {
+			if err := s.collectJobs(tw, pachClient, pipelineInfo, limit, selector, "jobs", func(pji *pps.PipelineJobInfo) proto.Message {
+				return redactPipelineJobInfo(pji)
+			}, prefix...); err != nil {
+				return err
+			}
+		}
+		if selector.collects(debug.CollectJobReasons) {
+			return s.collectJobs(tw, pachClient, pipelineInfo, limit, selector, "job-reasons", func(pji *pps.PipelineJobInfo) proto.Message {
+				if pji.Reason == "" {
+					return nil
+				}
+				return &pps.PipelineJobInfo{
+					PipelineJob: pji.PipelineJob,
+					State:       pji.State,
+					Reason:      pji.Reason,
+					Started:     pji.Started,
+					Finished:    pji.Finished,
+					Restart:     pji.Restart,
 				}
-				count++
-				return s.marshaller.Marshal(w, pji)
-			})
-		}, prefix...)
+			}, prefix...)
+		}
+		return nil
 	}
 }
 
-func (s *debugServer) collectWorkerDump(tw *tar.Writer, pod *v1.Pod, prefix ...string) error {
-	// Collect the worker user and storage container logs.
-	userPrefix := client.PPSWorkerUserContainerName
-	sidecarPrefix := client.PPSWorkerSidecarContainerName
-	if len(prefix) > 0 {
-		userPrefix = join(prefix[0], userPrefix)
-		sidecarPrefix = join(prefix[0], sidecarPrefix)
-	}
-	if err := s.collectLogs(tw, pod.Name, client.PPSWorkerUserContainerName, userPrefix); err != nil {
-		return err
+// collectJobs writes the pipeline's jobs in the selector's time window to
+// the file 'name', after transforming them with 'f' (which skips a job by
+// returning nil).
+func (s *debugServer) collectJobs(tw *tar.Writer, pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo, limit int64, selector *dumpSelector, name string, f func(*pps.PipelineJobInfo) proto.Message, prefix ...string) error {
+	return collectRedactedFile(tw, name, func(w io.Writer) error {
+		// TODO: The limiting should eventually be a feature of list job.
+		var count int64
+		return pachClient.ListPipelineJobF(pipelineInfo.Pipeline.QualifiedName(), nil, nil, 0, false, func(pji *pps.PipelineJobInfo) error {
+			if !selector.inWindowProto(pji.Started) {
+				return nil
+			}
+			msg := f(pji)
+			if msg == nil {
+				return nil
+			}
+			if count >= limit {
+				return errutil.ErrBreak
+			}
+			count++
+			return s.marshaller.Marshal(w, msg)
+		})
+	}, prefix...)
+}
+
+func (s *debugServer) collectWorkerDumpFunc(selector *dumpSelector) collectWorkerFunc {
+	return func(tw *tar.Writer, pod *v1.Pod, prefix ...string) error {
+		if !selector.collects(debug.CollectLogs) {
+			return nil
+		}
+		// Collect the worker user and storage container logs.
+		userPrefix := client.PPSWorkerUserContainerName
+		sidecarPrefix := client.PPSWorkerSidecarContainerName
+		if len(prefix) > 0 {
+			userPrefix = join(prefix[0], userPrefix)
+			sidecarPrefix = join(prefix[0], sidecarPrefix)
+		}
+		if err := s.collectLogs(tw, pod.Name, client.PPSWorkerUserContainerName, selector, userPrefix); err != nil {
+			return err
+		}
+		return s.collectLogs(tw, pod.Name, client.PPSWorkerSidecarContainerName, selector, sidecarPrefix)
 	}
-	return s.collectLogs(tw, pod.Name, client.PPSWorkerSidecarContainerName, sidecarPrefix)
 }
 
-func redirectDumpFunc(ctx context.Context) redirectFunc {
+func redirectDumpFunc(ctx context.Context, request *debug.DumpRequest) redirectFunc {
 	return func(c debug.DebugClient, filter *debug.Filter) (io.Reader, error) {
-		dumpC, err := c.Dump(ctx, &debug.DumpRequest{Filter: filter})
+		dumpC, err := c.Dump(ctx, &debug.DumpRequest{
+			Filter:   filter,
+			Limit:    request.Limit,
+			Selector: request.Selector,
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,386 @@
+package http
+
+import (
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"reflect"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/admin"
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pagination"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/transaction"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/gogo/protobuf/types"
+	"github.com/julienschmidt/httprouter"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+const (
+	jsonContentType   = "application/json"
+	ndjsonContentType = "application/x-ndjson"
+	tarContentType    = "application/x-tar"
+	formContentType   = "multipart/form-data"
+
+	// transactionHeader is the header that runs a request in a transaction,
+	// like 'pachctl start transaction' does for pachctl commands.
+	transactionHeader = "Pach-Transaction"
+	// requestedWithHeader must be set on RPCs that are authenticated with the
+	// login cookie rather than the Authorization header.
+	requestedWithHeader = "X-Requested-With"
+)
+
+// gatewayPath is the prefix of the JSON gateway's routes. Each RPC is served
+// at gatewayPath/<package>/<method>, e.g. /v1/api/pfs/InspectRepo.
+var gatewayPath = versionPath("api")
+
+// gatewayProtos contains a message from each of the proto files whose
+// services are served by the JSON gateway.
+var gatewayProtos = []descriptor.Message{
+	&pfs.Repo{},
+	&pps.Pipeline{},
+	&auth.WhoAmIRequest{},
+	&transaction.Transaction{},
+	&admin.ClusterInfo{},
+}
+
+var (
+	marshaler   = &jsonpb.Marshaler{}
+	unmarshaler = &jsonpb.Unmarshaler{}
+)
+
+// gatewayMethod is an RPC served by the JSON gateway.
+type gatewayMethod struct {
+	pkg  string
+	name string
+	// fullName is the RPC's grpc method name, e.g. /pfs.API/InspectRepo.
+	fullName string
+	desc     *descriptor.MethodDescriptorProto
+}
+
+func (m *gatewayMethod) path() string {
+	return path.Join(gatewayPath, m.pkg, m.name)
+}
+
+// upload returns true if the method takes a body of files rather than JSON.
+func (m *gatewayMethod) upload() bool {
+	return m.fullName == "/pfs.API/ModifyFile" || m.fullName == "/pfs.API/CreateFileset"
+}
+
+// gatewayFiles returns the descriptors of the proto files served by the JSON
+// gateway.
+func gatewayFiles() []*descriptor.FileDescriptorProto {
+	var files []*descriptor.FileDescriptorProto
+	for _, msg := range gatewayProtos {
+		fd, _ := descriptor.ForMessage(msg)
+		files = append(files, fd)
+	}
+	return files
+}
+
+func gatewayMethods(files []*descriptor.FileDescriptorProto) []*gatewayMethod {
+	var methods []*gatewayMethod
+	for _, fd := range files {
+		for _, svc := range fd.Service {
+			for _, m := range svc.Method {
+				methods = append(methods, &gatewayMethod{
+					pkg:      fd.GetPackage(),
+					name:     m.GetName(),
+					fullName: "/" + fd.GetPackage() + "." + svc.GetName() + "/" + m.GetName(),
+					desc:     m,
+				})
+			}
+		}
+	}
+	return methods
+}
+
+// newMessage returns a new message of the fully qualified proto type
+// typeName, e.g. ".pfs.Repo".
+func newMessage(typeName string) (proto.Message, error) {
+	t := proto.MessageType(strings.TrimPrefix(typeName, "."))
+	if t == nil {
+		return nil, errors.Errorf("unknown message type %q", typeName)
+	}
+	return reflect.New(t.Elem()).Interface().(proto.Message), nil
+}
+
+// gatewayClient returns a client for the RPC request r, or writes an error if
+// r is authenticated in a way that RPCs don't accept.
+func (s *server) gatewayClient(w http.ResponseWriter, r *http.Request) (*client.APIClient, bool) {
+	if err := checkCookieAuth(r); err != nil {
+		writeError(w, http.StatusUnauthorized, err)
+		return nil, false
+	}
+	return s.requestClient(r, true), true
+}
+
+// checkCookieAuth returns an error if r is authenticated with the login cookie
+// but could have been sent by another site. Another site can make a browser
+// post a form with the cookie, so the cookie is only accepted on JSON requests
+// with the X-Requested-With header, which browsers only send across sites
+// after a CORS preflight that pachd doesn't allow.
+func checkCookieAuth(r *http.Request) error {
+	if bearerToken(r) != "" || cookieToken(r) == "" {
+		return nil
+	}
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || mediaType != jsonContentType || r.Header.Get(requestedWithHeader) == "" {
+		return errors.Errorf("requests authenticated with the login cookie must have the content type %s and the %s header; use the Authorization header otherwise", jsonContentType, requestedWithHeader)
+	}
+	return nil
+}
+
+// gatewayHandler returns the handler for the RPC m.
+func (s *server) gatewayHandler(m *gatewayMethod) httprouter.Handle {
+	switch {
+	case m.fullName == "/pfs.API/ModifyFile":
+		return s.modifyFileHandler
+	case m.fullName == "/pfs.API/CreateFileset":
+		return s.createFilesetHandler
+	case m.desc.GetClientStreaming():
+		return func(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+			writeError(w, http.StatusNotImplemented, errors.Errorf("%s is not supported over HTTP", m.fullName))
+		}
+	case m.desc.GetServerStreaming():
+		return s.streamHandler(m)
+	default:
+		return s.unaryHandler(m)
+	}
+}
+
+func (s *server) unaryHandler(m *gatewayMethod) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+		req, err := readRequest(r, m)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		resp, err := newMessage(m.desc.GetOutputType())
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		c, ok := s.gatewayClient(w, r)
+		if !ok {
+			return
+		}
+		if err := c.ClientConn().Invoke(c.Ctx(), m.fullName, req, resp); err != nil {
+			writeError(w, httpStatus(err), err)
+			return
+		}
+		writeMessage(w, resp)
+	}
+}
+
+// nextPageBody is the last line of a paginated stream that has more pages.
+type nextPageBody struct {
+	NextPageToken string `json:"nextPageToken"`
+}
+
+// streamHandler returns a handler for a server-streaming RPC, which writes
+// each response as a line of JSON. Errors that occur after the first
+// response has been written are reported on the last line, as is the token
+// of the next page of a paginated listing.
+func (s *server) streamHandler(m *gatewayMethod) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+		req, err := readRequest(r, m)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		c, ok := s.gatewayClient(w, r)
+		if !ok {
+			return
+		}
+		ctx, cancel := context.WithCancel(c.Ctx())
+		defer cancel()
+		stream, err := c.ClientConn().NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, m.fullName)
+		if err != nil {
+			writeError(w, httpStatus(err), err)
+			return
+		}
+		if err := stream.SendMsg(req); err != nil {
+			writeError(w, httpStatus(err), err)
+			return
+		}
+		if err := stream.CloseSend(); err != nil {
+			writeError(w, httpStatus(err), err)
+			return
+		}
+		var started bool
+		for {
+			resp, err := newMessage(m.desc.GetOutputType())
+			if err == nil {
+				err = stream.RecvMsg(resp)
+			}
+			if err != nil {
+				switch {
+				case errors.Is(err, io.EOF):
+					if !started {
+						w.Header().Set("Content-Type", ndjsonContentType)
+						w.WriteHeader(http.StatusOK)
+					}
+					if token := pagination.NextPageTokenFromTrailer(stream.Trailer()); token != "" {
+						json.NewEncoder(w).Encode(nextPageBody{NextPageToken: token})
+					}
+				case !started:
+					writeError(w, httpStatus(err), err)
+				default:
+					json.NewEncoder(w).Encode(newErrorBody(httpStatus(err), err))
+				}
+				return
+			}
+			if !started {
+				w.Header().Set("Content-Type", ndjsonContentType)
+				started = true
+			}
+			if err := marshaler.Marshal(w, resp); err != nil {
+				return
+			}
+			if _, err := io.WriteString(w, "\n"); err != nil {
+				return
+			}
+			if f, ok := w.(http.Flusher); ok {
+				f.Flush()
+			}
+		}
+	}
+}
+
+// modifyFileHandler puts the files in the request body into the commit given
+// by the repo, branch and commit query parameters.
+func (s *server) modifyFileHandler(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	mediaType, ok := uploadMediaType(w, r)
+	if !ok {
+		return
+	}
+	q := r.URL.Query()
+	commit := client.NewCommit(q.Get("repo"), q.Get("branch"), q.Get("commit"))
+	c, ok := s.gatewayClient(w, r)
+	if !ok {
+		return
+	}
+	if err := c.WithModifyFileClient(commit, func(mf client.ModifyFile) error {
+		return putFiles(mf, r, mediaType)
+	}); err != nil {
+		writeError(w, httpStatus(err), err)
+		return
+	}
+	writeMessage(w, &types.Empty{})
+}
+
+// createFilesetHandler creates a fileset from the files in the request body.
+func (s *server) createFilesetHandler(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	mediaType, ok := uploadMediaType(w, r)
+	if !ok {
+		return
+	}
+	c, ok := s.gatewayClient(w, r)
+	if !ok {
+		return
+	}
+	resp, err := c.WithCreateFilesetClient(func(mf client.ModifyFile) error {
+		return putFiles(mf, r, mediaType)
+	})
+	if err != nil {
+		writeError(w, httpStatus(err), err)
+		return
+	}
+	writeMessage(w, resp)
+}
+
+// uploadMediaType returns the media type of an upload, writing an error if
+// it isn't a tar stream or a multipart form.
+func uploadMediaType(w http.ResponseWriter, r *http.Request) (string, bool) {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err == nil && (mediaType == tarContentType || mediaType == formContentType) {
+		return mediaType, true
+	}
+	writeError(w, http.StatusUnsupportedMediaType, errors.Errorf("uploads must be %s or %s", formContentType, tarContentType))
+	return "", false
+}
+
+// putFiles puts the files in the body of r with mf. A tar stream is put as
+// is, and each part of a multipart form is put at the path given by its form
+// name, or its file name if it has no form name, under the path query
+// parameter.
+func putFiles(mf client.ModifyFile, r *http.Request, mediaType string) error {
+	q := r.URL.Query()
+	var opts []client.PutFileOption
+	if q.Get("append") == "true" {
+		opts = append(opts, client.WithAppendPutFile())
+	}
+	if tag := q.Get("tag"); tag != "" {
+		opts = append(opts, client.WithTagPutFile(tag))
+	}
+	if mediaType == tarContentType {
+		return mf.PutFileTar(r.Body, opts...)
+	}
+	mr, err := r.MultipartReader()
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	for {
+		part, err := mr.NextPart()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return errors.EnsureStack(err)
+		}
+		name := part.FormName()
+		if name == "" {
+			name = part.FileName()
+		}
+		if err := mf.PutFile(path.Join("/", q.Get("path"), name), part, opts...); err != nil {
+			return err
+		}
+	}
+}
+
+// readRequest reads the JSON request for m from the body of r. An empty body
+// is an empty request.
+func readRequest(r *http.Request, m *gatewayMethod) (proto.Message, error) {
+	req, err := newMessage(m.desc.GetInputType())
+	if err != nil {
+		return nil, err
+	}
+	if err := unmarshaler.Unmarshal(r.Body, req); err != nil && !errors.Is(err, io.EOF) {
+		return nil, errors.Wrap(err, "could not parse request")
+	}
+	return req, nil
+}
+
+func writeMessage(w http.ResponseWriter, msg proto.Message) {
+	w.Header().Set("Content-Type", jsonContentType)
+	marshaler.Marshal(w, msg)
+}
+
+// errorBody is the JSON body of a failed request.
+type errorBody struct {
+	Code  int    `json:"code"`
+	Error string `json:"error"`
+}
+
+func newErrorBody(code int, err error) *errorBody {
+	return &errorBody{Code: code, Error: grpcutil.ScrubGRPC(err).Error()}
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	w.Header().Set("Content-Type", jsonContentType)
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(newErrorBody(code, err))
+}
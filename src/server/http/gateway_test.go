@@ -0,0 +1,86 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGatewayMethods(t *testing.T) {
+	methods := gatewayMethods(gatewayFiles())
+	paths := make(map[string]bool)
+	for _, m := range methods {
+		_, err := newMessage(m.desc.GetInputType())
+		require.NoError(t, err)
+		_, err = newMessage(m.desc.GetOutputType())
+		require.NoError(t, err)
+		paths[m.path()] = true
+	}
+	require.True(t, paths["/v1/api/pfs/InspectRepo"])
+	require.True(t, paths["/v1/api/pps/CreatePipeline"])
+	require.True(t, paths["/v1/api/auth/WhoAmI"])
+	require.True(t, paths["/v1/api/transaction/StartTransaction"])
+	require.True(t, paths["/v1/api/admin/InspectCluster"])
+}
+
+func TestOpenAPIDocument(t *testing.T) {
+	files := gatewayFiles()
+	doc, err := openAPIDocument(files, gatewayMethods(files))
+	require.NoError(t, err)
+	var parsed struct {
+		Paths      map[string]interface{}
+		Components struct {
+			Schemas map[string]interface{}
+		}
+	}
+	require.NoError(t, json.Unmarshal(doc, &parsed))
+	require.NotNil(t, parsed.Paths["/v1/api/pfs/ModifyFile"])
+	// ReceiveCommit can't be called over HTTP.
+	require.Nil(t, parsed.Paths["/v1/api/pfs/ReceiveCommit"])
+	// Every referenced schema is defined.
+	for _, ref := range regexp.MustCompile(`#/components/schemas/([\w.]+)`).FindAllStringSubmatch(string(doc), -1) {
+		require.NotNil(t, parsed.Components.Schemas[ref[1]], "undefined schema %s", ref[1])
+	}
+}
+
+func TestHTTPStatus(t *testing.T) {
+	require.Equal(t, http.StatusUnauthorized, httpStatus(auth.ErrNotSignedIn))
+	require.Equal(t, http.StatusNotFound, httpStatus(errors.New("repo foo not found")))
+	require.Equal(t, http.StatusConflict, httpStatus(errors.New("repo foo already exists")))
+	require.Equal(t, http.StatusBadRequest, httpStatus(status.Error(codes.InvalidArgument, "bad")))
+	require.Equal(t, http.StatusInternalServerError, httpStatus(errors.New("failed")))
+}
+
+func TestCheckCookieAuth(t *testing.T) {
+	newRequest := func(contentType string, headers ...string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/v1/api/pfs/DeleteRepo", strings.NewReader(`{}`))
+		if contentType != "" {
+			r.Header.Set("Content-Type", contentType)
+		}
+		r.AddCookie(&http.Cookie{Name: auth.ContextTokenKey, Value: "token"})
+		for i := 0; i < len(headers); i += 2 {
+			r.Header.Set(headers[i], headers[i+1])
+		}
+		return r
+	}
+	// A form post from another site carries the cookie, and is rejected.
+	require.YesError(t, checkCookieAuth(newRequest("application/x-www-form-urlencoded")))
+	require.YesError(t, checkCookieAuth(newRequest("text/plain", requestedWithHeader, "XMLHttpRequest")))
+	require.YesError(t, checkCookieAuth(newRequest(jsonContentType)))
+	require.YesError(t, checkCookieAuth(newRequest(formContentType+"; boundary=x", requestedWithHeader, "XMLHttpRequest")))
+	// JSON requests with the custom header can only come from pachd's origin.
+	require.NoError(t, checkCookieAuth(newRequest(jsonContentType+"; charset=utf-8", requestedWithHeader, "XMLHttpRequest")))
+	// Requests with a bearer token don't rely on the cookie.
+	require.NoError(t, checkCookieAuth(newRequest("text/plain", "Authorization", "Bearer token")))
+	// Requests without the cookie aren't authenticated by it.
+	require.NoError(t, checkCookieAuth(httptest.NewRequest(http.MethodPost, "/v1/api/pfs/DeleteRepo", nil)))
+}
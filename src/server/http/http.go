@@ -12,11 +12,13 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/transaction"
 
 	"github.com/gogo/protobuf/types"
 	"github.com/julienschmidt/httprouter"
-	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 // HTTPPort specifies the port the server will listen on
@@ -31,6 +33,7 @@ var (
 	servicePath = versionPath("pps/services/:serviceName/*path")
 	loginPath   = versionPath("auth/login")
 	logoutPath  = versionPath("auth/logout")
+	openAPIPath = versionPath("openapi.json")
 )
 
 type router = *httprouter.Router
@@ -41,6 +44,8 @@ type server struct {
 	pachClient     *client.APIClient
 	pachClientOnce sync.Once
 	httpClient     *http.Client
+	// openAPI is the OpenAPI document describing the JSON gateway.
+	openAPI []byte
 }
 
 // NewHTTPServer returns a Pachyderm HTTP server.
@@ -59,6 +64,17 @@ func NewHTTPServer(address string) (http.Handler, error) {
 	router.POST(logoutPath, s.authLogoutHandler)
 	router.POST(servicePath, s.serviceHandler)
 
+	files := gatewayFiles()
+	methods := gatewayMethods(files)
+	for _, m := range methods {
+		router.POST(m.path(), s.gatewayHandler(m))
+	}
+	var err error
+	if s.openAPI, err = openAPIDocument(files, methods); err != nil {
+		return nil, err
+	}
+	router.GET(openAPIPath, s.openAPIHandler)
+
 	router.NotFound = http.HandlerFunc(notFound)
 	return s, nil
 }
@@ -66,20 +82,13 @@ func NewHTTPServer(address string) (http.Handler, error) {
 func (s *server) getFileHandler(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	filePaths := strings.Split(ps.ByName("filePath"), "/")
 	fileName := filePaths[len(filePaths)-1]
-	ctx := context.Background()
-	for _, cookie := range r.Cookies() {
-		if cookie.Name == auth.ContextTokenKey {
-			ctx = metadata.NewIncomingContext(
-				ctx,
-				metadata.Pairs(auth.ContextTokenKey, cookie.Value),
-			)
-		}
-	}
 	downloadValues := r.URL.Query()["download"]
 	if len(downloadValues) == 1 && downloadValues[0] == "true" {
 		w.Header().Add("Content-Disposition", fmt.Sprintf("attachment; filename=\"%v\"", fileName))
 	}
-	c := s.getPachClient().WithCtx(ctx)
+	// Browsers send the login cookie along with requests that other sites
+	// make, but a file download doesn't change anything.
+	c := s.requestClient(r, true)
 	commit := client.NewCommit(ps.ByName("repoName"), ps.ByName("branchName"), ps.ByName("commitID"))
 	commitInfo, err := c.InspectCommit(ps.ByName("repoName"), ps.ByName("branchName"), ps.ByName("commitID"))
 	if err != nil {
@@ -121,6 +130,11 @@ func (s *server) serviceHandler(w http.ResponseWriter, r *http.Request, ps httpr
 	proxy.ServeHTTP(w, r)
 }
 
+func (s *server) openAPIHandler(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	w.Header().Set("Content-Type", jsonContentType)
+	w.Write(s.openAPI)
+}
+
 func (s *server) authLoginHandler(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	token := r.FormValue("Token")
 	if token == "" {
@@ -144,11 +158,83 @@ func notFound(w http.ResponseWriter, r *http.Request) {
 }
 
 func httpError(w http.ResponseWriter, err error) {
-	if errutil.IsNotFoundError(err) {
-		http.Error(w, err.Error(), http.StatusNotFound)
-	} else {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+	http.Error(w, err.Error(), httpStatus(err))
+}
+
+// httpStatus returns the HTTP status code for an error returned by pachd.
+func httpStatus(err error) int {
+	switch {
+	case auth.IsErrNotSignedIn(err), auth.IsErrBadToken(err), auth.IsErrExpiredToken(err):
+		return http.StatusUnauthorized
+	case auth.IsErrNotAuthorized(err):
+		return http.StatusForbidden
+	case errutil.IsNotFoundError(err):
+		return http.StatusNotFound
+	case errutil.IsAlreadyExistError(err):
+		return http.StatusConflict
+	}
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// requestClient returns a client for requests made on behalf of r. It uses
+// the auth token from r's Authorization header, falling back to the cookie
+// set by the login handler if allowCookie is set, and runs in the
+// transaction in r's Pach-Transaction header, if any.
+func (s *server) requestClient(r *http.Request, allowCookie bool) *client.APIClient {
+	ctx := r.Context()
+	token := bearerToken(r)
+	if token == "" && allowCookie {
+		token = cookieToken(r)
+	}
+	if token != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(auth.ContextTokenKey, token))
+	}
+	c := s.getPachClient().WithCtx(ctx)
+	if txn := r.Header.Get(transactionHeader); txn != "" {
+		c = c.WithTransaction(&transaction.Transaction{ID: txn})
+	}
+	return c
+}
+
+// bearerToken returns the auth token in r's Authorization header, if any.
+func bearerToken(r *http.Request) string {
+	if header := r.Header.Get("Authorization"); strings.HasPrefix(header, "Bearer ") {
+		return strings.TrimPrefix(header, "Bearer ")
+	}
+	return ""
+}
+
+// cookieToken returns the auth token in the cookie set by the login handler,
+// if any.
+func cookieToken(r *http.Request) string {
+	var token string
+	for _, cookie := range r.Cookies() {
+		if cookie.Name == auth.ContextTokenKey {
+			token = cookie.Value
+		}
 	}
+	return token
 }
 
 func (s *server) getPachClient() *client.APIClient {
@@ -0,0 +1,268 @@
+package http
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/version"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+)
+
+// object is a JSON object in the OpenAPI document.
+type object = map[string]interface{}
+
+// errorSchema is the name of the schema of errorBody.
+const errorSchema = "Error"
+
+// wellKnownSchemas are the schemas of the well known types, which jsonpb
+// doesn't encode as objects.
+var wellKnownSchemas = map[string]object{
+	".google.protobuf.Any":         {"type": "object"},
+	".google.protobuf.Empty":       {"type": "object"},
+	".google.protobuf.Timestamp":   {"type": "string", "format": "date-time"},
+	".google.protobuf.Duration":    {"type": "string", "example": "1.5s"},
+	".google.protobuf.BoolValue":   {"type": "boolean"},
+	".google.protobuf.BytesValue":  {"type": "string", "format": "byte"},
+	".google.protobuf.StringValue": {"type": "string"},
+	".google.protobuf.DoubleValue": {"type": "number"},
+	".google.protobuf.FloatValue":  {"type": "number"},
+	".google.protobuf.Int32Value":  {"type": "integer", "format": "int32"},
+	".google.protobuf.UInt32Value": {"type": "integer", "format": "int32"},
+	".google.protobuf.Int64Value":  {"type": "string", "format": "int64"},
+	".google.protobuf.UInt64Value": {"type": "string", "format": "int64"},
+}
+
+// openAPIGenerator generates the schemas of the messages in an OpenAPI
+// document, adding each message's schema the first time it's referenced.
+type openAPIGenerator struct {
+	messages map[string]*descriptor.DescriptorProto
+	enums    map[string]*descriptor.EnumDescriptorProto
+	schemas  object
+}
+
+func newOpenAPIGenerator(files []*descriptor.FileDescriptorProto) *openAPIGenerator {
+	g := &openAPIGenerator{
+		messages: make(map[string]*descriptor.DescriptorProto),
+		enums:    make(map[string]*descriptor.EnumDescriptorProto),
+		schemas: object{
+			errorSchema: object{
+				"type": "object",
+				"properties": object{
+					"code":  object{"type": "integer", "description": "The HTTP status code."},
+					"error": object{"type": "string"},
+				},
+			},
+		},
+	}
+	for _, fd := range files {
+		prefix := "." + fd.GetPackage()
+		for _, msg := range fd.MessageType {
+			g.addMessage(prefix, msg)
+		}
+		for _, enum := range fd.EnumType {
+			g.enums[prefix+"."+enum.GetName()] = enum
+		}
+	}
+	return g
+}
+
+func (g *openAPIGenerator) addMessage(prefix string, msg *descriptor.DescriptorProto) {
+	name := prefix + "." + msg.GetName()
+	g.messages[name] = msg
+	for _, nested := range msg.NestedType {
+		g.addMessage(name, nested)
+	}
+	for _, enum := range msg.EnumType {
+		g.enums[name+"."+enum.GetName()] = enum
+	}
+}
+
+func schemaRef(name string) object {
+	return object{"$ref": "#/components/schemas/" + name}
+}
+
+// messageSchema returns the schema of the message typeName, e.g. ".pfs.Repo".
+func (g *openAPIGenerator) messageSchema(typeName string) object {
+	if schema, ok := wellKnownSchemas[typeName]; ok {
+		return schema
+	}
+	name := strings.TrimPrefix(typeName, ".")
+	if _, ok := g.schemas[name]; ok {
+		return schemaRef(name)
+	}
+	msg, ok := g.messages[typeName]
+	if !ok {
+		return object{"type": "object"}
+	}
+	properties := object{}
+	// Add the reference before generating the fields, for recursive messages.
+	g.schemas[name] = object{"type": "object", "properties": properties}
+	for _, field := range msg.Field {
+		jsonName := field.GetJsonName()
+		if jsonName == "" {
+			jsonName = lowerCamel(field.GetName())
+		}
+		properties[jsonName] = g.fieldSchema(field)
+	}
+	return schemaRef(name)
+}
+
+func (g *openAPIGenerator) enumSchema(typeName string) object {
+	name := strings.TrimPrefix(typeName, ".")
+	if _, ok := g.schemas[name]; ok {
+		return schemaRef(name)
+	}
+	enum, ok := g.enums[typeName]
+	if !ok {
+		return object{"type": "string"}
+	}
+	var values []string
+	for _, value := range enum.Value {
+		values = append(values, value.GetName())
+	}
+	g.schemas[name] = object{"type": "string", "enum": values}
+	return schemaRef(name)
+}
+
+func (g *openAPIGenerator) fieldSchema(field *descriptor.FieldDescriptorProto) object {
+	var schema object
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE, descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		schema = object{"type": "number"}
+	case descriptor.FieldDescriptorProto_TYPE_INT32, descriptor.FieldDescriptorProto_TYPE_SINT32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32, descriptor.FieldDescriptorProto_TYPE_UINT32,
+		descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		schema = object{"type": "integer", "format": "int32"}
+	case descriptor.FieldDescriptorProto_TYPE_INT64, descriptor.FieldDescriptorProto_TYPE_SINT64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64, descriptor.FieldDescriptorProto_TYPE_UINT64,
+		descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		// jsonpb encodes 64 bit integers as strings.
+		schema = object{"type": "string", "format": "int64"}
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		schema = object{"type": "boolean"}
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		schema = object{"type": "string"}
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		schema = object{"type": "string", "format": "byte"}
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		schema = g.enumSchema(field.GetTypeName())
+	default:
+		if msg, ok := g.messages[field.GetTypeName()]; ok && msg.GetOptions().GetMapEntry() {
+			_, value := msg.GetMapFields()
+			return object{"type": "object", "additionalProperties": g.fieldSchema(value)}
+		}
+		schema = g.messageSchema(field.GetTypeName())
+	}
+	if field.IsRepeated() {
+		return object{"type": "array", "items": schema}
+	}
+	return schema
+}
+
+func (g *openAPIGenerator) operation(m *gatewayMethod) object {
+	op := object{
+		"operationId": m.pkg + "_" + m.name,
+		"tags":        []string{m.pkg},
+		"responses": object{
+			"default": object{
+				"description": "The request failed.",
+				"content":     object{jsonContentType: object{"schema": schemaRef(errorSchema)}},
+			},
+		},
+	}
+	output := object{"schema": g.messageSchema(m.desc.GetOutputType())}
+	if m.desc.GetServerStreaming() {
+		op["responses"].(object)["200"] = object{
+			"description": "A stream of responses, one JSON object per line. A paginated listing with more pages ends with a {\"nextPageToken\": ...} line.",
+			"content":     object{ndjsonContentType: output},
+		}
+	} else {
+		op["responses"].(object)["200"] = object{
+			"description": "The response.",
+			"content":     object{jsonContentType: output},
+		}
+	}
+	if !m.upload() {
+		op["requestBody"] = object{
+			"content": object{jsonContentType: object{"schema": g.messageSchema(m.desc.GetInputType())}},
+		}
+		return op
+	}
+	var params []object
+	if m.name == "ModifyFile" {
+		params = append(params, queryParam("repo", "The repo to modify."),
+			queryParam("branch", "The branch to modify."),
+			queryParam("commit", "The commit to modify, if no branch is given."))
+	}
+	params = append(params, queryParam("path", "The directory to put the form's files in."),
+		queryParam("append", "Append to existing files, rather than overwriting them, if true."),
+		queryParam("tag", "The tag of the files."))
+	op["parameters"] = params
+	op["requestBody"] = object{
+		"required": true,
+		"content": object{
+			formContentType: object{"schema": object{
+				"type":                 "object",
+				"description":          "Each part is put at the path given by its form name.",
+				"additionalProperties": object{"type": "string", "format": "binary"},
+			}},
+			tarContentType: object{"schema": object{"type": "string", "format": "binary"}},
+		},
+	}
+	return op
+}
+
+func queryParam(name, description string) object {
+	return object{
+		"name":        name,
+		"in":          "query",
+		"description": description,
+		"schema":      object{"type": "string"},
+	}
+}
+
+// openAPIDocument returns an OpenAPI document describing the JSON gateway's
+// methods.
+func openAPIDocument(files []*descriptor.FileDescriptorProto, methods []*gatewayMethod) ([]byte, error) {
+	g := newOpenAPIGenerator(files)
+	paths := object{}
+	for _, m := range methods {
+		if m.desc.GetClientStreaming() && !m.upload() {
+			continue
+		}
+		paths[m.path()] = object{"post": g.operation(m)}
+	}
+	doc := object{
+		"openapi": "3.0.3",
+		"info": object{
+			"title":   "Pachyderm API",
+			"version": version.PrettyPrintVersion(version.Version),
+		},
+		"paths": paths,
+		"components": object{
+			"schemas": g.schemas,
+			"securitySchemes": object{
+				"bearer": object{"type": "http", "scheme": "bearer"},
+			},
+		},
+		"security": []object{{"bearer": []string{}}},
+	}
+	result, err := json.Marshal(doc)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return result, nil
+}
+
+// lowerCamel converts a proto field name to its JSON name.
+func lowerCamel(name string) string {
+	parts := strings.Split(name, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
@@ -104,6 +104,85 @@ func TestSimplePipeline(t *testing.T) {
 	require.Equal(t, "foo", buf.String())
 }
 
+func TestInspectFileLineage(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestInspectFileLineage_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	commit1, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit1, "a", strings.NewReader("a")))
+	require.NoError(t, c.PutFile(commit1, "b", strings.NewReader("b")))
+	require.NoError(t, c.FinishCommit(dataRepo, commit1.Branch.Name, commit1.ID))
+
+	copyPipeline := tu.UniqueString("TestInspectFileLineage_copy")
+	require.NoError(t, c.CreatePipeline(
+		copyPipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
+		},
+		nil,
+		client.NewPFSInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+	catPipeline := tu.UniqueString("TestInspectFileLineage_cat")
+	require.NoError(t, c.CreatePipeline(
+		catPipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("cat /pfs/%s/* > /pfs/out/all", copyPipeline),
+		},
+		nil,
+		client.NewPFSInput(copyPipeline, "/"),
+		"",
+		false,
+	))
+	_, err = c.FlushCommitAll([]*pfs.Commit{commit1}, nil)
+	require.NoError(t, err)
+
+	lineage, err := c.InspectFileLineage(client.NewCommit(catPipeline, "master", ""), "all")
+	require.NoError(t, err)
+	require.Equal(t, "/all", lineage.File.Path)
+	require.Equal(t, 1, len(lineage.Datums))
+	require.Equal(t, catPipeline, lineage.Datums[0].Pipeline)
+	require.Equal(t, 1, len(lineage.Datums[0].Inputs))
+	// The cat datum's input is the whole output of the copy pipeline, which
+	// was written by one datum per input file.
+	copyLineage := lineage.Datums[0].Inputs[0]
+	require.Equal(t, copyPipeline, copyLineage.File.Commit.Branch.Repo.Name)
+	require.Equal(t, 2, len(copyLineage.Datums))
+	var inputPaths []string
+	for _, datum := range copyLineage.Datums {
+		require.Equal(t, copyPipeline, datum.Pipeline)
+		require.Equal(t, 1, len(datum.Inputs))
+		input := datum.Inputs[0]
+		require.Equal(t, dataRepo, input.File.Commit.Branch.Repo.Name)
+		require.Equal(t, commit1.ID, input.File.Commit.ID)
+		require.Equal(t, 0, len(input.Datums))
+		inputPaths = append(inputPaths, input.File.Path)
+	}
+	require.ElementsEqual(t, []string{"/a", "/b"}, inputPaths)
+
+	// The lineage of a single output file of the copy pipeline only includes
+	// the datum that wrote it.
+	lineage, err = c.InspectFileLineage(client.NewCommit(copyPipeline, "master", ""), "a")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(lineage.Datums))
+	require.Equal(t, "/a", lineage.Datums[0].Inputs[0].File.Path)
+
+	_, err = c.InspectFileLineage(client.NewCommit(copyPipeline, "master", ""), "missing")
+	require.YesError(t, err)
+}
+
 func TestRepoSize(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
@@ -1293,6 +1372,71 @@ func TestPipelineErrorHandling(t *testing.T) {
 		require.Equal(t, int64(0), pipelineJobInfo.DataRecovered)
 		require.Equal(t, int64(0), pipelineJobInfo.DataFailed)
 	})
+	t.Run("QuarantinedDatums", func(t *testing.T) {
+		dataRepo := tu.UniqueString("TestPipelineQuarantinedDatums_data")
+		require.NoError(t, c.CreateRepo(dataRepo))
+
+		dataCommit := client.NewCommit(dataRepo, "master", "")
+		require.NoError(t, c.PutFile(dataCommit, "file1", strings.NewReader("foo\n"), client.WithAppendPutFile()))
+		require.NoError(t, c.PutFile(dataCommit, "file2", strings.NewReader("bar\n"), client.WithAppendPutFile()))
+
+		// In this pipeline, we'll have a command that fails for file 2, and an
+		// error handler that reports the failure but doesn't recover it
+		pipeline := tu.UniqueString("pipeline4")
+		_, err := c.PpsAPIClient.CreatePipeline(
+			context.Background(),
+			&pps.CreatePipelineRequest{
+				Pipeline: client.NewPipeline(pipeline),
+				Transform: &pps.Transform{
+					Cmd:      []string{"bash"},
+					Stdin:    []string{"if", fmt.Sprintf("[ -a pfs/%v/file1 ]", dataRepo), "then", "cp pfs/*/file1 pfs/out/", "exit 0", "fi", "exit 1"},
+					ErrCmd:   []string{"bash"},
+					ErrStdin: []string{"echo quarantining", "exit 1"},
+				},
+				Input:              client.NewPFSInput(dataRepo, "/*"),
+				DatumFailurePolicy: pps.DatumFailurePolicy_QUARANTINE,
+			})
+		require.NoError(t, err)
+
+		pjis, err := c.FlushPipelineJobAll([]*pfs.Commit{dataCommit}, nil)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(pjis))
+		pipelineJobInfo := pjis[0]
+
+		// We expect the job to succeed without the failed datum
+		require.Equal(t, pps.PipelineJobState_JOB_SUCCESS, pipelineJobInfo.State)
+		require.Equal(t, int64(1), pipelineJobInfo.DataProcessed)
+		require.Equal(t, int64(1), pipelineJobInfo.DataQuarantined)
+		require.Equal(t, int64(0), pipelineJobInfo.DataFailed)
+		fileInfos, err := c.ListFileAll(pipelineJobInfo.OutputCommit, "")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(fileInfos))
+		require.Equal(t, "/file1", fileInfos[0].File.Path)
+
+		// The error handling output is kept with the quarantined datum's meta
+		fileInfos, err = c.GlobFileAll(pipelineJobInfo.StatsCommit, "/meta/*/err_cmd_output")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(fileInfos))
+		var buf bytes.Buffer
+		require.NoError(t, c.GetFile(pipelineJobInfo.StatsCommit, fileInfos[0].File.Path, &buf))
+		require.Equal(t, "quarantining\n", buf.String())
+
+		// Re-driving the quarantined datums runs a job that only processes them
+		require.NoError(t, c.RedriveQuarantined(pipeline))
+		require.NoError(t, backoff.Retry(func() error {
+			pjis, err = c.ListPipelineJob(pipeline, nil, nil, 0, false)
+			require.NoError(t, err)
+			if len(pjis) != 2 {
+				return errors.Errorf("expected 2 jobs, got %d", len(pjis))
+			}
+			return nil
+		}, backoff.NewTestingBackOff()))
+		pipelineJobInfo, err = c.InspectPipelineJob(pjis[0].PipelineJob.ID, true)
+		require.NoError(t, err)
+		require.Equal(t, pps.PipelineJobState_JOB_SUCCESS, pipelineJobInfo.State)
+		require.Equal(t, int64(1), pipelineJobInfo.DataSkipped)
+		require.Equal(t, int64(1), pipelineJobInfo.DataQuarantined)
+	})
 }
 
 func TestLazyPipelinePropagation(t *testing.T) {
@@ -2452,29 +2596,29 @@ func TestUpdatePipeline(t *testing.T) {
 		var newServiceSeen bool
 		for _, svc := range svcs.Items {
 			switch svc.ObjectMeta.Name {
-			case ppsutil.PipelineRcName(pipelineName, 1):
+			case ppsutil.PipelineRcName(client.NewPipeline(pipelineName), 1):
 				return fmt.Errorf("stale service encountered: %q", svc.ObjectMeta.Name)
-			case ppsutil.PipelineRcName(pipelineName, 2):
+			case ppsutil.PipelineRcName(client.NewPipeline(pipelineName), 2):
 				newServiceSeen = true
 			}
 		}
 		if !newServiceSeen {
-			return fmt.Errorf("did not find new service: %q", ppsutil.PipelineRcName(pipelineName, 2))
+			return fmt.Errorf("did not find new service: %q", ppsutil.PipelineRcName(client.NewPipeline(pipelineName), 2))
 		}
 		rcs, err := kc.CoreV1().ReplicationControllers("default").List(metav1.ListOptions{})
 		require.NoError(t, err)
 		var newRCSeen bool
 		for _, rc := range rcs.Items {
 			switch rc.ObjectMeta.Name {
-			case ppsutil.PipelineRcName(pipelineName, 1):
+			case ppsutil.PipelineRcName(client.NewPipeline(pipelineName), 1):
 				return fmt.Errorf("stale RC encountered: %q", rc.ObjectMeta.Name)
-			case ppsutil.PipelineRcName(pipelineName, 2):
+			case ppsutil.PipelineRcName(client.NewPipeline(pipelineName), 2):
 				newRCSeen = true
 			}
 		}
 		require.True(t, newRCSeen)
 		if !newRCSeen {
-			return fmt.Errorf("did not find new RC: %q", ppsutil.PipelineRcName(pipelineName, 2))
+			return fmt.Errorf("did not find new RC: %q", ppsutil.PipelineRcName(client.NewPipeline(pipelineName), 2))
 		}
 		return nil
 	})
@@ -2525,29 +2669,29 @@ func TestUpdatePipeline(t *testing.T) {
 		var newServiceSeen bool
 		for _, svc := range svcs.Items {
 			switch svc.ObjectMeta.Name {
-			case ppsutil.PipelineRcName(pipelineName, 1):
+			case ppsutil.PipelineRcName(client.NewPipeline(pipelineName), 1):
 				return fmt.Errorf("stale service encountered: %q", svc.ObjectMeta.Name)
-			case ppsutil.PipelineRcName(pipelineName, 2):
+			case ppsutil.PipelineRcName(client.NewPipeline(pipelineName), 2):
 				newServiceSeen = true
 			}
 		}
 		if !newServiceSeen {
-			return fmt.Errorf("did not find new service: %q", ppsutil.PipelineRcName(pipelineName, 2))
+			return fmt.Errorf("did not find new service: %q", ppsutil.PipelineRcName(client.NewPipeline(pipelineName), 2))
 		}
 		rcs, err := kc.CoreV1().ReplicationControllers("default").List(metav1.ListOptions{})
 		require.NoError(t, err)
 		var newRCSeen bool
 		for _, rc := range rcs.Items {
 			switch rc.ObjectMeta.Name {
-			case ppsutil.PipelineRcName(pipelineName, 1):
+			case ppsutil.PipelineRcName(client.NewPipeline(pipelineName), 1):
 				return fmt.Errorf("stale RC encountered: %q", rc.ObjectMeta.Name)
-			case ppsutil.PipelineRcName(pipelineName, 2):
+			case ppsutil.PipelineRcName(client.NewPipeline(pipelineName), 2):
 				newRCSeen = true
 			}
 		}
 		require.True(t, newRCSeen)
 		if !newRCSeen {
-			return fmt.Errorf("did not find new RC: %q", ppsutil.PipelineRcName(pipelineName, 2))
+			return fmt.Errorf("did not find new RC: %q", ppsutil.PipelineRcName(client.NewPipeline(pipelineName), 2))
 		}
 		return nil
 	})
@@ -4307,7 +4451,7 @@ func TestPipelineResourceRequest(t *testing.T) {
 	require.NoError(t, err)
 
 	var container v1.Container
-	rcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version)
+	rcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline, pipelineInfo.Version)
 	kubeClient := tu.GetKubeClient(t)
 	require.NoError(t, backoff.Retry(func() error {
 		podList, err := kubeClient.CoreV1().Pods(v1.NamespaceDefault).List(
@@ -4378,7 +4522,7 @@ func TestPipelineResourceLimit(t *testing.T) {
 	require.NoError(t, err)
 
 	var container v1.Container
-	rcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version)
+	rcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline, pipelineInfo.Version)
 	kubeClient := tu.GetKubeClient(t)
 	err = backoff.Retry(func() error {
 		podList, err := kubeClient.CoreV1().Pods(v1.NamespaceDefault).List(metav1.ListOptions{
@@ -4442,7 +4586,7 @@ func TestPipelineResourceLimitDefaults(t *testing.T) {
 	require.NoError(t, err)
 
 	var container v1.Container
-	rcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version)
+	rcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline, pipelineInfo.Version)
 	kubeClient := tu.GetKubeClient(t)
 	err = backoff.Retry(func() error {
 		podList, err := kubeClient.CoreV1().Pods(v1.NamespaceDefault).List(metav1.ListOptions{
@@ -4676,7 +4820,7 @@ func TestPodOpts(t *testing.T) {
 		require.NoError(t, err)
 
 		var pod v1.Pod
-		rcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version)
+		rcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline, pipelineInfo.Version)
 		kubeClient := tu.GetKubeClient(t)
 		err = backoff.Retry(func() error {
 			podList, err := kubeClient.CoreV1().Pods(v1.NamespaceDefault).List(metav1.ListOptions{
@@ -4736,7 +4880,7 @@ func TestPodOpts(t *testing.T) {
 		require.NoError(t, err)
 
 		var pod v1.Pod
-		rcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version)
+		rcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline, pipelineInfo.Version)
 		kubeClient := tu.GetKubeClient(t)
 		err = backoff.Retry(func() error {
 			podList, err := kubeClient.CoreV1().Pods(v1.NamespaceDefault).List(metav1.ListOptions{
@@ -6408,8 +6552,6 @@ func TestPipelineEnvVarAlias(t *testing.T) {
 }
 
 func TestMaxQueueSize(t *testing.T) {
-	// TODO: Implement max queue size.
-	t.Skip("Max queue size not implemented in V2")
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
 	}
@@ -6419,64 +6561,55 @@ func TestMaxQueueSize(t *testing.T) {
 	dataRepo := tu.UniqueString("TestMaxQueueSize_input")
 	require.NoError(t, c.CreateRepo(dataRepo))
 
-	commit1, err := c.StartCommit(dataRepo, "master")
-	require.NoError(t, err)
-	for i := 0; i < 20; i++ {
-		require.NoError(t, c.PutFile(commit1, fmt.Sprintf("file%d", i), strings.NewReader("foo"), client.WithAppendPutFile()))
-	}
-	require.NoError(t, c.FinishCommit(dataRepo, commit1.Branch.Name, commit1.ID))
-
 	pipeline := tu.UniqueString("TestMaxQueueSize_output")
-	// This pipeline sleeps for 10 secs per datum
-	_, err = c.PpsAPIClient.CreatePipeline(
+	// This pipeline sleeps for 10 secs per job, so that input commits pile up
+	// behind the first job
+	_, err := c.PpsAPIClient.CreatePipeline(
 		context.Background(),
 		&pps.CreatePipelineRequest{
 			Pipeline: client.NewPipeline(pipeline),
 			Transform: &pps.Transform{
 				Cmd: []string{"bash"},
 				Stdin: []string{
-					"sleep 5",
+					"sleep 10",
+					fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
 				},
 			},
-			Input: client.NewPFSInput(dataRepo, "/*"),
-			ParallelismSpec: &pps.ParallelismSpec{
-				Constant: 2,
-			},
-			MaxQueueSize: 1,
-			ChunkSpec: &pps.ChunkSpec{
-				Number: 10,
-			},
+			Input:               client.NewPFSInput(dataRepo, "/"),
+			MaxQueueSize:        1,
+			QueueOverflowPolicy: pps.QueueOverflowPolicy_QUEUE_COALESCE,
 		})
 	require.NoError(t, err)
 
-	var pipelineJobInfo *pps.PipelineJobInfo
-	for i := 0; i < 10; i++ {
-		require.NoError(t, backoff.Retry(func() error {
-			jobs, err := c.ListPipelineJob(pipeline, nil, nil, -1, true)
-			if err != nil {
-				return errors.Wrapf(err, "could not list job")
-			}
-			if len(jobs) == 0 {
-				return errors.Errorf("failed to find job")
-			}
-			pipelineJobInfo, err = c.InspectPipelineJob(jobs[0].PipelineJob.ID, false, true)
-			if err != nil {
-				return errors.Wrapf(err, "could not inspect job")
-			}
-			if len(pipelineJobInfo.WorkerStatus) != 2 {
-				return errors.Errorf("incorrect number of statuses: %v", len(pipelineJobInfo.WorkerStatus))
-			}
-			return nil
-		}, backoff.RetryEvery(500*time.Millisecond).For(60*time.Second)))
+	numCommits := 5
+	for i := 0; i < numCommits; i++ {
+		require.NoError(t, c.PutFile(client.NewCommit(dataRepo, "master", ""), fmt.Sprintf("file%d", i), strings.NewReader("foo")))
+	}
 
-		//for _, status := range pipelineJobInfo.WorkerStatus {
-		//	if status.QueueSize > 1 {
-		//		t.Fatalf("queue size too big: %d", status.QueueSize)
-		//	}
-		//}
+	// The output commit for the last input commit is processed, and contains
+	// every file
+	commitInfos, err := c.FlushCommitAll([]*pfs.Commit{client.NewCommit(dataRepo, "master", "")}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(commitInfos))
+	files, err := c.ListFileAll(commitInfos[0].Commit, "")
+	require.NoError(t, err)
+	require.Equal(t, numCommits, len(files))
 
-		time.Sleep(500 * time.Millisecond)
+	// Some of the commits in between were coalesced rather than processed
+	jobInfos, err := c.ListPipelineJob(pipeline, nil, nil, -1, false)
+	require.NoError(t, err)
+	var killed int
+	for _, ji := range jobInfos {
+		if ji.State == pps.PipelineJobState_JOB_KILLED {
+			killed++
+		}
 	}
+	require.True(t, killed > 0)
+	pipelineInfo, err := c.InspectPipeline(pipeline)
+	require.NoError(t, err)
+	require.NotNil(t, pipelineInfo.QueueState)
+	require.Equal(t, int64(killed), pipelineInfo.QueueState.CoalescedCommits)
+	require.Equal(t, int64(0), pipelineInfo.QueueState.HeldCommits)
 }
 
 func TestHTTPAuth(t *testing.T) {
@@ -9630,7 +9763,7 @@ func TestPodPatchUnmarshalling(t *testing.T) {
 
 	// make sure 'vol0' is correct in the pod spec
 	var volumes []v1.Volume
-	rcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version)
+	rcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline, pipelineInfo.Version)
 	kubeClient := tu.GetKubeClient(t)
 	require.NoError(t, backoff.Retry(func() error {
 		podList, err := kubeClient.CoreV1().Pods(v1.NamespaceDefault).List(
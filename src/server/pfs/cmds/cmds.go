@@ -12,9 +12,11 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	prompt "github.com/c-bata/go-prompt"
 	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
 	"github.com/mattn/go-isatty"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
@@ -23,7 +25,6 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pager"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsload"
-	"github.com/pachyderm/pachyderm/v2/src/internal/ppsconsts"
 	"github.com/pachyderm/pachyderm/v2/src/internal/progress"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tabwriter"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
@@ -69,11 +70,16 @@ or type (e.g. csv, binary, images, etc).`,
 	commands = append(commands, cmdutil.CreateDocsAlias(repoDocs, "repo", " repo$"))
 
 	var description string
+	var coldAfter string
 	createRepo := &cobra.Command{
 		Use:   "{{alias}} <repo>",
 		Short: "Create a new repo.",
 		Long:  "Create a new repo.",
 		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			coldAfterProto, err := parseColdAfter(coldAfter)
+			if err != nil {
+				return err
+			}
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
 				return err
@@ -84,8 +90,9 @@ or type (e.g. csv, binary, images, etc).`,
 				_, err = c.PfsAPIClient.CreateRepo(
 					c.Ctx(),
 					&pfsclient.CreateRepoRequest{
-						Repo:        client.NewRepo(args[0]),
+						Repo:        cmdutil.ParseRepo(args[0]),
 						Description: description,
+						ColdAfter:   coldAfterProto,
 					},
 				)
 				return err
@@ -94,6 +101,7 @@ or type (e.g. csv, binary, images, etc).`,
 		}),
 	}
 	createRepo.Flags().StringVarP(&description, "description", "d", "", "A description of the repo.")
+	createRepo.Flags().StringVar(&coldAfter, "cold-after", "", "How long after they're finished the repo's commits become cold, and their data is moved to the cold storage tier (e.g. 720h). 0 keeps them hot. Defaults to the cluster's cold tier age.")
 	commands = append(commands, cmdutil.CreateAlias(createRepo, "create repo"))
 
 	updateRepo := &cobra.Command{
@@ -101,6 +109,10 @@ or type (e.g. csv, binary, images, etc).`,
 		Short: "Update a repo.",
 		Long:  "Update a repo.",
 		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			coldAfterProto, err := parseColdAfter(coldAfter)
+			if err != nil {
+				return err
+			}
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
 				return err
@@ -111,9 +123,10 @@ or type (e.g. csv, binary, images, etc).`,
 				_, err = c.PfsAPIClient.CreateRepo(
 					c.Ctx(),
 					&pfsclient.CreateRepoRequest{
-						Repo:        client.NewRepo(args[0]),
+						Repo:        cmdutil.ParseRepo(args[0]),
 						Description: description,
 						Update:      true,
+						ColdAfter:   coldAfterProto,
 					},
 				)
 				return err
@@ -122,6 +135,7 @@ or type (e.g. csv, binary, images, etc).`,
 		}),
 	}
 	updateRepo.Flags().StringVarP(&description, "description", "d", "", "A description of the repo.")
+	updateRepo.Flags().StringVar(&coldAfter, "cold-after", "", "How long after they're finished the repo's commits become cold, and their data is moved to the cold storage tier (e.g. 720h). 0 keeps them hot. Unchanged if unset.")
 	shell.RegisterCompletionFunc(updateRepo, shell.RepoCompletion)
 	commands = append(commands, cmdutil.CreateAlias(updateRepo, "update repo"))
 
@@ -135,7 +149,7 @@ or type (e.g. csv, binary, images, etc).`,
 				return err
 			}
 			defer c.Close()
-			repoInfo, err := c.InspectRepo(args[0])
+			repoInfo, err := c.InspectRepo(cmdutil.ParseRepo(args[0]).QualifiedName())
 			if err != nil {
 				return err
 			}
@@ -159,6 +173,8 @@ or type (e.g. csv, binary, images, etc).`,
 
 	var all bool
 	var repoType string
+	var project string
+	var allProjects bool
 	listRepo := &cobra.Command{
 		Short: "Return a list of repos.",
 		Long:  "Return a list of repos. By default, only show user repos",
@@ -175,10 +191,18 @@ or type (e.g. csv, binary, images, etc).`,
 			if repoType == "" && !all {
 				repoType = pfsclient.UserRepoType // default to user
 			}
-			repoInfos, err := c.ListRepoByType(repoType)
+			request := &pfsclient.ListRepoRequest{Type: repoType}
+			if !allProjects {
+				if project == "" {
+					project = cmdutil.CurrentProject
+				}
+				request.Project = client.NewProject(project)
+			}
+			resp, err := c.PfsAPIClient.ListRepo(c.Ctx(), request)
 			if err != nil {
-				return err
+				return grpcutil.ScrubGRPC(err)
 			}
+			repoInfos := resp.RepoInfo
 			if raw {
 				for _, repoInfo := range repoInfos {
 					if err := marshaller.Marshal(os.Stdout, repoInfo); err != nil {
@@ -203,6 +227,8 @@ or type (e.g. csv, binary, images, etc).`,
 	listRepo.Flags().AddFlagSet(fullTimestampsFlags)
 	listRepo.Flags().BoolVar(&all, "all", false, "include system repos of all types")
 	listRepo.Flags().StringVar(&repoType, "type", "", "only include repos of the given type")
+	listRepo.Flags().StringVar(&project, "project", "", "only include repos in the given project (defaults to the current project)")
+	listRepo.Flags().BoolVarP(&allProjects, "all-projects", "A", false, "include repos in all projects")
 	commands = append(commands, cmdutil.CreateAlias(listRepo, "list repo"))
 
 	var force bool
@@ -225,7 +251,7 @@ or type (e.g. csv, binary, images, etc).`,
 				if all {
 					return errors.Errorf("cannot use the --all flag with an argument")
 				}
-				request.Repo = client.NewRepo(args[0])
+				request.Repo = cmdutil.ParseRepo(args[0])
 			} else if !all {
 				return errors.Errorf("either a repo name or the --all flag needs to be provided")
 			}
@@ -242,6 +268,159 @@ or type (e.g. csv, binary, images, etc).`,
 	shell.RegisterCompletionFunc(deleteRepo, shell.RepoCompletion)
 	commands = append(commands, cmdutil.CreateAlias(deleteRepo, "delete repo"))
 
+	projectDocs := &cobra.Command{
+		Short: "Docs for projects.",
+		Long: `Projects group repos and pipelines, so that teams sharing a cluster don't
+collide on names and can be granted access to all of their repos at once.
+
+Repos and pipelines that aren't given a project are in the current project,
+which is set with 'pachctl config update context --project', or in the
+"default" project if there's no current project. A repo in another project is
+named as <project>/<repo>.`,
+	}
+	commands = append(commands, cmdutil.CreateDocsAlias(projectDocs, "project", " project$"))
+
+	var projectDescription string
+	var maxRepos, maxPipelines int64
+	var inputGrants []string
+	projectRequest := func(cmd *cobra.Command, name string, update bool) *pfsclient.CreateProjectRequest {
+		request := &pfsclient.CreateProjectRequest{
+			Project:     client.NewProject(name),
+			Description: projectDescription,
+			Update:      update,
+		}
+		if cmd.Flags().Changed("max-repos") || cmd.Flags().Changed("max-pipelines") {
+			request.Quota = &pfsclient.ProjectQuota{
+				MaxRepos:     maxRepos,
+				MaxPipelines: maxPipelines,
+			}
+		}
+		for _, grant := range inputGrants {
+			request.InputGrants = append(request.InputGrants, client.NewProject(grant))
+		}
+		return request
+	}
+	createProject := &cobra.Command{
+		Use:   "{{alias}} <project>",
+		Short: "Create a new project.",
+		Long:  "Create a new project. Setting a quota requires cluster admin access.",
+		Run: cmdutil.RunCmdFixedArgs(1, func(cmd *cobra.Command, args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			_, err = c.PfsAPIClient.CreateProject(c.Ctx(), projectRequest(cmd, args[0], false))
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	createProject.Flags().StringVarP(&projectDescription, "description", "d", "", "A description of the project.")
+	createProject.Flags().Int64Var(&maxRepos, "max-repos", 0, "The maximum number of repos in the project, including pipeline output repos (0 means no limit).")
+	createProject.Flags().Int64Var(&maxPipelines, "max-pipelines", 0, "The maximum number of pipelines in the project (0 means no limit).")
+	createProject.Flags().StringSliceVar(&inputGrants, "input-grant", nil, "A project whose pipelines may take this project's repos as inputs.")
+	commands = append(commands, cmdutil.CreateAlias(createProject, "create project"))
+
+	updateProject := &cobra.Command{
+		Use:   "{{alias}} <project>",
+		Short: "Update a project.",
+		Long: "Update a project. The description, quota and input grants are replaced " +
+			"by the given ones. Changing the quota requires cluster admin access.",
+		Run: cmdutil.RunCmdFixedArgs(1, func(cmd *cobra.Command, args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			_, err = c.PfsAPIClient.CreateProject(c.Ctx(), projectRequest(cmd, args[0], true))
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	updateProject.Flags().StringVarP(&projectDescription, "description", "d", "", "A description of the project.")
+	updateProject.Flags().Int64Var(&maxRepos, "max-repos", 0, "The maximum number of repos in the project, including pipeline output repos (0 means no limit).")
+	updateProject.Flags().Int64Var(&maxPipelines, "max-pipelines", 0, "The maximum number of pipelines in the project (0 means no limit).")
+	updateProject.Flags().StringSliceVar(&inputGrants, "input-grant", nil, "A project whose pipelines may take this project's repos as inputs.")
+	shell.RegisterCompletionFunc(updateProject, shell.ProjectCompletion)
+	commands = append(commands, cmdutil.CreateAlias(updateProject, "update project"))
+
+	inspectProject := &cobra.Command{
+		Use:   "{{alias}} <project>",
+		Short: "Return info about a project.",
+		Long:  "Return info about a project.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			projectInfo, err := c.InspectProject(args[0])
+			if err != nil {
+				return err
+			}
+			if raw {
+				return marshaller.Marshal(os.Stdout, projectInfo)
+			}
+			return pretty.PrintDetailedProjectInfo(&pretty.PrintableProjectInfo{
+				ProjectInfo:    projectInfo,
+				FullTimestamps: fullTimestamps,
+			})
+		}),
+	}
+	inspectProject.Flags().AddFlagSet(rawFlags)
+	inspectProject.Flags().AddFlagSet(fullTimestampsFlags)
+	shell.RegisterCompletionFunc(inspectProject, shell.ProjectCompletion)
+	commands = append(commands, cmdutil.CreateAlias(inspectProject, "inspect project"))
+
+	listProject := &cobra.Command{
+		Short: "Return a list of projects.",
+		Long:  "Return a list of projects.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			projectInfos, err := c.ListProject()
+			if err != nil {
+				return err
+			}
+			if raw {
+				for _, projectInfo := range projectInfos {
+					if err := marshaller.Marshal(os.Stdout, projectInfo); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.ProjectHeader)
+			for _, projectInfo := range projectInfos {
+				pretty.PrintProjectInfo(writer, projectInfo, fullTimestamps)
+			}
+			return writer.Flush()
+		}),
+	}
+	listProject.Flags().AddFlagSet(rawFlags)
+	listProject.Flags().AddFlagSet(fullTimestampsFlags)
+	commands = append(commands, cmdutil.CreateAlias(listProject, "list project"))
+
+	deleteProject := &cobra.Command{
+		Use:   "{{alias}} <project>",
+		Short: "Delete a project.",
+		Long:  "Delete a project. The project must not contain any repos.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			return c.DeleteProject(args[0])
+		}),
+	}
+	shell.RegisterCompletionFunc(deleteProject, shell.ProjectCompletion)
+	commands = append(commands, cmdutil.CreateAlias(deleteProject, "delete project"))
+
 	commitDocs := &cobra.Command{
 		Short: "Docs for commits.",
 		Long: `Commits are atomic transactions on the content of a repo.
@@ -354,6 +533,32 @@ $ {{alias}} test -p XXX`,
 	shell.RegisterCompletionFunc(finishCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(finishCommit, "finish commit"))
 
+	rehydrateCommit := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch-or-commit>",
+		Short: "Move a commit's data out of the cold storage tier.",
+		Long:  "Move a commit's data out of the cold storage tier, back to the primary object store. It stays there until the commit has been cold for another cold-after.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			commit, err := cmdutil.ParseCommit(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := newClient("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			n, err := c.RehydrateCommit(commit.Branch.Repo.QualifiedName(), commit.Branch.Name, commit.ID)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Rehydrated %d chunks\n", n)
+			return nil
+		}),
+	}
+	shell.RegisterCompletionFunc(rehydrateCommit, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(rehydrateCommit, "rehydrate commit"))
+
 	inspectCommit := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>",
 		Short: "Return info about a commit.",
@@ -469,7 +674,7 @@ $ {{alias}} foo@XXX -r bar -r baz`,
 
 			var toRepos []*pfsclient.Repo
 			for _, repoName := range repos {
-				toRepos = append(toRepos, client.NewRepo(repoName))
+				toRepos = append(toRepos, cmdutil.ParseRepo(repoName))
 			}
 
 			w := tabwriter.NewWriter(os.Stdout, pretty.CommitHeader)
@@ -534,7 +739,7 @@ $ {{alias}} test@master --new`,
 				if err != nil {
 					return err
 				}
-				prov = client.NewCommitProvenance(ppsconsts.SpecRepo, pipeline, pipelineInfo.SpecCommit.ID)
+				prov = pipelineInfo.SpecCommit.NewProvenance()
 			}
 
 			w := tabwriter.NewWriter(os.Stdout, pretty.CommitHeader)
@@ -688,7 +893,7 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 				return err
 			}
 			defer c.Close()
-			branches, err := c.ListBranch(args[0])
+			branches, err := c.ListBranch(cmdutil.ParseRepo(args[0]).QualifiedName())
 			if err != nil {
 				return err
 			}
@@ -735,6 +940,237 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 	shell.RegisterCompletionFunc(deleteBranch, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(deleteBranch, "delete branch"))
 
+	var strategy string
+	var resolveFlags []string
+	mergeBranch := &cobra.Command{
+		Use:   "{{alias}} <repo>@<source-branch> <target-branch>",
+		Short: "Merge the changes on one branch into another.",
+		Long: `Merge the changes made on the source branch since its common ancestor with the
+target branch into the target branch, as a new commit on the target branch.
+
+Paths changed differently on both branches are conflicts. Conflicts are
+resolved with --resolve, or else --strategy: 'ours' keeps the target branch's
+version, 'theirs' takes the source branch's version, and 'fail' (the default)
+leaves the conflict unresolved. If any conflict is unresolved, no commit is
+made and the conflicts are printed.`,
+		Example: `
+# Merge the changes on branch "feature" of repo "foo" into "master".
+$ {{alias}} foo@feature master
+
+# Merge, taking the version on "feature" of all conflicting paths except
+# /config.json, which keeps the version on "master".
+$ {{alias}} foo@feature master --strategy theirs --resolve /config.json=ours`,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			source, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			mergeStrategy, err := parseMergeStrategy(strategy)
+			if err != nil {
+				return err
+			}
+			var resolutions []*pfs.MergeResolution
+			for _, resolve := range resolveFlags {
+				parts := strings.SplitN(resolve, "=", 2)
+				if len(parts) != 2 {
+					return errors.Errorf("invalid resolution %q, must be of the form <path>=<strategy>", resolve)
+				}
+				s, err := parseMergeStrategy(parts[1])
+				if err != nil {
+					return err
+				}
+				resolutions = append(resolutions, &pfs.MergeResolution{Path: parts[0], Strategy: s})
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			resp, err := c.MergeBranch(source.Repo.Name, source.Name, args[1], mergeStrategy, resolutions...)
+			if err != nil {
+				return err
+			}
+			if len(resp.Conflicts) > 0 {
+				writer := tabwriter.NewWriter(os.Stdout, pretty.MergeConflictHeader)
+				for _, conflict := range resp.Conflicts {
+					pretty.PrintMergeConflict(writer, conflict)
+				}
+				if err := writer.Flush(); err != nil {
+					return err
+				}
+			}
+			if resp.Commit == nil {
+				if len(resp.Conflicts) > 0 {
+					return errors.Errorf("merge failed: unresolved conflicts")
+				}
+				fmt.Println("Already up to date.")
+				return nil
+			}
+			fmt.Println(resp.Commit.ID)
+			return nil
+		}),
+	}
+	mergeBranch.Flags().StringVar(&strategy, "strategy", "fail", "how to resolve conflicts that aren't resolved by --resolve: 'ours', 'theirs' or 'fail'")
+	mergeBranch.Flags().StringSliceVar(&resolveFlags, "resolve", nil, "resolve the conflict at a path, in the form <path>=<ours|theirs|fail>")
+	shell.RegisterCompletionFunc(mergeBranch, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(mergeBranch, "merge branch"))
+
+	var remoteBranch string
+	pushBranch := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch> <remote>",
+		Short: "Push a branch to a remote pachd.",
+		Long: `Copy the commits on a branch that a remote pachd doesn't have to the remote,
+preserving their IDs, descriptions and parentage. Only the data that the remote
+doesn't already store is sent. The repo is created on the remote if it doesn't
+exist.
+
+The remote's branch must not have commits that aren't on the local branch.`,
+		Example: `
+# Push branch "master" of repo "foo" to remote "backup".
+$ {{alias}} foo@master backup
+
+# Push branch "master" of repo "foo" to branch "staging" on remote "backup".
+$ {{alias}} foo@master backup --remote-branch staging`,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			branch, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			stats, err := c.PushBranch(branch.Repo.Name, branch.Name, args[1], remoteBranch)
+			if err != nil {
+				return err
+			}
+			pretty.PrintTransferStats(stats)
+			return nil
+		}),
+	}
+	pushBranch.Flags().StringVar(&remoteBranch, "remote-branch", "", "the branch on the remote to update, defaults to the branch with the same name")
+	shell.RegisterCompletionFunc(pushBranch, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(pushBranch, "push branch"))
+
+	pullBranch := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch> <remote>",
+		Short: "Pull a branch from a remote pachd.",
+		Long: `Copy the commits on a remote pachd's branch that this pachd doesn't have,
+preserving their IDs, descriptions and parentage. Only the data that isn't
+already stored locally is fetched. The repo is created if it doesn't exist.
+
+The local branch must not have commits that aren't on the remote's branch.`,
+		Example: `
+# Pull branch "master" of repo "foo" from remote "origin".
+$ {{alias}} foo@master origin
+
+# Pull branch "staging" of repo "foo" on remote "origin" into "master".
+$ {{alias}} foo@master origin --remote-branch staging`,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			branch, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			stats, err := c.PullBranch(branch.Repo.Name, branch.Name, args[1], remoteBranch)
+			if err != nil {
+				return err
+			}
+			pretty.PrintTransferStats(stats)
+			return nil
+		}),
+	}
+	pullBranch.Flags().StringVar(&remoteBranch, "remote-branch", "", "the branch on the remote to pull, defaults to the branch with the same name")
+	shell.RegisterCompletionFunc(pullBranch, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(pullBranch, "pull branch"))
+
+	remoteDocs := &cobra.Command{
+		Short: "Docs for remotes.",
+		Long: `A remote is another pachd that branches can be pushed to with 'push branch'
+and pulled from with 'pull branch'.
+
+Requests to a remote are made with your auth token, so you must be authorized
+on the remote as well.`,
+	}
+	commands = append(commands, cmdutil.CreateDocsAlias(remoteDocs, "remote", " remote$"))
+
+	var updateRemote bool
+	createRemote := &cobra.Command{
+		Use:   "{{alias}} <name> <pachd-address>",
+		Short: "Create a new remote.",
+		Long:  "Create a new remote, a pachd that branches can be pushed to and pulled from.",
+		Example: `
+# Create a remote named "backup".
+$ {{alias}} backup grpcs://pachd.example.com:30650`,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			return c.CreateRemote(args[0], args[1], updateRemote)
+		}),
+	}
+	createRemote.Flags().BoolVar(&updateRemote, "update", false, "replace the remote if it already exists")
+	commands = append(commands, cmdutil.CreateAlias(createRemote, "create remote"))
+
+	listRemote := &cobra.Command{
+		Short: "Return all remotes.",
+		Long:  "Return all remotes.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			remotes, err := c.ListRemote()
+			if err != nil {
+				return err
+			}
+			if raw {
+				for _, remote := range remotes {
+					if err := marshaller.Marshal(os.Stdout, remote); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.RemoteHeader)
+			for _, remote := range remotes {
+				pretty.PrintRemote(writer, remote)
+			}
+			return writer.Flush()
+		}),
+	}
+	listRemote.Flags().AddFlagSet(rawFlags)
+	commands = append(commands, cmdutil.CreateAlias(listRemote, "list remote"))
+
+	deleteRemote := &cobra.Command{
+		Use:   "{{alias}} <name>",
+		Short: "Delete a remote.",
+		Long:  "Delete a remote. Data that was pushed to or pulled from it is unaffected.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			return c.DeleteRemote(args[0])
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(deleteRemote, "delete remote"))
+
 	fileDocs := &cobra.Command{
 		Short: "Docs for files.",
 		Long: `Files are the lowest level data objects in Pachyderm.
@@ -858,32 +1294,34 @@ $ {{alias}} repo@branch -i http://host/path`,
 				sources = filePaths
 			}
 
-			return c.WithModifyFileClient(file.Commit, func(mf client.ModifyFile) error {
-				for _, source := range sources {
-					source := source
-					if file.Path == "" {
-						// The user has not specified a path so we use source as path.
-						if source == "-" {
-							return errors.Errorf("must specify filename when reading data from stdin")
-						}
-						if err := putFileHelper(mf, joinPaths("", source), source, recursive, appendFile); err != nil {
-							return err
-						}
-					} else if len(sources) == 1 {
-						// We have a single source and the user has specified a path,
-						// we use the path and ignore source (in terms of naming the file).
-						if err := putFileHelper(mf, file.Path, source, recursive, appendFile); err != nil {
-							return err
-						}
-					} else {
-						// We have multiple sources and the user has specified a path,
-						// we use that path as a prefix for the filepaths.
-						if err := putFileHelper(mf, joinPaths(file.Path, source), source, recursive, appendFile); err != nil {
-							return err
+			return txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
+				return c.WithModifyFileClient(file.Commit, func(mf client.ModifyFile) error {
+					for _, source := range sources {
+						source := source
+						if file.Path == "" {
+							// The user has not specified a path so we use source as path.
+							if source == "-" {
+								return errors.Errorf("must specify filename when reading data from stdin")
+							}
+							if err := putFileHelper(mf, joinPaths("", source), source, recursive, appendFile); err != nil {
+								return err
+							}
+						} else if len(sources) == 1 {
+							// We have a single source and the user has specified a path,
+							// we use the path and ignore source (in terms of naming the file).
+							if err := putFileHelper(mf, file.Path, source, recursive, appendFile); err != nil {
+								return err
+							}
+						} else {
+							// We have multiple sources and the user has specified a path,
+							// we use that path as a prefix for the filepaths.
+							if err := putFileHelper(mf, joinPaths(file.Path, source), source, recursive, appendFile); err != nil {
+								return err
+							}
 						}
 					}
-				}
-				return nil
+					return nil
+				})
 			})
 		}),
 	}
@@ -930,11 +1368,13 @@ $ {{alias}} repo@branch -i http://host/path`,
 			if appendFile {
 				opts = append(opts, client.WithAppendCopyFile())
 			}
-			return c.CopyFile(
-				destFile.Commit, destFile.Path,
-				srcFile.Commit, srcFile.Path,
-				opts...,
-			)
+			return txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
+				return c.CopyFile(
+					destFile.Commit, destFile.Path,
+					srcFile.Commit, srcFile.Path,
+					opts...,
+				)
+			})
 		}),
 	}
 	copyFile.Flags().BoolVarP(&appendFile, "append", "a", false, "Append to the existing content of the file, either from previous commits or previous calls to 'put file' within this commit.")
@@ -1002,10 +1442,21 @@ $ {{alias}} 'foo@master:/test\[\].txt'`,
 	shell.RegisterCompletionFunc(getFile, shell.FileCompletion)
 	commands = append(commands, cmdutil.CreateAlias(getFile, "get file"))
 
+	var lineage bool
 	inspectFile := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>:<path/in/pfs>",
 		Short: "Return info about a file.",
-		Long:  "Return info about a file.",
+		Long: `Return info about a file.
+
+With --lineage, return the datums that wrote the file, if it's in a pipeline's
+output, and the input files and commits of those datums, following them back
+through upstream pipelines to the input repos.`,
+		Example: `
+# Return info about file "report.json" on branch "master" of repo "reports".
+$ {{alias}} reports@master:/report.json
+
+# Return the input files that "report.json" was produced from.
+$ {{alias}} reports@master:/report.json --lineage`,
 		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
 			file, err := cmdutil.ParseFile(args[0])
 			if err != nil {
@@ -1016,6 +1467,17 @@ $ {{alias}} 'foo@master:/test\[\].txt'`,
 				return err
 			}
 			defer c.Close()
+			if lineage {
+				fileLineage, err := c.InspectFileLineage(file.Commit, file.Path)
+				if err != nil {
+					return err
+				}
+				if raw {
+					return marshaller.Marshal(os.Stdout, fileLineage)
+				}
+				pretty.PrintFileLineage(os.Stdout, fileLineage)
+				return nil
+			}
 			fileInfo, err := c.InspectFile(file.Commit, file.Path)
 			if err != nil {
 				return err
@@ -1029,6 +1491,7 @@ $ {{alias}} 'foo@master:/test\[\].txt'`,
 			return pretty.PrintDetailedFileInfo(fileInfo)
 		}),
 	}
+	inspectFile.Flags().BoolVar(&lineage, "lineage", false, "return the datums and input files that produced the file")
 	inspectFile.Flags().AddFlagSet(rawFlags)
 	shell.RegisterCompletionFunc(inspectFile, shell.FileCompletion)
 	commands = append(commands, cmdutil.CreateAlias(inspectFile, "inspect file"))
@@ -1076,18 +1539,50 @@ $ {{alias}} 'foo@master:dir\[1\]'`,
 				return err
 			}
 			defer c.Close()
+			if history != 0 {
+				// A history of -1 ("all") is a limit of 0 (no limit).
+				limit := history
+				if limit < 0 {
+					limit = 0
+				}
+				var writer *tabwriter.Writer
+				if !raw {
+					writer = tabwriter.NewWriter(os.Stdout, pretty.FileHeaderWithCommit)
+				}
+				if err := c.ListFile(file.Commit, file.Path, func(fi *pfsclient.FileInfo) error {
+					versions, err := c.ListFileHistory(file.Commit, fi.File.Path, limit)
+					if err != nil {
+						return err
+					}
+					for _, version := range versions {
+						if raw {
+							if err := marshaller.Marshal(os.Stdout, version); err != nil {
+								return err
+							}
+							continue
+						}
+						if version.Deleted {
+							continue
+						}
+						pretty.PrintFileInfo(writer, version.FileInfo, fullTimestamps, true)
+					}
+					return nil
+				}); err != nil {
+					return err
+				}
+				if raw {
+					return nil
+				}
+				return writer.Flush()
+			}
 			if raw {
 				return c.ListFile(file.Commit, file.Path, func(fi *pfsclient.FileInfo) error {
 					return marshaller.Marshal(os.Stdout, fi)
 				})
 			}
-			header := pretty.FileHeader
-			if history != 0 {
-				header = pretty.FileHeaderWithCommit
-			}
-			writer := tabwriter.NewWriter(os.Stdout, header)
+			writer := tabwriter.NewWriter(os.Stdout, pretty.FileHeader)
 			if err := c.ListFile(file.Commit, file.Path, func(fi *pfsclient.FileInfo) error {
-				pretty.PrintFileInfo(writer, fi, fullTimestamps, history != 0)
+				pretty.PrintFileInfo(writer, fi, fullTimestamps, false)
 				return nil
 			}); err != nil {
 				return err
@@ -1097,7 +1592,7 @@ $ {{alias}} 'foo@master:dir\[1\]'`,
 	}
 	listFile.Flags().AddFlagSet(rawFlags)
 	listFile.Flags().AddFlagSet(fullTimestampsFlags)
-	listFile.Flags().StringVar(&history, "history", "none", "Return revision history for files.")
+	listFile.Flags().StringVar(&history, "history", "none", "Return revision history for files: the last n versions of each file, or \"all\" of them.")
 	shell.RegisterCompletionFunc(listFile, shell.FileCompletion)
 	commands = append(commands, cmdutil.CreateAlias(listFile, "list file"))
 
@@ -1266,7 +1761,9 @@ $ {{alias}} foo@master:path1 bar@master:path2`,
 			}
 			defer c.Close()
 
-			return c.DeleteFile(file.Commit, file.Path)
+			return txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
+				return c.DeleteFile(file.Commit, file.Path)
+			})
 		}),
 	}
 	shell.RegisterCompletionFunc(deleteFile, shell.FileCompletion)
@@ -1312,6 +1809,84 @@ Objects are a low-level resource and should not be accessed directly by most use
 	fsck.Flags().BoolVarP(&fix, "fix", "f", false, "Attempt to fix as many issues as possible.")
 	commands = append(commands, cmdutil.CreateAlias(fsck, "fsck"))
 
+	inspectStorage := &cobra.Command{
+		Use:   "{{alias}}",
+		Short: "Return info about how pfs is using the object store.",
+		Long: `Return info about how pfs is using the object store: the total size of the chunks in the object store, how many are pending deletion, the last run of each garbage collector, and how much each repo uses.
+
+A repo's exclusive bytes are in chunks referenced only by that repo's commits; deleting the repo would (eventually) free them. Its shared bytes are in chunks that other repos' commits also reference.
+
+If auth is active, only cluster admins can inspect storage.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			storageInfo, err := c.InspectStorage()
+			if err != nil {
+				return err
+			}
+			if raw {
+				return marshaller.Marshal(os.Stdout, storageInfo)
+			}
+			pretty.PrintDetailedStorageInfo(os.Stdout, storageInfo)
+			fmt.Println()
+			writer := tabwriter.NewWriter(os.Stdout, pretty.GCRunHeader)
+			for _, run := range storageInfo.GcRuns {
+				pretty.PrintGCRunInfo(writer, run, fullTimestamps)
+			}
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+			fmt.Println()
+			writer = tabwriter.NewWriter(os.Stdout, pretty.RepoStorageHeader)
+			for _, repoStorageInfo := range storageInfo.Repos {
+				pretty.PrintRepoStorageInfo(writer, repoStorageInfo)
+			}
+			return writer.Flush()
+		}),
+	}
+	inspectStorage.Flags().AddFlagSet(rawFlags)
+	inspectStorage.Flags().AddFlagSet(fullTimestampsFlags)
+	commands = append(commands, cmdutil.CreateAlias(inspectStorage, "inspect storage"))
+
+	var repair bool
+	verifyMirror := &cobra.Command{
+		Use:   "{{alias}}",
+		Short: "Compare the chunks in the object store with those in its mirror.",
+		Long:  "Compare the chunks in the object store with those in its mirror (see STORAGE_MIRROR_URL), and print each chunk that's only in one of them. If auth is active, only cluster admins can verify the mirror.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			consistent := true
+			if err := c.VerifyMirror(repair, func(diff *pfsclient.MirrorDifference) error {
+				consistent = false
+				where := "primary"
+				if diff.InSecondary {
+					where = "mirror"
+				}
+				if diff.Repaired {
+					fmt.Printf("Repaired: %s (was only in the %s)\n", diff.Object, where)
+				} else {
+					fmt.Printf("Only in the %s: %s\n", where, diff.Object)
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			if consistent {
+				fmt.Println("Mirror is consistent.")
+			}
+			return nil
+		}),
+	}
+	verifyMirror.Flags().BoolVar(&repair, "repair", false, "Copy (or delete) the chunks that differ in the mirror.")
+	commands = append(commands, cmdutil.CreateAlias(verifyMirror, "verify mirror"))
+
 	var seed int64
 	runLoadTest := &cobra.Command{
 		Use:     "{{alias}} <spec>",
@@ -1488,3 +2063,29 @@ func newClient(name string, options ...client.Option) (*client.APIClient, error)
 	}
 	return client.NewOnUserMachine(name, options...)
 }
+
+func parseMergeStrategy(s string) (pfs.MergeStrategy, error) {
+	switch strings.ToLower(s) {
+	case "fail":
+		return pfs.MergeStrategy_MERGE_FAIL, nil
+	case "ours":
+		return pfs.MergeStrategy_MERGE_OURS, nil
+	case "theirs":
+		return pfs.MergeStrategy_MERGE_THEIRS, nil
+	default:
+		return 0, errors.Errorf("unrecognized merge strategy %q, must be one of 'ours', 'theirs' or 'fail'", s)
+	}
+}
+
+// parseColdAfter parses the --cold-after flag of create repo and update repo,
+// which is unset if it's empty.
+func parseColdAfter(coldAfter string) (*types.Duration, error) {
+	if coldAfter == "" {
+		return nil, nil
+	}
+	d, err := time.ParseDuration(coldAfter)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid --cold-after")
+	}
+	return types.DurationProto(d), nil
+}
@@ -20,6 +20,8 @@ type APIServer interface {
 	InspectRepoInTransaction(*txncontext.TransactionContext, *pfs_client.InspectRepoRequest) (*pfs_client.RepoInfo, error)
 	DeleteRepoInTransaction(*txncontext.TransactionContext, *pfs_client.DeleteRepoRequest) error
 
+	InspectProjectInTransaction(*txncontext.TransactionContext, *pfs_client.InspectProjectRequest) (*pfs_client.ProjectInfo, error)
+
 	StartCommitInTransaction(*txncontext.TransactionContext, *pfs_client.StartCommitRequest, *pfs_client.Commit) (*pfs_client.Commit, error)
 	FinishCommitInTransaction(*txncontext.TransactionContext, *pfs_client.FinishCommitRequest) error
 	SquashCommitInTransaction(*txncontext.TransactionContext, *pfs_client.SquashCommitRequest) error
@@ -77,15 +77,15 @@ func (e ErrFileNotFound) Error() string {
 }
 
 func (e ErrRepoNotFound) Error() string {
-	return fmt.Sprintf("repo %v not found", e.Repo.Name)
+	return fmt.Sprintf("repo %v not found", e.Repo.QualifiedName())
 }
 
 func (e ErrRepoExists) Error() string {
-	return fmt.Sprintf("repo %v already exists", e.Repo.Name)
+	return fmt.Sprintf("repo %v already exists", e.Repo.QualifiedName())
 }
 
 func (e ErrRepoDeleted) Error() string {
-	return fmt.Sprintf("repo %v was deleted", e.Repo.Name)
+	return fmt.Sprintf("repo %v was deleted", e.Repo.QualifiedName())
 }
 
 func (e ErrCommitNotFound) Error() string {
@@ -125,9 +125,9 @@ var (
 	commitNotFoundRe          = regexp.MustCompile("commit [^ ]+ not found in repo [^ ]+")
 	commitDeletedRe           = regexp.MustCompile("commit [^ ]+ was deleted")
 	commitFinishedRe          = regexp.MustCompile("commit [^ ]+ in repo [^ ]+ has already finished")
-	repoNotFoundRe            = regexp.MustCompile(`repos [a-zA-Z0-9.\-_]{1,255} not found`)
-	repoExistsRe              = regexp.MustCompile(`repo ?[a-zA-Z0-9.\-_]{1,255} already exists`)
-	branchNotFoundRe          = regexp.MustCompile(`branches [a-zA-Z0-9.\-_@]{1,255} not found`)
+	repoNotFoundRe            = regexp.MustCompile(`repos [a-zA-Z0-9.\-_/]{1,255} not found`)
+	repoExistsRe              = regexp.MustCompile(`repo ?[a-zA-Z0-9.\-_/]{1,255} already exists`)
+	branchNotFoundRe          = regexp.MustCompile(`branches [a-zA-Z0-9.\-_@/]{1,255} not found`)
 	fileNotFoundRe            = regexp.MustCompile(`file .+ not found`)
 	hasNoHeadRe               = regexp.MustCompile(`the branch .+ has no head \(create one with 'start commit'\)`)
 	outputCommitNotFinishedRe = regexp.MustCompile("output commit .+ not finished")
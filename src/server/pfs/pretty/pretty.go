@@ -14,6 +14,8 @@ import (
 )
 
 const (
+	// ProjectHeader is the header for projects.
+	ProjectHeader = "NAME\tCREATED\tDESCRIPTION\t\n"
 	// RepoHeader is the header for repos.
 	RepoHeader = "NAME\tCREATED\tSIZE (MASTER)\tDESCRIPTION\t\n"
 	// RepoAuthHeader is the header for repos with auth information attached.
@@ -28,11 +30,56 @@ const (
 	FileHeaderWithCommit = "COMMIT\tNAME\tTYPE\tCOMMITTED\tSIZE\t\n"
 	// DiffFileHeader is the header for files produced by diff file.
 	DiffFileHeader = "OP\t" + FileHeader
+	// GCRunHeader is the header for garbage collector runs.
+	GCRunHeader = "GC\tSTARTED\tDURATION\tDELETED\tBYTES DELETED\tERROR\t\n"
+	// RepoStorageHeader is the header for repos' storage usage.
+	RepoStorageHeader = "REPO\tTYPE\tEXCLUSIVE\tSHARED\t\n"
+	// MergeConflictHeader is the header for conflicts produced by merge branch.
+	MergeConflictHeader = "PATH\tOURS\tTHEIRS\tRESOLUTION\t\n"
+	// RemoteHeader is the header for remotes.
+	RemoteHeader = "NAME\tADDRESS\t\n"
 )
 
+// PrintProjectInfo pretty-prints project info.
+func PrintProjectInfo(w io.Writer, projectInfo *pfs.ProjectInfo, fullTimestamps bool) {
+	fmt.Fprintf(w, "%s\t", projectInfo.Project.Name)
+	if fullTimestamps {
+		fmt.Fprintf(w, "%s\t", projectInfo.Created.String())
+	} else {
+		fmt.Fprintf(w, "%s\t", pretty.Ago(projectInfo.Created))
+	}
+	fmt.Fprintf(w, "%s\t", projectInfo.Description)
+	fmt.Fprintln(w)
+}
+
+// PrintableProjectInfo is a wrapper around ProjectInfo containing any
+// formatting options used within the template to conditionally print
+// information.
+type PrintableProjectInfo struct {
+	*pfs.ProjectInfo
+	FullTimestamps bool
+}
+
+// PrintDetailedProjectInfo pretty-prints detailed project info.
+func PrintDetailedProjectInfo(projectInfo *PrintableProjectInfo) error {
+	template, err := template.New("ProjectInfo").Funcs(funcMap).Parse(
+		`Name: {{.Project.Name}}{{if .Description}}
+Description: {{.Description}}{{end}}{{if .FullTimestamps}}
+Created: {{.Created}}{{else}}
+Created: {{prettyAgo .Created}}{{end}}{{if .Quota}}{{if .Quota.MaxRepos}}
+Max repos: {{.Quota.MaxRepos}}{{end}}{{if .Quota.MaxPipelines}}
+Max pipelines: {{.Quota.MaxPipelines}}{{end}}{{end}}{{if .InputGrants}}
+Input grants: {{range $i, $p := .InputGrants}}{{if $i}}, {{end}}{{$p.Name}}{{end}}{{end}}
+`)
+	if err != nil {
+		return err
+	}
+	return template.Execute(os.Stdout, projectInfo)
+}
+
 // PrintRepoInfo pretty-prints repo info.
 func PrintRepoInfo(w io.Writer, repoInfo *pfs.RepoInfo, fullTimestamps bool) {
-	fmt.Fprintf(w, "%s\t", repoInfo.Repo.Name)
+	fmt.Fprintf(w, "%s\t", repoInfo.Repo.QualifiedName())
 	if fullTimestamps {
 		fmt.Fprintf(w, "%s\t", repoInfo.Created.String())
 	} else {
@@ -63,11 +110,12 @@ func NewPrintableRepoInfo(ri *pfs.RepoInfo) *PrintableRepoInfo {
 // PrintDetailedRepoInfo pretty-prints detailed repo info.
 func PrintDetailedRepoInfo(repoInfo *PrintableRepoInfo) error {
 	template, err := template.New("RepoInfo").Funcs(funcMap).Parse(
-		`Name: {{.Repo.Name}}{{if .Description}}
+		`Name: {{.Repo.QualifiedName}}{{if .Description}}
 Description: {{.Description}}{{end}}{{if .FullTimestamps}}
 Created: {{.Created}}{{else}}
 Created: {{prettyAgo .Created}}{{end}}
-Size of HEAD on master: {{prettySize .SizeBytes}}{{if .AuthInfo}}
+Size of HEAD on master: {{prettySize .SizeBytes}}{{if .ColdAfter}}
+Cold after: {{prettyDuration .ColdAfter}}{{end}}{{if .AuthInfo}}
 Access level: {{ .AuthInfo.AccessLevel.String }}{{end}}
 `)
 	if err != nil {
@@ -80,6 +128,51 @@ Access level: {{ .AuthInfo.AccessLevel.String }}{{end}}
 	return nil
 }
 
+// PrintDetailedStorageInfo pretty-prints a summary of PFS's storage usage.
+// The GC runs and repos in 'storageInfo' are printed by PrintGCRunInfo and
+// PrintRepoStorageInfo.
+func PrintDetailedStorageInfo(w io.Writer, storageInfo *pfs.StorageInfo) {
+	fmt.Fprintf(w, "Object store: %s in %d chunks\n", units.BytesSize(float64(storageInfo.ObjectStoreBytes)), storageInfo.Chunks)
+	fmt.Fprintf(w, "Chunks pending deletion: %d (%s)\n", storageInfo.ChunksPendingDeletion, units.BytesSize(float64(storageInfo.BytesPendingDeletion)))
+	fmt.Fprintf(w, "Tracked objects pending deletion: %d\n", storageInfo.TrackedObjectsPendingDeletion)
+	fmt.Fprintf(w, "Not referenced by any commit: %s\n", units.BytesSize(float64(storageInfo.UnattributedBytes)))
+	if storageInfo.ColdChunks > 0 {
+		fmt.Fprintf(w, "Cold tier: %s in %d chunks\n", units.BytesSize(float64(storageInfo.ColdBytes)), storageInfo.ColdChunks)
+	}
+}
+
+// PrintGCRunInfo pretty-prints the last run of a garbage collector.
+func PrintGCRunInfo(w io.Writer, run *pfs.GCRunInfo, fullTimestamps bool) {
+	fmt.Fprintf(w, "%s\t", run.Name)
+	if fullTimestamps {
+		fmt.Fprintf(w, "%s\t", run.Started.String())
+	} else {
+		fmt.Fprintf(w, "%s\t", pretty.Ago(run.Started))
+	}
+	fmt.Fprintf(w, "%s\t", pretty.TimeDifference(run.Started, run.Finished))
+	fmt.Fprintf(w, "%d\t", run.ObjectsDeleted)
+	if run.Name == "chunk" {
+		fmt.Fprintf(w, "%s\t", units.BytesSize(float64(run.BytesDeleted)))
+	} else {
+		fmt.Fprintf(w, "-\t")
+	}
+	if run.Error != "" {
+		fmt.Fprintf(w, "%s\t", run.Error)
+	} else {
+		fmt.Fprintf(w, "-\t")
+	}
+	fmt.Fprintln(w)
+}
+
+// PrintRepoStorageInfo pretty-prints a repo's storage usage.
+func PrintRepoStorageInfo(w io.Writer, repoStorageInfo *pfs.RepoStorageInfo) {
+	fmt.Fprintf(w, "%s\t", repoStorageInfo.Repo.Name)
+	fmt.Fprintf(w, "%s\t", repoStorageInfo.Repo.Type)
+	fmt.Fprintf(w, "%s\t", units.BytesSize(float64(repoStorageInfo.ExclusiveBytes)))
+	fmt.Fprintf(w, "%s\t", units.BytesSize(float64(repoStorageInfo.SharedBytes)))
+	fmt.Fprintln(w)
+}
+
 func printTrigger(trigger *pfs.Trigger) string {
 	var conds []string
 	if trigger.CronSpec != "" {
@@ -236,6 +329,39 @@ func PrintDiffFileInfo(w io.Writer, added bool, fileInfo *pfs.FileInfo, fullTime
 	PrintFileInfo(w, fileInfo, fullTimestamps, false)
 }
 
+// PrintMergeConflict pretty-prints a conflict from merge branch.
+func PrintMergeConflict(w io.Writer, conflict *pfs.MergeConflict) {
+	fmt.Fprintf(w, "%s\t", conflict.Path)
+	for _, fileInfo := range []*pfs.FileInfo{conflict.Ours, conflict.Theirs} {
+		if fileInfo == nil {
+			fmt.Fprint(w, "deleted\t")
+		} else {
+			fmt.Fprintf(w, "%s\t", units.BytesSize(float64(fileInfo.SizeBytes)))
+		}
+	}
+	switch conflict.Resolution {
+	case pfs.MergeStrategy_MERGE_OURS:
+		fmt.Fprint(w, "ours\t")
+	case pfs.MergeStrategy_MERGE_THEIRS:
+		fmt.Fprint(w, "theirs\t")
+	default:
+		fmt.Fprint(w, color.RedString("unresolved\t"))
+	}
+	fmt.Fprintln(w)
+}
+
+// PrintRemote pretty-prints a remote.
+func PrintRemote(w io.Writer, remote *pfs.Remote) {
+	fmt.Fprintf(w, "%s\t%s\t\n", remote.Name, remote.PachdAddress)
+}
+
+// PrintTransferStats pretty-prints the result of push branch or pull branch.
+func PrintTransferStats(stats *pfs.TransferStats) {
+	fmt.Printf("%s@%s=%s\n", stats.Head.Branch.Repo.Name, stats.Head.Branch.Name, stats.Head.ID)
+	fmt.Printf("%d commits, %d chunks (%s) transferred, %d chunks already present\n",
+		stats.CommitsTransferred, stats.ChunksTransferred, units.BytesSize(float64(stats.BytesTransferred)), stats.ChunksSkipped)
+}
+
 // PrintDetailedFileInfo pretty-prints detailed file info.
 func PrintDetailedFileInfo(fileInfo *pfs.FileInfo) error {
 	template, err := template.New("FileInfo").Funcs(funcMap).Parse(
@@ -249,6 +375,23 @@ Size: {{prettySize .SizeBytes}}
 	return template.Execute(os.Stdout, fileInfo)
 }
 
+// PrintFileLineage pretty-prints a file's lineage as a tree, with each file
+// followed by the datums that wrote it and their input files.
+func PrintFileLineage(w io.Writer, lineage *pfs.FileLineage) {
+	printFileLineage(w, lineage, "")
+}
+
+func printFileLineage(w io.Writer, lineage *pfs.FileLineage, indent string) {
+	file := lineage.File
+	fmt.Fprintf(w, "%s%s@%s:%s\n", indent, file.Commit.Branch.Repo.Name, file.Commit.ID, file.Path)
+	for _, datum := range lineage.Datums {
+		fmt.Fprintf(w, "%s  datum %s (pipeline %s, job %s)\n", indent, datum.DatumID, datum.Pipeline, datum.PipelineJobID)
+		for _, input := range datum.Inputs {
+			printFileLineage(w, input, indent+"    ")
+		}
+	}
+}
+
 func fileType(fileType pfs.FileType) string {
 	if fileType == pfs.FileType_FILE {
 		return "file"
@@ -257,10 +400,11 @@ func fileType(fileType pfs.FileType) string {
 }
 
 var funcMap = template.FuncMap{
-	"prettyAgo":    pretty.Ago,
-	"prettySize":   pretty.Size,
-	"fileType":     fileType,
-	"printTrigger": printTrigger,
+	"prettyAgo":      pretty.Ago,
+	"prettySize":     pretty.Size,
+	"prettyDuration": pretty.Duration,
+	"fileType":       fileType,
+	"printTrigger":   printTrigger,
 }
 
 // CompactPrintBranch renders 'b' as a compact string, e.g.
@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	glob "github.com/pachyderm/ohmyglob"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pagination"
 	pfsClient "github.com/pachyderm/pachyderm/v2/src/pfs"
 	pfsServer "github.com/pachyderm/pachyderm/v2/src/server/pfs"
 	"github.com/pachyderm/s2"
@@ -95,7 +98,16 @@ func (c *controller) ListObjects(r *http.Request, bucketName, prefix, marker, de
 		pattern = fmt.Sprintf("%s*", glob.QuoteMeta(prefix))
 	}
 
-	err = pc.GlobFile(client.NewCommit(bucket.Repo, bucket.Branch, bucket.Commit), pattern, func(fileInfo *pfsClient.FileInfo) error {
+	// Start the listing after the marker, rather than globbing the whole
+	// bucket and skipping everything up to it. In a recursive listing a
+	// marker that ends with a slash isn't a common prefix, so the files under
+	// it must still be listed.
+	var pageToken string
+	if marker != "" && (!recursive || !strings.HasSuffix(marker, "/")) {
+		pageToken = pagination.Encode(pagination.Files, "/"+marker)
+	}
+
+	_, err = pc.GlobFilePage(client.NewCommit(bucket.Repo, bucket.Branch, bucket.Commit), pattern, 0, pageToken, func(fileInfo *pfsClient.FileInfo) error {
 		if fileInfo.FileType == pfsClient.FileType_DIR {
 			if fileInfo.File.Path == "/" {
 				// skip the root directory
@@ -110,6 +122,13 @@ func (c *controller) ListObjects(r *http.Request, bucketName, prefix, marker, de
 			return nil
 		}
 
+		if fileInfo.FileType == pfsClient.FileType_FILE && !bucket.contains(fileInfo.File.Path) {
+			return nil
+		}
+		if fileInfo.FileType == pfsClient.FileType_DIR && !bucket.containsDir(fileInfo.File.Path) {
+			return nil
+		}
+
 		fileInfo.File.Path = fileInfo.File.Path[1:] // strip leading slash
 
 		if !strings.HasPrefix(fileInfo.File.Path, prefix) {
@@ -259,12 +278,143 @@ func (c *controller) DeleteBucket(r *http.Request, bucketName string) error {
 }
 
 func (c *controller) ListObjectVersions(r *http.Request, bucketName, prefix, keyMarker, versionIDMarker string, delimiter string, maxKeys int) (*s2.ListObjectVersionsResult, error) {
-	// NOTE: because this endpoint isn't implemented, conformance tests will
-	// fail on teardown. It's nevertheless unimplemented because it's too
-	// expensive to pull off with PFS until this is implemented:
-	// https://github.com/pachyderm/pachyderm/v2/issues/3896
 	c.logger.Debugf("ListObjectVersions: bucketName=%+v, prefix=%+v, keyMarker=%+v, versionIDMarker=%+v, delimiter=%+v, maxKeys=%+v", bucketName, prefix, keyMarker, versionIDMarker, delimiter, maxKeys)
-	return nil, s2.NotImplementedError(r)
+
+	prefix = strings.TrimPrefix(prefix, "/")
+
+	pc, err := c.requestClient(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if delimiter != "" && delimiter != "/" {
+		return nil, invalidDelimiterError(r)
+	}
+
+	bucket, err := c.driver.bucket(pc, r, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	bucketCaps, err := c.driver.bucketCapabilities(pc, r, bucket)
+	if err != nil {
+		return nil, err
+	}
+	if !bucketCaps.historicVersions {
+		return nil, s2.NotImplementedError(r)
+	}
+
+	result := s2.ListObjectVersionsResult{
+		Versions:      []*s2.Version{},
+		DeleteMarkers: []*s2.DeleteMarker{},
+	}
+
+	if !bucketCaps.readable {
+		return &result, nil
+	}
+
+	if maxKeys <= 0 {
+		return &result, nil
+	}
+	// Versions are listed by walking the branch's history once, from the
+	// newest commit to the oldest, so they're ordered by commit and then by
+	// key rather than by key. The walk stops once a page is full, and the
+	// next page continues it from the last version returned, which clients
+	// send back as the key and version ID markers.
+	commit := client.NewCommit(bucket.Repo, bucket.Branch, bucket.Commit)
+	dir := "/"
+	if i := strings.LastIndex(prefix, "/"); i >= 0 {
+		dir = "/" + prefix[:i+1]
+	}
+	var pageToken string
+	if versionIDMarker != "" {
+		pageToken = pagination.EncodeFileHistory(versionIDMarker, "/"+keyMarker)
+	}
+	// seen records the keys that a version has been listed for. A listing
+	// that starts at the head has seen every newer version, so the first
+	// version of each key it lists is the latest one.
+	seen := make(map[string]bool)
+	fromHead := pageToken == ""
+	for {
+		response, err := pc.PfsAPIClient.ListFileHistory(pc.Ctx(), &pfsClient.ListFileHistoryRequest{
+			File:      commit.NewFile(dir),
+			Recursive: true,
+			Limit:     int64(maxKeys - len(result.Versions) - len(result.DeleteMarkers)),
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, grpcutil.ScrubGRPC(err)
+		}
+		for _, version := range response.Versions {
+			p := version.FileInfo.File.Path
+			key := p[1:] // strip leading slash
+			if !strings.HasPrefix(key, prefix) || !bucket.contains(p) {
+				continue
+			}
+			if delimiter != "" && strings.Contains(key[len(prefix):], delimiter) {
+				continue
+			}
+			if versionIDMarker == "" && keyMarker != "" && key <= keyMarker {
+				continue
+			}
+			isLatest := !seen[key]
+			if isLatest && !fromHead {
+				isLatest, err = isLatestVersion(pc, commit, version)
+				if err != nil {
+					return nil, err
+				}
+			}
+			seen[key] = true
+			if version.Deleted {
+				var t time.Time
+				if version.FileInfo.Committed != nil {
+					t, err = types.TimestampFromProto(version.FileInfo.Committed)
+					if err != nil {
+						return nil, err
+					}
+				}
+				result.DeleteMarkers = append(result.DeleteMarkers, &s2.DeleteMarker{
+					Key:          key,
+					Version:      version.FileInfo.File.Commit.ID,
+					IsLatest:     isLatest,
+					LastModified: t,
+					Owner:        defaultUser,
+				})
+				continue
+			}
+			contents, err := newContents(version.FileInfo)
+			if err != nil {
+				return nil, err
+			}
+			result.Versions = append(result.Versions, &s2.Version{
+				Key:          key,
+				Version:      version.FileInfo.File.Commit.ID,
+				IsLatest:     isLatest,
+				LastModified: contents.LastModified,
+				ETag:         contents.ETag,
+				Size:         contents.Size,
+				StorageClass: contents.StorageClass,
+				Owner:        contents.Owner,
+			})
+		}
+		if response.NextPageToken == "" {
+			return &result, nil
+		}
+		if len(result.Versions)+len(result.DeleteMarkers) >= maxKeys {
+			result.IsTruncated = true
+			return &result, nil
+		}
+		pageToken = response.NextPageToken
+	}
+}
+
+// isLatestVersion returns true if version is the newest version of its file
+// in commit's history.
+func isLatestVersion(pc *client.APIClient, commit *pfsClient.Commit, version *pfsClient.FileVersion) (bool, error) {
+	versions, err := pc.ListFileHistory(commit, version.FileInfo.File.Path, 1)
+	if err != nil {
+		return false, err
+	}
+	return len(versions) > 0 && versions[0].FileInfo.File.Commit.ID == version.FileInfo.File.Commit.ID, nil
 }
 
 func (c *controller) GetBucketVersioning(r *http.Request, bucketName string) (string, error) {
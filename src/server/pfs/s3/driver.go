@@ -23,6 +23,43 @@ type Bucket struct {
 	Commit string
 	// Name is the name of the bucket
 	Name string
+	// Paths, if set, restricts the bucket to the files at or under these
+	// paths, e.g. the files that belong to a single datum
+	Paths []string
+}
+
+// contains returns true if the file at 'file' is visible in the bucket
+func (b *Bucket) contains(file string) bool {
+	if len(b.Paths) == 0 {
+		return true
+	}
+	file = cleanPath(file)
+	for _, p := range b.Paths {
+		p = cleanPath(p)
+		if p == "/" || file == p || strings.HasPrefix(file, p+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// containsDir returns true if the directory at 'dir' contains, or is
+// contained by, one of the bucket's paths
+func (b *Bucket) containsDir(dir string) bool {
+	if b.contains(dir) {
+		return true
+	}
+	dir = strings.TrimSuffix(cleanPath(dir), "/")
+	for _, p := range b.Paths {
+		if strings.HasPrefix(cleanPath(p), dir+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func cleanPath(p string) string {
+	return "/" + strings.Trim(p, "/")
 }
 
 type bucketCapabilities struct {
@@ -113,8 +150,14 @@ type WorkerDriver struct {
 	inputBuckets []*Bucket
 	outputBucket *Bucket
 	namesMap     map[string]*Bucket
+	datumBuckets DatumBucketResolver
 }
 
+// DatumBucketResolver returns the per-datum bucket named 'name', or nil if
+// there is no such bucket. Per-datum buckets expose the files that a single
+// datum sees of an input, and aren't included in bucket listings.
+type DatumBucketResolver func(pc *client.APIClient, name string) (*Bucket, error)
+
 // NewWorkerDriver creates a new worker driver. `inputBuckets` is a list of
 // whitelisted buckets to be served from input repos. `outputBucket` is the
 // whitelisted bucket to be served from an output repo. If `nil`, no output
@@ -137,6 +180,13 @@ func NewWorkerDriver(inputBuckets []*Bucket, outputBucket *Bucket) *WorkerDriver
 	}
 }
 
+// WithDatumBuckets sets the resolver used to look up per-datum buckets, which
+// are served in addition to the input and output buckets.
+func (d *WorkerDriver) WithDatumBuckets(resolver DatumBucketResolver) *WorkerDriver {
+	d.datumBuckets = resolver
+	return d
+}
+
 func (d *WorkerDriver) listBuckets(pc *client.APIClient, r *http.Request, buckets *[]*s2.Bucket) error {
 	repos, err := pc.ListRepo()
 	if err != nil {
@@ -167,6 +217,13 @@ func (d *WorkerDriver) listBuckets(pc *client.APIClient, r *http.Request, bucket
 
 func (d *WorkerDriver) bucket(pc *client.APIClient, r *http.Request, name string) (*Bucket, error) {
 	bucket := d.namesMap[name]
+	if bucket == nil && d.datumBuckets != nil {
+		var err error
+		bucket, err = d.datumBuckets(pc, name)
+		if err != nil {
+			return nil, s2.InternalError(r, err)
+		}
+	}
 	if bucket == nil {
 		return &Bucket{
 			Name: name,
@@ -33,7 +33,7 @@ func (c *controller) GetObject(r *http.Request, bucketName, file, version string
 	if err != nil {
 		return nil, err
 	}
-	if !bucketCaps.readable {
+	if !bucketCaps.readable || !bucket.contains(file) {
 		return nil, s2.NoSuchKeyError(r)
 	}
 
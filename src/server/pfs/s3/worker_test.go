@@ -223,6 +223,33 @@ func workerListObjectsRecursive(t *testing.T, s *workerTestState) {
 	checkListObjects(t, ch, nil, nil, expectedFiles, []string{})
 }
 
+func workerDatumBucket(t *testing.T, s *workerTestState) {
+	// only the files in the datum are listed
+	ch := s.minioClient.ListObjects(datumBucket, "", true, make(chan struct{}))
+	checkListObjects(t, ch, nil, nil, []string{"rootdir/subdir/2"}, []string{})
+	ch = s.minioClient.ListObjects(datumBucket, "", false, make(chan struct{}))
+	checkListObjects(t, ch, nil, nil, []string{}, []string{"rootdir/"})
+
+	fetchedContent, err := getObject(t, s.minioClient, datumBucket, "rootdir/subdir/2")
+	require.NoError(t, err)
+	require.Equal(t, "2\n", fetchedContent)
+	// files outside of the datum aren't visible
+	_, err = getObject(t, s.minioClient, datumBucket, "0")
+	keyNotFoundError(t, err)
+	_, err = getObject(t, s.minioClient, datumBucket, "rootdir/1")
+	keyNotFoundError(t, err)
+
+	exists, err := s.minioClient.BucketExists(datumBucket)
+	require.NoError(t, err)
+	require.True(t, exists)
+	exists, err = s.minioClient.BucketExists("fedcba9876543210.in3")
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
+// datumBucket is the name of the per-datum bucket served in TestWorkerDriver
+const datumBucket = "0123456789abcdef.in3"
+
 func TestWorkerDriver(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
@@ -279,7 +306,18 @@ func TestWorkerDriver(t *testing.T) {
 			Branch: outputBranch,
 			Name:   "out",
 		},
-	)
+	).WithDatumBuckets(func(pc *client.APIClient, name string) (*Bucket, error) {
+		if name != datumBucket {
+			return nil, nil
+		}
+		return &Bucket{
+			Repo:   inputRepo,
+			Branch: inputMasterCommit.Branch.Name,
+			Commit: inputMasterCommit.ID,
+			Name:   datumBucket,
+			Paths:  []string{"/rootdir/subdir"},
+		}, nil
+	})
 
 	testRunner(t, pachClient, "worker", driver, func(t *testing.T, pachClient *client.APIClient, minioClient *minio.Client) {
 		s := &workerTestState{
@@ -334,5 +372,8 @@ func TestWorkerDriver(t *testing.T) {
 		t.Run("ListObjectsRecursive", func(t *testing.T) {
 			workerListObjectsRecursive(t, s)
 		})
+		t.Run("DatumBucket", func(t *testing.T) {
+			workerDatumBucket(t, s)
+		})
 	})
 }
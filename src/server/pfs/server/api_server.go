@@ -18,8 +18,11 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pagination"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsload"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/metrics"
 	txnenv "github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
@@ -27,6 +30,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/transaction"
 	"gopkg.in/yaml.v2"
 
 	"golang.org/x/net/context"
@@ -77,7 +81,7 @@ func (a *apiServer) CreateRepoInTransaction(txnCtx *txncontext.TransactionContex
 	if repo := request.GetRepo(); repo != nil && repo.Name == fileSetsRepo {
 		return errors.Errorf("%s is a reserved name", fileSetsRepo)
 	}
-	return a.driver.createRepo(txnCtx, request.Repo, request.Description, request.Update)
+	return a.driver.createRepo(txnCtx, request.Repo, request.Description, request.Update, request.ColdAfter)
 }
 
 // CreateRepo implements the protobuf pfs.CreateRepo RPC
@@ -124,7 +128,7 @@ func (a *apiServer) InspectRepo(ctx context.Context, request *pfs.InspectRepoReq
 func (a *apiServer) ListRepo(ctx context.Context, request *pfs.ListRepoRequest) (response *pfs.ListRepoResponse, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
-	repoInfos, err := a.driver.listRepo(ctx, true, request.Type)
+	repoInfos, err := a.driver.listRepo(ctx, true, request.Type, request.Project)
 	return repoInfos, err
 }
 
@@ -149,6 +153,58 @@ func (a *apiServer) DeleteRepo(ctx context.Context, request *pfs.DeleteRepoReque
 	return &types.Empty{}, nil
 }
 
+// CreateProject implements the protobuf pfs.CreateProject RPC
+func (a *apiServer) CreateProject(ctx context.Context, request *pfs.CreateProjectRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.driver.createProject(txnCtx, request)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// InspectProjectInTransaction is identical to InspectProject except that it
+// can run inside an existing postgres transaction.  This is not an RPC.
+func (a *apiServer) InspectProjectInTransaction(txnCtx *txncontext.TransactionContext, request *pfs.InspectProjectRequest) (*pfs.ProjectInfo, error) {
+	return a.driver.inspectProject(txnCtx, request.Project)
+}
+
+// InspectProject implements the protobuf pfs.InspectProject RPC
+func (a *apiServer) InspectProject(ctx context.Context, request *pfs.InspectProjectRequest) (response *pfs.ProjectInfo, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	var info *pfs.ProjectInfo
+	if err := a.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		var err error
+		info, err = a.InspectProjectInTransaction(txnCtx, request)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// ListProject implements the protobuf pfs.ListProject RPC
+func (a *apiServer) ListProject(ctx context.Context, request *pfs.ListProjectRequest) (response *pfs.ListProjectResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.listProject(ctx)
+}
+
+// DeleteProject implements the protobuf pfs.DeleteProject RPC
+func (a *apiServer) DeleteProject(ctx context.Context, request *pfs.DeleteProjectRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.driver.deleteProject(txnCtx, request.Project)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
 // StartCommitInTransaction is identical to StartCommit except that it can run
 // inside an existing postgres transaction.  This is not an RPC.  The target
 // commit can be specified but is optional.  This is so that the transaction can
@@ -220,10 +276,26 @@ func (a *apiServer) ListCommit(request *pfs.ListCommitRequest, respServer pfs.AP
 	defer func(start time.Time) {
 		a.Log(request, fmt.Sprintf("stream containing %d commits", sent), retErr, time.Since(start))
 	}(time.Now())
-	return a.driver.listCommit(respServer.Context(), request.Repo, request.To, request.From, request.Number, request.Reverse, func(ci *pfs.CommitInfo) error {
+	kind := pagination.Commits
+	if request.To != nil {
+		kind = pagination.CommitAncestors
+	}
+	after, err := pagination.Decode(kind, request.PageToken)
+	if err != nil {
+		return err
+	}
+	pager, err := pagination.NewPager(kind, request.PageSize)
+	if err != nil {
+		return err
+	}
+	paginate := request.PageSize > 0 || request.PageToken != ""
+	return pager.Finish(respServer, a.driver.listCommit(respServer.Context(), request.Repo, request.To, request.From, request.Number, request.Reverse, paginate, after, func(ci *pfs.CommitInfo) error {
+		if err := pager.Add(pfsdb.CommitKey(ci.Commit)); err != nil {
+			return err
+		}
 		sent++
 		return respServer.Send(ci)
-	})
+	}))
 }
 
 // SquashCommitInTransaction is identical to SquashCommit except that it can run
@@ -331,6 +403,13 @@ func (a *apiServer) DeleteBranch(ctx context.Context, request *pfs.DeleteBranchR
 	return &types.Empty{}, nil
 }
 
+// MergeBranch implements the protobuf pfs.MergeBranch RPC
+func (a *apiServer) MergeBranch(ctx context.Context, request *pfs.MergeBranchRequest) (response *pfs.MergeBranchResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.mergeBranch(ctx, request)
+}
+
 func (a *apiServer) ModifyFile(server pfs.API_ModifyFileServer) (retErr error) {
 	request, err := server.Recv()
 	func() { a.Log(request, nil, nil, 0) }()
@@ -339,8 +418,16 @@ func (a *apiServer) ModifyFile(server pfs.API_ModifyFileServer) (retErr error) {
 		if err != nil {
 			return 0, err
 		}
+		modifyFile := a.driver.modifyFile
+		if activeTxn, err := client.GetTransaction(server.Context()); err != nil {
+			return 0, err
+		} else if activeTxn != nil {
+			modifyFile = func(ctx context.Context, commit *pfs.Commit, cb func(*fileset.UnorderedWriter) error) error {
+				return a.modifyFileInTransaction(ctx, activeTxn, commit, cb)
+			}
+		}
 		var bytesRead int64
-		if err := a.driver.modifyFile(server.Context(), request.Commit, func(uw *fileset.UnorderedWriter) error {
+		if err := modifyFile(server.Context(), request.Commit, func(uw *fileset.UnorderedWriter) error {
 			var err error
 			bytesRead, err = a.modifyFile(server.Context(), uw, server, request)
 			return err
@@ -351,6 +438,36 @@ func (a *apiServer) ModifyFile(server pfs.API_ModifyFileServer) (retErr error) {
 	})
 }
 
+// modifyFileInTransaction writes the modifications to a new fileset, which is
+// added to the commit when the active transaction is finished. The fileset is
+// kept until the transaction expires, since the client may be gone by then.
+func (a *apiServer) modifyFileInTransaction(ctx context.Context, activeTxn *transaction.Transaction, commit *pfs.Commit, cb func(*fileset.UnorderedWriter) error) error {
+	info, err := a.env.GetPachClient(ctx).InspectTransaction(activeTxn)
+	if err != nil {
+		return err
+	}
+	if info.Expires == nil {
+		return errors.Errorf("transaction %s has no expiry, so files can't be modified in it; start a new transaction", activeTxn.ID)
+	}
+	expires, err := types.TimestampFromProto(info.Expires)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	id, err := a.driver.createFileset(ctx, cb)
+	if err != nil {
+		return err
+	}
+	if err := a.driver.keepFilesetUntil(ctx, *id, expires); err != nil {
+		return err
+	}
+	return a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+		return txn.AddFileset(&pfs.AddFilesetRequest{
+			Commit:    commit,
+			FilesetId: id.HexString(),
+		})
+	})
+}
+
 type modifyFileSource interface {
 	Recv() (*pfs.ModifyFileRequest, error)
 }
@@ -538,7 +655,7 @@ func (a *apiServer) GetFile(request *pfs.GetFileRequest, server pfs.API_GetFileS
 	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
 	return metrics.ReportRequestWithThroughput(func() (int64, error) {
 		ctx := server.Context()
-		src, err := a.driver.getFile(ctx, request.File)
+		src, err := a.driver.getFile(ctx, request.File, request.After)
 		if err != nil {
 			return 0, err
 		}
@@ -627,6 +744,20 @@ func (a *apiServer) InspectFile(ctx context.Context, request *pfs.InspectFileReq
 	return a.driver.inspectFile(ctx, request.File)
 }
 
+// InspectFileLineage implements the protobuf pfs.InspectFileLineage RPC
+func (a *apiServer) InspectFileLineage(ctx context.Context, request *pfs.InspectFileLineageRequest) (response *pfs.FileLineage, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.inspectFileLineage(ctx, request.File)
+}
+
+// ListFileHistory implements the protobuf pfs.ListFileHistory RPC
+func (a *apiServer) ListFileHistory(ctx context.Context, request *pfs.ListFileHistoryRequest) (response *pfs.ListFileHistoryResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.listFileHistory(ctx, request.File, request.Recursive, request.Limit, request.PageToken)
+}
+
 // ListFile implements the protobuf pfs.ListFile RPC
 func (a *apiServer) ListFile(request *pfs.ListFileRequest, server pfs.API_ListFileServer) (retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
@@ -634,10 +765,21 @@ func (a *apiServer) ListFile(request *pfs.ListFileRequest, server pfs.API_ListFi
 	defer func(start time.Time) {
 		a.Log(request, fmt.Sprintf("response stream with %d objects", sent), retErr, time.Since(start))
 	}(time.Now())
-	return a.driver.listFile(server.Context(), request.File, request.Full, func(fi *pfs.FileInfo) error {
+	after, err := pagination.Decode(pagination.Files, request.PageToken)
+	if err != nil {
+		return err
+	}
+	pager, err := pagination.NewPager(pagination.Files, request.PageSize)
+	if err != nil {
+		return err
+	}
+	return pager.Finish(server, a.driver.listFile(server.Context(), request.File, request.Full, after, func(fi *pfs.FileInfo) error {
+		if err := pager.Add(fi.File.Path); err != nil {
+			return err
+		}
 		sent++
 		return server.Send(fi)
-	})
+	}))
 }
 
 // WalkFile implements the protobuf pfs.WalkFile RPC
@@ -660,10 +802,21 @@ func (a *apiServer) GlobFile(request *pfs.GlobFileRequest, respServer pfs.API_Gl
 	defer func(start time.Time) {
 		a.Log(request, fmt.Sprintf("response stream with %d objects", sent), retErr, time.Since(start))
 	}(time.Now())
-	return a.driver.globFile(respServer.Context(), request.Commit, request.Pattern, func(fi *pfs.FileInfo) error {
+	after, err := pagination.Decode(pagination.Files, request.PageToken)
+	if err != nil {
+		return err
+	}
+	pager, err := pagination.NewPager(pagination.Files, request.PageSize)
+	if err != nil {
+		return err
+	}
+	return pager.Finish(respServer, a.driver.globFile(respServer.Context(), request.Commit, request.Pattern, after, func(fi *pfs.FileInfo) error {
+		if err := pager.Add(fi.File.Path); err != nil {
+			return err
+		}
 		sent++
 		return respServer.Send(fi)
-	})
+	}))
 }
 
 // DiffFile implements the protobuf pfs.DiffFile RPC
@@ -711,6 +864,13 @@ func (a *apiServer) Fsck(request *pfs.FsckRequest, fsckServer pfs.API_FsckServer
 	return nil
 }
 
+// InspectStorage implements the pfs.InspectStorage RPC
+func (a *apiServer) InspectStorage(ctx context.Context, request *pfs.InspectStorageRequest) (response *pfs.StorageInfo, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.inspectStorage(ctx)
+}
+
 // CreateFileset implements the pfs.CreateFileset RPC
 func (a *apiServer) CreateFileset(server pfs.API_CreateFilesetServer) error {
 	fsID, err := a.driver.createFileset(server.Context(), func(uw *fileset.UnorderedWriter) error {
@@ -736,8 +896,8 @@ func (a *apiServer) GetFileset(ctx context.Context, req *pfs.GetFilesetRequest)
 }
 
 func (a *apiServer) AddFileset(ctx context.Context, req *pfs.AddFilesetRequest) (*types.Empty, error) {
-	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		return a.AddFilesetInTransaction(txnCtx, req)
+	if err := a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+		return txn.AddFileset(req)
 	}); err != nil {
 		return nil, err
 	}
@@ -801,3 +961,127 @@ func (a *apiServer) runLoadTest(pachClient *client.APIClient, branch *pfs.Branch
 	}
 	return pfsload.Commits(pachClient, branch.Repo.Name, branch.Name, spec, seed)
 }
+
+// CreateRemote implements the protobuf pfs.CreateRemote RPC
+func (a *apiServer) CreateRemote(ctx context.Context, request *pfs.CreateRemoteRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.driver.createRemote(txnCtx, request.Remote, request.Update)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// ListRemote implements the protobuf pfs.ListRemote RPC
+func (a *apiServer) ListRemote(ctx context.Context, request *pfs.ListRemoteRequest) (response *pfs.ListRemoteResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.listRemote(ctx)
+}
+
+// DeleteRemote implements the protobuf pfs.DeleteRemote RPC
+func (a *apiServer) DeleteRemote(ctx context.Context, request *pfs.DeleteRemoteRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.driver.deleteRemote(txnCtx, request.Name)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// PushBranch implements the protobuf pfs.PushBranch RPC
+func (a *apiServer) PushBranch(ctx context.Context, request *pfs.PushBranchRequest) (response *pfs.TransferStats, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.pushBranch(ctx, request)
+}
+
+// PullBranch implements the protobuf pfs.PullBranch RPC
+func (a *apiServer) PullBranch(ctx context.Context, request *pfs.PullBranchRequest) (response *pfs.TransferStats, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.pullBranch(ctx, request)
+}
+
+// MissingChunks implements the protobuf pfs.MissingChunks RPC
+func (a *apiServer) MissingChunks(ctx context.Context, request *pfs.MissingChunksRequest) (response *pfs.MissingChunksResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) {
+		a.Log(fmt.Sprintf("request with %d chunks", len(request.ChunkIds)), response, retErr, time.Since(start))
+	}(time.Now())
+	var ids []chunk.ID
+	for _, id := range request.ChunkIds {
+		ids = append(ids, id)
+	}
+	missing, err := a.driver.missingChunks(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	response = &pfs.MissingChunksResponse{}
+	for _, id := range missing {
+		response.ChunkIds = append(response.ChunkIds, id)
+	}
+	return response, nil
+}
+
+// RehydrateCommit implements the protobuf pfs.RehydrateCommit RPC
+func (a *apiServer) RehydrateCommit(ctx context.Context, request *pfs.RehydrateCommitRequest) (response *pfs.RehydrateCommitResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	n, err := a.driver.rehydrateCommit(ctx, request.Commit)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.RehydrateCommitResponse{ChunksMoved: n}, nil
+}
+
+// VerifyMirror implements the protobuf pfs.VerifyMirror RPC
+func (a *apiServer) VerifyMirror(request *pfs.VerifyMirrorRequest, server pfs.API_VerifyMirrorServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	sent := 0
+	defer func(start time.Time) {
+		a.Log(request, fmt.Sprintf("stream containing %d messages", sent), retErr, time.Since(start))
+	}(time.Now())
+	return a.driver.verifyMirror(server.Context(), request.Repair, func(diff *pfs.MirrorDifference) error {
+		sent++
+		return server.Send(diff)
+	})
+}
+
+// ExportCommit implements the protobuf pfs.ExportCommit RPC
+func (a *apiServer) ExportCommit(ctx context.Context, request *pfs.ExportCommitRequest) (response *pfs.ExportCommitResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+	return a.driver.exportCommit(ctx, request.Commit)
+}
+
+// GetChunk implements the protobuf pfs.GetChunk RPC
+func (a *apiServer) GetChunk(request *pfs.GetChunkRequest, server pfs.API_GetChunkServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+	data, err := a.driver.getChunk(server.Context(), request.ChunkId)
+	if err != nil {
+		return err
+	}
+	return grpcutil.WriteToStreamingBytesServer(bytes.NewReader(data), server)
+}
+
+// ReceiveCommit implements the protobuf pfs.ReceiveCommit RPC
+func (a *apiServer) ReceiveCommit(server pfs.API_ReceiveCommitServer) (retErr error) {
+	request, err := server.Recv()
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+	if err != nil {
+		return err
+	}
+	if err := a.driver.receiveCommit(server.Context(), request.Branch, func(store func(*pfs.ChunkInfo, []byte) error) (*pfs.ExportCommitResponse, error) {
+		return readReceivedChunks(server, store)
+	}); err != nil {
+		return err
+	}
+	return server.SendAndClose(&types.Empty{})
+}
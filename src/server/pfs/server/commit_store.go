@@ -24,6 +24,8 @@ type commitStore interface {
 	AddFilesetTx(tx *sqlx.Tx, commit *pfs.Commit, filesetID fileset.ID) error
 	// SetTotalFileset sets the total fileset for the commit, overwriting whatever is there.
 	SetTotalFileset(ctx context.Context, commit *pfs.Commit, id fileset.ID) error
+	// SetTotalFilesetTx is identical to SetTotalFileset except it runs in the provided transaction.
+	SetTotalFilesetTx(tx *sqlx.Tx, commit *pfs.Commit, id fileset.ID) error
 	// GetTotalFileset returns the total fileset for a commit.
 	GetTotalFileset(ctx context.Context, commit *pfs.Commit) (*fileset.ID, error)
 	// GetDiffFileset returns the diff fileset for a commit
@@ -117,13 +119,17 @@ func (cs *postgresCommitStore) GetDiffFileset(ctx context.Context, commit *pfs.C
 
 func (cs *postgresCommitStore) SetTotalFileset(ctx context.Context, commit *pfs.Commit, id fileset.ID) error {
 	return dbutil.WithTx(ctx, cs.db, func(tx *sqlx.Tx) error {
-		if err := dropTotal(tx, cs.tr, commit); err != nil {
-			return err
-		}
-		return setTotal(tx, cs.tr, commit, id)
+		return cs.SetTotalFilesetTx(tx, commit, id)
 	})
 }
 
+func (cs *postgresCommitStore) SetTotalFilesetTx(tx *sqlx.Tx, commit *pfs.Commit, id fileset.ID) error {
+	if err := dropTotal(tx, cs.tr, commit); err != nil {
+		return err
+	}
+	return setTotal(tx, cs.tr, commit, id)
+}
+
 func (cs *postgresCommitStore) DropFilesets(ctx context.Context, commit *pfs.Commit) error {
 	return dbutil.WithTx(ctx, cs.db, func(tx *sqlx.Tx) error {
 		return cs.DropFilesetsTx(tx, commit)
@@ -3,11 +3,11 @@ package server
 import (
 	"time"
 
-	etcd "github.com/coreos/etcd/clientv3"
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
 	"github.com/pachyderm/pachyderm/v2/src/internal/work"
@@ -25,15 +25,15 @@ type compactor struct {
 	worker          *work.Worker
 }
 
-func newCompactor(ctx context.Context, storage *fileset.Storage, etcdClient *etcd.Client, etcdPrefix string, maxFanIn int) (*compactor, error) {
+func newCompactor(ctx context.Context, storage *fileset.Storage, env serviceenv.ServiceEnv, etcdPrefix string, maxFanIn int) (*compactor, error) {
 	if maxFanIn < 2 {
 		panic(maxFanIn)
 	}
-	compactionQueue, err := work.NewTaskQueue(ctx, etcdClient, etcdPrefix, storageTaskNamespace)
+	compactionQueue, err := serviceenv.NewTaskQueue(ctx, env, etcdPrefix, storageTaskNamespace)
 	if err != nil {
 		return nil, err
 	}
-	worker := work.NewWorker(etcdClient, etcdPrefix, storageTaskNamespace)
+	worker := serviceenv.NewTaskWorker(env, etcdPrefix, storageTaskNamespace)
 	c := &compactor{
 		storage:         storage,
 		maxFanIn:        maxFanIn,
@@ -75,15 +75,22 @@ type driver struct {
 	prefix     string
 
 	// collections
+	projects    col.PostgresCollection
 	repos       col.PostgresCollection
 	commits     col.PostgresCollection
 	branches    col.PostgresCollection
 	openCommits col.PostgresCollection
 	jobs        col.PostgresCollection
+	remotes     col.PostgresCollection
 
 	storage     *fileset.Storage
 	commitStore commitStore
 	compactor   *compactor
+	// coldTierAge is how long after it's finished a commit becomes cold, in
+	// repos that don't set cold_after.
+	coldTierAge time.Duration
+	// mirror is the object store that chunks are mirrored to, or nil.
+	mirror *mirror
 }
 
 // TODO: use pfsdb.CommitKey instead once branches are in the primary key (part of global IDs)
@@ -98,6 +105,13 @@ func newDriver(env serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv, etcdPre
 	if err != nil {
 		return nil, err
 	}
+	mirror, err := newMirror(env, objClient)
+	if err != nil {
+		return nil, err
+	}
+	if mirror != nil {
+		objClient = obj.NewMirrorClient(mirror.primary, mirror.secondary, mirror.mode, mirror.queue)
+	}
 	repos := pfsdb.Repos(env.GetDBClient(), env.GetPostgresListener())
 	commits := pfsdb.Commits(env.GetDBClient(), env.GetPostgresListener())
 	branches := pfsdb.Branches(env.GetDBClient(), env.GetPostgresListener())
@@ -110,11 +124,14 @@ func newDriver(env serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv, etcdPre
 		txnEnv:      txnEnv,
 		etcdClient:  etcdClient,
 		prefix:      etcdPrefix,
+		projects:    pfsdb.Projects(env.GetDBClient(), env.GetPostgresListener()),
 		repos:       repos,
 		commits:     commits,
 		branches:    branches,
 		openCommits: openCommits,
 		jobs:        jobs,
+		remotes:     pfsdb.Remotes(env.GetDBClient(), env.GetPostgresListener()),
+		mirror:      mirror,
 		// TODO: set maxFanIn based on downward API.
 	}
 	// Setup tracker and chunk / fileset storage.
@@ -131,13 +148,22 @@ func newDriver(env serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv, etcdPre
 	}
 	chunkStorageOpts = append(chunkStorageOpts, chunk.WithSecret(secret))
 	chunkStorage := chunk.NewStorage(objClient, memCache, env.GetDBClient(), tracker, chunkStorageOpts...)
+	if age := env.Config().StorageColdTierAge; age != "" {
+		d.coldTierAge, err = time.ParseDuration(age)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid cold tier age")
+		}
+	}
 	d.storage = fileset.NewStorage(fileset.NewPostgresStore(env.GetDBClient()), tracker, chunkStorage, fileset.StorageOptions(env.Config())...)
 	// Setup compaction queue and worker.
-	d.compactor, err = newCompactor(env.Context(), d.storage, etcdClient, etcdPrefix, env.Config().StorageCompactionMaxFanIn)
+	d.compactor, err = newCompactor(env.Context(), d.storage, env, etcdPrefix, env.Config().StorageCompactionMaxFanIn)
 	if err != nil {
 		return nil, err
 	}
 	d.commitStore = newPostgresCommitStore(env.GetDBClient(), tracker, d.storage)
+	if err := d.createDefaultProject(env.Context()); err != nil {
+		return nil, err
+	}
 	// Create spec repo (default repo)
 	repo := client.NewRepo(ppsconsts.SpecRepo)
 	repoInfo := &pfs.RepoInfo{
@@ -159,7 +185,7 @@ func (d *driver) activateAuth(txnCtx *txncontext.TransactionContext) error {
 	return d.repos.ReadOnly(txnCtx.ClientContext).List(repoInfo, col.DefaultOptions(), func(string) error {
 		err := d.env.AuthServer().CreateRoleBindingInTransaction(txnCtx, "", nil, &auth.Resource{
 			Type: auth.ResourceType_REPO,
-			Name: repoInfo.Repo.Name,
+			Name: repoInfo.Repo.QualifiedName(),
 		})
 		if err != nil && !col.IsErrExists(err) {
 			return err
@@ -168,11 +194,20 @@ func (d *driver) activateAuth(txnCtx *txncontext.TransactionContext) error {
 	})
 }
 
-func (d *driver) createRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Repo, description string, update bool) error {
+func (d *driver) createRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Repo, description string, update bool, coldAfter *types.Duration) error {
 	// Validate arguments
 	if repo == nil {
 		return errors.New("repo cannot be nil")
 	}
+	if coldAfter != nil {
+		age, err := types.DurationFromProto(coldAfter)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		if age < 0 {
+			return errors.Errorf("cold_after must not be negative, got %v", age)
+		}
+	}
 
 	// Check that the user is logged in (user doesn't need any access level to
 	// create a repo, but they must be authenticated if auth is active)
@@ -184,6 +219,11 @@ func (d *driver) createRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 	if err := ancestry.ValidateName(repo.Name); err != nil {
 		return err
 	}
+	if pfs.IsDefaultProject(repo.Project.GetName()) {
+		// Repos in the default project are stored without it, as they were
+		// before projects existed
+		repo.Project = nil
+	}
 
 	if repo.Type == "" {
 		// default to user type
@@ -205,7 +245,7 @@ func (d *driver) createRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 			return pfsserver.ErrRepoExists{repo}
 		}
 
-		if existingRepoInfo.Description == description {
+		if existingRepoInfo.Description == description && (coldAfter == nil || coldAfter.Equal(existingRepoInfo.ColdAfter)) {
 			// Don't overwrite the stored proto with an identical value. This
 			// optimization is impactful because pps will frequently update the __spec__
 			// repo to make sure it exists.
@@ -218,15 +258,18 @@ func (d *driver) createRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 		// idempotent way to ensure that R exists. By permitting these calls when
 		// they don't actually change anything, even if the caller doesn't have
 		// WRITER access, we make the pattern more generally useful.
-		if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, repo.Name, auth.Permission_REPO_WRITE); err != nil {
+		if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, repo.QualifiedName(), auth.Permission_REPO_WRITE); err != nil {
 			return errors.Wrapf(err, "could not update description of %q", repo)
 		}
 		existingRepoInfo.Description = description
+		if coldAfter != nil {
+			existingRepoInfo.ColdAfter = coldAfter
+		}
 		return repos.Put(pfsdb.RepoKey(repo), &existingRepoInfo)
 	} else {
 		// if this is a system repo, make sure the corresponding user repo already exists
 		if repo.Type != pfs.UserRepoType {
-			baseRepo := client.NewRepo(repo.Name)
+			baseRepo := client.NewRepo(repo.QualifiedName())
 			err = repos.Get(pfsdb.RepoKey(baseRepo), &existingRepoInfo)
 			if err != nil && col.IsErrNotFound(err) {
 				return errors.Errorf("cannot create a system repo without a corresponding 'user' repo")
@@ -236,10 +279,15 @@ func (d *driver) createRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 		}
 
 		// New repo case
+		if repo.Type == pfs.UserRepoType {
+			if err := d.checkProjectAllowsRepo(txnCtx, repo); err != nil {
+				return err
+			}
+		}
 		if authIsActivated {
 			// Create ACL for new repo. Make caller the sole owner. If the ACL already
 			// exists with a different owner, this will fail.
-			if err := d.env.AuthServer().CreateRoleBindingInTransaction(txnCtx, whoAmI.Username, []string{auth.RepoOwnerRole}, &auth.Resource{Type: auth.ResourceType_REPO, Name: repo.Name}); err != nil {
+			if err := d.env.AuthServer().CreateRoleBindingInTransaction(txnCtx, whoAmI.Username, []string{auth.RepoOwnerRole}, &auth.Resource{Type: auth.ResourceType_REPO, Name: repo.QualifiedName()}); err != nil {
 				return errors.Wrapf(grpcutil.ScrubGRPC(err), "could not create role binding for new repo \"%s\"", repo.Name)
 			}
 		}
@@ -247,6 +295,7 @@ func (d *driver) createRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 			Repo:        repo,
 			Created:     types.TimestampNow(),
 			Description: description,
+			ColdAfter:   coldAfter,
 		})
 	}
 }
@@ -275,7 +324,7 @@ func (d *driver) inspectRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Re
 
 func (d *driver) getPermissions(ctx context.Context, repo *pfs.Repo) ([]auth.Permission, []string, error) {
 	resp, err := d.env.AuthServer().GetPermissions(ctx, &auth.GetPermissionsRequest{
-		Resource: &auth.Resource{Type: auth.ResourceType_REPO, Name: repo.Name},
+		Resource: &auth.Resource{Type: auth.ResourceType_REPO, Name: repo.QualifiedName()},
 	})
 	if err != nil {
 		return nil, nil, err
@@ -284,7 +333,7 @@ func (d *driver) getPermissions(ctx context.Context, repo *pfs.Repo) ([]auth.Per
 	return resp.Permissions, resp.Roles, nil
 }
 
-func (d *driver) listRepo(ctx context.Context, includeAuth bool, repoType string) (*pfs.ListRepoResponse, error) {
+func (d *driver) listRepo(ctx context.Context, includeAuth bool, repoType string, project *pfs.Project) (*pfs.ListRepoResponse, error) {
 	result := &pfs.ListRepoResponse{}
 	authSeemsActive := true
 	repoInfo := &pfs.RepoInfo{}
@@ -293,6 +342,9 @@ func (d *driver) listRepo(ctx context.Context, includeAuth bool, repoType string
 		if repoInfo.Repo.Name == ppsconsts.SpecRepo {
 			return nil
 		}
+		if project != nil && repoInfo.Repo.ProjectName() != pfsdb.ProjectKey(project) {
+			return nil
+		}
 		size, err := d.getRepoSize(ctx, repoInfo.Repo)
 		if err != nil {
 			return err
@@ -305,7 +357,7 @@ func (d *driver) listRepo(ctx context.Context, includeAuth bool, repoType string
 			} else if auth.IsErrNotActivated(err) {
 				authSeemsActive = false
 			} else {
-				return errors.Wrapf(grpcutil.ScrubGRPC(err), "error getting access level for \"%s\"", repoInfo.Repo.Name)
+				return errors.Wrapf(grpcutil.ScrubGRPC(err), "error getting access level for \"%s\"", repoInfo.Repo.QualifiedName())
 			}
 		}
 		result.RepoInfo = append(result.RepoInfo, proto.Clone(repoInfo).(*pfs.RepoInfo))
@@ -347,7 +399,7 @@ func (d *driver) deleteRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 	}
 
 	// Check if the caller is authorized to delete this repo
-	if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, repo.Name, auth.Permission_REPO_DELETE); err != nil {
+	if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, repo.QualifiedName(), auth.Permission_REPO_DELETE); err != nil {
 		return err
 	}
 
@@ -355,7 +407,7 @@ func (d *driver) deleteRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 	if repo.Type == pfs.UserRepoType {
 		var dependentRepos []pfs.Repo
 		var otherRepo pfs.RepoInfo
-		if err := repos.GetByIndex(pfsdb.ReposNameIndex, repo.Name, &otherRepo, col.DefaultOptions(), func(key string) error {
+		if err := repos.GetByIndex(pfsdb.ReposNameIndex, repo.QualifiedName(), &otherRepo, col.DefaultOptions(), func(key string) error {
 			if otherRepo.Repo.Type != repo.Type {
 				dependentRepos = append(dependentRepos, *otherRepo.Repo)
 			}
@@ -389,7 +441,7 @@ func (d *driver) deleteRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 		for _, prov := range ci.Provenance {
 			// Check if we've fixed prov already (or if it's in this repo and
 			// doesn't need to be fixed
-			if visited[prov.Commit.ID] || prov.Commit.Branch.Repo.Name == repo.Name {
+			if visited[prov.Commit.ID] || prov.Commit.Branch.Repo.QualifiedName() == repo.QualifiedName() {
 				continue
 			}
 			// or if the repo has already been deleted
@@ -407,7 +459,7 @@ func (d *driver) deleteRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 			if err := d.commits.ReadWrite(txnCtx.SqlTx).Update(pfsdb.CommitKey(prov.Commit), provCI, func() error {
 				subvTo := 0 // copy subvFrom to subvTo, excepting subv ranges to delete (so that they're overwritten)
 				for subvFrom, subv := range provCI.Subvenance {
-					if subv.Upper.Branch.Repo.Name == repo.Name {
+					if subv.Upper.Branch.Repo.QualifiedName() == repo.QualifiedName() {
 						continue
 					}
 					provCI.Subvenance[subvTo] = provCI.Subvenance[subvFrom]
@@ -458,7 +510,7 @@ func (d *driver) deleteRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 		return errors.Wrapf(err, "repos.Delete")
 	}
 
-	if err := d.env.AuthServer().DeleteRoleBindingInTransaction(txnCtx, &auth.Resource{Type: auth.ResourceType_REPO, Name: repo.Name}); err != nil && !auth.IsErrNotActivated(err) {
+	if err := d.env.AuthServer().DeleteRoleBindingInTransaction(txnCtx, &auth.Resource{Type: auth.ResourceType_REPO, Name: repo.QualifiedName()}); err != nil && !auth.IsErrNotActivated(err) {
 		return grpcutil.ScrubGRPC(err)
 	}
 	return nil
@@ -493,7 +545,7 @@ func (d *driver) makeCommit(
 		return nil, errors.Errorf("branch must be specified")
 	}
 	// Check that caller is authorized
-	if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, branch.Repo.Name, auth.Permission_REPO_WRITE); err != nil {
+	if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, branch.Repo.QualifiedName(), auth.Permission_REPO_WRITE); err != nil {
 		return nil, err
 	}
 
@@ -522,7 +574,10 @@ func (d *driver) makeCommit(
 	spoutCommit, ok2 := os.LookupEnv("PPS_SPEC_COMMIT")
 	if ok1 && ok2 {
 		log.Infof("Appending provenance for spout: %v %v", spoutName, spoutCommit)
-		provenance = append(provenance, client.NewCommitProvenance(ppsconsts.SpecRepo, spoutName, spoutCommit))
+		// spoutName is qualified with the pipeline's project, whose spec repo
+		// holds the spout's spec commit
+		project, name := pfs.SplitProject(spoutName)
+		provenance = append(provenance, client.NewCommitProvenance(pfs.QualifyName(project, ppsconsts.SpecRepo), name, spoutCommit))
 	}
 
 	// Set newCommitInfo.Started and possibly newCommitInfo.Finished. Enforce:
@@ -754,7 +809,7 @@ func (d *driver) makeCommit(
 			return nil, err
 		}
 	}
-	for _, b := range append(triggeredBranches, client.NewBranch(branch.Repo.Name, branch.Name)) {
+	for _, b := range append(triggeredBranches, client.NewBranch(branch.Repo.QualifiedName(), branch.Name)) {
 		if err := txnCtx.PropagateCommit(b, true); err != nil {
 			return nil, err
 		}
@@ -965,7 +1020,7 @@ nextSubvBI:
 			//     its head commit has commit provenance.
 			// - We need to key on both the commit id and the branch name, so that
 			//   branches with a shared commit are both represented in the provenance
-			provCommit := client.NewCommit(provOfSubvB.Repo.Name, provOfSubvB.Name, provOfSubvBI.Head.ID)
+			provCommit := client.NewCommit(provOfSubvB.Repo.QualifiedName(), provOfSubvB.Name, provOfSubvBI.Head.ID)
 			newCommitProvMap[commitKey(provCommit)] = &pfs.CommitProvenance{Commit: provCommit}
 			jobProvMap[pfsdb.CommitKey(provCommit)] = provCommit
 			provOfSubvBHeadInfo := &pfs.CommitInfo{}
@@ -1142,7 +1197,7 @@ func (d *driver) inspectCommit(ctx context.Context, commit *pfs.Commit, blockSta
 	if commit == nil {
 		return nil, errors.Errorf("cannot inspect nil commit")
 	}
-	if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, commit.Branch.Repo.Name, auth.Permission_REPO_INSPECT_COMMIT); err != nil {
+	if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, commit.Branch.Repo.QualifiedName(), auth.Permission_REPO_INSPECT_COMMIT); err != nil {
 		return nil, err
 	}
 
@@ -1299,7 +1354,7 @@ func (d *driver) getCommit(ctx context.Context, commit *pfs.Commit) (*pfs.Commit
 		return nil, errors.Errorf("cannot inspect nil commit")
 	}
 
-	if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, commit.Branch.Repo.Name, auth.Permission_REPO_INSPECT_COMMIT); err != nil {
+	if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, commit.Branch.Repo.QualifiedName(), auth.Permission_REPO_INSPECT_COMMIT); err != nil {
 		return nil, err
 	}
 
@@ -1315,13 +1370,16 @@ func (d *driver) getCommit(ctx context.Context, commit *pfs.Commit) (*pfs.Commit
 	return commitInfo, nil
 }
 
-func (d *driver) listCommit(ctx context.Context, repo *pfs.Repo, to *pfs.Commit, from *pfs.Commit, number uint64, reverse bool, cb func(*pfs.CommitInfo) error) error {
+// listCommit lists the commits in repo. If paginate is set, the commits are
+// listed in key order rather than by creation time, unless to is set, and the
+// listing continues after the commit with the key after.
+func (d *driver) listCommit(ctx context.Context, repo *pfs.Repo, to *pfs.Commit, from *pfs.Commit, number uint64, reverse bool, paginate bool, after string, cb func(*pfs.CommitInfo) error) error {
 	// Validate arguments
 	if repo == nil {
 		return errors.New("repo cannot be nil")
 	}
 
-	if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, repo.Name, auth.Permission_REPO_LIST_COMMIT); err != nil {
+	if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, repo.QualifiedName(), auth.Permission_REPO_LIST_COMMIT); err != nil {
 		return err
 	}
 	if from != nil && from.Branch.Repo.Name != repo.Name || to != nil && to.Branch.Repo.Name != repo.Name {
@@ -1363,6 +1421,25 @@ func (d *driver) listCommit(ctx context.Context, repo *pfs.Repo, to *pfs.Commit,
 
 	if from != nil && to == nil {
 		return errors.Errorf("cannot use `from` commit without `to` commit")
+	} else if from == nil && to == nil && paginate {
+		// Paginated listings are ordered by key, so that they can be continued
+		// after the last key of a page.
+		opts := &col.Options{Target: col.SortByKey, Order: col.SortAscend, After: after}
+		if reverse {
+			opts.Order = col.SortDescend
+		}
+		ci := &pfs.CommitInfo{}
+		listCallback := func(string) error {
+			if number == 0 {
+				return errutil.ErrBreak
+			}
+			number--
+			return cb(proto.Clone(ci).(*pfs.CommitInfo))
+		}
+		if repo.Name == "" {
+			return d.commits.ReadOnly(ctx).List(ci, opts, listCallback)
+		}
+		return d.commits.ReadOnly(ctx).GetByIndex(pfsdb.CommitsRepoIndex, pfsdb.RepoKey(repo), ci, opts, listCallback)
 	} else if from == nil && to == nil {
 		// we hold onto a revisions worth of cis so that we can sort them by provenance
 		var cis []*pfs.CommitInfo
@@ -1428,6 +1505,17 @@ func (d *driver) listCommit(ctx context.Context, repo *pfs.Repo, to *pfs.Commit,
 			return errors.Errorf("cannot use 'Reverse' while also using 'From' or 'To'")
 		}
 		cursor := to
+		if after != "" {
+			// Continue the walk with the parent of the last commit listed.
+			var commitInfo pfs.CommitInfo
+			if err := d.commits.ReadOnly(ctx).Get(after, &commitInfo); err != nil {
+				return err
+			}
+			if pfsdb.RepoKey(commitInfo.Commit.Branch.Repo) != pfsdb.RepoKey(repo) {
+				return errors.Errorf("page token is for a listing of repo %s, not %s", commitInfo.Commit.Branch.Repo.QualifiedName(), repo.QualifiedName())
+			}
+			cursor = commitInfo.ParentCommit
+		}
 		for number != 0 && cursor != nil && (from == nil || cursor.ID != from.ID) {
 			var commitInfo pfs.CommitInfo
 			if err := d.commits.ReadOnly(ctx).Get(pfsdb.CommitKey(cursor), &commitInfo); err != nil {
@@ -1630,7 +1718,7 @@ func (d *driver) squashCommit(txnCtx *txncontext.TransactionContext, userCommit
 		// and point first non-deleted parent at all non-deleted children
 		parent := lowestCommitInfo.ParentCommit
 		for child, branch := range liveChildren {
-			commit := client.NewCommit(deletedInfo.Commit.Branch.Repo.Name, branch, child)
+			commit := client.NewCommit(deletedInfo.Commit.Branch.Repo.QualifiedName(), branch, child)
 			commitInfo := &pfs.CommitInfo{}
 			if err := d.commits.ReadWrite(txnCtx.SqlTx).Update(pfsdb.CommitKey(commit), commitInfo, func() error {
 				commitInfo.ParentCommit = parent
@@ -1653,7 +1741,7 @@ func (d *driver) squashCommit(txnCtx *txncontext.TransactionContext, userCommit
 				}
 				commitInfo.ChildCommits = make([]*pfs.Commit, 0, len(liveChildren))
 				for child, branchName := range liveChildren {
-					commitInfo.ChildCommits = append(commitInfo.ChildCommits, client.NewCommit(parent.Branch.Repo.Name, branchName, child))
+					commitInfo.ChildCommits = append(commitInfo.ChildCommits, client.NewCommit(parent.Branch.Repo.QualifiedName(), branchName, child))
 				}
 				return nil
 			}); err != nil {
@@ -1927,7 +2015,7 @@ func (d *driver) createBranch(txnCtx *txncontext.TransactionContext, branch *pfs
 	}
 
 	var err error
-	if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, branch.Repo.Name, auth.Permission_REPO_CREATE_BRANCH); err != nil {
+	if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, branch.Repo.QualifiedName(), auth.Permission_REPO_CREATE_BRANCH); err != nil {
 		return err
 	}
 	// Validate request
@@ -2113,7 +2201,7 @@ func (d *driver) listBranch(ctx context.Context, repo *pfs.Repo, reverse bool) (
 		return nil, errors.New("repo cannot be nil")
 	}
 
-	if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, repo.Name, auth.Permission_REPO_LIST_BRANCH); err != nil {
+	if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, repo.QualifiedName(), auth.Permission_REPO_LIST_BRANCH); err != nil {
 		return nil, err
 	}
 
@@ -2178,7 +2266,7 @@ func (d *driver) deleteBranch(txnCtx *txncontext.TransactionContext, branch *pfs
 		return errors.New("branch repo cannot be nil")
 	}
 
-	if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, branch.Repo.Name, auth.Permission_REPO_DELETE_BRANCH); err != nil {
+	if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, branch.Repo.QualifiedName(), auth.Permission_REPO_DELETE_BRANCH); err != nil {
 		return err
 	}
 
@@ -2269,7 +2357,7 @@ func (d *driver) appendSubvenance(commitInfo *pfs.CommitInfo, subvCommitInfo *pf
 func (d *driver) deleteAll(txnCtx *txncontext.TransactionContext) error {
 	// Note: d.listRepo() doesn't return the 'spec' repo, so it doesn't get
 	// deleted here. Instead, PPS is responsible for deleting and re-creating it
-	repoInfos, err := d.listRepo(txnCtx.ClientContext, !includeAuth, "")
+	repoInfos, err := d.listRepo(txnCtx.ClientContext, !includeAuth, "", nil)
 	if err != nil {
 		return err
 	}
@@ -21,7 +21,7 @@ import (
 
 func (d *driver) modifyFile(ctx context.Context, commit *pfs.Commit, cb func(*fileset.UnorderedWriter) error) error {
 	// Store the originally-requested parameters because they will be overwritten by inspectCommit
-	repo := commit.Branch.Repo.Name
+	repo := commit.Branch.Repo.QualifiedName()
 	branch := commit.Branch.Name
 	commitID := commit.ID
 	if branch == "" && !uuid.IsUUIDWithoutDashes(commitID) {
@@ -120,7 +120,7 @@ func (d *driver) openCommit(ctx context.Context, commit *pfs.Commit, opts ...ind
 		}
 		return &pfs.CommitInfo{Commit: commit}, fs, nil
 	}
-	if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, commit.Branch.Repo.Name, auth.Permission_REPO_READ); err != nil {
+	if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, commit.Branch.Repo.QualifiedName(), auth.Permission_REPO_READ); err != nil {
 		return nil, nil, err
 	}
 	commitInfo, err := d.inspectCommit(ctx, commit, pfs.CommitState_STARTED)
@@ -168,10 +168,12 @@ func (d *driver) copyFile(ctx context.Context, uw *fileset.UnorderedWriter, dst
 	return uw.Copy(ctx, fs, tag, appendFile)
 }
 
-func (d *driver) getFile(ctx context.Context, file *pfs.File) (Source, error) {
+// getFile returns the files that match the glob in file, after the path after
+// if it's set.
+func (d *driver) getFile(ctx context.Context, file *pfs.File, after string) (Source, error) {
 	commit := file.Commit
 	glob := cleanPath(file.Path)
-	commitInfo, fs, err := d.openCommit(ctx, commit, index.WithPrefix(globLiteralPrefix(glob)), index.WithTag(file.Tag))
+	commitInfo, fs, err := d.openCommit(ctx, commit, index.WithPrefix(globLiteralPrefix(glob)), index.WithTag(file.Tag), index.WithLowerBound(pathsAfter(after)))
 	if err != nil {
 		return nil, err
 	}
@@ -182,7 +184,7 @@ func (d *driver) getFile(ctx context.Context, file *pfs.File) (Source, error) {
 	opts := []SourceOption{
 		WithFilter(func(fs fileset.FileSet) fileset.FileSet {
 			return fileset.NewIndexFilter(fs, func(idx *index.Index) bool {
-				return mf(idx.Path)
+				return idx.Path > after && mf(idx.Path)
 			}, true)
 		}),
 	}
@@ -222,9 +224,11 @@ func (d *driver) inspectFile(ctx context.Context, file *pfs.File) (*pfs.FileInfo
 	return ret, nil
 }
 
-func (d *driver) listFile(ctx context.Context, file *pfs.File, full bool, cb func(*pfs.FileInfo) error) error {
+// listFile lists the files in the directory file, after the path after if
+// it's set.
+func (d *driver) listFile(ctx context.Context, file *pfs.File, full bool, after string, cb func(*pfs.FileInfo) error) error {
 	name := cleanPath(file.Path)
-	commitInfo, fs, err := d.openCommit(ctx, file.Commit, index.WithPrefix(name), index.WithTag(file.Tag))
+	commitInfo, fs, err := d.openCommit(ctx, file.Commit, index.WithPrefix(name), index.WithTag(file.Tag), index.WithLowerBound(pathsAfter(after)))
 	if err != nil {
 		return err
 	}
@@ -247,7 +251,7 @@ func (d *driver) listFile(ctx context.Context, file *pfs.File, full bool, cb fun
 	}
 	s := NewSource(d.storage, commitInfo, fs, opts...)
 	return s.Iterate(ctx, func(fi *pfs.FileInfo, _ fileset.File) error {
-		if pathIsChild(name, cleanPath(fi.File.Path)) {
+		if fi.File.Path > after && pathIsChild(name, cleanPath(fi.File.Path)) {
 			return cb(fi)
 		}
 		return nil
@@ -277,9 +281,11 @@ func (d *driver) walkFile(ctx context.Context, file *pfs.File, cb func(*pfs.File
 	})
 }
 
-func (d *driver) globFile(ctx context.Context, commit *pfs.Commit, glob string, cb func(*pfs.FileInfo) error) error {
+// globFile lists the files that match glob, after the path after if it's
+// set.
+func (d *driver) globFile(ctx context.Context, commit *pfs.Commit, glob string, after string, cb func(*pfs.FileInfo) error) error {
 	glob = cleanPath(glob)
-	commitInfo, fs, err := d.openCommit(ctx, commit, index.WithPrefix(globLiteralPrefix(glob)))
+	commitInfo, fs, err := d.openCommit(ctx, commit, index.WithPrefix(globLiteralPrefix(glob)), index.WithLowerBound(pathsAfter(after)))
 	if err != nil {
 		return err
 	}
@@ -297,13 +303,28 @@ func (d *driver) globFile(ctx context.Context, commit *pfs.Commit, glob string,
 	}
 	s := NewSource(d.storage, commitInfo, fs, opts...)
 	return s.Iterate(ctx, func(fi *pfs.FileInfo, _ fileset.File) error {
-		if mf(fi.File.Path) {
+		if fi.File.Path > after && mf(fi.File.Path) {
 			return cb(fi)
 		}
 		return nil
 	})
 }
 
+// pathsAfter returns the lowest path that sorts after p and, if p is a
+// directory, after all of the paths in it. Listings that continue after p
+// start at that path, and skip any directories before it that they'd
+// otherwise list again.
+func pathsAfter(p string) string {
+	if p == "" {
+		return ""
+	}
+	if strings.HasSuffix(p, "/") {
+		// '0' is the byte after '/'.
+		return p[:len(p)-1] + "0"
+	}
+	return p + "\x00"
+}
+
 func (d *driver) diffFile(ctx context.Context, oldFile, newFile *pfs.File, cb func(oldFi, newFi *pfs.FileInfo) error) error {
 	// TODO: move validation to the Validating API Server
 	// Validation
@@ -321,12 +342,12 @@ func (d *driver) diffFile(ctx context.Context, oldFile, newFile *pfs.File, cb fu
 	}
 	// Do READER authorization check for both newFile and oldFile
 	if oldFile != nil && oldFile.Commit != nil {
-		if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, oldFile.Commit.Branch.Repo.Name, auth.Permission_REPO_READ); err != nil {
+		if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, oldFile.Commit.Branch.Repo.QualifiedName(), auth.Permission_REPO_READ); err != nil {
 			return err
 		}
 	}
 	if newFile != nil && newFile.Commit != nil {
-		if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, newFile.Commit.Branch.Repo.Name, auth.Permission_REPO_READ); err != nil {
+		if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, newFile.Commit.Branch.Repo.QualifiedName(), auth.Permission_REPO_READ); err != nil {
 			return err
 		}
 	}
@@ -407,6 +428,17 @@ func (d *driver) renewFileset(ctx context.Context, id fileset.ID, ttl time.Durat
 	return err
 }
 
+// keepFilesetUntil keeps a fileset until the given time, which may be further
+// out than renewFileset allows.
+func (d *driver) keepFilesetUntil(ctx context.Context, id fileset.ID, until time.Time) error {
+	ttl := time.Until(until)
+	if ttl < time.Second {
+		return errors.Errorf("fileset would expire at %s, which is too soon", until.Format(time.RFC3339))
+	}
+	_, err := d.storage.SetTTL(ctx, id, ttl)
+	return err
+}
+
 func (d *driver) addFileset(txnCtx *txncontext.TransactionContext, commit *pfs.Commit, filesetID fileset.ID) error {
 	commitInfo, err := d.resolveCommit(txnCtx.SqlTx, commit)
 	if err != nil {
@@ -0,0 +1,145 @@
+package server
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pagination"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	"golang.org/x/net/context"
+)
+
+// historyScanLimit bounds the number of commits that one call to
+// listFileHistory walks, so that the history of a file that is rarely
+// modified is returned over several pages instead of in one long call.
+const historyScanLimit = 1000
+
+// listFileHistory walks the commit chain starting at file's commit, from the
+// newest commit to the oldest, and returns the version of file in each commit
+// that modified it. If recursive is set, it returns the version of every file
+// under file's path that each commit modified instead.
+//
+// Whether a commit modified a file is decided from the commit's diff fileset,
+// so only the files that a commit modified are read in full. The key of the
+// page token is the commit that the walk continues at, and the path of the
+// last version returned from that commit, if any.
+func (d *driver) listFileHistory(ctx context.Context, file *pfs.File, recursive bool, limit int64, pageToken string) (*pfs.ListFileHistoryResponse, error) {
+	if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, file.Commit.Branch.Repo.QualifiedName(), auth.Permission_REPO_READ); err != nil {
+		return nil, err
+	}
+	p := cleanPath(file.Path)
+	commit := file.Commit
+	commitID, afterPath, err := pagination.DecodeFileHistory(pageToken)
+	if err != nil {
+		return nil, err
+	}
+	if commitID != "" {
+		commit = file.Commit.Branch.Repo.NewCommit("", commitID)
+	}
+	response := &pfs.ListFileHistoryResponse{}
+	for scanned := 0; commit != nil; scanned++ {
+		if scanned == historyScanLimit {
+			response.NextPageToken = pagination.EncodeFileHistory(commit.ID, "")
+			return response, nil
+		}
+		commitInfo, err := d.inspectCommit(ctx, commit, pfs.CommitState_STARTED)
+		if err != nil {
+			return nil, err
+		}
+		paths, err := d.modifiedPaths(ctx, commitInfo.Commit, p, recursive)
+		if err != nil {
+			return nil, err
+		}
+		for _, mp := range paths {
+			if mp <= afterPath {
+				continue
+			}
+			version, err := d.fileVersion(ctx, commitInfo, mp)
+			if err != nil {
+				return nil, err
+			}
+			response.Versions = append(response.Versions, version)
+			if limit > 0 && int64(len(response.Versions)) >= limit {
+				response.NextPageToken = pagination.EncodeFileHistory(commitInfo.Commit.ID, mp)
+				return response, nil
+			}
+		}
+		afterPath = ""
+		commit = commitInfo.ParentCommit
+	}
+	return response, nil
+}
+
+// modifiedPaths returns the sorted paths that the diff fileset of commit
+// writes or deletes. If recursive is set, those are the paths of the files
+// under p, otherwise it's p itself if it or any file under it was modified.
+func (d *driver) modifiedPaths(ctx context.Context, commit *pfs.Commit, p string, recursive bool) ([]string, error) {
+	id, err := d.commitStore.GetDiffFileset(ctx, commit)
+	if err != nil {
+		return nil, err
+	}
+	prefix := p
+	if prefix == "/" {
+		prefix = ""
+	}
+	fs, err := d.storage.Open(ctx, []fileset.ID{*id}, index.WithPrefix(prefix))
+	if err != nil {
+		return nil, err
+	}
+	dir := strings.TrimSuffix(p, "/") + "/"
+	modified := make(map[string]bool)
+	check := func(f fileset.File) error {
+		idx := f.Index()
+		if idx.Path != p && !strings.HasPrefix(idx.Path, dir) {
+			return nil
+		}
+		if !recursive {
+			modified[p] = true
+			return errutil.ErrBreak
+		}
+		if !fileset.IsDir(idx.Path) {
+			modified[idx.Path] = true
+		}
+		return nil
+	}
+	for _, deletive := range []bool{false, true} {
+		if err := fs.Iterate(ctx, check, deletive); err != nil && !errors.Is(err, errutil.ErrBreak) {
+			return nil, err
+		}
+		if !recursive && len(modified) > 0 {
+			break
+		}
+	}
+	var paths []string
+	for mp := range modified {
+		paths = append(paths, mp)
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// fileVersion returns the version of the file at p in the commit, which
+// modified it.
+func (d *driver) fileVersion(ctx context.Context, commitInfo *pfs.CommitInfo, p string) (*pfs.FileVersion, error) {
+	file := commitInfo.Commit.NewFile(p)
+	fileInfo, err := d.inspectFile(ctx, file)
+	if err != nil {
+		if !pfsserver.IsFileNotFoundErr(err) {
+			return nil, err
+		}
+		return &pfs.FileVersion{
+			FileInfo: &pfs.FileInfo{
+				File:      file,
+				Committed: commitInfo.Finished,
+			},
+			Deleted: true,
+		}, nil
+	}
+	return &pfs.FileVersion{FileInfo: fileInfo}, nil
+}
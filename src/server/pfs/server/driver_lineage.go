@@ -0,0 +1,171 @@
+package server
+
+import (
+	"bytes"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
+	"golang.org/x/net/context"
+)
+
+// inspectFileLineage returns the lineage of a file: the datums that wrote it,
+// and, recursively, the lineage of their input files.
+//
+// Workers tag the files that a datum writes to the output commit and the meta
+// commit with the datum's ID, so the datums that wrote a file are the tags of
+// its index entries, and their inputs are in the datums' meta files.
+func (d *driver) inspectFileLineage(ctx context.Context, file *pfs.File) (*pfs.FileLineage, error) {
+	w := &lineageWalker{
+		d:          d,
+		visited:    make(map[string]*pfs.FileLineage),
+		authorized: make(map[string]bool),
+	}
+	return w.fileLineage(ctx, file)
+}
+
+type lineageWalker struct {
+	d *driver
+	// visited memoizes the lineages of files, keyed by commit and path,
+	// because the inputs of many datums are often the same.
+	visited map[string]*pfs.FileLineage
+	// authorized records the repos that the caller is allowed to read files
+	// from, so that each repo is only checked once.
+	authorized map[string]bool
+}
+
+func (w *lineageWalker) fileLineage(ctx context.Context, file *pfs.File) (*pfs.FileLineage, error) {
+	if file == nil || file.Commit == nil || file.Commit.Branch == nil || file.Commit.Branch.Repo == nil {
+		return nil, errors.New("file must be specified")
+	}
+	// The lineage includes the files, commits and datum meta of every repo
+	// that it visits, so the caller must be able to read all of them.
+	repo := file.Commit.Branch.Repo.QualifiedName()
+	if !w.authorized[repo] {
+		if err := w.d.env.AuthServer().CheckRepoIsAuthorized(ctx, repo, auth.Permission_REPO_INSPECT_FILE); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		w.authorized[repo] = true
+	}
+	commitInfo, err := w.d.inspectCommit(ctx, file.Commit, pfs.CommitState_STARTED)
+	if err != nil {
+		return nil, err
+	}
+	p := cleanPath(file.Path)
+	key := pfsdb.CommitKey(commitInfo.Commit) + ":" + p
+	if fl, ok := w.visited[key]; ok {
+		return fl, nil
+	}
+	fl := &pfs.FileLineage{File: commitInfo.Commit.NewFile(p)}
+	tags, err := w.d.fileTags(ctx, commitInfo.Commit, p)
+	if err != nil {
+		return nil, err
+	}
+	w.visited[key] = fl
+	statsCommit := ppsutil.GetStatsCommit(commitInfo)
+	if statsCommit == nil {
+		// The commit isn't a pipeline's output.
+		return fl, nil
+	}
+	for _, tag := range tags {
+		meta, err := w.d.datumMeta(ctx, statsCommit, tag)
+		if err != nil {
+			return nil, err
+		}
+		if meta == nil {
+			// The file wasn't written by a datum.
+			continue
+		}
+		dl := &pfs.DatumLineage{
+			Pipeline:      commitInfo.Commit.Branch.Repo.Name,
+			PipelineJobID: meta.PipelineJobID,
+			DatumID:       tag,
+		}
+		for _, input := range meta.Inputs {
+			if input.FileInfo == nil || input.FileInfo.File == nil {
+				continue
+			}
+			il, err := w.fileLineage(ctx, input.FileInfo.File)
+			if err != nil {
+				return nil, err
+			}
+			dl.Inputs = append(dl.Inputs, il)
+		}
+		fl.Datums = append(fl.Datums, dl)
+	}
+	return fl, nil
+}
+
+// fileTags returns the sorted, non-empty tags of the files at or under p in
+// commit. It returns a file not found error if there are no such files.
+func (d *driver) fileTags(ctx context.Context, commit *pfs.Commit, p string) ([]string, error) {
+	_, fs, err := d.openCommit(ctx, commit, index.WithPrefix(p))
+	if err != nil {
+		return nil, err
+	}
+	dir := strings.TrimSuffix(p, "/") + "/"
+	var found bool
+	tags := make(map[string]bool)
+	if err := fs.Iterate(ctx, func(f fileset.File) error {
+		idx := f.Index()
+		if idx.Path != p && !strings.HasPrefix(idx.Path, dir) {
+			return nil
+		}
+		found = true
+		if idx.File.Tag != "" {
+			tags[idx.File.Tag] = true
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, pfsserver.ErrFileNotFound{File: commit.NewFile(p)}
+	}
+	var result []string
+	for tag := range tags {
+		result = append(result, tag)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// datumMeta returns the meta of the datum with ID datumID in the meta commit
+// statsCommit, or nil if there is no such datum.
+func (d *driver) datumMeta(ctx context.Context, statsCommit *pfs.Commit, datumID string) (*common.DatumMeta, error) {
+	p := path.Join("/", common.MetaPrefix, datumID, common.MetaFileName)
+	_, fs, err := d.openCommit(ctx, statsCommit, index.WithPrefix(p))
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	var found bool
+	if err := fs.Iterate(ctx, func(f fileset.File) error {
+		if f.Index().Path != p {
+			return nil
+		}
+		found = true
+		return f.Content(buf)
+	}); err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, nil
+	}
+	meta := &common.DatumMeta{}
+	unmarshaler := &jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err := unmarshaler.Unmarshal(buf, meta); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return meta, nil
+}
@@ -0,0 +1,244 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"golang.org/x/net/context"
+)
+
+// mergeBranch performs a three-way merge of the changes made on the source
+// branch since its common ancestor with the target branch into the target
+// branch. Paths that were changed on both sides are conflicts, which are
+// resolved with the request's resolutions and strategy. If any conflict is
+// unresolved, no commit is made and the conflicts are returned.
+//
+// Commits only record a single parent, so a merge commit doesn't change the
+// common ancestor of the two branches, and nothing records which changes have
+// already been merged. Merging the same branches again diffs against the same
+// base: changes that are now identical on both sides are skipped, but a path
+// that has changed on the target since the previous merge is reported as a
+// conflict again, even if the source's change to it was already merged.
+func (d *driver) mergeBranch(ctx context.Context, request *pfs.MergeBranchRequest) (*pfs.MergeBranchResponse, error) {
+	source, target := request.Source, request.Target
+	if source == nil || target == nil {
+		return nil, errors.New("source and target branches must be specified")
+	}
+	if source.Repo.QualifiedName() != target.Repo.QualifiedName() {
+		return nil, errors.Errorf("cannot merge branches in different repos (%q and %q)", source.Repo.QualifiedName(), target.Repo.QualifiedName())
+	}
+	if source.Name == target.Name {
+		return nil, errors.Errorf("cannot merge branch %q into itself", source.Name)
+	}
+	if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, source.Repo.QualifiedName(), auth.Permission_REPO_READ); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, target.Repo.QualifiedName(), auth.Permission_REPO_WRITE); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	resolutions := make(map[string]pfs.MergeStrategy)
+	for _, r := range request.Resolutions {
+		resolutions[cleanPath(r.Path)] = r.Strategy
+	}
+	theirsInfo, err := d.inspectCommit(ctx, client.NewCommit(source.Repo.QualifiedName(), source.Name, ""), pfs.CommitState_FINISHED)
+	if err != nil {
+		return nil, err
+	}
+	oursInfo, err := d.inspectCommit(ctx, client.NewCommit(target.Repo.QualifiedName(), target.Name, ""), pfs.CommitState_FINISHED)
+	if err != nil {
+		return nil, err
+	}
+	base, err := d.mergeBase(ctx, oursInfo, theirsInfo)
+	if err != nil {
+		return nil, err
+	}
+	response := &pfs.MergeBranchResponse{Base: base}
+	if base != nil && base.ID == theirsInfo.Commit.ID {
+		// The target branch already contains every change on the source branch.
+		return response, nil
+	}
+	ours, err := d.changedFiles(ctx, base, oursInfo.Commit)
+	if err != nil {
+		return nil, err
+	}
+	theirs, err := d.changedFiles(ctx, base, theirsInfo.Commit)
+	if err != nil {
+		return nil, err
+	}
+	// apply maps each path that is taken from the source branch to its file
+	// info there, or nil if it's deleted.
+	apply := make(map[string]*pfs.FileInfo)
+	var unresolved bool
+	for _, p := range sortedPaths(theirs) {
+		theirFi := theirs[p]
+		ourFi, changed := ours[p]
+		if !changed {
+			apply[p] = theirFi
+			continue
+		}
+		if sameFile(ourFi, theirFi) {
+			continue
+		}
+		strategy, ok := resolutions[p]
+		if !ok {
+			strategy = request.Strategy
+		}
+		response.Conflicts = append(response.Conflicts, &pfs.MergeConflict{
+			Path:       p,
+			Ours:       ourFi,
+			Theirs:     theirFi,
+			Resolution: strategy,
+		})
+		switch strategy {
+		case pfs.MergeStrategy_MERGE_THEIRS:
+			apply[p] = theirFi
+		case pfs.MergeStrategy_MERGE_OURS:
+		default:
+			unresolved = true
+		}
+	}
+	if unresolved || len(apply) == 0 {
+		return response, nil
+	}
+	parentID, err := d.getFileset(ctx, oursInfo.Commit)
+	if err != nil {
+		return nil, err
+	}
+	description := request.Description
+	if description == "" {
+		description = fmt.Sprintf("merge %s into %s", source.Name, target.Name)
+	}
+	// Write the merged files before starting the commit, so that the commit
+	// and its fileset are added in the same transaction, and a failed merge
+	// leaves nothing behind.
+	if err := d.storage.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *renew.StringSet) error {
+		id, err := d.withUnorderedWriter(ctx, renewer, false, func(uw *fileset.UnorderedWriter) error {
+			return d.applyMerge(ctx, uw, theirsInfo.Commit, apply)
+		}, fileset.WithParentID(parentID))
+		if err != nil {
+			return err
+		}
+		return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+			branchInfo, err := d.inspectBranch(txnCtx, target)
+			if err != nil {
+				return err
+			}
+			if branchInfo.Head == nil || branchInfo.Head.ID != oursInfo.Commit.ID {
+				return errors.Errorf("branch %q moved during the merge, retry the merge", target.Name)
+			}
+			commit, err := d.startCommit(txnCtx, "", nil, target, nil, description)
+			if err != nil {
+				return err
+			}
+			if err := d.commitStore.AddFilesetTx(txnCtx.SqlTx, commit, *id); err != nil {
+				return err
+			}
+			response.Commit = commit
+			return d.finishCommit(txnCtx, commit, "")
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// mergeBase returns the most recent commit that is an ancestor of both ours
+// and theirs (or one of the commits themselves), or nil if they have none.
+func (d *driver) mergeBase(ctx context.Context, ours, theirs *pfs.CommitInfo) (*pfs.Commit, error) {
+	ancestors := make(map[string]bool)
+	for ci := ours; ; {
+		ancestors[ci.Commit.ID] = true
+		if ci.ParentCommit == nil {
+			break
+		}
+		var err error
+		if ci, err = d.inspectCommit(ctx, ci.ParentCommit, pfs.CommitState_STARTED); err != nil {
+			return nil, err
+		}
+	}
+	for ci := theirs; ; {
+		if ancestors[ci.Commit.ID] {
+			return ci.Commit, nil
+		}
+		if ci.ParentCommit == nil {
+			return nil, nil
+		}
+		var err error
+		if ci, err = d.inspectCommit(ctx, ci.ParentCommit, pfs.CommitState_STARTED); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// changedFiles returns the files that differ between base and commit, keyed
+// by path. Deleted files map to nil.
+func (d *driver) changedFiles(ctx context.Context, base, commit *pfs.Commit) (map[string]*pfs.FileInfo, error) {
+	changed := make(map[string]*pfs.FileInfo)
+	oldFile := &pfs.File{Commit: base, Path: "/"}
+	if err := d.diffFile(ctx, oldFile, &pfs.File{Commit: commit, Path: "/"}, func(oldFi, newFi *pfs.FileInfo) error {
+		if newFi != nil && newFi.FileType == pfs.FileType_FILE {
+			changed[cleanPath(newFi.File.Path)] = newFi
+			return nil
+		}
+		if oldFi != nil && oldFi.FileType == pfs.FileType_FILE {
+			if _, ok := changed[cleanPath(oldFi.File.Path)]; !ok {
+				changed[cleanPath(oldFi.File.Path)] = nil
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return changed, nil
+}
+
+// applyMerge writes the files in apply from the source commit, and deletes
+// the ones that were deleted there.
+func (d *driver) applyMerge(ctx context.Context, uw *fileset.UnorderedWriter, source *pfs.Commit, apply map[string]*pfs.FileInfo) error {
+	copied := make(map[string]bool)
+	for _, p := range sortedPaths(apply) {
+		if apply[p] != nil {
+			copied[p] = true
+			continue
+		}
+		if err := uw.Delete(p, ""); err != nil {
+			return err
+		}
+	}
+	if len(copied) == 0 {
+		return nil
+	}
+	_, fs, err := d.openCommit(ctx, source)
+	if err != nil {
+		return err
+	}
+	fs = fileset.NewIndexFilter(fs, func(idx *index.Index) bool {
+		return copied[idx.Path]
+	})
+	return uw.Copy(ctx, fs, "", false)
+}
+
+func sameFile(a, b *pfs.FileInfo) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return bytes.Equal(a.Hash, b.Hash)
+}
+
+func sortedPaths(files map[string]*pfs.FileInfo) []string {
+	var paths []string
+	for p := range files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths
+}
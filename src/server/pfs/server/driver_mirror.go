@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// mirrorRepairInterval is how often the pfs master repairs the objects
+	// that couldn't be written to the mirror.
+	mirrorRepairInterval = time.Minute
+	// mirrorPrefix is the prefix of the objects that are mirrored (the
+	// chunks).
+	mirrorPrefix = "chunk/"
+)
+
+// mirror is the second object store that chunks are written to, for disaster
+// recovery.
+type mirror struct {
+	primary, secondary obj.Client
+	mode               obj.MirrorMode
+	queue              obj.RepairQueue
+}
+
+// newMirror returns the mirror of primary configured in env, or nil if no
+// mirror is configured.
+func newMirror(env serviceenv.ServiceEnv, primary obj.Client) (*mirror, error) {
+	conf := env.Config()
+	if conf.StorageMirrorURL == "" {
+		return nil, nil
+	}
+	mode, err := obj.ParseMirrorMode(conf.StorageMirrorMode)
+	if err != nil {
+		return nil, err
+	}
+	url, err := obj.ParseURL(conf.StorageMirrorURL)
+	if err != nil {
+		return nil, err
+	}
+	secondary, err := obj.NewClientFromURLAndSecret(url)
+	if err != nil {
+		return nil, err
+	}
+	return &mirror{
+		primary:   primary,
+		secondary: secondary,
+		mode:      mode,
+		queue:     obj.NewPostgresRepairQueue(env.GetDBClient()),
+	}, nil
+}
+
+// repairMirrorForever repairs the objects in the mirror's repair queue every
+// mirrorRepairInterval until ctx is cancelled, logging any errors.
+func (d *driver) repairMirrorForever(ctx context.Context) error {
+	ticker := time.NewTicker(mirrorRepairInterval)
+	defer ticker.Stop()
+	for {
+		n, err := obj.RepairMirror(ctx, d.mirror.primary, d.mirror.secondary, d.mirror.queue)
+		if err != nil {
+			select {
+			case <-ctx.Done():
+				return err
+			default:
+			}
+			log.Errorf("error repairing the object store mirror: %v", err)
+		}
+		if n > 0 {
+			log.Infof("repaired %d objects in the object store mirror", n)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// verifyMirror compares the chunks in the primary object store with those in
+// the mirror, and calls cb with each chunk that's only in one of them. If
+// repair is true, it also copies (or deletes) each of them in the mirror.
+func (d *driver) verifyMirror(ctx context.Context, repair bool, cb func(*pfs.MirrorDifference) error) error {
+	if d.mirror == nil {
+		return errors.New("no object store mirror is configured")
+	}
+	return obj.VerifyMirror(ctx, d.mirror.primary, d.mirror.secondary, mirrorPrefix, repair, func(diff obj.MirrorDifference) error {
+		return cb(&pfs.MirrorDifference{
+			Object:      diff.Name,
+			InPrimary:   diff.InPrimary,
+			InSecondary: diff.InSecondary,
+			Repaired:    repair,
+		})
+	})
+}
@@ -0,0 +1,231 @@
+package server
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/jmoiron/sqlx"
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsconsts"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// createDefaultProject creates the default project, which holds the repos
+// that don't name a project, if it doesn't exist yet.
+func (d *driver) createDefaultProject(ctx context.Context) error {
+	projectInfo := &pfs.ProjectInfo{
+		Project: &pfs.Project{Name: pfs.DefaultProjectName},
+		Created: types.TimestampNow(),
+	}
+	if err := col.NewSQLTx(ctx, d.env.GetDBClient(), func(sqlTx *sqlx.Tx) error {
+		return d.projects.ReadWrite(sqlTx).Create(pfsdb.ProjectKey(projectInfo.Project), projectInfo)
+	}); err != nil && !col.IsErrExists(err) {
+		return err
+	}
+	return nil
+}
+
+func (d *driver) createProject(txnCtx *txncontext.TransactionContext, request *pfs.CreateProjectRequest) error {
+	if request.Project == nil || request.Project.Name == "" {
+		return errors.New("project name must be specified")
+	}
+	if err := ancestry.ValidateName(request.Project.Name); err != nil {
+		return err
+	}
+	for _, grant := range request.InputGrants {
+		if grant.GetName() == "" {
+			return errors.New("input grant project name must be specified")
+		}
+	}
+	// Check that the user is logged in (user doesn't need any access level to
+	// create a project, but they must be authenticated if auth is active)
+	whoAmI, err := d.env.AuthServer().WhoAmI(txnCtx.ClientContext, &auth.WhoAmIRequest{})
+	authIsActivated := !auth.IsErrNotActivated(err)
+	if authIsActivated && err != nil {
+		return errors.Wrapf(grpcutil.ScrubGRPC(err), "error authenticating (must log in to create a project)")
+	}
+	key := pfsdb.ProjectKey(request.Project)
+	projects := d.projects.ReadWrite(txnCtx.SqlTx)
+	var existing pfs.ProjectInfo
+	if err := projects.Get(key, &existing); err != nil {
+		if !col.IsErrNotFound(err) {
+			return errors.Wrapf(err, "error checking whether project %q exists", request.Project.Name)
+		}
+	} else {
+		if !request.Update {
+			return errors.Errorf("project %q already exists", request.Project.Name)
+		}
+		if err := d.env.AuthServer().CheckProjectIsAuthorizedInTransaction(txnCtx, key, auth.Permission_REPO_MODIFY_BINDINGS); err != nil {
+			return errors.Wrapf(err, "could not update project %q", request.Project.Name)
+		}
+		// Quotas limit the project's owners, so only cluster admins may change them
+		if !proto.Equal(existing.Quota, request.Quota) {
+			if err := d.env.AuthServer().CheckClusterIsAuthorizedInTransaction(txnCtx, auth.Permission_CLUSTER_MODIFY_BINDINGS); err != nil {
+				return errors.Wrapf(err, "could not update the quota of project %q", request.Project.Name)
+			}
+		}
+		existing.Description = request.Description
+		existing.Quota = request.Quota
+		existing.InputGrants = request.InputGrants
+		return projects.Put(key, &existing)
+	}
+	if request.Quota != nil {
+		if err := d.env.AuthServer().CheckClusterIsAuthorizedInTransaction(txnCtx, auth.Permission_CLUSTER_MODIFY_BINDINGS); err != nil {
+			return errors.Wrapf(err, "could not set the quota of project %q", request.Project.Name)
+		}
+	}
+	if authIsActivated {
+		// Make the caller the owner of every repo in the new project.
+		if err := d.env.AuthServer().CreateRoleBindingInTransaction(txnCtx, whoAmI.Username, []string{auth.RepoOwnerRole}, &auth.Resource{Type: auth.ResourceType_PROJECT, Name: key}); err != nil {
+			return errors.Wrapf(grpcutil.ScrubGRPC(err), "could not create role binding for new project %q", request.Project.Name)
+		}
+	}
+	if err := d.createProjectSpecRepo(txnCtx, key, authIsActivated); err != nil {
+		return errors.Wrapf(err, "could not create spec repo for project %q", request.Project.Name)
+	}
+	return projects.Create(key, &pfs.ProjectInfo{
+		Project:     request.Project,
+		Description: request.Description,
+		Created:     types.TimestampNow(),
+		Quota:       request.Quota,
+		InputGrants: request.InputGrants,
+	})
+}
+
+// createProjectSpecRepo creates the spec repo of project, which holds the specs
+// of the project's pipelines so that pipelines in different projects can share
+// a name. Like the default project's spec repo, every user can read it and PPS
+// can write to it.
+func (d *driver) createProjectSpecRepo(txnCtx *txncontext.TransactionContext, project string, authIsActivated bool) error {
+	repo := client.NewRepo(pfs.QualifyName(project, ppsconsts.SpecRepo))
+	if err := d.repos.ReadWrite(txnCtx.SqlTx).Create(pfsdb.RepoKey(repo), &pfs.RepoInfo{
+		Repo:        repo,
+		Created:     types.TimestampNow(),
+		Description: ppsconsts.SpecRepoDesc,
+	}); err != nil {
+		return err
+	}
+	if !authIsActivated {
+		return nil
+	}
+	resource := &auth.Resource{Type: auth.ResourceType_REPO, Name: repo.QualifiedName()}
+	if err := d.env.AuthServer().CreateRoleBindingInTransaction(txnCtx, "", nil, resource); err != nil && !col.IsErrExists(err) {
+		return grpcutil.ScrubGRPC(err)
+	}
+	for principal, role := range map[string]string{
+		auth.AllClusterUsersSubject: auth.RepoReaderRole,
+		auth.PpsUser:                auth.RepoWriterRole,
+	} {
+		if _, err := d.env.AuthServer().ModifyRoleBindingInTransaction(txnCtx, &auth.ModifyRoleBindingRequest{
+			Principal: principal,
+			Roles:     []string{role},
+			Resource:  resource,
+		}); err != nil {
+			return grpcutil.ScrubGRPC(err)
+		}
+	}
+	return nil
+}
+
+func (d *driver) inspectProject(txnCtx *txncontext.TransactionContext, project *pfs.Project) (*pfs.ProjectInfo, error) {
+	projectInfo := &pfs.ProjectInfo{}
+	if err := d.projects.ReadWrite(txnCtx.SqlTx).Get(pfsdb.ProjectKey(project), projectInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, errors.Errorf("project %q not found", pfsdb.ProjectKey(project))
+		}
+		return nil, err
+	}
+	return projectInfo, nil
+}
+
+func (d *driver) listProject(ctx context.Context) (*pfs.ListProjectResponse, error) {
+	response := &pfs.ListProjectResponse{}
+	projectInfo := &pfs.ProjectInfo{}
+	if err := d.projects.ReadOnly(ctx).List(projectInfo, col.DefaultOptions(), func(string) error {
+		response.ProjectInfo = append(response.ProjectInfo, proto.Clone(projectInfo).(*pfs.ProjectInfo))
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (d *driver) deleteProject(txnCtx *txncontext.TransactionContext, project *pfs.Project) error {
+	if pfs.IsDefaultProject(project.GetName()) {
+		return errors.New("cannot delete the default project")
+	}
+	key := pfsdb.ProjectKey(project)
+	projects := d.projects.ReadWrite(txnCtx.SqlTx)
+	if err := projects.Get(key, &pfs.ProjectInfo{}); err != nil {
+		if col.IsErrNotFound(err) {
+			return errors.Errorf("project %q not found", key)
+		}
+		return err
+	}
+	if err := d.env.AuthServer().CheckProjectIsAuthorizedInTransaction(txnCtx, key, auth.Permission_REPO_DELETE); err != nil {
+		return err
+	}
+	n, err := d.countProjectRepos(txnCtx, project)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		return errors.Errorf("cannot delete project %q, which still has %d repo(s)", key, n)
+	}
+	if err := d.deleteRepo(txnCtx, client.NewRepo(pfs.QualifyName(key, ppsconsts.SpecRepo)), true); err != nil && !col.IsErrNotFound(err) {
+		return errors.Wrapf(err, "could not delete spec repo of project %q", key)
+	}
+	if err := d.env.AuthServer().DeleteRoleBindingInTransaction(txnCtx, &auth.Resource{Type: auth.ResourceType_PROJECT, Name: key}); err != nil && !auth.IsErrNotActivated(err) && !col.IsErrNotFound(err) {
+		return grpcutil.ScrubGRPC(err)
+	}
+	return projects.Delete(key)
+}
+
+// checkProjectAllowsRepo returns an error if the user repo repo can't be
+// created in its project, because the project doesn't exist, the caller can't
+// write to it, or it's at its repo quota.
+func (d *driver) checkProjectAllowsRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Repo) error {
+	projectInfo, err := d.inspectProject(txnCtx, repo.Project)
+	if err != nil {
+		return err
+	}
+	if !pfs.IsDefaultProject(repo.Project.GetName()) {
+		if err := d.env.AuthServer().CheckProjectIsAuthorizedInTransaction(txnCtx, repo.ProjectName(), auth.Permission_REPO_WRITE); err != nil {
+			return errors.Wrapf(err, "cannot create repo in project %q", repo.ProjectName())
+		}
+	}
+	if max := projectInfo.Quota.GetMaxRepos(); max > 0 {
+		n, err := d.countProjectRepos(txnCtx, repo.Project)
+		if err != nil {
+			return err
+		}
+		if n >= max {
+			return errors.Errorf("project %q is at its quota of %d repo(s)", repo.ProjectName(), max)
+		}
+	}
+	return nil
+}
+
+// countProjectRepos returns the number of user repos in project, not counting
+// its spec repo.
+func (d *driver) countProjectRepos(txnCtx *txncontext.TransactionContext, project *pfs.Project) (int64, error) {
+	var n int64
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadWrite(txnCtx.SqlTx).GetByIndex(pfsdb.ReposTypeIndex, pfs.UserRepoType, repoInfo, col.DefaultOptions(), func(string) error {
+		if repoInfo.Repo.Name != ppsconsts.SpecRepo && repoInfo.Repo.ProjectName() == pfsdb.ProjectKey(project) {
+			n++
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
@@ -0,0 +1,629 @@
+package server
+
+import (
+	"bytes"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	"golang.org/x/net/context"
+)
+
+const (
+	// missingChunksBatchSize is the number of chunk IDs sent in each
+	// MissingChunks request.
+	missingChunksBatchSize = 10000
+	// receiveCommitChunkSize is the maximum size of the data sent in each
+	// ReceiveCommit message.
+	receiveCommitChunkSize = 8 * 1024 * 1024
+)
+
+func (d *driver) createRemote(txnCtx *txncontext.TransactionContext, remote *pfs.Remote, update bool) error {
+	if remote == nil || remote.Name == "" {
+		return errors.New("remote name must be specified")
+	}
+	if err := ancestry.ValidateName(remote.Name); err != nil {
+		return err
+	}
+	if _, err := grpcutil.ParsePachdAddress(remote.PachdAddress); err != nil {
+		return errors.Wrapf(err, "invalid pachd address %q", remote.PachdAddress)
+	}
+	remotes := d.remotes.ReadWrite(txnCtx.SqlTx)
+	if update {
+		return errors.EnsureStack(remotes.Put(remote.Name, remote))
+	}
+	if err := remotes.Create(remote.Name, remote); err != nil {
+		if col.IsErrExists(err) {
+			return errors.Errorf("remote %q already exists", remote.Name)
+		}
+		return errors.EnsureStack(err)
+	}
+	return nil
+}
+
+func (d *driver) listRemote(ctx context.Context) (*pfs.ListRemoteResponse, error) {
+	response := &pfs.ListRemoteResponse{}
+	remote := &pfs.Remote{}
+	if err := d.remotes.ReadOnly(ctx).List(remote, col.DefaultOptions(), func(string) error {
+		response.Remotes = append(response.Remotes, proto.Clone(remote).(*pfs.Remote))
+		return nil
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return response, nil
+}
+
+func (d *driver) deleteRemote(txnCtx *txncontext.TransactionContext, name string) error {
+	if err := d.remotes.ReadWrite(txnCtx.SqlTx).Delete(name); err != nil {
+		if col.IsErrNotFound(err) {
+			return errors.Errorf("remote %q not found", name)
+		}
+		return errors.EnsureStack(err)
+	}
+	return nil
+}
+
+// remoteClient returns a client for the named remote. Requests made with it
+// carry the caller's auth token, so the caller must be authorized on the
+// remote as well.
+func (d *driver) remoteClient(ctx context.Context, name string) (*client.APIClient, error) {
+	remote := &pfs.Remote{}
+	if err := d.remotes.ReadOnly(ctx).Get(name, remote); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, errors.Errorf("remote %q not found", name)
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	addr, err := grpcutil.ParsePachdAddress(remote.PachdAddress)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid pachd address %q for remote %q", remote.PachdAddress, name)
+	}
+	c, err := client.NewFromPachdAddress(addr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not connect to remote %q", name)
+	}
+	return c.WithCtx(ctx), nil
+}
+
+func (d *driver) pushBranch(ctx context.Context, request *pfs.PushBranchRequest) (*pfs.TransferStats, error) {
+	if request.Branch == nil {
+		return nil, errors.New("branch must be specified")
+	}
+	c, err := d.remoteClient(ctx, request.Remote)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+	remoteBranch := request.RemoteBranch
+	if remoteBranch == nil {
+		remoteBranch = request.Branch
+	}
+	return transfer(ctx, &localCommitSource{d}, request.Branch, &remoteCommitSink{c}, remoteBranch)
+}
+
+func (d *driver) pullBranch(ctx context.Context, request *pfs.PullBranchRequest) (*pfs.TransferStats, error) {
+	if request.Branch == nil {
+		return nil, errors.New("branch must be specified")
+	}
+	c, err := d.remoteClient(ctx, request.Remote)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+	remoteBranch := request.RemoteBranch
+	if remoteBranch == nil {
+		remoteBranch = request.Branch
+	}
+	return transfer(ctx, &remoteCommitSource{c}, remoteBranch, &localCommitSink{d}, request.Branch)
+}
+
+// commitSource is a pachd that commits are transferred from.
+type commitSource interface {
+	inspectCommit(ctx context.Context, commit *pfs.Commit) (*pfs.CommitInfo, error)
+	exportCommit(ctx context.Context, commit *pfs.Commit) (*pfs.ExportCommitResponse, error)
+	getChunk(ctx context.Context, id chunk.ID) ([]byte, error)
+}
+
+// commitSink is a pachd that commits are transferred to.
+type commitSink interface {
+	ensureRepo(ctx context.Context, repo *pfs.Repo) error
+	// branchHead returns the head of branch, or nil if the branch doesn't
+	// exist or has no head.
+	branchHead(ctx context.Context, branch *pfs.Branch) (*pfs.Commit, error)
+	hasCommit(ctx context.Context, commit *pfs.Commit) (bool, error)
+	missingChunks(ctx context.Context, ids []chunk.ID) ([]chunk.ID, error)
+	// receiveCommit stores chunks, whose data is read with getChunk, and then
+	// creates the exported commit on branch.
+	receiveCommit(ctx context.Context, branch *pfs.Branch, export *pfs.ExportCommitResponse, chunks []*pfs.ChunkInfo, getChunk func(chunk.ID) ([]byte, error)) error
+	setHead(ctx context.Context, branch *pfs.Branch, commit *pfs.Commit) error
+}
+
+// transfer copies the commits on srcBranch that dst doesn't have to
+// dstBranch, oldest first, preserving their IDs, descriptions and parentage.
+// Only the chunks that dst doesn't already store are copied. The transfer
+// must be a fast-forward: dstBranch's head, if any, must be an ancestor of
+// srcBranch's head.
+func transfer(ctx context.Context, src commitSource, srcBranch *pfs.Branch, dst commitSink, dstBranch *pfs.Branch) (*pfs.TransferStats, error) {
+	head, err := src.inspectCommit(ctx, client.NewCommit(srcBranch.Repo.QualifiedName(), srcBranch.Name, ""))
+	if err != nil {
+		return nil, err
+	}
+	if head.Finished == nil {
+		return nil, errors.Errorf("head of branch %q is not finished", srcBranch.Name)
+	}
+	if err := dst.ensureRepo(ctx, dstBranch.Repo); err != nil {
+		return nil, err
+	}
+	dstHead, err := dst.branchHead(ctx, dstBranch)
+	if err != nil {
+		return nil, err
+	}
+	// Walk back from the head to the newest commit that dst already has.
+	var commits []*pfs.CommitInfo
+	ci := head
+	for {
+		ok, err := dst.hasCommit(ctx, client.NewCommit(dstBranch.Repo.QualifiedName(), "", ci.Commit.ID))
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			break
+		}
+		commits = append(commits, ci)
+		if ci.ParentCommit == nil {
+			ci = nil
+			break
+		}
+		if ci, err = src.inspectCommit(ctx, ci.ParentCommit); err != nil {
+			return nil, err
+		}
+	}
+	// Check that dstBranch's head is in the history being pushed, so that no
+	// commits on dstBranch are lost.
+	if dstHead != nil {
+		for ci != nil && ci.Commit.ID != dstHead.ID {
+			if ci.ParentCommit == nil {
+				ci = nil
+				break
+			}
+			if ci, err = src.inspectCommit(ctx, ci.ParentCommit); err != nil {
+				return nil, err
+			}
+		}
+		if ci == nil {
+			return nil, errors.Errorf("branch %q has diverged from branch %q, its head %s is not an ancestor of %s", dstBranch.Name, srcBranch.Name, dstHead.ID, head.Commit.ID)
+		}
+	}
+	stats := &pfs.TransferStats{}
+	for i := len(commits) - 1; i >= 0; i-- {
+		export, err := src.exportCommit(ctx, commits[i].Commit)
+		if err != nil {
+			return nil, err
+		}
+		var ids []chunk.ID
+		for _, ci := range export.Chunks {
+			ids = append(ids, ci.Id)
+		}
+		missing := make(map[string]bool)
+		for len(ids) > 0 {
+			n := missingChunksBatchSize
+			if n > len(ids) {
+				n = len(ids)
+			}
+			batch, err := dst.missingChunks(ctx, ids[:n])
+			if err != nil {
+				return nil, err
+			}
+			for _, id := range batch {
+				missing[string(id)] = true
+			}
+			ids = ids[n:]
+		}
+		var chunks []*pfs.ChunkInfo
+		for _, ci := range export.Chunks {
+			if missing[string(ci.Id)] {
+				chunks = append(chunks, ci)
+			}
+		}
+		stats.ChunksSkipped += int64(len(export.Chunks) - len(chunks))
+		if err := dst.receiveCommit(ctx, dstBranch, export, chunks, func(id chunk.ID) ([]byte, error) {
+			data, err := src.getChunk(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			stats.ChunksTransferred++
+			stats.BytesTransferred += int64(len(data))
+			return data, nil
+		}); err != nil {
+			return nil, err
+		}
+		stats.CommitsTransferred++
+	}
+	stats.Head = client.NewCommit(dstBranch.Repo.QualifiedName(), dstBranch.Name, head.Commit.ID)
+	if len(commits) == 0 && (dstHead == nil || dstHead.ID != head.Commit.ID) {
+		// dst already has the head, but on another branch.
+		if err := dst.setHead(ctx, dstBranch, stats.Head); err != nil {
+			return nil, err
+		}
+	}
+	return stats, nil
+}
+
+func (d *driver) missingChunks(ctx context.Context, ids []chunk.ID) ([]chunk.ID, error) {
+	return d.storage.ChunkStorage().Missing(ctx, ids)
+}
+
+// exportCommit returns the primitive filesets that make up a finished
+// commit's contents, and the chunks that they reference.
+func (d *driver) exportCommit(ctx context.Context, commit *pfs.Commit) (*pfs.ExportCommitResponse, error) {
+	if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, commit.Branch.Repo.QualifiedName(), auth.Permission_REPO_READ); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	commitInfo, err := d.inspectCommit(ctx, commit, pfs.CommitState_FINISHED)
+	if err != nil {
+		return nil, err
+	}
+	id, err := d.getFileset(ctx, commitInfo.Commit)
+	if err != nil {
+		return nil, err
+	}
+	prims, err := d.storage.Export(ctx, *id)
+	if err != nil {
+		return nil, err
+	}
+	response := &pfs.ExportCommitResponse{CommitInfo: commitInfo}
+	var roots []chunk.ID
+	for _, prim := range prims {
+		data, err := proto.Marshal(prim)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		response.Primitives = append(response.Primitives, data)
+		roots = append(roots, prim.PointsTo()...)
+	}
+	if err := d.storage.ChunkStorage().Walk(ctx, roots, func(id chunk.ID, pointsTo []chunk.ID) error {
+		ci := &pfs.ChunkInfo{Id: id}
+		for _, p := range pointsTo {
+			ci.PointsTo = append(ci.PointsTo, p)
+		}
+		response.Chunks = append(response.Chunks, ci)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// getChunk returns the stored data of a chunk. The data is encrypted, and can
+// only be read with the keys in the filesets that reference it.
+func (d *driver) getChunk(ctx context.Context, id chunk.ID) ([]byte, error) {
+	c := d.storage.ChunkStorage().NewClient("")
+	defer c.Close()
+	var data []byte
+	if err := c.Get(ctx, id, func(value []byte) error {
+		data = append([]byte{}, value...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// receiveCommit stores the chunks passed to store by read, and then creates
+// the commit that read returns on branch. Each chunk's data must hash to its
+// ID, and chunks must be stored after the chunks they reference.
+func (d *driver) receiveCommit(ctx context.Context, branch *pfs.Branch, read func(store func(ci *pfs.ChunkInfo, data []byte) error) (*pfs.ExportCommitResponse, error)) error {
+	if branch == nil {
+		return errors.New("branch must be specified")
+	}
+	if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, branch.Repo.QualifiedName(), auth.Permission_REPO_WRITE); err != nil {
+		return errors.EnsureStack(err)
+	}
+	// The chunk client keeps the chunks alive until the commit references them.
+	c := d.storage.ChunkStorage().NewClient("replication")
+	defer c.Close()
+	export, err := read(func(ci *pfs.ChunkInfo, data []byte) error {
+		md := chunk.Metadata{Size: len(data)}
+		for _, p := range ci.PointsTo {
+			md.PointsTo = append(md.PointsTo, p)
+		}
+		id, err := c.Create(ctx, md, data)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(id, ci.Id) {
+			return errors.Errorf("data for chunk %v has ID %v", chunk.ID(ci.Id), id)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if export == nil || export.CommitInfo == nil {
+		return errors.New("exported commit must be specified")
+	}
+	var prims []*fileset.Primitive
+	for _, data := range export.Primitives {
+		prim := &fileset.Primitive{}
+		if err := proto.Unmarshal(data, prim); err != nil {
+			return errors.EnsureStack(err)
+		}
+		prims = append(prims, prim)
+	}
+	return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return d.importCommit(txnCtx, branch, export.CommitInfo, prims)
+	})
+}
+
+// importCommit creates a commit on branch with the ID, description and parent
+// of commitInfo, and the contents of prims. The commit's parent must already
+// exist.
+func (d *driver) importCommit(txnCtx *txncontext.TransactionContext, branch *pfs.Branch, commitInfo *pfs.CommitInfo, prims []*fileset.Primitive) error {
+	if _, err := d.resolveCommit(txnCtx.SqlTx, client.NewCommit(branch.Repo.QualifiedName(), "", commitInfo.Commit.ID)); err == nil {
+		// The commit was received by an earlier transfer.
+		return nil
+	} else if !pfsserver.IsCommitNotFoundErr(err) {
+		return err
+	}
+	id, err := d.storage.ImportTx(txnCtx.SqlTx, prims, defaultTTL)
+	if err != nil {
+		return err
+	}
+	var parent *pfs.Commit
+	if commitInfo.ParentCommit != nil {
+		parent = client.NewCommit(branch.Repo.QualifiedName(), branch.Name, commitInfo.ParentCommit.ID)
+	}
+	commit, err := d.startCommit(txnCtx, commitInfo.Commit.ID, parent, branch, nil, commitInfo.Description)
+	if err != nil {
+		return err
+	}
+	if err := d.commitStore.SetTotalFilesetTx(txnCtx.SqlTx, commit, *id); err != nil {
+		return err
+	}
+	return d.finishCommit(txnCtx, commit, "")
+}
+
+// localCommitSource and localCommitSink transfer commits from and to this
+// pachd.
+type localCommitSource struct {
+	d *driver
+}
+
+func (s *localCommitSource) inspectCommit(ctx context.Context, commit *pfs.Commit) (*pfs.CommitInfo, error) {
+	return s.d.inspectCommit(ctx, commit, pfs.CommitState_STARTED)
+}
+
+func (s *localCommitSource) exportCommit(ctx context.Context, commit *pfs.Commit) (*pfs.ExportCommitResponse, error) {
+	return s.d.exportCommit(ctx, commit)
+}
+
+func (s *localCommitSource) getChunk(ctx context.Context, id chunk.ID) ([]byte, error) {
+	return s.d.getChunk(ctx, id)
+}
+
+type localCommitSink struct {
+	d *driver
+}
+
+func (s *localCommitSink) ensureRepo(ctx context.Context, repo *pfs.Repo) error {
+	return s.d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		if _, err := s.d.inspectRepo(txnCtx, repo, false); err == nil {
+			return nil
+		}
+		return s.d.createRepo(txnCtx, repo, "", false, nil)
+	})
+}
+
+func (s *localCommitSink) branchHead(ctx context.Context, branch *pfs.Branch) (*pfs.Commit, error) {
+	var head *pfs.Commit
+	if err := s.d.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		branchInfo, err := s.d.inspectBranch(txnCtx, branch)
+		if err != nil {
+			if col.IsErrNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		head = branchInfo.Head
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return head, nil
+}
+
+func (s *localCommitSink) hasCommit(ctx context.Context, commit *pfs.Commit) (bool, error) {
+	if _, err := s.d.getCommit(ctx, commit); err != nil {
+		if pfsserver.IsCommitNotFoundErr(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *localCommitSink) missingChunks(ctx context.Context, ids []chunk.ID) ([]chunk.ID, error) {
+	return s.d.missingChunks(ctx, ids)
+}
+
+func (s *localCommitSink) receiveCommit(ctx context.Context, branch *pfs.Branch, export *pfs.ExportCommitResponse, chunks []*pfs.ChunkInfo, getChunk func(chunk.ID) ([]byte, error)) error {
+	return s.d.receiveCommit(ctx, branch, func(store func(*pfs.ChunkInfo, []byte) error) (*pfs.ExportCommitResponse, error) {
+		for _, ci := range chunks {
+			data, err := getChunk(ci.Id)
+			if err != nil {
+				return nil, err
+			}
+			if err := store(ci, data); err != nil {
+				return nil, err
+			}
+		}
+		return export, nil
+	})
+}
+
+func (s *localCommitSink) setHead(ctx context.Context, branch *pfs.Branch, commit *pfs.Commit) error {
+	return s.d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return s.d.createBranch(txnCtx, branch, commit, nil, nil)
+	})
+}
+
+// remoteCommitSource and remoteCommitSink transfer commits from and to a
+// remote pachd.
+type remoteCommitSource struct {
+	c *client.APIClient
+}
+
+func (s *remoteCommitSource) inspectCommit(ctx context.Context, commit *pfs.Commit) (*pfs.CommitInfo, error) {
+	return s.c.InspectCommit(commit.Branch.Repo.QualifiedName(), commit.Branch.Name, commit.ID)
+}
+
+func (s *remoteCommitSource) exportCommit(ctx context.Context, commit *pfs.Commit) (*pfs.ExportCommitResponse, error) {
+	response, err := s.c.PfsAPIClient.ExportCommit(s.c.Ctx(), &pfs.ExportCommitRequest{Commit: commit})
+	return response, grpcutil.ScrubGRPC(err)
+}
+
+func (s *remoteCommitSource) getChunk(ctx context.Context, id chunk.ID) ([]byte, error) {
+	ctx, cancel := context.WithCancel(s.c.Ctx())
+	defer cancel()
+	getChunkClient, err := s.c.PfsAPIClient.GetChunk(ctx, &pfs.GetChunkRequest{ChunkId: id})
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	buf := &bytes.Buffer{}
+	if err := grpcutil.WriteFromStreamingBytesClient(getChunkClient, buf); err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return buf.Bytes(), nil
+}
+
+type remoteCommitSink struct {
+	c *client.APIClient
+}
+
+func (s *remoteCommitSink) ensureRepo(ctx context.Context, repo *pfs.Repo) error {
+	if _, err := s.c.InspectRepo(repo.QualifiedName()); err == nil {
+		return nil
+	}
+	if err := s.c.CreateRepo(repo.QualifiedName()); err != nil && !errutil.IsAlreadyExistError(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *remoteCommitSink) branchHead(ctx context.Context, branch *pfs.Branch) (*pfs.Commit, error) {
+	branchInfo, err := s.c.InspectBranch(branch.Repo.QualifiedName(), branch.Name)
+	if err != nil {
+		if errutil.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return branchInfo.Head, nil
+}
+
+func (s *remoteCommitSink) hasCommit(ctx context.Context, commit *pfs.Commit) (bool, error) {
+	if _, err := s.c.InspectCommit(commit.Branch.Repo.QualifiedName(), commit.Branch.Name, commit.ID); err != nil {
+		if pfsserver.IsCommitNotFoundErr(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *remoteCommitSink) missingChunks(ctx context.Context, ids []chunk.ID) ([]chunk.ID, error) {
+	request := &pfs.MissingChunksRequest{}
+	for _, id := range ids {
+		request.ChunkIds = append(request.ChunkIds, id)
+	}
+	response, err := s.c.PfsAPIClient.MissingChunks(s.c.Ctx(), request)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	var missing []chunk.ID
+	for _, id := range response.ChunkIds {
+		missing = append(missing, id)
+	}
+	return missing, nil
+}
+
+func (s *remoteCommitSink) receiveCommit(ctx context.Context, branch *pfs.Branch, export *pfs.ExportCommitResponse, chunks []*pfs.ChunkInfo, getChunk func(chunk.ID) ([]byte, error)) error {
+	ctx, cancel := context.WithCancel(s.c.Ctx())
+	defer cancel()
+	receiveCommitClient, err := s.c.PfsAPIClient.ReceiveCommit(ctx)
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	if err := receiveCommitClient.Send(&pfs.ReceiveCommitRequest{Branch: branch}); err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	for _, ci := range chunks {
+		data, err := getChunk(ci.Id)
+		if err != nil {
+			return err
+		}
+		request := &pfs.ReceiveCommitRequest{Chunk: ci}
+		for {
+			n := receiveCommitChunkSize
+			if n > len(data) {
+				n = len(data)
+			}
+			request.Data = data[:n]
+			if err := receiveCommitClient.Send(request); err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			data = data[n:]
+			if len(data) == 0 {
+				break
+			}
+			request = &pfs.ReceiveCommitRequest{}
+		}
+	}
+	if err := receiveCommitClient.Send(&pfs.ReceiveCommitRequest{Commit: export}); err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	_, err = receiveCommitClient.CloseAndRecv()
+	return grpcutil.ScrubGRPC(err)
+}
+
+func (s *remoteCommitSink) setHead(ctx context.Context, branch *pfs.Branch, commit *pfs.Commit) error {
+	return s.c.CreateBranch(branch.Repo.QualifiedName(), branch.Name, "", commit.ID, nil)
+}
+
+// readReceivedChunks calls store with each chunk sent to ReceiveCommit and its
+// data, and returns the commit that follows them.
+func readReceivedChunks(server pfs.API_ReceiveCommitServer, store func(ci *pfs.ChunkInfo, data []byte) error) (*pfs.ExportCommitResponse, error) {
+	var ci *pfs.ChunkInfo
+	buf := &bytes.Buffer{}
+	for {
+		request, err := server.Recv()
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		if request.Chunk != nil || request.Commit != nil {
+			if ci != nil {
+				if err := store(ci, buf.Bytes()); err != nil {
+					return nil, err
+				}
+				buf.Reset()
+			}
+			if request.Commit != nil {
+				return request.Commit, nil
+			}
+			ci = request.Chunk
+		} else if ci == nil {
+			return nil, errors.New("chunk data received before chunk")
+		}
+		buf.Write(request.Data)
+	}
+}
@@ -0,0 +1,172 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// coldTierInterval is how often the pfs master moves the chunks of cold
+// commits to the cold tier.
+const coldTierInterval = time.Hour
+
+// moveColdChunksForever calls moveColdChunks every coldTierInterval until ctx
+// is cancelled, logging any errors.
+func (d *driver) moveColdChunksForever(ctx context.Context) error {
+	ticker := time.NewTicker(coldTierInterval)
+	defer ticker.Stop()
+	for {
+		n, err := d.moveColdChunks(ctx)
+		if err != nil {
+			select {
+			case <-ctx.Done():
+				return err
+			default:
+			}
+			log.Errorf("error moving chunks to the cold tier: %v", err)
+		} else if n > 0 {
+			log.Infof("moved %d chunks to the cold tier", n)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// moveColdChunks moves the chunks that are only referenced by cold commits to
+// the cold tier, and returns the number of chunks it moved. A commit is cold
+// once it's been finished for longer than its repo's cold_after (or the
+// cluster's cold tier age), unless it's the head of a branch.
+//
+// Commits in different repos can share an ID, and the tracker doesn't record
+// which repo a commit's filesets belong to, so a commit ID is only cold if
+// every commit with that ID is.
+func (d *driver) moveColdChunks(ctx context.Context) (int64, error) {
+	ages := make(map[string]time.Duration)
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadOnly(ctx).List(repoInfo, col.DefaultOptions(), func(string) error {
+		age := d.coldTierAge
+		if repoInfo.ColdAfter != nil {
+			var err error
+			if age, err = types.DurationFromProto(repoInfo.ColdAfter); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		ages[pfsdb.RepoKey(repoInfo.Repo)] = age
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	heads := make(map[string]bool)
+	branchInfo := &pfs.BranchInfo{}
+	if err := d.branches.ReadOnly(ctx).List(branchInfo, col.DefaultOptions(), func(string) error {
+		if branchInfo.Head != nil {
+			heads[branchInfo.Head.ID] = true
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	// minAge is the shortest age that made any commit cold. Chunks that were
+	// rehydrated more recently than that are left in the hot tier.
+	var minAge time.Duration
+	now := time.Now()
+	cold := make(map[string]bool)
+	hot := make(map[string]bool)
+	commitInfo := &pfs.CommitInfo{}
+	if err := d.commits.ReadOnly(ctx).List(commitInfo, col.DefaultOptions(), func(string) error {
+		id := commitInfo.Commit.ID
+		age := ages[pfsdb.RepoKey(commitInfo.Commit.Branch.Repo)]
+		if age <= 0 || commitInfo.Finished == nil || heads[id] {
+			hot[id] = true
+			return nil
+		}
+		finished, err := types.TimestampFromProto(commitInfo.Finished)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		if now.Sub(finished) <= age {
+			hot[id] = true
+			return nil
+		}
+		cold[id] = true
+		if minAge == 0 || age < minAge {
+			minAge = age
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	// Chunks referenced by a hot commit, or by no commit at all, stay hot.
+	hotChunks := make(map[string]bool)
+	coldChunks := make(map[string]bool)
+	if err := track.IterateReachable(ctx, d.env.GetDBClient(), commitTrackerPrefix, chunk.TrackerPrefix, func(root, target string) error {
+		id := trackerCommitID(root)
+		chunkID := strings.TrimPrefix(target, chunk.TrackerPrefix)
+		switch {
+		case hot[id]:
+			hotChunks[chunkID] = true
+		case cold[id]:
+			coldChunks[chunkID] = true
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	var ids []chunk.ID
+	for chunkID := range coldChunks {
+		if hotChunks[chunkID] {
+			continue
+		}
+		id, err := chunk.IDFromHex(chunkID)
+		if err != nil {
+			return 0, errors.EnsureStack(err)
+		}
+		ids = append(ids, id)
+	}
+	return d.storage.ChunkStorage().MoveToTier(ctx, ids, chunk.TierCold, minAge)
+}
+
+// rehydrateCommit moves the chunks of a commit back to the hot tier. They
+// stay there until the commit has been cold for another cold_after.
+func (d *driver) rehydrateCommit(ctx context.Context, commit *pfs.Commit) (int64, error) {
+	if commit == nil {
+		return 0, errors.New("commit cannot be nil")
+	}
+	if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, commit.Branch.Repo.QualifiedName(), auth.Permission_REPO_WRITE); err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	id, err := d.getFileset(ctx, commit)
+	if err != nil {
+		return 0, err
+	}
+	prims, err := d.storage.Export(ctx, *id)
+	if err != nil {
+		return 0, err
+	}
+	var roots []chunk.ID
+	for _, prim := range prims {
+		roots = append(roots, prim.PointsTo()...)
+	}
+	var ids []chunk.ID
+	if err := d.storage.ChunkStorage().Walk(ctx, roots, func(id chunk.ID, _ []chunk.ID) error {
+		ids = append(ids, id)
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return d.storage.ChunkStorage().MoveToTier(ctx, ids, chunk.TierHot, 0)
+}
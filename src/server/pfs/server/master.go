@@ -6,8 +6,8 @@ import (
 	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
-	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
 	_ "github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 
 	log "github.com/sirupsen/logrus"
@@ -19,7 +19,7 @@ const (
 )
 
 func (d *driver) master(ctx context.Context) {
-	masterLock := dlock.NewDLock(d.etcdClient, path.Join(d.prefix, masterLockPath))
+	masterLock := serviceenv.NewDLock(d.env, path.Join(d.prefix, masterLockPath))
 	backoff.RetryUntilCancel(ctx, func() error {
 		masterCtx, err := masterLock.Lock(ctx)
 		if err != nil {
@@ -34,6 +34,16 @@ func (d *driver) master(ctx context.Context) {
 			gc := chunk.NewGC(d.storage.ChunkStorage())
 			return gc.RunForever(ctx)
 		})
+		if d.storage.ChunkStorage().HasColdTier() {
+			eg.Go(func() error {
+				return d.moveColdChunksForever(ctx)
+			})
+		}
+		if d.mirror != nil {
+			eg.Go(func() error {
+				return d.repairMirrorForever(ctx)
+			})
+		}
 		return eg.Wait()
 	}, backoff.NewInfiniteBackOff(), func(err error, _ time.Duration) error {
 		log.Errorf("error in pfs master: %v", err)
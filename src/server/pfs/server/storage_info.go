@@ -0,0 +1,135 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// inspectStorage reports how PFS is using the object store. Per-repo usage is
+// computed by walking the tracker's reference graph from each commit's
+// filesets down to the chunks they reference.
+func (d *driver) inspectStorage(ctx context.Context) (*pfs.StorageInfo, error) {
+	db := d.env.GetDBClient()
+	stats, err := d.storage.ChunkStorage().Stats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pendingDeletion, err := track.CountDeletable(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	info := &pfs.StorageInfo{
+		ObjectStoreBytes:              stats.Bytes,
+		Chunks:                        stats.Chunks,
+		ChunksPendingDeletion:         stats.TombstonedChunks,
+		BytesPendingDeletion:          stats.TombstonedBytes,
+		TrackedObjectsPendingDeletion: pendingDeletion,
+		ColdChunks:                    stats.ColdChunks,
+		ColdBytes:                     stats.ColdBytes,
+	}
+	runs, err := track.ListGCRuns(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	for _, run := range runs {
+		started, err := types.TimestampProto(run.StartedAt)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		finished, err := types.TimestampProto(run.FinishedAt)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		info.GcRuns = append(info.GcRuns, &pfs.GCRunInfo{
+			Name:           run.Name,
+			Started:        started,
+			Finished:       finished,
+			ObjectsDeleted: run.Deleted,
+			BytesDeleted:   run.DeletedBytes,
+			Error:          run.Error,
+		})
+	}
+	// map each commit to its repo
+	repos := make(map[string]*pfs.Repo)
+	commitRepos := make(map[string]string)
+	commitInfo := &pfs.CommitInfo{}
+	if err := d.commits.ReadOnly(ctx).List(commitInfo, col.DefaultOptions(), func(string) error {
+		repoKey := pfsdb.RepoKey(commitInfo.Commit.Branch.Repo)
+		repos[repoKey] = commitInfo.Commit.Branch.Repo
+		commitRepos[commitInfo.Commit.ID] = repoKey
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	// map each chunk to the repos whose commits reference it
+	chunkRepos := make(map[string]map[string]struct{})
+	if err := track.IterateReachable(ctx, db, commitTrackerPrefix, chunk.TrackerPrefix, func(root, target string) error {
+		repoKey, ok := commitRepos[trackerCommitID(root)]
+		if !ok {
+			// the commit was deleted, and its filesets are waiting to be
+			// garbage collected
+			return nil
+		}
+		chunkID := strings.TrimPrefix(target, chunk.TrackerPrefix)
+		if chunkRepos[chunkID] == nil {
+			chunkRepos[chunkID] = make(map[string]struct{})
+		}
+		chunkRepos[chunkID][repoKey] = struct{}{}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	repoInfos := make(map[string]*pfs.RepoStorageInfo)
+	for repoKey, repo := range repos {
+		repoInfos[repoKey] = &pfs.RepoStorageInfo{Repo: repo}
+	}
+	if err := d.storage.ChunkStorage().IterateSizes(ctx, func(id chunk.ID, size int64) error {
+		referencedBy := chunkRepos[id.HexString()]
+		switch len(referencedBy) {
+		case 0:
+			info.UnattributedBytes += size
+		case 1:
+			for repoKey := range referencedBy {
+				repoInfos[repoKey].ExclusiveBytes += size
+			}
+		default:
+			for repoKey := range referencedBy {
+				repoInfos[repoKey].SharedBytes += size
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadOnly(ctx).List(repoInfo, col.DefaultOptions(), func(string) error {
+		if ri, ok := repoInfos[pfsdb.RepoKey(repoInfo.Repo)]; ok {
+			info.Repos = append(info.Repos, ri)
+		} else {
+			info.Repos = append(info.Repos, &pfs.RepoStorageInfo{Repo: repoInfo.Repo})
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// trackerCommitID returns the ID of the commit that created the tracker object
+// 'id' (see commitDiffTrackerID and commitTotalTrackerID).
+func trackerCommitID(id string) string {
+	id = strings.TrimPrefix(id, commitTrackerPrefix)
+	if i := strings.IndexByte(id, '/'); i >= 0 {
+		return id[:i]
+	}
+	return id
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"io/ioutil"
 	"math/rand"
+	"net"
 	"path"
 	"path/filepath"
 	"regexp"
@@ -29,6 +30,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pagination"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
@@ -497,6 +499,100 @@ func TestPFS(suite *testing.T) {
 		require.YesError(t, err)
 	})
 
+	suite.Run("Projects", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		// Repos can only be created in projects that exist
+		require.YesError(t, env.PachClient.CreateRepo("team/repo"))
+		require.NoError(t, env.PachClient.CreateProject("team"))
+		require.YesError(t, env.PachClient.CreateProject("team"))
+		require.NoError(t, env.PachClient.CreateRepo("team/repo"))
+
+		// Repos in different projects can have the same name
+		require.NoError(t, env.PachClient.CreateRepo("repo"))
+		require.NoError(t, env.PachClient.PutFile(client.NewCommit("team/repo", "master", ""), "file", strings.NewReader("team")))
+		require.NoError(t, env.PachClient.PutFile(client.NewCommit("repo", "master", ""), "file", strings.NewReader("default")))
+		var buf bytes.Buffer
+		require.NoError(t, env.PachClient.GetFile(client.NewCommit("team/repo", "master", ""), "file", &buf))
+		require.Equal(t, "team", buf.String())
+
+		repoInfo, err := env.PachClient.InspectRepo("team/repo")
+		require.NoError(t, err)
+		require.Equal(t, "team", repoInfo.Repo.ProjectName())
+		repoInfos, err := env.PachClient.ListProjectRepo("team")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(repoInfos))
+		require.Equal(t, "team/repo", repoInfos[0].Repo.QualifiedName())
+		repoInfos, err = env.PachClient.ListProjectRepo(pfs.DefaultProjectName)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(repoInfos))
+		require.Equal(t, "repo", repoInfos[0].Repo.QualifiedName())
+
+		projectInfos, err := env.PachClient.ListProject()
+		require.NoError(t, err)
+		require.Equal(t, 2, len(projectInfos))
+
+		// Projects can't be deleted while they have repos, and the default
+		// project can't be deleted at all
+		require.YesError(t, env.PachClient.DeleteProject("team"))
+		require.YesError(t, env.PachClient.DeleteProject(pfs.DefaultProjectName))
+		require.NoError(t, env.PachClient.DeleteRepo("team/repo", false))
+		require.NoError(t, env.PachClient.DeleteProject("team"))
+		_, err = env.PachClient.InspectProject("team")
+		require.YesError(t, err)
+	})
+
+	suite.Run("ProjectQuota", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		_, err := env.PachClient.PfsAPIClient.CreateProject(env.PachClient.Ctx(), &pfs.CreateProjectRequest{
+			Project: client.NewProject("team"),
+			Quota:   &pfs.ProjectQuota{MaxRepos: 2},
+		})
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.CreateRepo("team/a"))
+		require.NoError(t, env.PachClient.CreateRepo("team/b"))
+		require.YesError(t, env.PachClient.CreateRepo("team/c"))
+		require.NoError(t, env.PachClient.DeleteRepo("team/a", false))
+		require.NoError(t, env.PachClient.CreateRepo("team/c"))
+	})
+
+	suite.Run("ColdTier", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		repo := "repo"
+		_, err := env.PachClient.PfsAPIClient.CreateRepo(env.PachClient.Ctx(), &pfs.CreateRepoRequest{
+			Repo:      client.NewRepo(repo),
+			ColdAfter: types.DurationProto(time.Hour),
+		})
+		require.NoError(t, err)
+		repoInfo, err := env.PachClient.InspectRepo(repo)
+		require.NoError(t, err)
+		require.Equal(t, types.DurationProto(time.Hour), repoInfo.ColdAfter)
+
+		// updating a repo without a cold_after leaves it unchanged
+		require.NoError(t, env.PachClient.UpdateRepo(repo))
+		repoInfo, err = env.PachClient.InspectRepo(repo)
+		require.NoError(t, err)
+		require.Equal(t, types.DurationProto(time.Hour), repoInfo.ColdAfter)
+		_, err = env.PachClient.PfsAPIClient.CreateRepo(env.PachClient.Ctx(), &pfs.CreateRepoRequest{
+			Repo:      client.NewRepo(repo),
+			Update:    true,
+			ColdAfter: types.DurationProto(-time.Hour),
+		})
+		require.YesError(t, err)
+
+		// without a cold tier, every chunk is already hot
+		commit := client.NewCommit(repo, "master", "")
+		require.NoError(t, env.PachClient.PutFile(commit, "foo", strings.NewReader("foo")))
+		n, err := env.PachClient.RehydrateCommit(repo, "master", "")
+		require.NoError(t, err)
+		require.Equal(t, int64(0), n)
+	})
+
 	suite.Run("DeleteRepo", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
@@ -1575,6 +1671,80 @@ func TestPFS(suite *testing.T) {
 		checks()
 	})
 
+	suite.Run("ListFilePage", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit := client.NewCommit(repo, "master", "")
+		for _, p := range []string{"a", "b/x", "b/y", "c", "d"} {
+			require.NoError(t, env.PachClient.PutFile(commit, p, strings.NewReader(p)))
+		}
+
+		var pages [][]string
+		var token string
+		for {
+			var page []string
+			next, err := env.PachClient.ListFilePage(commit, "/", 2, token, func(fi *pfs.FileInfo) error {
+				page = append(page, fi.File.Path)
+				return nil
+			})
+			require.NoError(t, err)
+			pages = append(pages, page)
+			if next == "" {
+				break
+			}
+			token = next
+		}
+		require.Equal(t, [][]string{{"/a", "/b/"}, {"/c", "/d"}}, pages)
+
+		var paths []string
+		next, err := env.PachClient.GlobFilePage(commit, "**", 3, pagination.Encode(pagination.Files, "/b/"), func(fi *pfs.FileInfo) error {
+			paths = append(paths, fi.File.Path)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"/b/x", "/b/y", "/c"}, paths)
+		require.NotEqual(t, "", next)
+
+		_, err = env.PachClient.ListFilePage(commit, "/", 2, pagination.Encode(pagination.Commits, "x"), func(*pfs.FileInfo) error { return nil })
+		require.YesError(t, err)
+	})
+
+	suite.Run("ListCommitPage", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		repo := "repo"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit := client.NewCommit(repo, "master", "")
+		for i := 0; i < 5; i++ {
+			require.NoError(t, env.PachClient.PutFile(commit, "foo", strings.NewReader(fmt.Sprint(i))))
+		}
+
+		seen := make(map[string]bool)
+		var token string
+		for {
+			var n int
+			next, err := env.PachClient.ListCommitPage(repo, 2, token, func(ci *pfs.CommitInfo) error {
+				require.False(t, seen[ci.Commit.ID])
+				seen[ci.Commit.ID] = true
+				n++
+				return nil
+			})
+			require.NoError(t, err)
+			require.True(t, n <= 2)
+			if next == "" {
+				break
+			}
+			token = next
+		}
+		commitInfos, err := env.PachClient.ListCommit(repo, "", "", "", "", 0)
+		require.NoError(t, err)
+		require.Equal(t, len(commitInfos), len(seen))
+	})
+
 	suite.Run("ListFile2", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
@@ -2912,6 +3082,240 @@ func TestPFS(suite *testing.T) {
 		checks()
 	})
 
+	suite.Run("MergeBranch", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		master := client.NewCommit(repo, "master", "")
+		feature := client.NewCommit(repo, "feature", "")
+		for _, file := range []string{"a", "b", "c"} {
+			require.NoError(t, env.PachClient.PutFile(master, file, strings.NewReader(file)))
+		}
+		require.NoError(t, env.PachClient.CreateBranch(repo, "feature", "master", "", nil))
+
+		require.NoError(t, env.PachClient.PutFile(master, "b", strings.NewReader("master b")))
+		require.NoError(t, env.PachClient.PutFile(master, "d", strings.NewReader("d")))
+		require.NoError(t, env.PachClient.PutFile(feature, "b", strings.NewReader("feature b")))
+		require.NoError(t, env.PachClient.PutFile(feature, "c", strings.NewReader("feature c")))
+		require.NoError(t, env.PachClient.DeleteFile(feature, "a"))
+
+		// "b" was changed on both branches
+		resp, err := env.PachClient.MergeBranch(repo, "feature", "master", pfs.MergeStrategy_MERGE_FAIL)
+		require.NoError(t, err)
+		require.Nil(t, resp.Commit)
+		require.NotNil(t, resp.Base)
+		require.Equal(t, 1, len(resp.Conflicts))
+		require.Equal(t, "/b", resp.Conflicts[0].Path)
+		require.Equal(t, pfs.MergeStrategy_MERGE_FAIL, resp.Conflicts[0].Resolution)
+
+		resp, err = env.PachClient.MergeBranch(repo, "feature", "master", pfs.MergeStrategy_MERGE_FAIL,
+			&pfs.MergeResolution{Path: "b", Strategy: pfs.MergeStrategy_MERGE_THEIRS})
+		require.NoError(t, err)
+		require.NotNil(t, resp.Commit)
+		require.Equal(t, pfs.MergeStrategy_MERGE_THEIRS, resp.Conflicts[0].Resolution)
+		fileInfos, err := env.PachClient.ListFileAll(master, "")
+		require.NoError(t, err)
+		require.ElementsEqual(t, []string{"/b", "/c", "/d"}, finfosToPaths(fileInfos))
+		for file, content := range map[string]string{"b": "feature b", "c": "feature c", "d": "d"} {
+			var buf bytes.Buffer
+			require.NoError(t, env.PachClient.GetFile(master, file, &buf))
+			require.Equal(t, content, buf.String())
+		}
+
+		// Merging again is a no-op, since the changes are identical on both sides.
+		resp, err = env.PachClient.MergeBranch(repo, "feature", "master", pfs.MergeStrategy_MERGE_FAIL)
+		require.NoError(t, err)
+		require.Nil(t, resp.Commit)
+		require.Equal(t, 0, len(resp.Conflicts))
+
+		// Merging back only brings over "d", the other changes are already on
+		// feature.
+		resp, err = env.PachClient.MergeBranch(repo, "master", "feature", pfs.MergeStrategy_MERGE_FAIL)
+		require.NoError(t, err)
+		require.NotNil(t, resp.Commit)
+		require.Equal(t, 0, len(resp.Conflicts))
+		fileInfos, err = env.PachClient.ListFileAll(feature, "")
+		require.NoError(t, err)
+		require.ElementsEqual(t, []string{"/b", "/c", "/d"}, finfosToPaths(fileInfos))
+		_, err = env.PachClient.MergeBranch(repo, "feature", "feature", pfs.MergeStrategy_MERGE_OURS)
+		require.YesError(t, err)
+
+		// A repo with the same name in another project is a different repo.
+		require.NoError(t, env.PachClient.CreateProject("team"))
+		require.NoError(t, env.PachClient.CreateRepo("team/"+repo))
+		require.NoError(t, env.PachClient.PutFile(client.NewCommit("team/"+repo, "master", ""), "e", strings.NewReader("e")))
+		_, err = env.PachClient.PfsAPIClient.MergeBranch(env.PachClient.Ctx(), &pfs.MergeBranchRequest{
+			Source:   client.NewBranch("team/"+repo, "master"),
+			Target:   client.NewBranch(repo, "feature"),
+			Strategy: pfs.MergeStrategy_MERGE_FAIL,
+		})
+		require.YesError(t, err)
+		require.Matches(t, "different repos", err.Error())
+	})
+
+	suite.Run("ListFileHistory", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		master := pclient.NewCommit(repo, "master", "")
+
+		// Write foo, write bar, overwrite foo, delete foo, and write foo again.
+		require.NoError(t, env.PachClient.PutFile(master, "foo", strings.NewReader("foo\n")))
+		c1, err := env.PachClient.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(master, "bar", strings.NewReader("bar\n")))
+		require.NoError(t, env.PachClient.PutFile(master, "foo", strings.NewReader("foo foo\n")))
+		c3, err := env.PachClient.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.DeleteFile(master, "foo"))
+		c4, err := env.PachClient.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(master, "foo", strings.NewReader("foo foo foo\n")))
+		c5, err := env.PachClient.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+
+		versions, err := env.PachClient.ListFileHistory(master, "foo", 0)
+		require.NoError(t, err)
+		require.Equal(t, 4, len(versions))
+		expected := []struct {
+			commit  *pfs.CommitInfo
+			size    uint64
+			deleted bool
+		}{
+			{c5, 12, false},
+			{c4, 0, true},
+			{c3, 8, false},
+			{c1, 4, false},
+		}
+		for i, e := range expected {
+			require.Equal(t, e.commit.Commit.ID, versions[i].FileInfo.File.Commit.ID)
+			require.Equal(t, e.deleted, versions[i].Deleted)
+			require.Equal(t, e.size, versions[i].FileInfo.SizeBytes)
+		}
+
+		// Page through the history one version at a time.
+		request := &pfs.ListFileHistoryRequest{File: master.NewFile("foo"), Limit: 1}
+		var paged []string
+		for {
+			response, err := env.PachClient.PfsAPIClient.ListFileHistory(env.PachClient.Ctx(), request)
+			require.NoError(t, err)
+			for _, version := range response.Versions {
+				paged = append(paged, version.FileInfo.File.Commit.ID)
+			}
+			if response.NextPageToken == "" {
+				break
+			}
+			request.PageToken = response.NextPageToken
+		}
+		require.Equal(t, []string{c5.Commit.ID, c4.Commit.ID, c3.Commit.ID, c1.Commit.ID}, paged)
+
+		versions, err = env.PachClient.ListFileHistory(master, "foo", 2)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(versions))
+
+		versions, err = env.PachClient.ListFileHistory(master, "bar", 0)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(versions))
+
+		// List the history of every file in the repo, one version at a time.
+		request = &pfs.ListFileHistoryRequest{File: master.NewFile("/"), Limit: 1, Recursive: true}
+		paged = nil
+		for {
+			response, err := env.PachClient.PfsAPIClient.ListFileHistory(env.PachClient.Ctx(), request)
+			require.NoError(t, err)
+			for _, version := range response.Versions {
+				paged = append(paged, version.FileInfo.File.Path+"@"+version.FileInfo.File.Commit.ID)
+			}
+			if response.NextPageToken == "" {
+				break
+			}
+			request.PageToken = response.NextPageToken
+		}
+		c2 := versions[0].FileInfo.File.Commit
+		require.Equal(t, []string{
+			"/foo@" + c5.Commit.ID,
+			"/foo@" + c4.Commit.ID,
+			"/foo@" + c3.Commit.ID,
+			"/bar@" + c2.ID,
+			"/foo@" + c1.Commit.ID,
+		}, paged)
+	})
+
+	suite.Run("PushPullBranch", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+		remoteEnv := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+		remoteAddr := fmt.Sprintf("grpc://localhost:%d", remoteEnv.MockPachd.Addr.(*net.TCPAddr).Port)
+		require.NoError(t, env.PachClient.CreateRemote("remote", remoteAddr, false))
+		require.YesError(t, env.PachClient.CreateRemote("remote", remoteAddr, false))
+		remotes, err := env.PachClient.ListRemote()
+		require.NoError(t, err)
+		require.Equal(t, 1, len(remotes))
+		require.Equal(t, remoteAddr, remotes[0].PachdAddress)
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		master := client.NewCommit(repo, "master", "")
+		require.NoError(t, env.PachClient.PutFile(master, "a", strings.NewReader("a")))
+		require.NoError(t, env.PachClient.PutFile(master, "b", strings.NewReader("b")))
+
+		stats, err := env.PachClient.PushBranch(repo, "master", "remote", "")
+		require.NoError(t, err)
+		require.Equal(t, int64(2), stats.CommitsTransferred)
+		require.True(t, stats.ChunksTransferred > 0)
+		ci, err := env.PachClient.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		remoteCi, err := remoteEnv.PachClient.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		require.Equal(t, ci.Commit.ID, remoteCi.Commit.ID)
+		require.Equal(t, ci.ParentCommit.ID, remoteCi.ParentCommit.ID)
+		checkFiles := func(c *client.APIClient, commit *pfs.Commit, files map[string]string) {
+			fileInfos, err := c.ListFileAll(commit, "")
+			require.NoError(t, err)
+			require.Equal(t, len(files), len(fileInfos))
+			for file, content := range files {
+				var buf bytes.Buffer
+				require.NoError(t, c.GetFile(commit, file, &buf))
+				require.Equal(t, content, buf.String())
+			}
+		}
+		checkFiles(remoteEnv.PachClient, master, map[string]string{"a": "a", "b": "b"})
+
+		// Pushing again transfers nothing.
+		stats, err = env.PachClient.PushBranch(repo, "master", "remote", "")
+		require.NoError(t, err)
+		require.Equal(t, int64(0), stats.CommitsTransferred)
+
+		// Only the new data is transferred.
+		require.NoError(t, remoteEnv.PachClient.PutFile(master, "c", strings.NewReader("c")))
+		stats, err = env.PachClient.PullBranch(repo, "master", "remote", "")
+		require.NoError(t, err)
+		require.Equal(t, int64(1), stats.CommitsTransferred)
+		require.True(t, stats.ChunksSkipped > 0)
+		checkFiles(env.PachClient, master, map[string]string{"a": "a", "b": "b", "c": "c"})
+
+		// Diverged branches are rejected.
+		require.NoError(t, env.PachClient.PutFile(master, "d", strings.NewReader("d")))
+		require.NoError(t, remoteEnv.PachClient.PutFile(master, "e", strings.NewReader("e")))
+		_, err = env.PachClient.PushBranch(repo, "master", "remote", "")
+		require.YesError(t, err)
+		_, err = env.PachClient.PullBranch(repo, "master", "remote", "")
+		require.YesError(t, err)
+
+		// But can be pushed to another branch.
+		_, err = env.PachClient.PushBranch(repo, "master", "remote", "local-master")
+		require.NoError(t, err)
+		checkFiles(remoteEnv.PachClient, client.NewCommit(repo, "local-master", ""), map[string]string{"a": "a", "b": "b", "c": "c", "d": "d"})
+
+		require.NoError(t, env.PachClient.DeleteRemote("remote"))
+		_, err = env.PachClient.PushBranch(repo, "master", "remote", "")
+		require.YesError(t, err)
+	})
+
 	suite.Run("GlobFile", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
@@ -5045,6 +5449,30 @@ func TestPFS(suite *testing.T) {
 		require.NoError(t, env.PachClient.DeleteRepo(output1, false))
 	})
 
+	suite.Run("InspectStorage", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		require.NoError(t, env.PachClient.CreateRepo("a"))
+		require.NoError(t, env.PachClient.CreateRepo("b"))
+		require.NoError(t, env.PachClient.CreateRepo("empty"))
+		require.NoError(t, env.PachClient.PutFile(client.NewCommit("a", "master", ""), "file", strings.NewReader("a's data")))
+		require.NoError(t, env.PachClient.PutFile(client.NewCommit("b", "master", ""), "file", strings.NewReader("b's data")))
+
+		storageInfo, err := env.PachClient.InspectStorage()
+		require.NoError(t, err)
+		require.True(t, storageInfo.ObjectStoreBytes > 0)
+		require.True(t, storageInfo.Chunks > 0)
+		repoBytes := make(map[string]int64)
+		for _, repoStorageInfo := range storageInfo.Repos {
+			repoBytes[repoStorageInfo.Repo.Name] = repoStorageInfo.ExclusiveBytes + repoStorageInfo.SharedBytes
+		}
+		require.Equal(t, 3, len(repoBytes))
+		require.True(t, repoBytes["a"] > 0)
+		require.True(t, repoBytes["b"] > 0)
+		require.Equal(t, int64(0), repoBytes["empty"])
+	})
+
 	suite.Run("PutFileAtomic", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
@@ -87,10 +87,12 @@ func (f *PipelineFinisher) Run() error {
 		if err := f.d.listCommit(
 			f.txnCtx.ClientContext,
 			branch.Repo,
-			client.NewCommit(branch.Repo.Name, branch.Name, ""), // to
+			client.NewCommit(branch.Repo.QualifiedName(), branch.Name, ""), // to
 			nil,   // from
 			0,     // number
 			false, // reverse
+			false, // paginate
+			"",    // after
 			func(commitInfo *pfs.CommitInfo) error {
 				return f.d.env.PpsServer().StopPipelineJobInTransaction(f.txnCtx, &pps.StopPipelineJobRequest{
 					OutputCommit: commitInfo.Commit,
@@ -62,7 +62,7 @@ func (d *driver) triggerCommit(
 			return err
 		}
 		if bi.Trigger != nil {
-			if err := triggerBranch(client.NewBranch(commit.Branch.Repo.Name, bi.Trigger.Branch)); err != nil && !col.IsErrNotFound(err) {
+			if err := triggerBranch(client.NewBranch(commit.Branch.Repo.QualifiedName(), bi.Trigger.Branch)); err != nil && !col.IsErrNotFound(err) {
 				return err
 			}
 			if headBranches[bi.Trigger.Branch] {
@@ -47,7 +47,7 @@ func (a *validatedAPIServer) FinishCommitInTransaction(txnCtx *txncontext.Transa
 	if userCommit.Branch.Repo == nil {
 		return errors.New("commit repo cannot be nil")
 	}
-	if err := a.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, userCommit.Branch.Repo.Name, auth.Permission_REPO_WRITE); err != nil {
+	if err := a.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, userCommit.Branch.Repo.QualifiedName(), auth.Permission_REPO_WRITE); err != nil {
 		return err
 	}
 	return a.apiServer.FinishCommitInTransaction(txnCtx, request)
@@ -67,7 +67,7 @@ func (a *validatedAPIServer) SquashCommitInTransaction(txnCtx *txncontext.Transa
 	if userCommit.Branch.Repo == nil {
 		return errors.New("commit repo cannot be nil")
 	}
-	if err := a.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, userCommit.Branch.Repo.Name, auth.Permission_REPO_DELETE_COMMIT); err != nil {
+	if err := a.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, userCommit.Branch.Repo.QualifiedName(), auth.Permission_REPO_DELETE_COMMIT); err != nil {
 		return err
 	}
 	return a.apiServer.SquashCommitInTransaction(txnCtx, request)
@@ -78,18 +78,43 @@ func (a *validatedAPIServer) InspectFile(ctx context.Context, request *pfs.Inspe
 	if err := validateFile(request.File); err != nil {
 		return nil, err
 	}
-	if err := a.env.AuthServer().CheckRepoIsAuthorized(ctx, request.File.Commit.Branch.Repo.Name, auth.Permission_REPO_INSPECT_FILE); err != nil {
+	if err := a.env.AuthServer().CheckRepoIsAuthorized(ctx, request.File.Commit.Branch.Repo.QualifiedName(), auth.Permission_REPO_INSPECT_FILE); err != nil {
 		return nil, err
 	}
 	return a.apiServer.InspectFile(ctx, request)
 }
 
+// InspectFileLineage implements the protobuf pfs.InspectFileLineage RPC
+func (a *validatedAPIServer) InspectFileLineage(ctx context.Context, request *pfs.InspectFileLineageRequest) (response *pfs.FileLineage, retErr error) {
+	if err := validateFile(request.File); err != nil {
+		return nil, err
+	}
+	if err := a.env.AuthServer().CheckRepoIsAuthorized(ctx, request.File.Commit.Branch.Repo.QualifiedName(), auth.Permission_REPO_INSPECT_FILE); err != nil {
+		return nil, err
+	}
+	return a.apiServer.InspectFileLineage(ctx, request)
+}
+
+// ListFileHistory implements the protobuf pfs.ListFileHistory RPC
+func (a *validatedAPIServer) ListFileHistory(ctx context.Context, request *pfs.ListFileHistoryRequest) (response *pfs.ListFileHistoryResponse, retErr error) {
+	if err := validateFile(request.File); err != nil {
+		return nil, err
+	}
+	if request.Limit < 0 {
+		return nil, errors.Errorf("limit (%d) cannot be negative", request.Limit)
+	}
+	if err := a.env.AuthServer().CheckRepoIsAuthorized(ctx, request.File.Commit.Branch.Repo.QualifiedName(), auth.Permission_REPO_LIST_FILE); err != nil {
+		return nil, err
+	}
+	return a.apiServer.ListFileHistory(ctx, request)
+}
+
 // ListFile implements the protobuf pfs.ListFile RPC
 func (a *validatedAPIServer) ListFile(request *pfs.ListFileRequest, server pfs.API_ListFileServer) (retErr error) {
 	if err := validateFile(request.File); err != nil {
 		return err
 	}
-	if err := a.env.AuthServer().CheckRepoIsAuthorized(server.Context(), request.File.Commit.Branch.Repo.Name, auth.Permission_REPO_LIST_FILE); err != nil {
+	if err := a.env.AuthServer().CheckRepoIsAuthorized(server.Context(), request.File.Commit.Branch.Repo.QualifiedName(), auth.Permission_REPO_LIST_FILE); err != nil {
 		return err
 	}
 	return a.apiServer.ListFile(request, server)
@@ -111,7 +136,7 @@ func (a *validatedAPIServer) WalkFile(request *pfs.WalkFileRequest, server pfs.A
 	if file.Commit.Branch.Repo == nil {
 		return errors.New("file commit repo cannot be nil")
 	}
-	if err := a.env.AuthServer().CheckRepoIsAuthorized(server.Context(), file.Commit.Branch.Repo.Name, auth.Permission_REPO_READ, auth.Permission_REPO_LIST_FILE); err != nil {
+	if err := a.env.AuthServer().CheckRepoIsAuthorized(server.Context(), file.Commit.Branch.Repo.QualifiedName(), auth.Permission_REPO_READ, auth.Permission_REPO_LIST_FILE); err != nil {
 		return err
 	}
 	return a.apiServer.WalkFile(request, server)
@@ -130,7 +155,7 @@ func (a *validatedAPIServer) GlobFile(request *pfs.GlobFileRequest, server pfs.A
 	if commit.Branch.Repo == nil {
 		return errors.New("commit repo cannot be nil")
 	}
-	if err := a.env.AuthServer().CheckRepoIsAuthorized(server.Context(), commit.Branch.Repo.Name, auth.Permission_REPO_READ, auth.Permission_REPO_LIST_FILE); err != nil {
+	if err := a.env.AuthServer().CheckRepoIsAuthorized(server.Context(), commit.Branch.Repo.QualifiedName(), auth.Permission_REPO_READ, auth.Permission_REPO_LIST_FILE); err != nil {
 		return err
 	}
 	return a.apiServer.GlobFile(request, server)
@@ -140,7 +165,7 @@ func (a *validatedAPIServer) ClearCommit(ctx context.Context, req *pfs.ClearComm
 	if req.Commit == nil {
 		return nil, errors.Errorf("commit cannot be nil")
 	}
-	if err := a.env.AuthServer().CheckRepoIsAuthorized(ctx, req.Commit.Branch.Repo.Name, auth.Permission_REPO_WRITE); err != nil {
+	if err := a.env.AuthServer().CheckRepoIsAuthorized(ctx, req.Commit.Branch.Repo.QualifiedName(), auth.Permission_REPO_WRITE); err != nil {
 		return nil, err
 	}
 	return a.apiServer.ClearCommit(ctx, req)
@@ -195,6 +195,9 @@ $ {{alias}} -p foo -i bar@YYY`,
 			}
 			defer client.Close()
 
+			if pipelineName != "" {
+				pipelineName = cmdutil.QualifyPipeline(pipelineName)
+			}
 			return pager.Page(noPager, os.Stdout, func(w io.Writer) error {
 				if raw {
 					e := encoder(output)
@@ -376,6 +379,24 @@ each datum.`,
 	}
 	commands = append(commands, cmdutil.CreateAlias(restartDatum, "restart datum"))
 
+	redriveDatum := &cobra.Command{
+		Use:   "{{alias}} <pipeline>",
+		Short: "Process the datums quarantined by a pipeline's latest job again.",
+		Long:  "Process the datums quarantined by a pipeline's latest job again. The pipeline must have a datum failure policy of QUARANTINE. This runs a new job on the latest job's inputs, which skips the datums that were processed successfully.",
+		Example: `
+		# Re-drive the datums quarantined by the latest job of the "edges" pipeline
+		$ {{alias}} edges`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			return client.RedriveQuarantined(args[0])
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(redriveDatum, "redrive datum"))
+
 	listDatum := &cobra.Command{
 		Use:   "{{alias}} <job>",
 		Short: "Return the datums in a job.",
@@ -519,6 +540,10 @@ each datum.`,
 				return errors.Errorf("tail has been deprecated and removed from Pachyderm, use --since instead")
 			}
 
+			if pipelineName != "" {
+				pipelineName = cmdutil.QualifyPipeline(pipelineName)
+			}
+
 			// Issue RPC
 			iter := client.GetLogs(pipelineName, pipelineJobID, data, datumID, master, follow, since)
 			var buf bytes.Buffer
@@ -649,7 +674,7 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 			if err != nil {
 				return err
 			}
-			err = client.RunPipeline(args[0], prov, pipelineJobID)
+			err = client.RunPipeline(cmdutil.QualifyPipeline(args[0]), prov, pipelineJobID)
 			if err != nil {
 				return err
 			}
@@ -672,7 +697,7 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 				return err
 			}
 			defer client.Close()
-			err = client.RunCron(args[0])
+			err = client.RunCron(cmdutil.QualifyPipeline(args[0]))
 			if err != nil {
 				return err
 			}
@@ -691,7 +716,7 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 				return err
 			}
 			defer client.Close()
-			pipelineInfo, err := client.InspectPipeline(args[0])
+			pipelineInfo, err := client.InspectPipeline(cmdutil.QualifyPipeline(args[0]))
 			if err != nil {
 				return err
 			}
@@ -749,7 +774,7 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 			}
 			request := &ppsclient.ListPipelineRequest{History: history, AllowIncomplete: true, JqFilter: filter}
 			if pipeline != "" {
-				request.Pipeline = pachdclient.NewPipeline(pipeline)
+				request.Pipeline = pachdclient.NewPipeline(cmdutil.QualifyPipeline(pipeline))
 			}
 			response, err := client.PpsAPIClient.ListPipeline(client.Ctx(), request)
 			if err != nil {
@@ -820,7 +845,7 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 				KeepRepo: keepRepo,
 			}
 			if len(args) > 0 {
-				req.Pipeline = pachdclient.NewPipeline(args[0])
+				req.Pipeline = pachdclient.NewPipeline(cmdutil.QualifyPipeline(args[0]))
 			}
 			if _, err = client.PpsAPIClient.DeletePipeline(client.Ctx(), req); err != nil {
 				return grpcutil.ScrubGRPC(err)
@@ -843,7 +868,7 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 				return err
 			}
 			defer client.Close()
-			if err := client.StartPipeline(args[0]); err != nil {
+			if err := client.StartPipeline(cmdutil.QualifyPipeline(args[0])); err != nil {
 				cmdutil.ErrorAndExit("error from StartPipeline: %s", err.Error())
 			}
 			return nil
@@ -861,7 +886,7 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 				return err
 			}
 			defer client.Close()
-			if err := client.StopPipeline(args[0]); err != nil {
+			if err := client.StopPipeline(cmdutil.QualifyPipeline(args[0])); err != nil {
 				cmdutil.ErrorAndExit("error from StopPipeline: %s", err.Error())
 			}
 			return nil
@@ -1013,6 +1038,10 @@ func pipelineHelper(reprocess bool, build bool, pushImages bool, registry, usern
 		if request.Pipeline.Name == "" {
 			return errors.New("no pipeline `name` specified")
 		}
+		// Pipelines that don't name a project are in the current project.
+		if request.Pipeline.Project == nil && cmdutil.CurrentProject != "" {
+			request.Pipeline.Project = pachdclient.NewProject(cmdutil.CurrentProject)
+		}
 
 		// Add trace if env var is set
 		ctx, err := extended.EmbedAnyDuration(pc.Ctx())
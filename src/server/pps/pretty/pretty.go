@@ -140,8 +140,10 @@ State: {{jobState .State}}
 Reason: {{.Reason}}
 Processed: {{.DataProcessed}}
 Failed: {{.DataFailed}}
+Quarantined: {{.DataQuarantined}}
 Skipped: {{.DataSkipped}}
-Recovered: {{.DataRecovered}}
+{{ if .DataSkippedByExitCode }}Skipped By Exit Code: {{.DataSkippedByExitCode}}
+{{end}}Recovered: {{.DataRecovered}}
 Total: {{.DataTotal}}
 Data Downloaded: {{prettySize .Stats.DownloadBytes}}
 Data Uploaded: {{prettySize .Stats.UploadBytes}}
@@ -218,7 +220,14 @@ Parallelism Spec: {{.ParallelismSpec}}
     Number: {{ .ResourceLimits.Gpu.Number }} {{end}} {{end}}
 Datum Timeout: {{.DatumTimeout}}
 Job Timeout: {{.JobTimeout}}
-Input:
+{{ if .DatumFailurePolicy }}Datum Failure Policy: {{datumFailurePolicy .DatumFailurePolicy}}
+{{end}}{{ if .MaxQueueSize }}Max Queue Size: {{.MaxQueueSize}} ({{queueOverflowPolicy .QueueOverflowPolicy}})
+{{ if .QueueState }}Queue:
+  Running Jobs: {{.QueueState.RunningJobs}}
+  Held Commits: {{.QueueState.HeldCommits}}
+  Coalesced Commits: {{.QueueState.CoalescedCommits}}
+  Dropped Commits: {{.QueueState.DroppedCommits}}
+{{end}}{{end}}Input:
 {{pipelineInput .PipelineInfo}}
 {{ if .GithookURL }}Githook URL: {{.GithookURL}} {{end}}
 Output Branch: {{.OutputBranch}}
@@ -315,6 +324,21 @@ func PrintDetailedDatumInfo(w io.Writer, datumInfo *ppsclient.DatumInfo) {
 		PrintFile(tw, d.File)
 	}
 	tw.Flush()
+	if len(datumInfo.Retries) > 0 {
+		fmt.Fprintf(w, "Retries:\n")
+		tw = ansiterm.NewTabWriter(w, 10, 1, 3, ' ', 0)
+		fmt.Fprintf(tw, "  FAILED\tEXIT CODE\tBACKOFF\tREASON\t\n")
+		for _, retry := range datumInfo.Retries {
+			var backoff string
+			if b, err := types.DurationFromProto(retry.Backoff); err != nil {
+				backoff = err.Error()
+			} else {
+				backoff = b.String()
+			}
+			fmt.Fprintf(tw, "  %s\t%d\t%s\t%s\t\n", pretty.Ago(retry.Failed), retry.ExitCode, backoff, retry.Reason)
+		}
+		tw.Flush()
+	}
 }
 
 // PrintSecretInfo pretty-prints secret info.
@@ -374,6 +398,14 @@ func Progress(pji *ppsclient.PipelineJobInfo) string {
 	return fmt.Sprintf("%d + %d / %d", pji.DataProcessed, pji.DataSkipped, pji.DataTotal)
 }
 
+func queueOverflowPolicy(policy ppsclient.QueueOverflowPolicy) string {
+	return strings.ToLower(strings.TrimPrefix(policy.String(), "QUEUE_"))
+}
+
+func datumFailurePolicy(policy ppsclient.DatumFailurePolicy) string {
+	return strings.ToLower(policy.String())
+}
+
 func pipelineState(pipelineState ppsclient.PipelineState) string {
 	switch pipelineState {
 	case ppsclient.PipelineState_PIPELINE_STARTING:
@@ -494,4 +526,6 @@ var funcMap = template.FuncMap{
 	"prettySize":           pretty.Size,
 	"jobCounts":            jobCounts,
 	"prettyTransform":      prettyTransform,
+	"queueOverflowPolicy":  queueOverflowPolicy,
+	"datumFailurePolicy":   datumFailurePolicy,
 }
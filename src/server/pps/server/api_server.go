@@ -6,7 +6,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"os"
 	"path"
+	"path/filepath"
 	"sort"
 	"strings"
 	"sync"
@@ -42,6 +44,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/lokiutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/metrics"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pagination"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsconsts"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
@@ -93,6 +96,10 @@ func newErrPipelineUpdate(pipeline string, reason string) error {
 	return errors.Errorf("pipeline %v update error: %s", pipeline, reason)
 }
 
+// errNoKube is returned by RPCs that manage kubernetes resources when pachd's
+// worker runtime doesn't use kubernetes
+var errNoKube = errors.New("not supported unless pipeline workers run in kubernetes")
+
 type errGithookServiceNotFound struct {
 	error
 }
@@ -121,6 +128,7 @@ type apiServer struct {
 	httpPort              uint16
 	peerPort              uint16
 	gcPercent             int
+	workerRuntime         WorkerRuntime
 	// collections
 	pipelines    col.PostgresCollection
 	pipelineJobs col.PostgresCollection
@@ -190,6 +198,24 @@ func (a *apiServer) validateInput(pipelineName string, input *pps.Input) error {
 	if err := validateNames(make(map[string]bool), input); err != nil {
 		return err
 	}
+	// S3 inputs inside join, group and union inputs are served per datum, so
+	// unlike other s3 inputs they may use any glob
+	combined := make(map[*pps.PFSInput]bool)
+	pps.VisitInput(input, func(input *pps.Input) error {
+		var children []*pps.Input
+		children = append(children, input.Join...)
+		children = append(children, input.Group...)
+		children = append(children, input.Union...)
+		for _, child := range children {
+			pps.VisitInput(child, func(in *pps.Input) error {
+				if in.Pfs != nil {
+					combined[in.Pfs] = true
+				}
+				return nil
+			})
+		}
+		return nil
+	})
 	return pps.VisitInput(input, func(input *pps.Input) error {
 		set := false
 		if input.Pfs != nil {
@@ -210,10 +236,11 @@ func (a *apiServer) validateInput(pipelineName string, input *pps.Input) error {
 				return errors.Errorf("input must specify a branch")
 			case !input.Pfs.S3 && len(input.Pfs.Glob) == 0:
 				return errors.Errorf("input must specify a glob")
-			case input.Pfs.S3 && input.Pfs.Glob != "/":
+			case input.Pfs.S3 && input.Pfs.Glob != "/" && !combined[input.Pfs]:
 				return errors.Errorf("inputs that set 's3' to 'true' must also set " +
-					"'glob', to \"/\", as the S3 gateway is only able to expose data " +
-					"at the commit level")
+					"'glob', to \"/\", unless they're part of a join, group or union " +
+					"input, as the S3 gateway is only able to expose data at the commit " +
+					"level otherwise")
 			case input.Pfs.S3 && input.Pfs.Lazy:
 				return errors.Errorf("input cannot specify both 's3' and 'lazy', as " +
 					"'s3' requires input data to be accessed via Pachyderm's S3 " +
@@ -235,34 +262,18 @@ func (a *apiServer) validateInput(pipelineName string, input *pps.Input) error {
 				return errors.Errorf("multiple input types set")
 			}
 			set = true
-			if ppsutil.ContainsS3Inputs(input) {
-				// The best datum semantics for s3 inputs embedded in join expressions
-				// are not yet clear, and we see no use case for them yet, so block
-				// them until we know how they should work
-				return errors.Errorf("S3 inputs in join expressions are not supported")
-			}
 		}
 		if input.Group != nil {
 			if set {
 				return errors.Errorf("multiple input types set")
 			}
 			set = true
-			if ppsutil.ContainsS3Inputs(input) {
-				// See above for "joins"; block s3 inputs in group expressions until
-				// we know how they should work
-				return errors.Errorf("S3 inputs in group expressions are not supported")
-			}
 		}
 		if input.Union != nil {
 			if set {
 				return errors.Errorf("multiple input types set")
 			}
 			set = true
-			if ppsutil.ContainsS3Inputs(input) {
-				// See above for "joins"; block s3 inputs in union expressions until
-				// we know how they should work
-				return errors.Errorf("S3 inputs in union expressions are not supported")
-			}
 		}
 		if input.Cron != nil {
 			if set {
@@ -303,83 +314,6 @@ func validateTransform(transform *pps.Transform) error {
 	return nil
 }
 
-func (a *apiServer) validateKube() {
-	errors := false
-	kubeClient := a.env.GetKubeClient()
-	_, err := kubeClient.CoreV1().Nodes().List(metav1.ListOptions{})
-	if err != nil {
-		errors = true
-		logrus.Errorf("unable to access kubernetes nodeslist, Pachyderm will continue to work but it will not be possible to use COEFFICIENT parallelism. error: %v", err)
-	}
-	_, err = kubeClient.CoreV1().Pods(a.namespace).Watch(metav1.ListOptions{Watch: true})
-	if err != nil {
-		errors = true
-		logrus.Errorf("unable to access kubernetes pods, Pachyderm will continue to work but certain pipeline errors will result in pipelines being stuck indefinitely in \"starting\" state. error: %v", err)
-	}
-	pods, err := a.rcPods("pachd")
-	if err != nil || len(pods) == 0 {
-		errors = true
-		logrus.Errorf("unable to access kubernetes pods, Pachyderm will continue to work but 'pachctl logs' will not work. error: %v", err)
-	} else {
-		// No need to check all pods since we're just checking permissions.
-		pod := pods[0]
-		_, err = kubeClient.CoreV1().Pods(a.namespace).GetLogs(
-			pod.ObjectMeta.Name, &v1.PodLogOptions{
-				Container: "pachd",
-			}).Timeout(10 * time.Second).Do().Raw()
-		if err != nil {
-			errors = true
-			logrus.Errorf("unable to access kubernetes logs, Pachyderm will continue to work but 'pachctl logs' will not work. error: %v", err)
-		}
-	}
-	name := uuid.NewWithoutDashes()
-	labels := map[string]string{"app": name}
-	rc := &v1.ReplicationController{
-		TypeMeta: metav1.TypeMeta{
-			Kind:       "ReplicationController",
-			APIVersion: "v1",
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:   name,
-			Labels: labels,
-		},
-		Spec: v1.ReplicationControllerSpec{
-			Selector: labels,
-			Replicas: new(int32),
-			Template: &v1.PodTemplateSpec{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:   name,
-					Labels: labels,
-				},
-				Spec: v1.PodSpec{
-					Containers: []v1.Container{
-						{
-							Name:    "name",
-							Image:   DefaultUserImage,
-							Command: []string{"true"},
-						},
-					},
-				},
-			},
-		},
-	}
-	if _, err := kubeClient.CoreV1().ReplicationControllers(a.namespace).Create(rc); err != nil {
-		if err != nil {
-			errors = true
-			logrus.Errorf("unable to create kubernetes replication controllers, Pachyderm will not function properly until this is fixed. error: %v", err)
-		}
-	}
-	if err := kubeClient.CoreV1().ReplicationControllers(a.namespace).Delete(name, nil); err != nil {
-		if err != nil {
-			errors = true
-			logrus.Errorf("unable to delete kubernetes replication controllers, Pachyderm function properly but pipeline cleanup will not work. error: %v", err)
-		}
-	}
-	if !errors {
-		logrus.Infof("validating kubernetes access returned no errors")
-	}
-}
-
 // authorizing a pipeline operation varies slightly depending on whether the
 // pipeline is being created, updated, or deleted
 type pipelineOperation uint8
@@ -508,6 +442,8 @@ func (a *apiServer) UpdatePipelineJobStateInTransaction(txnCtx *txncontext.Trans
 	pipelineJobPtr.DataSkipped = request.DataSkipped
 	pipelineJobPtr.DataFailed = request.DataFailed
 	pipelineJobPtr.DataRecovered = request.DataRecovered
+	pipelineJobPtr.DataQuarantined = request.DataQuarantined
+	pipelineJobPtr.DataSkippedByExitCode = request.DataSkippedByExitCode
 	pipelineJobPtr.DataTotal = request.DataTotal
 	pipelineJobPtr.Stats = request.Stats
 
@@ -535,19 +471,20 @@ func (a *apiServer) CreatePipelineJob(ctx context.Context, request *pps.CreatePi
 		pipelines := a.pipelines.ReadWrite(txnCtx.SqlTx)
 		pipelineJobs := a.pipelineJobs.ReadWrite(txnCtx.SqlTx)
 		pipelineJobPtr := &pps.StoredPipelineJobInfo{
-			PipelineJob:   client.NewPipelineJob(uuid.NewWithoutDashes()),
-			OutputCommit:  request.OutputCommit,
-			Pipeline:      request.Pipeline,
-			Stats:         request.Stats,
-			Restart:       request.Restart,
-			DataProcessed: request.DataProcessed,
-			DataSkipped:   request.DataSkipped,
-			DataTotal:     request.DataTotal,
-			DataFailed:    request.DataFailed,
-			DataRecovered: request.DataRecovered,
-			StatsCommit:   request.StatsCommit,
-			Started:       request.Started,
-			Finished:      request.Finished,
+			PipelineJob:     client.NewPipelineJob(uuid.NewWithoutDashes()),
+			OutputCommit:    request.OutputCommit,
+			Pipeline:        request.Pipeline,
+			Stats:           request.Stats,
+			Restart:         request.Restart,
+			DataProcessed:   request.DataProcessed,
+			DataSkipped:     request.DataSkipped,
+			DataTotal:       request.DataTotal,
+			DataFailed:      request.DataFailed,
+			DataRecovered:   request.DataRecovered,
+			DataQuarantined: request.DataQuarantined,
+			StatsCommit:     request.StatsCommit,
+			Started:         request.Started,
+			Finished:        request.Finished,
 		}
 		result = pipelineJobPtr.PipelineJob
 		return ppsutil.UpdatePipelineJobState(pipelines, pipelineJobs, pipelineJobPtr, pps.PipelineJobState_JOB_STARTING, "")
@@ -570,11 +507,11 @@ func (a *apiServer) InspectPipelineJob(ctx context.Context, request *pps.Inspect
 			return nil, errors.Errorf("can't set both PipelineJob and OutputCommit")
 		}
 		pachClient := a.env.GetPachClient(ctx)
-		ci, err := pachClient.InspectCommit(request.OutputCommit.Branch.Repo.Name, request.OutputCommit.Branch.Name, request.OutputCommit.ID)
+		ci, err := pachClient.InspectCommit(request.OutputCommit.Branch.Repo.QualifiedName(), request.OutputCommit.Branch.Name, request.OutputCommit.ID)
 		if err != nil {
 			return nil, err
 		}
-		if err := a.listPipelineJob(ctx, nil, ci.Commit, nil, -1, false, "", func(pji *pps.PipelineJobInfo) error {
+		if err := a.listPipelineJob(ctx, nil, ci.Commit, nil, -1, false, "", col.DefaultOptions(), func(pji *pps.PipelineJobInfo) error {
 			if request.PipelineJob != nil {
 				return errors.Errorf("internal error, more than 1 PipelineJob has output commit: %v (this is likely a bug)", request.OutputCommit)
 			}
@@ -630,7 +567,7 @@ func (a *apiServer) InspectPipelineJob(ctx context.Context, request *pps.Inspect
 		if pipelineJobInfo.State != pps.PipelineJobState_JOB_RUNNING {
 			return pipelineJobInfo, nil
 		}
-		workerPoolID := ppsutil.PipelineRcName(pipelineJobInfo.Pipeline.Name, pipelineJobInfo.PipelineVersion)
+		workerPoolID := ppsutil.PipelineRcName(pipelineJobInfo.Pipeline, pipelineJobInfo.PipelineVersion)
 		workerStatus, err := workerserver.Status(ctx, workerPoolID, a.env.GetEtcdClient(), a.etcdPrefix, a.workerGrpcPort)
 		if err != nil {
 			logrus.Errorf("failed to get worker status with err: %s", err.Error())
@@ -659,6 +596,7 @@ func (a *apiServer) listPipelineJob(
 	history int64,
 	full bool,
 	jqFilter string,
+	opts *col.Options,
 	f func(*pps.PipelineJobInfo) error,
 ) error {
 	if pipeline != nil {
@@ -669,7 +607,7 @@ func (a *apiServer) listPipelineJob(
 		// caller without access to a single pipeline's output repo couldn't run
 		// `pachctl list job` at all) and instead silently skip jobs where the user
 		// doesn't have access to the job's output repo.
-		if err := a.env.AuthServer().CheckRepoIsAuthorized(ctx, pipeline.Name, auth.Permission_PIPELINE_LIST_JOB); err != nil && !auth.IsErrNotActivated(err) {
+		if err := a.env.AuthServer().CheckRepoIsAuthorized(ctx, pipeline.OutputRepoName(), auth.Permission_PIPELINE_LIST_JOB); err != nil && !auth.IsErrNotActivated(err) {
 			return err
 		}
 	}
@@ -762,36 +700,38 @@ func (a *apiServer) listPipelineJob(
 		return f(pipelineJobInfo)
 	}
 	if pipeline != nil {
-		return pipelineJobs.GetByIndex(ppsdb.PipelineJobsPipelineIndex, pipeline.Name, pipelineJobPtr, col.DefaultOptions(), _f)
+		return pipelineJobs.GetByIndex(ppsdb.PipelineJobsPipelineIndex, pipeline.QualifiedName(), pipelineJobPtr, opts, _f)
 	} else if outputCommit != nil {
-		return pipelineJobs.GetByIndex(ppsdb.PipelineJobsOutputIndex, pfsdb.CommitKey(outputCommit), pipelineJobPtr, col.DefaultOptions(), _f)
+		return pipelineJobs.GetByIndex(ppsdb.PipelineJobsOutputIndex, pfsdb.CommitKey(outputCommit), pipelineJobPtr, opts, _f)
 	} else {
-		return pipelineJobs.List(pipelineJobPtr, col.DefaultOptions(), _f)
+		return pipelineJobs.List(pipelineJobPtr, opts, _f)
 	}
 }
 
 func (a *apiServer) pipelineJobInfoFromPtr(ctx context.Context, pipelineJobPtr *pps.StoredPipelineJobInfo, full bool) (*pps.PipelineJobInfo, error) {
 	result := &pps.PipelineJobInfo{
-		PipelineJob:   pipelineJobPtr.PipelineJob,
-		Pipeline:      pipelineJobPtr.Pipeline,
-		OutputRepo:    client.NewRepo(pipelineJobPtr.Pipeline.Name),
-		OutputCommit:  pipelineJobPtr.OutputCommit,
-		Restart:       pipelineJobPtr.Restart,
-		DataProcessed: pipelineJobPtr.DataProcessed,
-		DataSkipped:   pipelineJobPtr.DataSkipped,
-		DataTotal:     pipelineJobPtr.DataTotal,
-		DataFailed:    pipelineJobPtr.DataFailed,
-		DataRecovered: pipelineJobPtr.DataRecovered,
-		Stats:         pipelineJobPtr.Stats,
-		StatsCommit:   pipelineJobPtr.StatsCommit,
-		State:         pipelineJobPtr.State,
-		Reason:        pipelineJobPtr.Reason,
-		Started:       pipelineJobPtr.Started,
-		Finished:      pipelineJobPtr.Finished,
+		PipelineJob:           pipelineJobPtr.PipelineJob,
+		Pipeline:              pipelineJobPtr.Pipeline,
+		OutputRepo:            ppsutil.PipelineRepo(pipelineJobPtr.Pipeline),
+		OutputCommit:          pipelineJobPtr.OutputCommit,
+		Restart:               pipelineJobPtr.Restart,
+		DataProcessed:         pipelineJobPtr.DataProcessed,
+		DataSkipped:           pipelineJobPtr.DataSkipped,
+		DataTotal:             pipelineJobPtr.DataTotal,
+		DataFailed:            pipelineJobPtr.DataFailed,
+		DataRecovered:         pipelineJobPtr.DataRecovered,
+		DataQuarantined:       pipelineJobPtr.DataQuarantined,
+		DataSkippedByExitCode: pipelineJobPtr.DataSkippedByExitCode,
+		Stats:                 pipelineJobPtr.Stats,
+		StatsCommit:           pipelineJobPtr.StatsCommit,
+		State:                 pipelineJobPtr.State,
+		Reason:                pipelineJobPtr.Reason,
+		Started:               pipelineJobPtr.Started,
+		Finished:              pipelineJobPtr.Finished,
 	}
 
 	pachClient := a.env.GetPachClient(ctx)
-	commitInfo, err := pachClient.InspectCommit(pipelineJobPtr.OutputCommit.Branch.Repo.Name, pipelineJobPtr.OutputCommit.Branch.Name, pipelineJobPtr.OutputCommit.ID)
+	commitInfo, err := pachClient.InspectCommit(pipelineJobPtr.OutputCommit.Branch.Repo.QualifiedName(), pipelineJobPtr.OutputCommit.Branch.Name, pipelineJobPtr.OutputCommit.ID)
 	if err != nil {
 		if isNotFoundErr(err) {
 			if err := col.NewSQLTx(ctx, a.env.GetDBClient(), func(sqlTx *sqlx.Tx) error {
@@ -805,7 +745,7 @@ func (a *apiServer) pipelineJobInfoFromPtr(ctx context.Context, pipelineJobPtr *
 	}
 	var specCommit *pfs.Commit
 	for _, prov := range commitInfo.Provenance {
-		if prov.Commit.Branch.Repo.Name == ppsconsts.SpecRepo && prov.Commit.Branch.Name == pipelineJobPtr.Pipeline.Name {
+		if pfsdb.BranchKey(prov.Commit.Branch) == pfsdb.BranchKey(ppsutil.SpecBranch(pipelineJobPtr.Pipeline)) {
 			specCommit = prov.Commit
 			break
 		}
@@ -815,7 +755,7 @@ func (a *apiServer) pipelineJobInfoFromPtr(ctx context.Context, pipelineJobPtr *
 	}
 	result.SpecCommit = specCommit
 	pipelinePtr := &pps.StoredPipelineInfo{}
-	if err := a.pipelines.ReadOnly(ctx).Get(pipelineJobPtr.Pipeline.Name, pipelinePtr); err != nil {
+	if err := a.pipelines.ReadOnly(ctx).Get(pipelineJobPtr.Pipeline.QualifiedName(), pipelinePtr); err != nil {
 		return nil, err
 	}
 	// Override the SpecCommit for the pipeline to be what it was when this job
@@ -858,13 +798,26 @@ func (a *apiServer) ListPipelineJob(request *pps.ListPipelineJobRequest, resp pp
 	defer func(start time.Time) {
 		a.Log(request, fmt.Sprintf("stream containing %d PipelineJobInfos", sent), retErr, time.Since(start))
 	}(time.Now())
-	return a.listPipelineJob(resp.Context(), request.Pipeline, request.OutputCommit, request.InputCommit, request.History, request.Full, request.JqFilter, func(pji *pps.PipelineJobInfo) error {
+	after, err := pagination.Decode(pagination.PipelineJobs, request.PageToken)
+	if err != nil {
+		return err
+	}
+	pager, err := pagination.NewPager(pagination.PipelineJobs, request.PageSize)
+	if err != nil {
+		return err
+	}
+	opts := col.DefaultOptions()
+	opts.After = after
+	return pager.Finish(resp, a.listPipelineJob(resp.Context(), request.Pipeline, request.OutputCommit, request.InputCommit, request.History, request.Full, request.JqFilter, opts, func(pji *pps.PipelineJobInfo) error {
+		if err := pager.Add(pji.PipelineJob.ID); err != nil {
+			return err
+		}
 		if err := resp.Send(pji); err != nil {
 			return err
 		}
 		sent++
 		return nil
-	})
+	}))
 }
 
 // FlushPipelineJob implements the protobuf pps.FlushPipelineJob RPC
@@ -876,7 +829,7 @@ func (a *apiServer) FlushPipelineJob(request *pps.FlushPipelineJobRequest, resp
 	}(time.Now())
 	var toRepos []*pfs.Repo
 	for _, pipeline := range request.ToPipelines {
-		toRepos = append(toRepos, client.NewRepo(pipeline.Name))
+		toRepos = append(toRepos, client.NewRepo(pipeline.OutputRepoName()))
 	}
 
 	pachClient := a.env.GetPachClient(resp.Context())
@@ -884,7 +837,7 @@ func (a *apiServer) FlushPipelineJob(request *pps.FlushPipelineJobRequest, resp
 		var pjis []*pps.PipelineJobInfo
 		// FlushPipelineJob passes -1 for history because we don't know which version
 		// of the pipeline created the output commit.
-		if err := a.listPipelineJob(resp.Context(), nil, ci.Commit, nil, -1, false, "", func(pji *pps.PipelineJobInfo) error {
+		if err := a.listPipelineJob(resp.Context(), nil, ci.Commit, nil, -1, false, "", col.DefaultOptions(), func(pji *pps.PipelineJobInfo) error {
 			pjis = append(pjis, pji)
 			return nil
 		}); err != nil {
@@ -1017,7 +970,7 @@ func (a *apiServer) RestartDatum(ctx context.Context, request *pps.RestartDatumR
 	if err != nil {
 		return nil, err
 	}
-	workerPoolID := ppsutil.PipelineRcName(pipelineJobInfo.Pipeline.Name, pipelineJobInfo.PipelineVersion)
+	workerPoolID := ppsutil.PipelineRcName(pipelineJobInfo.Pipeline, pipelineJobInfo.PipelineVersion)
 	if err := workerserver.Cancel(ctx, workerPoolID, a.env.GetEtcdClient(), a.etcdPrefix, a.workerGrpcPort, request.PipelineJob.ID, request.DataFilters); err != nil {
 		return nil, err
 	}
@@ -1045,17 +998,74 @@ func (a *apiServer) ListDatum(request *pps.ListDatumRequest, server pps.API_List
 	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
 	// TODO: Auth?
 	if request.Input != nil {
-		return a.listDatumInput(server.Context(), request.Input, func(meta *datum.Meta) error {
-			return server.Send(convertDatumMetaToInfo(meta))
+		if request.PageSize == 0 && request.PageToken == "" {
+			return a.listDatumInput(server.Context(), request.Input, func(meta *datum.Meta) error {
+				return server.Send(convertDatumMetaToInfo(meta))
+			})
+		}
+		return a.listDatumInputPage(request, server)
+	}
+	after, err := pagination.Decode(pagination.Datums, request.PageToken)
+	if err != nil {
+		return err
+	}
+	pager, err := pagination.NewPager(pagination.Datums, request.PageSize)
+	if err != nil {
+		return err
+	}
+	return pager.Finish(server, a.collectDatums(server.Context(), request.PipelineJob, func(meta *datum.Meta, _ *pfs.File) error {
+		if err := pager.Add(common.DatumID(meta.Inputs)); err != nil {
+			return err
+		}
+		return server.Send(convertDatumMetaToInfo(meta))
+	}, datum.WithDatumsAfter(after)))
+}
+
+// listDatumInputPage lists a page of an input's datums. The datums of an input
+// aren't sorted, so the first page writes them to a temporary fileset, and
+// the page tokens continue from a datum ID in it.
+func (a *apiServer) listDatumInputPage(request *pps.ListDatumRequest, server pps.API_ListDatumServer) error {
+	fsID, after, err := pagination.DecodeInputDatums(request.PageToken)
+	if err != nil {
+		return err
+	}
+	pager, err := pagination.NewPager(pagination.InputDatums, request.PageSize)
+	if err != nil {
+		return err
+	}
+	pachClient := a.env.GetPachClient(server.Context())
+	if fsID == "" {
+		resp, err := pachClient.WithCreateFilesetClient(func(mf client.ModifyFile) error {
+			storageRoot := filepath.Join(os.TempDir(), "pachyderm-list-datum-tmp", uuid.NewWithoutDashes())
+			return datum.WithSet(nil, storageRoot, func(s *datum.Set) error {
+				return a.listDatumInput(server.Context(), request.Input, func(meta *datum.Meta) error {
+					return s.UploadMeta(meta)
+				})
+			}, datum.WithMetaOutput(mf))
 		})
+		if err != nil {
+			return err
+		}
+		fsID = resp.FilesetId
+	}
+	// Each page keeps the fileset alive for the next one.
+	if err := pachClient.RenewFileSet(fsID, client.DefaultTTL); err != nil {
+		if request.PageToken != "" {
+			return errors.Wrapf(err, "page token %q has expired, list the datums again", request.PageToken)
+		}
+		return err
 	}
-	return a.collectDatums(server.Context(), request.PipelineJob, func(meta *datum.Meta, _ *pfs.File) error {
+	fsi := datum.NewFileSetIterator(pachClient, fsID, datum.WithDatumsAfter(after))
+	return pager.Finish(server, fsi.Iterate(func(meta *datum.Meta) error {
+		if err := pager.Add(pagination.InputDatumsKey(fsID, common.DatumID(meta.Inputs))); err != nil {
+			return err
+		}
 		return server.Send(convertDatumMetaToInfo(meta))
-	})
+	}))
 }
 
 func (a *apiServer) listDatumInput(ctx context.Context, input *pps.Input, cb func(*datum.Meta) error) error {
-	setInputDefaults("", input)
+	setInputDefaults("", "", input)
 	if visitErr := pps.VisitInput(input, func(input *pps.Input) error {
 		if input.Pfs != nil {
 			pachClient := a.env.GetPachClient(ctx)
@@ -1088,8 +1098,9 @@ func convertDatumMetaToInfo(meta *datum.Meta) *pps.DatumInfo {
 			PipelineJob: client.NewPipelineJob(meta.PipelineJobID),
 			ID:          common.DatumID(meta.Inputs),
 		},
-		State: convertDatumState(meta.State),
-		Stats: meta.Stats,
+		State:   convertDatumState(meta.State),
+		Stats:   meta.Stats,
+		Retries: meta.Retries,
 	}
 	for _, input := range meta.Inputs {
 		di.Data = append(di.Data, input.FileInfo)
@@ -1104,12 +1115,14 @@ func convertDatumState(state datum.State) pps.DatumState {
 		return pps.DatumState_FAILED
 	case datum.State_RECOVERED:
 		return pps.DatumState_RECOVERED
+	case datum.State_SKIPPED:
+		return pps.DatumState_SKIPPED
 	default:
 		return pps.DatumState_SUCCESS
 	}
 }
 
-func (a *apiServer) collectDatums(ctx context.Context, pipelineJob *pps.PipelineJob, cb func(*datum.Meta, *pfs.File) error) error {
+func (a *apiServer) collectDatums(ctx context.Context, pipelineJob *pps.PipelineJob, cb func(*datum.Meta, *pfs.File) error, opts ...datum.IteratorOption) error {
 	pipelineJobInfo, err := a.InspectPipelineJob(ctx, &pps.InspectPipelineJobRequest{
 		PipelineJob: client.NewPipelineJob(pipelineJob.ID),
 	})
@@ -1117,7 +1130,7 @@ func (a *apiServer) collectDatums(ctx context.Context, pipelineJob *pps.Pipeline
 		return err
 	}
 	pachClient := a.env.GetPachClient(ctx)
-	fsi := datum.NewCommitIterator(pachClient, pipelineJobInfo.StatsCommit.Branch.Repo.Name, pipelineJobInfo.StatsCommit.Branch.Name, pipelineJobInfo.StatsCommit.ID)
+	fsi := datum.NewCommitIterator(pachClient, pipelineJobInfo.StatsCommit.Branch.Repo.QualifiedName(), pipelineJobInfo.StatsCommit.Branch.Name, pipelineJobInfo.StatsCommit.ID, opts...)
 	return fsi.Iterate(func(meta *datum.Meta) error {
 		// TODO: Potentially refactor into datum package (at least the path).
 		pfsState := &pfs.File{
@@ -1164,9 +1177,9 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 		var pipelineInfo *pps.PipelineInfo
 		var err error
 		if request.Pipeline != nil {
-			pipelineInfo, err = a.inspectPipeline(apiGetLogsServer.Context(), request.Pipeline.Name)
+			pipelineInfo, err = a.inspectPipeline(apiGetLogsServer.Context(), request.Pipeline)
 			if err != nil {
-				return errors.Wrapf(err, "could not get pipeline information for %s", request.Pipeline.Name)
+				return errors.Wrapf(err, "could not get pipeline information for %s", request.Pipeline.QualifiedName())
 			}
 		} else if request.PipelineJob != nil {
 			// If user provides a pipeline job, lookup the pipeline from the
@@ -1176,19 +1189,19 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 			if err != nil {
 				return errors.Wrapf(err, "could not get pipeline job information for \"%s\"", request.PipelineJob.ID)
 			}
-			pipelineInfo, err = a.inspectPipeline(apiGetLogsServer.Context(), pipelineJobPtr.Pipeline.Name)
+			pipelineInfo, err = a.inspectPipeline(apiGetLogsServer.Context(), pipelineJobPtr.Pipeline)
 			if err != nil {
-				return errors.Wrapf(err, "could not get pipeline information for %s", pipelineJobPtr.Pipeline.Name)
+				return errors.Wrapf(err, "could not get pipeline information for %s", pipelineJobPtr.Pipeline.QualifiedName())
 			}
 		}
 
 		// 2) Check whether the caller is authorized to get logs from this pipeline/job
-		if err := a.authorizePipelineOp(apiGetLogsServer.Context(), pipelineOpGetLogs, pipelineInfo.Input, pipelineInfo.Pipeline.Name); err != nil {
+		if err := a.authorizePipelineOp(apiGetLogsServer.Context(), pipelineOpGetLogs, pipelineInfo.Input, pipelineInfo.Pipeline.OutputRepoName()); err != nil {
 			return err
 		}
 
 		// 3) Get rcName for this pipeline
-		rcName = ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version)
+		rcName = ppsutil.PipelineRcName(pipelineInfo.Pipeline, pipelineInfo.Version)
 		if err != nil {
 			return err
 		}
@@ -1231,13 +1244,13 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 					tailLines = nil
 				}
 				// Get full set of logs from pod i
-				stream, err := a.env.GetKubeClient().CoreV1().Pods(a.namespace).GetLogs(
+				stream, err := a.workerRuntime.Logs(
 					pod.ObjectMeta.Name, &v1.PodLogOptions{
 						Container:    containerName,
 						Follow:       request.Follow,
 						TailLines:    tailLines,
 						SinceSeconds: &sinceSeconds,
-					}).Timeout(10 * time.Second).Stream()
+					})
 				if err != nil {
 					return err
 				}
@@ -1334,9 +1347,9 @@ func (a *apiServer) getLogsLoki(request *pps.GetLogsRequest, apiGetLogsServer pp
 	// RC name
 	var pipelineInfo *pps.PipelineInfo
 	if request.Pipeline != nil {
-		pipelineInfo, err = a.inspectPipeline(apiGetLogsServer.Context(), request.Pipeline.Name)
+		pipelineInfo, err = a.inspectPipeline(apiGetLogsServer.Context(), request.Pipeline)
 		if err != nil {
-			return errors.Wrapf(err, "could not get pipeline information for %s", request.Pipeline.Name)
+			return errors.Wrapf(err, "could not get pipeline information for %s", request.Pipeline.QualifiedName())
 		}
 	} else if request.PipelineJob != nil {
 		// If user provides a pipeline job, lookup the pipeline from the
@@ -1346,17 +1359,20 @@ func (a *apiServer) getLogsLoki(request *pps.GetLogsRequest, apiGetLogsServer pp
 		if err != nil {
 			return errors.Wrapf(err, "could not get pipeline job information for \"%s\"", request.PipelineJob.ID)
 		}
-		pipelineInfo, err = a.inspectPipeline(apiGetLogsServer.Context(), pipelineJobPtr.Pipeline.Name)
+		pipelineInfo, err = a.inspectPipeline(apiGetLogsServer.Context(), pipelineJobPtr.Pipeline)
 		if err != nil {
-			return errors.Wrapf(err, "could not get pipeline information for %s", pipelineJobPtr.Pipeline.Name)
+			return errors.Wrapf(err, "could not get pipeline information for %s", pipelineJobPtr.Pipeline.QualifiedName())
 		}
 	}
 
 	// 2) Check whether the caller is authorized to get logs from this pipeline/job
-	if err := a.authorizePipelineOp(apiGetLogsServer.Context(), pipelineOpGetLogs, pipelineInfo.Input, pipelineInfo.Pipeline.Name); err != nil {
+	if err := a.authorizePipelineOp(apiGetLogsServer.Context(), pipelineOpGetLogs, pipelineInfo.Input, pipelineInfo.Pipeline.OutputRepoName()); err != nil {
 		return err
 	}
-	query := fmt.Sprintf(`{pipelineName=%q, container="user"}`, pipelineInfo.Pipeline.Name)
+	// pipelineProject="" also matches pipelines in the default project, which
+	// aren't labeled with a project
+	query := fmt.Sprintf(`{pipelineName=%q, pipelineProject=%q, container="user"}`,
+		pipelineInfo.Pipeline.Name, pipelineLabels(pipelineInfo.Pipeline)[pipelineProjectLabel])
 	if request.Master {
 		query += contains("master")
 	}
@@ -1466,18 +1482,40 @@ func (a *apiServer) validatePipelineRequest(request *pps.CreatePipelineRequest)
 
 // TODO: Implement the appropriate features.
 func (a *apiServer) validateV2Features(request *pps.CreatePipelineRequest) (*pps.CreatePipelineRequest, error) {
-	if request.CacheSize != "" {
-		return nil, errors.Errorf("CacheSize not implemented")
-	}
 	if request.Service == nil && request.Spout == nil {
 		request.EnableStats = true
 	}
-	if request.MaxQueueSize != 0 {
-		return nil, errors.Errorf("MaxQueueSize not implemented")
-	}
 	return request, nil
 }
 
+func validateRetrySpec(spec *pps.RetrySpec) error {
+	if spec == nil {
+		return nil
+	}
+	for _, d := range []*types.Duration{spec.InitialBackoff, spec.MaxBackoff} {
+		if d == nil {
+			continue
+		}
+		duration, err := types.DurationFromProto(d)
+		if err != nil {
+			return errors.Wrapf(err, "invalid retry backoff")
+		}
+		if duration < 0 {
+			return errors.Errorf("retry backoff %v cannot be negative", duration)
+		}
+	}
+	if spec.Multiplier < 0 {
+		return errors.Errorf("retry multiplier %v cannot be negative", spec.Multiplier)
+	}
+	if spec.Jitter < 0 || spec.Jitter > 1 {
+		return errors.Errorf("retry jitter %v must be between 0 and 1", spec.Jitter)
+	}
+	if spec.JobRetryBudget < 0 {
+		return errors.Errorf("job retry budget %v cannot be negative", spec.JobRetryBudget)
+	}
+	return nil
+}
+
 func (a *apiServer) validatePipeline(pipelineInfo *pps.PipelineInfo) error {
 	if pipelineInfo.Pipeline == nil {
 		return errors.New("invalid pipeline spec: Pipeline field cannot be nil")
@@ -1502,6 +1540,16 @@ func (a *apiServer) validatePipeline(pipelineInfo *pps.PipelineInfo) error {
 	if err := a.validateInput(pipelineInfo.Pipeline.Name, pipelineInfo.Input); err != nil {
 		return err
 	}
+	if !pfs.IsDefaultProject(pipelineInfo.Pipeline.Project.GetName()) {
+		if err := pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) error {
+			if input.Git != nil {
+				return errors.Errorf("git inputs can only be used by pipelines in the default project")
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
 	if pipelineInfo.ParallelismSpec != nil {
 		if pipelineInfo.ParallelismSpec.Coefficient < 0 {
 			return errors.New("ParallelismSpec.Coefficient cannot be negative")
@@ -1521,6 +1569,15 @@ func (a *apiServer) validatePipeline(pipelineInfo *pps.PipelineInfo) error {
 	if _, err := resource.ParseQuantity(pipelineInfo.CacheSize); err != nil {
 		return errors.Wrapf(err, "could not parse cacheSize '%s'", pipelineInfo.CacheSize)
 	}
+	if pipelineInfo.MaxQueueSize < 0 {
+		return errors.New("MaxQueueSize cannot be negative")
+	}
+	if _, ok := pps.QueueOverflowPolicy_name[int32(pipelineInfo.QueueOverflowPolicy)]; !ok {
+		return errors.Errorf("invalid QueueOverflowPolicy %d", pipelineInfo.QueueOverflowPolicy)
+	}
+	if _, ok := pps.DatumFailurePolicy_name[int32(pipelineInfo.DatumFailurePolicy)]; !ok {
+		return errors.Errorf("invalid DatumFailurePolicy %d", pipelineInfo.DatumFailurePolicy)
+	}
 	if pipelineInfo.JobTimeout != nil {
 		_, err := types.DurationFromProto(pipelineInfo.JobTimeout)
 		if err != nil {
@@ -1550,6 +1607,12 @@ func (a *apiServer) validatePipeline(pipelineInfo *pps.PipelineInfo) error {
 			return errors.Errorf("the following service type %s is not allowed", pipelineInfo.Service.Type)
 		}
 	}
+	if pipelineInfo.Transform.DatumBatching && (pipelineInfo.Service != nil || pipelineInfo.Spout != nil) {
+		return errors.New("datum batching is only supported by pipelines that process datums, not services or spouts")
+	}
+	if err := validateRetrySpec(pipelineInfo.Transform.RetrySpec); err != nil {
+		return err
+	}
 	if pipelineInfo.Spout != nil {
 		if pipelineInfo.EnableStats {
 			return errors.Errorf("spouts are not allowed to have a stats branch")
@@ -1651,7 +1714,7 @@ func (a *apiServer) writePipelineInfo(ctx context.Context, pipelineInfo *pps.Pip
 	var commit *pfs.Commit
 	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
 		var err error
-		commit, err = a.commitPipelineInfoFromFileset(txnCtx, pipelineInfo.Pipeline.Name, filesetID, pipelineInfo.SpecCommit)
+		commit, err = a.commitPipelineInfoFromFileset(txnCtx, pipelineInfo.Pipeline, filesetID, pipelineInfo.SpecCommit)
 		return err
 	}); err != nil {
 		return nil, err
@@ -1678,7 +1741,7 @@ func (a *apiServer) writePipelineInfoToFileset(ctx context.Context, pipelineInfo
 
 func (a *apiServer) commitPipelineInfoFromFileset(
 	txnCtx *txncontext.TransactionContext,
-	pipelineName string,
+	pipeline *pps.Pipeline,
 	filesetID string,
 	prevSpecCommit *pfs.Commit,
 ) (*pfs.Commit, error) {
@@ -1687,7 +1750,7 @@ func (a *apiServer) commitPipelineInfoFromFileset(
 		var err error
 		commit, err = a.env.PfsServer().StartCommitInTransaction(superCtx, &pfs.StartCommitRequest{
 			Parent: prevSpecCommit,
-			Branch: client.NewBranch(ppsconsts.SpecRepo, pipelineName),
+			Branch: ppsutil.SpecBranch(pipeline),
 		}, nil)
 		if err != nil {
 			return errors.Wrapf(err, "could not marshal PipelineInfo")
@@ -1722,7 +1785,7 @@ func (a *apiServer) fixPipelineInputRepoACLsInTransaction(txnCtx *txncontext.Tra
 	var pipelineName string
 	// Figure out which repos 'pipeline' might no longer be using
 	if prevPipelineInfo != nil {
-		pipelineName = prevPipelineInfo.Pipeline.Name
+		pipelineName = prevPipelineInfo.Pipeline.QualifiedName()
 		pps.VisitInput(prevPipelineInfo.Input, func(input *pps.Input) error {
 			var repo string
 			switch {
@@ -1744,11 +1807,11 @@ func (a *apiServer) fixPipelineInputRepoACLsInTransaction(txnCtx *txncontext.Tra
 	if pipelineInfo != nil {
 		// also check that pipeline name is consistent
 		if pipelineName == "" {
-			pipelineName = pipelineInfo.Pipeline.Name
-		} else if pipelineInfo.Pipeline.Name != pipelineName {
+			pipelineName = pipelineInfo.Pipeline.QualifiedName()
+		} else if pipelineInfo.Pipeline.QualifiedName() != pipelineName {
 			return errors.Errorf("pipelineInfo (%s) and prevPipelineInfo (%s) do not "+
 				"belong to matching pipelines; this is a bug",
-				pipelineInfo.Pipeline.Name, prevPipelineInfo.Pipeline.Name)
+				pipelineInfo.Pipeline.QualifiedName(), pipelineName)
 		}
 
 		// collect inputs (remove redundant inputs from 'remove', but don't
@@ -1778,9 +1841,13 @@ func (a *apiServer) fixPipelineInputRepoACLsInTransaction(txnCtx *txncontext.Tra
 			"previous pipelineInfos == to nil; this is a bug")
 	}
 
+	// The pipeline's output repo, which shares the pipeline's qualified name
+	// and which the auth server also uses to identify the pipeline
+	outputRepo := pipelineName
+
 	// make sure we don't touch the pipeline's permissions on its output repo
-	delete(remove, pipelineName)
-	delete(add, pipelineName)
+	delete(remove, outputRepo)
+	delete(add, outputRepo)
 
 	defer func() {
 		retErr = errors.Wrapf(retErr, "error fixing ACLs on \"%s\"'s input repos", pipelineName)
@@ -1789,20 +1856,20 @@ func (a *apiServer) fixPipelineInputRepoACLsInTransaction(txnCtx *txncontext.Tra
 	// Remove pipeline from old, unused inputs
 	for repo := range remove {
 		// If we get an `ErrNoRoleBinding` that means the input repo no longer exists - we're removing it anyways, so we don't care.
-		if err := a.env.AuthServer().RemovePipelineReaderFromRepoInTransaction(txnCtx, repo, pipelineName); err != nil && !auth.IsErrNoRoleBinding(err) {
+		if err := a.env.AuthServer().RemovePipelineReaderFromRepoInTransaction(txnCtx, repo, outputRepo); err != nil && !auth.IsErrNoRoleBinding(err) {
 			return err
 		}
 	}
 	// Add pipeline to every new input's ACL as a READER
 	for repo := range add {
 		// This raises an error if the input repo doesn't exist, or if the user doesn't have permissions to add a pipeline as a reader on the input repo
-		if err := a.env.AuthServer().AddPipelineReaderToRepoInTransaction(txnCtx, repo, pipelineName); err != nil {
+		if err := a.env.AuthServer().AddPipelineReaderToRepoInTransaction(txnCtx, repo, outputRepo); err != nil {
 			return err
 		}
 	}
 	// Add pipeline to its output repo's ACL as a WRITER if it's new
 	if prevPipelineInfo == nil {
-		if err := a.env.AuthServer().AddPipelineWriterToRepoInTransaction(txnCtx, pipelineName); err != nil {
+		if err := a.env.AuthServer().AddPipelineWriterToRepoInTransaction(txnCtx, outputRepo); err != nil {
 			return err
 		}
 	}
@@ -1812,6 +1879,9 @@ func (a *apiServer) fixPipelineInputRepoACLsInTransaction(txnCtx *txncontext.Tra
 // getExpectedNumWorkers is a helper function for CreatePipeline that transforms
 // the parallelism spec in CreatePipelineRequest.Parallelism into a constant
 // that can be stored in StoredPipelineInfo.Parallelism
+//
+// If 'kc' is nil (i.e. workers run as local processes), the cluster is treated
+// as a single node.
 func getExpectedNumWorkers(kc *kube.Clientset, pipelineInfo *pps.PipelineInfo) (int, error) {
 	switch pspec := pipelineInfo.ParallelismSpec; {
 	case pspec == nil, pspec.Constant == 0 && pspec.Coefficient == 0:
@@ -1820,15 +1890,18 @@ func getExpectedNumWorkers(kc *kube.Clientset, pipelineInfo *pps.PipelineInfo) (
 		return int(pspec.Constant), nil
 	case pspec.Constant == 0 && pspec.Coefficient > 0:
 		// Start ('coefficient' * 'nodes') workers. Determine number of workers
-		nodeList, err := kc.CoreV1().Nodes().List(metav1.ListOptions{})
-		if err != nil {
-			return 0, errors.Wrapf(err, "unable to retrieve node list from k8s to determine parallelism")
-		}
-		if len(nodeList.Items) == 0 {
-			return 0, errors.Errorf("unable to determine parallelism for %q: no k8s nodes found",
-				pipelineInfo.Pipeline.Name)
+		numNodes := 1
+		if kc != nil {
+			nodeList, err := kc.CoreV1().Nodes().List(metav1.ListOptions{})
+			if err != nil {
+				return 0, errors.Wrapf(err, "unable to retrieve node list from k8s to determine parallelism")
+			}
+			if len(nodeList.Items) == 0 {
+				return 0, errors.Errorf("unable to determine parallelism for %q: no k8s nodes found",
+					pipelineInfo.Pipeline.Name)
+			}
+			numNodes = len(nodeList.Items)
 		}
-		numNodes := len(nodeList.Items)
 		floatParallelism := math.Floor(pspec.Coefficient * float64(numNodes))
 		return int(math.Max(floatParallelism, 1)), nil
 	default:
@@ -1873,7 +1946,7 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 	defer func() {
 		tracing.TagAnySpan(span, "err", retErr)
 	}()
-	extended.PersistAny(ctx, a.env.GetEtcdClient(), request.Pipeline.Name)
+	extended.PersistAny(ctx, a.env.GetEtcdClient(), request.Pipeline.QualifiedName())
 
 	// Don't provide a fileset and the transaction env will generate it
 	filesetID := ""
@@ -1891,6 +1964,12 @@ func (a *apiServer) initializePipelineInfo(request *pps.CreatePipelineRequest, o
 		return nil, err
 	}
 
+	if pfs.IsDefaultProject(request.Pipeline.Project.GetName()) {
+		// Pipelines in the default project are stored without it, as they were
+		// before projects existed
+		request.Pipeline.Project = nil
+	}
+
 	// Reprocess overrides the salt in the request
 	if request.Salt == "" || request.Reprocess {
 		request.Salt = uuid.NewWithoutDashes()
@@ -1914,6 +1993,9 @@ func (a *apiServer) initializePipelineInfo(request *pps.CreatePipelineRequest, o
 		EnableStats:           request.EnableStats,
 		Salt:                  request.Salt,
 		MaxQueueSize:          request.MaxQueueSize,
+		MaxQueueSizeEnforced:  true,
+		QueueOverflowPolicy:   request.QueueOverflowPolicy,
+		DatumFailurePolicy:    request.DatumFailurePolicy,
 		Service:               request.Service,
 		Spout:                 request.Spout,
 		ChunkSpec:             request.ChunkSpec,
@@ -1961,9 +2043,9 @@ func (a *apiServer) initializePipelineInfo(request *pps.CreatePipelineRequest, o
 // latestPipelineInfo doesn't actually work transactionally because
 // ppsutil.GetPipelineInfo needs to use pfs.GetFile to read the spec commit,
 // which does not support transactions.
-func (a *apiServer) latestPipelineInfo(txnCtx *txncontext.TransactionContext, pipelineName string) (*pps.PipelineInfo, error) {
+func (a *apiServer) latestPipelineInfo(txnCtx *txncontext.TransactionContext, pipeline *pps.Pipeline) (*pps.PipelineInfo, error) {
 	pipelinePtr := pps.StoredPipelineInfo{}
-	if err := a.pipelines.ReadWrite(txnCtx.SqlTx).Get(pipelineName, &pipelinePtr); err != nil {
+	if err := a.pipelines.ReadWrite(txnCtx.SqlTx).Get(pipeline.QualifiedName(), &pipelinePtr); err != nil {
 		if col.IsErrNotFound(err) {
 			return nil, nil
 		}
@@ -1982,7 +2064,7 @@ func (a *apiServer) pipelineInfosForUpdate(txnCtx *txncontext.TransactionContext
 	// modifying the spec repo depends on being able to access the previous
 	// commit. We therefore use `GetPipelineInfo` which will error if the
 	// spec commit isn't working.
-	oldPipelineInfo, err := a.latestPipelineInfo(txnCtx, request.Pipeline.Name)
+	oldPipelineInfo, err := a.latestPipelineInfo(txnCtx, request.Pipeline)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -2005,7 +2087,8 @@ func (a *apiServer) CreatePipelineInTransaction(
 	if err != nil {
 		return err
 	}
-	pipelineName := request.Pipeline.Name
+	pipelineName := request.Pipeline.QualifiedName()
+	outputRepo := request.Pipeline.OutputRepoName()
 
 	if *specFilesetID != "" {
 		// If we already have a fileset, try to renew it - if that fails, invalidate it
@@ -2038,6 +2121,10 @@ func (a *apiServer) CreatePipelineInTransaction(
 		return &col.ErrTransactionConflict{}
 	}
 
+	if err := a.checkPipelineProject(txnCtx, newPipelineInfo, oldPipelineInfo == nil); err != nil {
+		return err
+	}
+
 	// Verify that all input repos exist (create cron and git repos if necessary)
 	if visitErr := pps.VisitInput(newPipelineInfo.Input, func(input *pps.Input) error {
 		if input.Pfs != nil {
@@ -2079,29 +2166,30 @@ func (a *apiServer) CreatePipelineInTransaction(
 	if request.Update {
 		operation = pipelineOpUpdate
 	}
-	if err := a.authorizePipelineOpInTransaction(txnCtx, operation, newPipelineInfo.Input, newPipelineInfo.Pipeline.Name); err != nil {
+	if err := a.authorizePipelineOpInTransaction(txnCtx, operation, newPipelineInfo.Input, outputRepo); err != nil {
 		return err
 	}
 	update := false
 	if request.Update {
 		// inspect the pipeline to see if this is a real update
-		if _, err := a.inspectPipelineInTransaction(txnCtx, request.Pipeline.Name); err == nil {
+		if _, err := a.inspectPipelineInTransaction(txnCtx, request.Pipeline); err == nil {
 			update = true
 		}
 	}
 	var (
 		// provenance for the pipeline's output branch (includes the spec branch)
 		provenance = append(branchProvenance(newPipelineInfo.Input),
-			client.NewBranch(ppsconsts.SpecRepo, pipelineName))
-		outputBranch     = client.NewBranch(pipelineName, newPipelineInfo.OutputBranch)
-		statsBranch      = client.NewBranch(pipelineName, "stats")
+			ppsutil.SpecBranch(request.Pipeline))
+		outputBranch     = client.NewBranch(outputRepo, newPipelineInfo.OutputBranch)
+		statsBranch      = client.NewBranch(outputRepo, "stats")
 		outputBranchHead *pfs.Commit
 		statsBranchHead  *pfs.Commit
 		specCommit       *pfs.Commit
 	)
 
 	// Get the expected number of workers for this pipeline
-	parallelism, err := getExpectedNumWorkers(a.env.GetKubeClient(), newPipelineInfo)
+	kubeClient, _ := a.kubeClient()
+	parallelism, err := getExpectedNumWorkers(kubeClient, newPipelineInfo)
 	if err != nil {
 		return err
 	}
@@ -2109,7 +2197,7 @@ func (a *apiServer) CreatePipelineInTransaction(
 	if update {
 		// Help user fix inconsistency if previous UpdatePipeline call failed
 		if ci, err := a.env.PfsServer().InspectCommitInTransaction(txnCtx, &pfs.InspectCommitRequest{
-			Commit: client.NewCommit(ppsconsts.SpecRepo, pipelineName, ""),
+			Commit: &pfs.Commit{Branch: ppsutil.SpecBranch(request.Pipeline)},
 		}); err != nil {
 			return err
 		} else if ci.Finished == nil {
@@ -2136,10 +2224,10 @@ func (a *apiServer) CreatePipelineInTransaction(
 		// It does, so we use that as the spec commit, rather than making a new one
 		specCommit = commitInfo.Commit
 		// We also use the existing head for the branches, rather than making a new one.
-		outputBranchHead = client.NewCommit(pipelineName, newPipelineInfo.OutputBranch, "")
-		statsBranchHead = client.NewCommit(pipelineName, "stats", "")
+		outputBranchHead = client.NewCommit(outputRepo, newPipelineInfo.OutputBranch, "")
+		statsBranchHead = client.NewCommit(outputRepo, "stats", "")
 	} else {
-		specCommit, err = a.commitPipelineInfoFromFileset(txnCtx, pipelineName, *specFilesetID, *prevSpecCommit)
+		specCommit, err = a.commitPipelineInfoFromFileset(txnCtx, request.Pipeline, *specFilesetID, *prevSpecCommit)
 		if err != nil {
 			return err
 		}
@@ -2147,7 +2235,7 @@ func (a *apiServer) CreatePipelineInTransaction(
 
 	if update {
 		// finish any open output commits at the end of the transaction
-		txnCtx.FinishPipelineCommits(client.NewBranch(pipelineName, newPipelineInfo.OutputBranch))
+		txnCtx.FinishPipelineCommits(client.NewBranch(outputRepo, newPipelineInfo.OutputBranch))
 
 		// Update the existing StoredPipelineInfo in postgres
 		pipelinePtr := &pps.StoredPipelineInfo{}
@@ -2168,7 +2256,7 @@ func (a *apiServer) CreatePipelineInTransaction(
 			// Generate new pipeline auth token (added due to & add pipeline to the ACLs of input/output repos
 			if err := func() error {
 				oldAuthToken := pipelinePtr.AuthToken
-				token, err := a.env.AuthServer().GetPipelineAuthTokenInTransaction(txnCtx, request.Pipeline.Name)
+				token, err := a.env.AuthServer().GetPipelineAuthTokenInTransaction(txnCtx, pipelineName)
 				if err != nil {
 					if auth.IsErrNotActivated(err) {
 						return nil // no auth work to do
@@ -2207,14 +2295,14 @@ func (a *apiServer) CreatePipelineInTransaction(
 			if err != nil && !isNotFoundErr(err) {
 				return err
 			} else if err == nil {
-				outputBranchHead = client.NewCommit(pipelineName, newPipelineInfo.OutputBranch, "")
+				outputBranchHead = client.NewCommit(outputRepo, newPipelineInfo.OutputBranch, "")
 			}
 
 			_, err = a.env.PfsServer().InspectBranchInTransaction(txnCtx, &pfs.InspectBranchRequest{Branch: statsBranch})
 			if err != nil && !isNotFoundErr(err) {
 				return err
 			} else if err == nil {
-				statsBranchHead = client.NewCommit(pipelineName, "stats", "")
+				statsBranchHead = client.NewCommit(outputRepo, "stats", "")
 			}
 		}
 
@@ -2227,7 +2315,7 @@ func (a *apiServer) CreatePipelineInTransaction(
 		// Create output repo, pipeline output, and stats
 		if err := a.env.PfsServer().CreateRepoInTransaction(txnCtx,
 			&pfs.CreateRepoRequest{
-				Repo:        client.NewRepo(pipelineName),
+				Repo:        client.NewRepo(outputRepo),
 				Description: fmt.Sprintf("Output repo for pipeline %s.", request.Pipeline.Name),
 			}); err != nil && !isAlreadyExistsErr(err) {
 			return err
@@ -2246,7 +2334,7 @@ func (a *apiServer) CreatePipelineInTransaction(
 		// repos
 
 		if err := func() error {
-			token, err := a.env.AuthServer().GetPipelineAuthTokenInTransaction(txnCtx, request.Pipeline.Name)
+			token, err := a.env.AuthServer().GetPipelineAuthTokenInTransaction(txnCtx, pipelineName)
 			if err != nil {
 				if auth.IsErrNotActivated(err) {
 					return nil // no auth work to do
@@ -2315,7 +2403,7 @@ func (a *apiServer) CreatePipelineInTransaction(
 	}
 	if newPipelineInfo.EnableStats {
 		if err := a.env.PfsServer().CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
-			Branch:     client.NewBranch(pipelineName, "stats"),
+			Branch:     client.NewBranch(outputRepo, "stats"),
 			Provenance: []*pfs.Branch{outputBranch},
 			Head:       statsBranchHead,
 		}); err != nil {
@@ -2330,7 +2418,7 @@ func setPipelineDefaults(pipelineInfo *pps.PipelineInfo) error {
 	if pipelineInfo.Transform.Image == "" {
 		pipelineInfo.Transform.Image = DefaultUserImage
 	}
-	setInputDefaults(pipelineInfo.Pipeline.Name, pipelineInfo.Input)
+	setInputDefaults(pipelineInfo.Pipeline.Name, pipelineInfo.Pipeline.Project.GetName(), pipelineInfo.Input)
 	if pipelineInfo.OutputBranch == "" {
 		// Output branches default to master
 		pipelineInfo.OutputBranch = "master"
@@ -2338,9 +2426,6 @@ func setPipelineDefaults(pipelineInfo *pps.PipelineInfo) error {
 	if pipelineInfo.CacheSize == "" {
 		pipelineInfo.CacheSize = "64M"
 	}
-	if pipelineInfo.MaxQueueSize < 1 {
-		pipelineInfo.MaxQueueSize = 1
-	}
 	if pipelineInfo.DatumTries == 0 {
 		pipelineInfo.DatumTries = DefaultDatumTries
 	}
@@ -2358,7 +2443,10 @@ func setPipelineDefaults(pipelineInfo *pps.PipelineInfo) error {
 	return nil
 }
 
-func setInputDefaults(pipelineName string, input *pps.Input) {
+// setInputDefaults sets the default values of the inputs of the pipeline
+// pipelineName, in the project project. Input repos that don't name a project
+// are in the pipeline's project.
+func setInputDefaults(pipelineName, project string, input *pps.Input) {
 	now := time.Now()
 	nCreatedBranches := make(map[string]int)
 	pps.VisitInput(input, func(input *pps.Input) error {
@@ -2376,8 +2464,9 @@ func setInputDefaults(pipelineName string, input *pps.Input) {
 				}
 			}
 			if input.Pfs.Name == "" {
-				input.Pfs.Name = input.Pfs.Repo
+				_, input.Pfs.Name = pfs.SplitProject(input.Pfs.Repo)
 			}
+			input.Pfs.Repo = qualifyRepo(project, input.Pfs.Repo)
 			if input.Pfs.RepoType == "" {
 				input.Pfs.RepoType = pfs.UserRepoType
 			}
@@ -2390,6 +2479,7 @@ func setInputDefaults(pipelineName string, input *pps.Input) {
 			if input.Cron.Repo == "" {
 				input.Cron.Repo = fmt.Sprintf("%s_%s", pipelineName, input.Cron.Name)
 			}
+			input.Cron.Repo = qualifyRepo(project, input.Cron.Repo)
 		}
 		if input.Git != nil {
 			if input.Git.Branch == "" {
@@ -2406,21 +2496,84 @@ func setInputDefaults(pipelineName string, input *pps.Input) {
 	})
 }
 
+// checkPipelineProject returns an error if the pipeline in pipelineInfo can't
+// be created or updated in its project: the project must exist, must be under
+// its pipeline quota if the pipeline is new, and every other project that the
+// pipeline takes inputs from must grant the pipeline's project access.
+func (a *apiServer) checkPipelineProject(txnCtx *txncontext.TransactionContext, pipelineInfo *pps.PipelineInfo, isNew bool) error {
+	project := pipelineInfo.Pipeline.ProjectName()
+	projectInfo, err := a.env.PfsServer().InspectProjectInTransaction(txnCtx, &pfs.InspectProjectRequest{
+		Project: client.NewProject(project),
+	})
+	if err != nil {
+		return err
+	}
+	if max := projectInfo.Quota.GetMaxPipelines(); isNew && max > 0 {
+		var n int64
+		pipelinePtr := &pps.StoredPipelineInfo{}
+		if err := a.pipelines.ReadWrite(txnCtx.SqlTx).List(pipelinePtr, col.DefaultOptions(), func(string) error {
+			if pipelinePtr.Pipeline.ProjectName() == project {
+				n++
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if n >= max {
+			return errors.Errorf("project %q is at its quota of %d pipeline(s)", project, max)
+		}
+	}
+	return pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) error {
+		if input.Pfs == nil {
+			return nil
+		}
+		inputProject, _ := pfs.SplitProject(input.Pfs.Repo)
+		if pfs.IsDefaultProject(inputProject) {
+			inputProject = pfs.DefaultProjectName
+		}
+		if inputProject == project {
+			return nil
+		}
+		inputProjectInfo, err := a.env.PfsServer().InspectProjectInTransaction(txnCtx, &pfs.InspectProjectRequest{
+			Project: client.NewProject(inputProject),
+		})
+		if err != nil {
+			return err
+		}
+		for _, grant := range inputProjectInfo.InputGrants {
+			if grant.Name == project {
+				return nil
+			}
+		}
+		return errors.Errorf("project %q doesn't allow pipelines in project %q to take its repos as inputs", inputProject, project)
+	})
+}
+
+// qualifyRepo qualifies the input repo name repo with project, unless it
+// already names a project.
+func qualifyRepo(project, repo string) string {
+	repoProject, name := pfs.SplitProject(repo)
+	if repoProject == "" {
+		repoProject = project
+	}
+	return pfs.QualifyName(repoProject, name)
+}
+
 // InspectPipeline implements the protobuf pps.InspectPipeline RPC
 func (a *apiServer) InspectPipeline(ctx context.Context, request *pps.InspectPipelineRequest) (response *pps.PipelineInfo, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
-	return a.inspectPipeline(ctx, request.Pipeline.Name)
+	return a.inspectPipeline(ctx, request.Pipeline)
 }
 
 // inspectPipeline contains the functional implementation of InspectPipeline.
 // Many functions (GetLogs, ListPipeline, CreatePipelineJob) need to inspect a
 // pipeline, so they call this instead of making an RPC
-func (a *apiServer) inspectPipeline(ctx context.Context, name string) (*pps.PipelineInfo, error) {
+func (a *apiServer) inspectPipeline(ctx context.Context, pipeline *pps.Pipeline) (*pps.PipelineInfo, error) {
 	var response *pps.PipelineInfo
 	if err := a.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
 		var err error
-		response, err = a.inspectPipelineInTransaction(txnCtx, name)
+		response, err = a.inspectPipelineInTransaction(txnCtx, pipeline)
 		return err
 	}); err != nil {
 		return nil, err
@@ -2428,16 +2581,16 @@ func (a *apiServer) inspectPipeline(ctx context.Context, name string) (*pps.Pipe
 	return response, nil
 }
 
-func (a *apiServer) inspectPipelineInTransaction(txnCtx *txncontext.TransactionContext, name string) (*pps.PipelineInfo, error) {
-	kubeClient := a.env.GetKubeClient()
-	name, ancestors, err := ancestry.Parse(name)
+func (a *apiServer) inspectPipelineInTransaction(txnCtx *txncontext.TransactionContext, pipeline *pps.Pipeline) (*pps.PipelineInfo, error) {
+	name, ancestors, err := ancestry.Parse(pipeline.Name)
 	if err != nil {
 		return nil, err
 	}
+	key := pfs.QualifyName(pipeline.GetProject().GetName(), name)
 	pipelinePtr := pps.StoredPipelineInfo{}
-	if err := a.pipelines.ReadWrite(txnCtx.SqlTx).Get(name, &pipelinePtr); err != nil {
+	if err := a.pipelines.ReadWrite(txnCtx.SqlTx).Get(key, &pipelinePtr); err != nil {
 		if col.IsErrNotFound(err) {
-			return nil, errors.Errorf("pipeline \"%s\" not found", name)
+			return nil, errors.Errorf("pipeline \"%s\" not found", key)
 		}
 		return nil, err
 	}
@@ -2448,8 +2601,11 @@ func (a *apiServer) inspectPipelineInTransaction(txnCtx *txncontext.TransactionC
 	if err != nil {
 		return nil, err
 	}
-	if pipelineInfo.Service != nil {
-		rcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version)
+	// pipelines only have services and a githook if their workers run in
+	// kubernetes
+	kubeClient, kubeErr := a.kubeClient()
+	if pipelineInfo.Service != nil && kubeErr == nil {
+		rcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline, pipelineInfo.Version)
 		if err != nil {
 			return nil, err
 		}
@@ -2470,7 +2626,7 @@ func (a *apiServer) inspectPipelineInTransaction(txnCtx *txncontext.TransactionC
 		}
 		return nil
 	})
-	if hasGitInput {
+	if hasGitInput && kubeErr == nil {
 		pipelineInfo.GithookURL = "pending"
 		svc, err := getGithookService(kubeClient, a.namespace)
 		if err != nil {
@@ -2494,7 +2650,7 @@ func (a *apiServer) inspectPipelineInTransaction(txnCtx *txncontext.TransactionC
 		}
 	}
 
-	workerPoolID := ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version)
+	workerPoolID := ppsutil.PipelineRcName(pipelineInfo.Pipeline, pipelineInfo.Version)
 	workerStatus, err := workerserver.Status(txnCtx.ClientContext, workerPoolID, a.env.GetEtcdClient(), a.etcdPrefix, a.workerGrpcPort)
 	if err != nil {
 		logrus.Errorf("failed to get worker status with err: %s", err.Error())
@@ -2564,6 +2720,9 @@ func (a *apiServer) listPipeline(ctx context.Context, request *pps.ListPipelineR
 	eg.Go(func() error {
 		defer close(infos)
 		return a.listPipelinePtr(ctx, request.Pipeline, request.History, func(ptr *pps.StoredPipelineInfo) error {
+			if request.Project != nil && ptr.Pipeline.ProjectName() != pfsdb.ProjectKey(request.Project) {
+				return nil
+			}
 			select {
 			case <-ctx.Done():
 				return ctx.Err()
@@ -2634,7 +2793,7 @@ func (a *apiServer) listPipelinePtr(ctx context.Context,
 			}
 			// Get parent commit
 			pachClient := a.env.GetPachClient(ctx)
-			ci, err := pachClient.InspectCommit(ppsconsts.SpecRepo, p.SpecCommit.Branch.Name, p.SpecCommit.ID)
+			ci, err := pachClient.InspectCommit(p.SpecCommit.Branch.Repo.QualifiedName(), p.SpecCommit.Branch.Name, p.SpecCommit.ID)
 			switch {
 			case err != nil:
 				return err
@@ -2652,9 +2811,9 @@ func (a *apiServer) listPipelinePtr(ctx context.Context,
 			return err
 		}
 	} else {
-		if err := a.pipelines.ReadOnly(ctx).Get(pipeline.Name, p); err != nil {
+		if err := a.pipelines.ReadOnly(ctx).Get(pipeline.QualifiedName(), p); err != nil {
 			if col.IsErrNotFound(err) {
-				return errors.Errorf("pipeline \"%s\" not found", pipeline.Name)
+				return errors.Errorf("pipeline \"%s\" not found", pipeline.QualifiedName())
 			}
 			return err
 		}
@@ -2671,10 +2830,12 @@ func (a *apiServer) DeletePipeline(ctx context.Context, request *pps.DeletePipel
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 	// Possibly list pipelines in etcd (skip PFS read--don't need it) and delete them
 	if request.All {
-		request.Pipeline = &pps.Pipeline{}
 		pipelinePtr := &pps.StoredPipelineInfo{}
 		if err := a.pipelines.ReadOnly(ctx).List(pipelinePtr, col.DefaultOptions(), func(string) error {
-			request.Pipeline.Name = pipelinePtr.Pipeline.Name
+			request.Pipeline = &pps.Pipeline{
+				Name:    pipelinePtr.Pipeline.Name,
+				Project: pipelinePtr.Pipeline.Project,
+			}
 			_, err := a.deletePipeline(ctx, request)
 			return err
 		}); err != nil {
@@ -2692,17 +2853,18 @@ func (a *apiServer) DeletePipeline(ctx context.Context, request *pps.DeletePipel
 // the pipeline is in an inconsistent state). It's called if a pipeline's
 // etcdPipelineInfo wasn't found, checks if an orphaned branch exists, and if
 // so, deletes the orphaned branch.
-func (a *apiServer) cleanUpSpecBranch(ctx context.Context, pipeline string) error {
+func (a *apiServer) cleanUpSpecBranch(ctx context.Context, pipeline *pps.Pipeline) error {
 	pachClient := a.env.GetPachClient(ctx)
-	specBranchInfo, err := pachClient.InspectBranch(ppsconsts.SpecRepo, pipeline)
+	specBranch := ppsutil.SpecBranch(pipeline)
+	specBranchInfo, err := pachClient.InspectBranch(specBranch.Repo.QualifiedName(), specBranch.Name)
 	if err != nil && (specBranchInfo != nil && specBranchInfo.Head != nil) {
 		// No spec branch (and no etcd pointer) => the pipeline doesn't exist
-		return errors.Wrapf(err, "pipeline %v was not found", pipeline)
+		return errors.Wrapf(err, "pipeline %v was not found", pipeline.QualifiedName())
 	}
 	// branch exists but head is nil => pipeline creation never finished/
 	// pps state is invalid. Delete nil branch
 	return grpcutil.ScrubGRPC(a.sudo(ctx, func(superUserClient *client.APIClient) error {
-		return superUserClient.DeleteBranch(ppsconsts.SpecRepo, pipeline, true)
+		return superUserClient.DeleteBranch(specBranch.Repo.QualifiedName(), specBranch.Name, true)
 	}))
 }
 
@@ -2710,9 +2872,9 @@ func (a *apiServer) deletePipeline(ctx context.Context, request *pps.DeletePipel
 	// Check if there's an StoredPipelineInfo for this pipeline. If not, we can't
 	// authorize, and must return something here
 	pipelinePtr := pps.StoredPipelineInfo{}
-	if err := a.pipelines.ReadOnly(ctx).Get(request.Pipeline.Name, &pipelinePtr); err != nil {
+	if err := a.pipelines.ReadOnly(ctx).Get(request.Pipeline.QualifiedName(), &pipelinePtr); err != nil {
 		if col.IsErrNotFound(err) {
-			if err := a.cleanUpSpecBranch(ctx, request.Pipeline.Name); err != nil {
+			if err := a.cleanUpSpecBranch(ctx, request.Pipeline); err != nil {
 				return nil, err
 			}
 			return &types.Empty{}, nil
@@ -2726,7 +2888,7 @@ func (a *apiServer) deletePipeline(ctx context.Context, request *pps.DeletePipel
 	// - spec commit in etcdPipelineInfo (which may not be the HEAD of the
 	//   pipeline's spec branch)
 	// - kubernetes services (for service pipelines, githook pipelines, etc)
-	pipelineInfo, err := a.inspectPipeline(ctx, request.Pipeline.Name)
+	pipelineInfo, err := a.inspectPipeline(ctx, request.Pipeline)
 	if err != nil {
 		logrus.Errorf("error inspecting pipeline: %v", err)
 		pipelineInfo = &pps.PipelineInfo{Pipeline: request.Pipeline, OutputBranch: "master"}
@@ -2736,20 +2898,20 @@ func (a *apiServer) deletePipeline(ctx context.Context, request *pps.DeletePipel
 
 	// check if the output repo exists--if not, the pipeline is non-functional and
 	// the rest of the delete operation continues without any auth checks
-	if _, err := pachClient.InspectRepo(request.Pipeline.Name); err != nil && !isNotFoundErr(err) && !auth.IsErrNoRoleBinding(err) {
+	if _, err := pachClient.InspectRepo(pipelineInfo.Pipeline.OutputRepoName()); err != nil && !isNotFoundErr(err) && !auth.IsErrNoRoleBinding(err) {
 		return nil, err
 	} else if err == nil {
 		// Check if the caller is authorized to delete this pipeline. This must be
 		// done after cleaning up the spec branch HEAD commit, because the
 		// authorization condition depends on the pipeline's PipelineInfo
-		if err := a.authorizePipelineOp(ctx, pipelineOpDelete, pipelineInfo.Input, pipelineInfo.Pipeline.Name); err != nil {
+		if err := a.authorizePipelineOp(ctx, pipelineOpDelete, pipelineInfo.Input, pipelineInfo.Pipeline.OutputRepoName()); err != nil {
 			return nil, err
 		}
 		if request.KeepRepo {
 			// Remove branch provenance (pass branch twice so that it continues to point
 			// at the same commit, but also pass empty provenance slice)
 			if err := pachClient.CreateBranch(
-				request.Pipeline.Name,
+				pipelineInfo.Pipeline.OutputRepoName(),
 				pipelineInfo.OutputBranch,
 				pipelineInfo.OutputBranch,
 				"",
@@ -2759,7 +2921,7 @@ func (a *apiServer) deletePipeline(ctx context.Context, request *pps.DeletePipel
 			}
 		} else {
 			// delete the pipeline's output repo
-			if err := pachClient.DeleteRepo(request.Pipeline.Name, request.Force); err != nil {
+			if err := pachClient.DeleteRepo(pipelineInfo.Pipeline.OutputRepoName(), request.Force); err != nil {
 				return nil, err
 			}
 		}
@@ -2791,7 +2953,7 @@ func (a *apiServer) deletePipeline(ctx context.Context, request *pps.DeletePipel
 	// pollPipelines.
 	var eg errgroup.Group
 	pipelinJobPtr := &pps.StoredPipelineJobInfo{}
-	if err := a.pipelineJobs.ReadOnly(ctx).GetByIndex(ppsdb.PipelineJobsPipelineIndex, request.Pipeline.Name, pipelinJobPtr, col.DefaultOptions(), func(pipelineJobID string) error {
+	if err := a.pipelineJobs.ReadOnly(ctx).GetByIndex(ppsdb.PipelineJobsPipelineIndex, request.Pipeline.QualifiedName(), pipelinJobPtr, col.DefaultOptions(), func(pipelineJobID string) error {
 		eg.Go(func() error {
 			_, err := a.DeletePipelineJob(ctx, &pps.DeletePipelineJobRequest{PipelineJob: client.NewPipelineJob(pipelineJobID)})
 			if isNotFoundErr(err) || auth.IsErrNoRoleBinding(err) {
@@ -2812,7 +2974,8 @@ func (a *apiServer) deletePipeline(ctx context.Context, request *pps.DeletePipel
 	// commits)
 	eg.Go(func() error {
 		return a.sudo(ctx, func(superUserClient *client.APIClient) error {
-			return grpcutil.ScrubGRPC(superUserClient.DeleteBranch(ppsconsts.SpecRepo, request.Pipeline.Name, request.Force))
+			specBranch := ppsutil.SpecBranch(request.Pipeline)
+			return grpcutil.ScrubGRPC(superUserClient.DeleteBranch(specBranch.Repo.QualifiedName(), specBranch.Name, request.Force))
 		})
 	})
 	// Delete cron input repos
@@ -2829,7 +2992,7 @@ func (a *apiServer) deletePipeline(ctx context.Context, request *pps.DeletePipel
 	// Delete StoredPipelineInfo
 	eg.Go(func() error {
 		if err := col.NewSQLTx(ctx, a.env.GetDBClient(), func(sqlTx *sqlx.Tx) error {
-			return a.pipelines.ReadWrite(sqlTx).Delete(request.Pipeline.Name)
+			return a.pipelines.ReadWrite(sqlTx).Delete(request.Pipeline.QualifiedName())
 		}); err != nil {
 			return errors.Wrapf(err, "collection.Delete")
 		}
@@ -2853,14 +3016,14 @@ func (a *apiServer) StartPipeline(ctx context.Context, request *pps.StartPipelin
 	var pipelineInfo *pps.PipelineInfo
 	if err := a.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
 		var err error
-		pipelineInfo, err = a.latestPipelineInfo(txnCtx, request.Pipeline.Name)
+		pipelineInfo, err = a.latestPipelineInfo(txnCtx, request.Pipeline)
 		return err
 	}); err != nil {
 		return nil, err
 	}
 
 	// check if the caller is authorized to update this pipeline
-	if err := a.authorizePipelineOp(ctx, pipelineOpUpdate, pipelineInfo.Input, pipelineInfo.Pipeline.Name); err != nil {
+	if err := a.authorizePipelineOp(ctx, pipelineOpUpdate, pipelineInfo.Input, pipelineInfo.Pipeline.OutputRepoName()); err != nil {
 		return nil, err
 	}
 
@@ -2870,16 +3033,16 @@ func (a *apiServer) StartPipeline(ctx context.Context, request *pps.StartPipelin
 	if err != nil {
 		return nil, err
 	}
-	if a.updatePipelineSpecCommit(ctx, request.Pipeline.Name, commit); err != nil {
+	if a.updatePipelineSpecCommit(ctx, request.Pipeline, commit); err != nil {
 		return nil, err
 	}
 
 	pachClient := a.env.GetPachClient(ctx)
 	// Replace missing branch provenance (removed by StopPipeline)
 	provenance := append(branchProvenance(pipelineInfo.Input),
-		client.NewBranch(ppsconsts.SpecRepo, pipelineInfo.Pipeline.Name))
+		ppsutil.SpecBranch(pipelineInfo.Pipeline))
 	if err := pachClient.CreateBranch(
-		request.Pipeline.Name,
+		pipelineInfo.Pipeline.OutputRepoName(),
 		pipelineInfo.OutputBranch,
 		pipelineInfo.OutputBranch,
 		"",
@@ -2901,14 +3064,14 @@ func (a *apiServer) StopPipeline(ctx context.Context, request *pps.StopPipelineR
 	var pipelineInfo *pps.PipelineInfo
 	if err := a.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
 		var err error
-		pipelineInfo, err = a.latestPipelineInfo(txnCtx, request.Pipeline.Name)
+		pipelineInfo, err = a.latestPipelineInfo(txnCtx, request.Pipeline)
 		return err
 	}); err != nil {
 		return nil, err
 	}
 
 	// check if the caller is authorized to update this pipeline
-	if err := a.authorizePipelineOp(ctx, pipelineOpUpdate, pipelineInfo.Input, pipelineInfo.Pipeline.Name); err != nil {
+	if err := a.authorizePipelineOp(ctx, pipelineOpUpdate, pipelineInfo.Input, pipelineInfo.Pipeline.OutputRepoName()); err != nil {
 		return nil, err
 	}
 
@@ -2916,7 +3079,7 @@ func (a *apiServer) StopPipeline(ctx context.Context, request *pps.StopPipelineR
 	// Remove branch provenance (pass branch twice so that it continues to point
 	// at the same commit, but also pass empty provenance slice)
 	if err := pachClient.CreateBranch(
-		request.Pipeline.Name,
+		pipelineInfo.Pipeline.OutputRepoName(),
 		pipelineInfo.OutputBranch,
 		pipelineInfo.OutputBranch,
 		"",
@@ -2931,7 +3094,7 @@ func (a *apiServer) StopPipeline(ctx context.Context, request *pps.StopPipelineR
 	if err != nil {
 		return nil, err
 	}
-	if a.updatePipelineSpecCommit(ctx, request.Pipeline.Name, commit); err != nil {
+	if a.updatePipelineSpecCommit(ctx, request.Pipeline, commit); err != nil {
 		return nil, err
 	}
 	return &types.Empty{}, nil
@@ -2946,13 +3109,13 @@ func (a *apiServer) RunPipeline(ctx context.Context, request *pps.RunPipelineReq
 	pfsClient := pachClient.PfsAPIClient
 	ppsClient := pachClient.PpsAPIClient
 
-	pipelineInfo, err := a.inspectPipeline(ctx, request.Pipeline.Name)
+	pipelineInfo, err := a.inspectPipeline(ctx, request.Pipeline)
 	if err != nil {
 		return nil, err
 	}
 	// make sure the user isn't trying to run pipeline on an empty branch
 	branchInfo, err := pfsClient.InspectBranch(ctx, &pfs.InspectBranchRequest{
-		Branch: client.NewBranch(request.Pipeline.Name, pipelineInfo.OutputBranch),
+		Branch: client.NewBranch(pipelineInfo.Pipeline.OutputRepoName(), pipelineInfo.OutputBranch),
 	})
 	if err != nil {
 		return nil, err
@@ -2965,19 +3128,19 @@ func (a *apiServer) RunPipeline(ctx context.Context, request *pps.RunPipelineReq
 	branchProvMap := make(map[string]bool)
 
 	// include the branch and its provenance in the branch provenance map
-	branchProvMap[key(branchInfo.Branch.Repo.Name, branchInfo.Branch.Name)] = true
+	branchProvMap[key(branchInfo.Branch.Repo.QualifiedName(), branchInfo.Branch.Name)] = true
 	for _, b := range branchInfo.Provenance {
-		branchProvMap[key(b.Repo.Name, b.Name)] = true
+		branchProvMap[key(b.Repo.QualifiedName(), b.Name)] = true
 	}
 	if branchInfo.Head != nil {
 		headCommitInfo, err := pfsClient.InspectCommit(ctx, &pfs.InspectCommitRequest{
-			Commit: client.NewCommit(branchInfo.Branch.Repo.Name, branchInfo.Branch.Name, branchInfo.Head.ID),
+			Commit: client.NewCommit(branchInfo.Branch.Repo.QualifiedName(), branchInfo.Branch.Name, branchInfo.Head.ID),
 		})
 		if err != nil {
 			return nil, err
 		}
 		for _, prov := range headCommitInfo.Provenance {
-			branchProvMap[key(prov.Commit.Branch.Repo.Name, prov.Commit.Branch.Name)] = true
+			branchProvMap[key(prov.Commit.Branch.Repo.QualifiedName(), prov.Commit.Branch.Name)] = true
 		}
 	}
 
@@ -3023,18 +3186,18 @@ func (a *apiServer) RunPipeline(ctx context.Context, request *pps.RunPipelineReq
 		// ensure the commit provenance is consistent with the branch provenance
 		branch := prov.Commit.Branch
 		if len(branchProvMap) != 0 {
-			if branch.Repo.Name != ppsconsts.SpecRepo && !branchProvMap[key(branch.Repo.Name, branch.Name)] {
+			if branch.Repo.Name != ppsconsts.SpecRepo && !branchProvMap[key(branch.Repo.QualifiedName(), branch.Name)] {
 				return nil, errors.Errorf("the commit provenance contains a branch which the pipeline's branch is not provenant on")
 			}
 		}
-		provenanceMap[key(branch.Repo.Name, branch.Name)] = prov
+		provenanceMap[key(branch.Repo.QualifiedName(), branch.Name)] = prov
 	}
 
 	// fill in the provenance from branches in the provenance that weren't explicitly set in the request
 	for _, branchProv := range append(branchInfo.Provenance, branchInfo.Branch) {
-		if _, ok := provenanceMap[key(branchProv.Repo.Name, branchProv.Name)]; !ok {
+		if _, ok := provenanceMap[key(branchProv.Repo.QualifiedName(), branchProv.Name)]; !ok {
 			branchInfo, err := pfsClient.InspectBranch(ctx, &pfs.InspectBranchRequest{
-				Branch: client.NewBranch(branchProv.Repo.Name, branchProv.Name),
+				Branch: client.NewBranch(branchProv.Repo.QualifiedName(), branchProv.Name),
 			})
 			if err != nil {
 				return nil, err
@@ -3047,7 +3210,7 @@ func (a *apiServer) RunPipeline(ctx context.Context, request *pps.RunPipelineReq
 				return nil, err
 			}
 			for _, headProv := range headCommit.Provenance {
-				if _, ok := provenanceMap[key(headProv.Commit.Branch.Repo.Name, headProv.Commit.Branch.Name)]; !ok {
+				if _, ok := provenanceMap[key(headProv.Commit.Branch.Repo.QualifiedName(), headProv.Commit.Branch.Name)]; !ok {
 					provenance = append(provenance, headProv)
 				}
 			}
@@ -3055,7 +3218,7 @@ func (a *apiServer) RunPipeline(ctx context.Context, request *pps.RunPipelineReq
 	}
 	// we need to include the spec commit in the provenance, so that the new job is represented by the correct spec commit
 	specProvenance := specCommit.Commit.NewProvenance()
-	if _, ok := provenanceMap[key(specProvenance.Commit.Branch.Repo.Name, specProvenance.Commit.Branch.Name)]; !ok {
+	if _, ok := provenanceMap[key(specProvenance.Commit.Branch.Repo.QualifiedName(), specProvenance.Commit.Branch.Name)]; !ok {
 		provenance = append(provenance, specProvenance)
 	}
 	if _, err := pachClient.ExecuteInTransaction(func(txnClient *client.APIClient) error {
@@ -3071,7 +3234,7 @@ func (a *apiServer) RunPipeline(ctx context.Context, request *pps.RunPipelineReq
 		if pipelineInfo.EnableStats {
 			// it needs to additionally be provenant on the commit we just created
 			_, err = txnClient.PfsAPIClient.StartCommit(txnClient.Ctx(), &pfs.StartCommitRequest{
-				Branch:     client.NewBranch(request.Pipeline.Name, "stats"),
+				Branch:     client.NewBranch(pipelineInfo.Pipeline.OutputRepoName(), "stats"),
 				Provenance: append(provenance, newCommit.NewProvenance()),
 			})
 			if err != nil {
@@ -3090,7 +3253,7 @@ func (a *apiServer) RunCron(ctx context.Context, request *pps.RunCronRequest) (r
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
-	pipelineInfo, err := a.inspectPipeline(ctx, request.Pipeline.Name)
+	pipelineInfo, err := a.inspectPipeline(ctx, request.Pipeline)
 	if err != nil {
 		return nil, err
 	}
@@ -3155,6 +3318,10 @@ func (a *apiServer) CreateSecret(ctx context.Context, request *pps.CreateSecretR
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "CreateSecret")
 	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
+	kubeClient, err := a.kubeClient()
+	if err != nil {
+		return nil, err
+	}
 
 	var s v1.Secret
 	if err := json.Unmarshal(request.GetFile(), &s); err != nil {
@@ -3172,7 +3339,7 @@ func (a *apiServer) CreateSecret(ctx context.Context, request *pps.CreateSecretR
 	labels["secret-source"] = "pachyderm-user"
 	s.SetLabels(labels)
 
-	if _, err := a.env.GetKubeClient().CoreV1().Secrets(a.namespace).Create(&s); err != nil {
+	if _, err := kubeClient.CoreV1().Secrets(a.namespace).Create(&s); err != nil {
 		return nil, errors.Wrapf(err, "failed to create secret")
 	}
 	return &types.Empty{}, nil
@@ -3184,8 +3351,12 @@ func (a *apiServer) DeleteSecret(ctx context.Context, request *pps.DeleteSecretR
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "DeleteSecret")
 	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
+	kubeClient, err := a.kubeClient()
+	if err != nil {
+		return nil, err
+	}
 
-	if err := a.env.GetKubeClient().CoreV1().Secrets(a.namespace).Delete(request.Secret.Name, &metav1.DeleteOptions{}); err != nil {
+	if err := kubeClient.CoreV1().Secrets(a.namespace).Delete(request.Secret.Name, &metav1.DeleteOptions{}); err != nil {
 		return nil, errors.Wrapf(err, "failed to delete secret")
 	}
 	return &types.Empty{}, nil
@@ -3197,8 +3368,12 @@ func (a *apiServer) InspectSecret(ctx context.Context, request *pps.InspectSecre
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "InspectSecret")
 	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
+	kubeClient, err := a.kubeClient()
+	if err != nil {
+		return nil, err
+	}
 
-	secret, err := a.env.GetKubeClient().CoreV1().Secrets(a.namespace).Get(request.Secret.Name, metav1.GetOptions{})
+	secret, err := kubeClient.CoreV1().Secrets(a.namespace).Get(request.Secret.Name, metav1.GetOptions{})
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get secret")
 	}
@@ -3224,8 +3399,12 @@ func (a *apiServer) ListSecret(ctx context.Context, in *types.Empty) (response *
 	defer func(start time.Time) { a.Log(nil, response, retErr, time.Since(start)) }(time.Now())
 	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "ListSecret")
 	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
+	kubeClient, err := a.kubeClient()
+	if err != nil {
+		return nil, err
+	}
 
-	secrets, err := a.env.GetKubeClient().CoreV1().Secrets(a.namespace).List(metav1.ListOptions{
+	secrets, err := kubeClient.CoreV1().Secrets(a.namespace).List(metav1.ListOptions{
 		LabelSelector: "secret-source=pachyderm-user",
 	})
 	if err != nil {
@@ -3265,10 +3444,13 @@ func (a *apiServer) DeleteAll(ctx context.Context, request *types.Empty) (respon
 		return nil, err
 	}
 
-	if err := a.env.GetKubeClient().CoreV1().Secrets(a.namespace).DeleteCollection(&metav1.DeleteOptions{}, metav1.ListOptions{
-		LabelSelector: "secret-source=pachyderm-user",
-	}); err != nil {
-		return nil, err
+	// user secrets only exist if pipeline workers run in kubernetes
+	if kubeClient, err := a.kubeClient(); err == nil {
+		if err := kubeClient.CoreV1().Secrets(a.namespace).DeleteCollection(&metav1.DeleteOptions{}, metav1.ListOptions{
+			LabelSelector: "secret-source=pachyderm-user",
+		}); err != nil {
+			return nil, err
+		}
 	}
 
 	// PFS doesn't delete the spec repo, so do it here
@@ -3294,20 +3476,28 @@ func (a *apiServer) ActivateAuth(ctx context.Context, req *pps.ActivateAuthReque
 	defer func(start time.Time) { a.Log(req, resp, retErr, time.Since(start)) }(time.Now())
 	pachClient := a.env.GetPachClient(ctx)
 
-	// Set the permissions on the spec repo so anyone can read it
-	if err := pachClient.ModifyRepoRoleBinding(ppsconsts.SpecRepo, auth.AllClusterUsersSubject, []string{auth.RepoReaderRole}); err != nil {
-		return nil, errors.Wrapf(grpcutil.ScrubGRPC(err), "cannot configure role binding on spec repo")
+	// Each project has its own spec repo
+	projectInfos, err := pachClient.ListProject()
+	if err != nil {
+		return nil, errors.Wrapf(grpcutil.ScrubGRPC(err), "cannot get list of projects to update")
 	}
+	for _, projectInfo := range projectInfos {
+		specRepo := ppsutil.SpecRepo(projectInfo.Project.Name)
+		// Set the permissions on the spec repo so anyone can read it
+		if err := pachClient.ModifyRepoRoleBinding(specRepo, auth.AllClusterUsersSubject, []string{auth.RepoReaderRole}); err != nil {
+			return nil, errors.Wrapf(grpcutil.ScrubGRPC(err), "cannot configure role binding on spec repo %q", specRepo)
+		}
 
-	// Set the permissions on the spec repo so the PPS user can write to it
-	if err := pachClient.ModifyRepoRoleBinding(ppsconsts.SpecRepo, auth.PpsUser, []string{auth.RepoWriterRole}); err != nil {
-		return nil, errors.Wrapf(grpcutil.ScrubGRPC(err), "cannot configure role binding on spec repo")
+		// Set the permissions on the spec repo so the PPS user can write to it
+		if err := pachClient.ModifyRepoRoleBinding(specRepo, auth.PpsUser, []string{auth.RepoWriterRole}); err != nil {
+			return nil, errors.Wrapf(grpcutil.ScrubGRPC(err), "cannot configure role binding on spec repo %q", specRepo)
+		}
 	}
 
 	// Unauthenticated users can't create new pipelines or repos, and users can't
 	// log in while auth is in an intermediate state, so 'pipelines' is exhaustive
 	var pipelines []*pps.PipelineInfo
-	pipelines, err := pachClient.ListPipeline()
+	pipelines, err = pachClient.ListPipeline()
 	if err != nil {
 		return nil, errors.Wrapf(grpcutil.ScrubGRPC(err), "cannot get list of pipelines to update")
 	}
@@ -3315,7 +3505,7 @@ func (a *apiServer) ActivateAuth(ctx context.Context, req *pps.ActivateAuthReque
 	var eg errgroup.Group
 	for _, pipeline := range pipelines {
 		pipeline := pipeline
-		pipelineName := pipeline.Pipeline.Name
+		pipelineName := pipeline.Pipeline.QualifiedName()
 		// 1) Create a new auth token for 'pipeline' and attach it, so that the
 		// pipeline can authenticate as itself when it needs to read input data
 		eg.Go(func() error {
@@ -3354,7 +3544,8 @@ func isNotFoundErr(err error) bool {
 	return err != nil && strings.Contains(err.Error(), "not found")
 }
 
-func (a *apiServer) updatePipelineSpecCommit(ctx context.Context, pipelineName string, commit *pfs.Commit) error {
+func (a *apiServer) updatePipelineSpecCommit(ctx context.Context, pipeline *pps.Pipeline, commit *pfs.Commit) error {
+	pipelineName := pipeline.QualifiedName()
 	err := col.NewSQLTx(ctx, a.env.GetDBClient(), func(sqlTx *sqlx.Tx) error {
 		pipelines := a.pipelines.ReadWrite(sqlTx)
 		pipelinePtr := &pps.StoredPipelineInfo{}
@@ -3377,22 +3568,23 @@ func RepoNameToEnvString(repoName string) string {
 }
 
 func (a *apiServer) rcPods(rcName string) ([]v1.Pod, error) {
-	podList, err := a.env.GetKubeClient().CoreV1().Pods(a.namespace).List(metav1.ListOptions{
-		TypeMeta: metav1.TypeMeta{
-			Kind:       "ListOptions",
-			APIVersion: "v1",
-		},
-		LabelSelector: metav1.FormatLabelSelector(metav1.SetAsLabelSelector(map[string]string{"app": rcName})),
-	})
-	if err != nil {
-		return nil, err
+	return a.workerRuntime.Pods(rcName)
+}
+
+// kubeClient returns the kubernetes client if pipeline workers run in
+// kubernetes, and errNoKube otherwise (in which case pachd may not be
+// connected to kubernetes at all).
+func (a *apiServer) kubeClient() (*kube.Clientset, error) {
+	k, ok := a.workerRuntime.(*kubeRuntime)
+	if !ok {
+		return nil, errNoKube
 	}
-	return podList.Items, nil
+	return k.kubeClient(), nil
 }
 
 func (a *apiServer) resolveCommit(ctx context.Context, commit *pfs.Commit) (*pfs.Commit, error) {
 	pachClient := a.env.GetPachClient(ctx)
-	ci, err := pachClient.InspectCommit(commit.Branch.Repo.Name, commit.Branch.Name, commit.ID)
+	ci, err := pachClient.InspectCommit(commit.Branch.Repo.QualifiedName(), commit.Branch.Name, commit.ID)
 	if err != nil {
 		return nil, err
 	}
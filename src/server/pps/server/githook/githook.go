@@ -136,7 +136,7 @@ func (s *gitHookServer) handlePush(pl github.PushPayload) (retErr error) {
 	}
 	if pl.Repository.Private {
 		for _, pipelineInfo := range pipelines {
-			if err := ppsutil.FailPipeline(context.Background(), s.env.GetDBClient(), s.pipelines, pipelineInfo.Pipeline.Name, fmt.Sprintf("unable to clone private github repo (%v)", pl.Repository.CloneURL)); err != nil {
+			if err := ppsutil.FailPipeline(context.Background(), s.env.GetDBClient(), s.pipelines, pipelineInfo.Pipeline.QualifiedName(), fmt.Sprintf("unable to clone private github repo (%v)", pl.Repository.CloneURL)); err != nil {
 				// err will be handled but first we want to
 				// try and fail all relevant pipelines
 				logrus.Errorf("error marking pipeline %v as failed %v", pipelineInfo.Pipeline.Name, err)
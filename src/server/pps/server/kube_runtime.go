@@ -0,0 +1,458 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/config"
+	"github.com/pachyderm/pachyderm/v2/src/internal/deploy/assets"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	workerstats "github.com/pachyderm/pachyderm/v2/src/server/worker/stats"
+
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	kube_err "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	kube_watch "k8s.io/apimachinery/pkg/watch"
+	kube "k8s.io/client-go/kubernetes"
+)
+
+// kubeRuntime is the default WorkerRuntime. It runs each pipeline's workers
+// as the pods of a kubernetes replication controller, alongside a service for
+// the workers' gRPC and metrics ports (and, if needed, the pipeline's user
+// service, the githook service, and a pachctl secret for spouts).
+type kubeRuntime struct {
+	// a is used to build workers' pod specs, which depend on most of the PPS
+	// server's configuration
+	a *apiServer
+}
+
+func newKubeRuntime(a *apiServer) *kubeRuntime {
+	return &kubeRuntime{a: a}
+}
+
+func (k *kubeRuntime) kubeClient() *kube.Clientset {
+	return k.a.env.GetKubeClient()
+}
+
+// validate checks that pachd has the kubernetes permissions that it needs,
+// and logs an error for each one that it's missing.
+func (k *kubeRuntime) validate() {
+	errors := false
+	kubeClient := k.kubeClient()
+	_, err := kubeClient.CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		errors = true
+		log.Errorf("unable to access kubernetes nodeslist, Pachyderm will continue to work but it will not be possible to use COEFFICIENT parallelism. error: %v", err)
+	}
+	_, err = kubeClient.CoreV1().Pods(k.a.namespace).Watch(metav1.ListOptions{Watch: true})
+	if err != nil {
+		errors = true
+		log.Errorf("unable to access kubernetes pods, Pachyderm will continue to work but certain pipeline errors will result in pipelines being stuck indefinitely in \"starting\" state. error: %v", err)
+	}
+	pods, err := k.Pods("pachd")
+	if err != nil || len(pods) == 0 {
+		errors = true
+		log.Errorf("unable to access kubernetes pods, Pachyderm will continue to work but 'pachctl logs' will not work. error: %v", err)
+	} else {
+		// No need to check all pods since we're just checking permissions.
+		pod := pods[0]
+		_, err = kubeClient.CoreV1().Pods(k.a.namespace).GetLogs(
+			pod.ObjectMeta.Name, &v1.PodLogOptions{
+				Container: "pachd",
+			}).Timeout(10 * time.Second).Do().Raw()
+		if err != nil {
+			errors = true
+			log.Errorf("unable to access kubernetes logs, Pachyderm will continue to work but 'pachctl logs' will not work. error: %v", err)
+		}
+	}
+	name := uuid.NewWithoutDashes()
+	labels := map[string]string{"app": name}
+	rc := &v1.ReplicationController{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ReplicationController",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+		Spec: v1.ReplicationControllerSpec{
+			Selector: labels,
+			Replicas: new(int32),
+			Template: &v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   name,
+					Labels: labels,
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name:    "name",
+							Image:   DefaultUserImage,
+							Command: []string{"true"},
+						},
+					},
+				},
+			},
+		},
+	}
+	if _, err := kubeClient.CoreV1().ReplicationControllers(k.a.namespace).Create(rc); err != nil {
+		if err != nil {
+			errors = true
+			log.Errorf("unable to create kubernetes replication controllers, Pachyderm will not function properly until this is fixed. error: %v", err)
+		}
+	}
+	if err := kubeClient.CoreV1().ReplicationControllers(k.a.namespace).Delete(name, nil); err != nil {
+		if err != nil {
+			errors = true
+			log.Errorf("unable to delete kubernetes replication controllers, Pachyderm function properly but pipeline cleanup will not work. error: %v", err)
+		}
+	}
+	if !errors {
+		log.Infof("validating kubernetes access returned no errors")
+	}
+}
+
+func (k *kubeRuntime) CreateWorkers(ctx context.Context, ptr *pps.StoredPipelineInfo, pipelineInfo *pps.PipelineInfo, options *workerOptions) error {
+	kubeClient := k.kubeClient()
+	namespace := k.a.namespace
+
+	// create pachctl secret used in spouts
+	if pipelineInfo.Spout != nil {
+		if err := k.createWorkerPachctlSecret(ptr, pipelineInfo); err != nil {
+			return err
+		}
+	}
+
+	podSpec, err := k.a.workerPodSpec(options, pipelineInfo)
+	if err != nil {
+		return err
+	}
+	rc := &v1.ReplicationController{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ReplicationController",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        options.rcName,
+			Labels:      options.labels,
+			Annotations: options.annotations,
+		},
+		Spec: v1.ReplicationControllerSpec{
+			Selector: options.labels,
+			Replicas: &options.parallelism,
+			Template: &v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        options.rcName,
+					Labels:      options.labels,
+					Annotations: options.annotations,
+				},
+				Spec: podSpec,
+			},
+		},
+	}
+	if _, err := kubeClient.CoreV1().ReplicationControllers(namespace).Create(rc); err != nil {
+		if !isAlreadyExistsErr(err) {
+			return err
+		}
+	}
+	serviceAnnotations := map[string]string{
+		"prometheus.io/scrape": "true",
+		"prometheus.io/port":   strconv.Itoa(workerstats.PrometheusPort),
+	}
+
+	service := &v1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        options.rcName,
+			Labels:      options.labels,
+			Annotations: serviceAnnotations,
+		},
+		Spec: v1.ServiceSpec{
+			Selector: options.labels,
+			Ports: []v1.ServicePort{
+				{
+					Port: int32(k.a.workerGrpcPort),
+					Name: "grpc-port",
+				},
+				{
+					Port: workerstats.PrometheusPort,
+					Name: "prometheus-metrics",
+				},
+			},
+		},
+	}
+	if _, err := kubeClient.CoreV1().Services(namespace).Create(service); err != nil {
+		if !isAlreadyExistsErr(err) {
+			return err
+		}
+	}
+
+	if options.service != nil {
+		var servicePort = []v1.ServicePort{
+			{
+				Port:       options.service.ExternalPort,
+				TargetPort: intstr.FromInt(int(options.service.InternalPort)),
+				Name:       "user-port",
+			},
+		}
+		var serviceType = v1.ServiceType(options.service.Type)
+		if serviceType == v1.ServiceTypeNodePort {
+			servicePort[0].NodePort = options.service.ExternalPort
+		}
+		service := &v1.Service{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Service",
+				APIVersion: "v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        options.rcName + "-user",
+				Labels:      options.labels,
+				Annotations: options.annotations,
+			},
+			Spec: v1.ServiceSpec{
+				Selector: options.labels,
+				Type:     serviceType,
+				Ports:    servicePort,
+			},
+		}
+		if _, err := kubeClient.CoreV1().Services(namespace).Create(service); err != nil {
+			if !isAlreadyExistsErr(err) {
+				return err
+			}
+		}
+	}
+
+	var hasGitInput bool
+	pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) error {
+		if input.Git != nil {
+			hasGitInput = true
+			return errutil.ErrBreak
+		}
+		return nil
+	})
+	if hasGitInput {
+		return k.checkOrDeployGithookService()
+	}
+	return nil
+}
+
+func (k *kubeRuntime) createWorkerPachctlSecret(ptr *pps.StoredPipelineInfo, pipelineInfo *pps.PipelineInfo) error {
+	var cfg config.Config
+	err := cfg.InitV2()
+	if err != nil {
+		return errors.Wrapf(err, "error initializing V2 for config")
+	}
+	_, context, err := cfg.ActiveContext(true)
+	if err != nil {
+		return errors.Wrapf(err, "error getting the active context")
+	}
+	context.SessionToken = ptr.AuthToken
+	context.PachdAddress = "localhost:653"
+
+	rawConfig, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return errors.Wrapf(err, "error marshaling the config")
+	}
+	s := v1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   spoutSecretName(pipelineInfo.Pipeline),
+			Labels: labels(pipelineInfo.Pipeline.Name),
+		},
+		Data: map[string][]byte{
+			"config.json": rawConfig,
+		},
+	}
+	labels := s.GetLabels()
+	for k, v := range pipelineLabels(pipelineInfo.Pipeline) {
+		labels[k] = v
+	}
+	s.SetLabels(labels)
+
+	// send RPC to k8s to create the secret there
+	if _, err := k.kubeClient().CoreV1().Secrets(k.a.namespace).Create(&s); err != nil {
+		if !isAlreadyExistsErr(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k *kubeRuntime) checkOrDeployGithookService() error {
+	kubeClient := k.kubeClient()
+	_, err := getGithookService(kubeClient, k.a.namespace)
+	if err != nil {
+		if errors.As(err, &errGithookServiceNotFound{}) {
+			svc := assets.GithookService(k.a.namespace)
+			_, err = kubeClient.CoreV1().Services(k.a.namespace).Create(svc)
+			return err
+		}
+		return err
+	}
+	// service already exists
+	return nil
+}
+
+func (k *kubeRuntime) ScaleWorkers(rc *v1.ReplicationController, replicas int32) error {
+	newRC := *rc
+	newRC.Spec.Replicas = &replicas
+	_, err := k.kubeClient().CoreV1().ReplicationControllers(k.a.namespace).Update(&newRC)
+	return err
+}
+
+func (k *kubeRuntime) DeleteWorkers(pipeline string) error {
+	kubeClient := k.kubeClient()
+	namespace := k.a.namespace
+
+	// Delete any services associated with op.pipeline
+	selector := pipelineSelector(pipeline)
+	opts := &metav1.DeleteOptions{
+		OrphanDependents: &falseVal,
+	}
+	services, err := kubeClient.CoreV1().Services(namespace).List(metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return errors.Wrapf(err, "could not list services")
+	}
+	for _, service := range services.Items {
+		if err := kubeClient.CoreV1().Services(namespace).Delete(service.Name, opts); err != nil {
+			if !isNotFoundErr(err) {
+				return errors.Wrapf(err, "could not delete service %q", service.Name)
+			}
+		}
+	}
+
+	// Delete any secrets associated with op.pipeline
+	secrets, err := kubeClient.CoreV1().Secrets(namespace).List(metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return errors.Wrapf(err, "could not list secrets")
+	}
+	for _, secret := range secrets.Items {
+		if err := kubeClient.CoreV1().Secrets(namespace).Delete(secret.Name, opts); err != nil {
+			if !isNotFoundErr(err) {
+				return errors.Wrapf(err, "could not delete secret %q", secret.Name)
+			}
+		}
+	}
+
+	// Finally, delete op.pipeline's RC, which will cause pollPipelines to stop
+	// polling it.
+	rcs, err := kubeClient.CoreV1().ReplicationControllers(namespace).List(metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return errors.Wrapf(err, "could not list RCs")
+	}
+	for _, rc := range rcs.Items {
+		if err := kubeClient.CoreV1().ReplicationControllers(namespace).Delete(rc.Name, opts); err != nil {
+			if !isNotFoundErr(err) {
+				return errors.Wrapf(err, "could not delete RC %q", rc.Name)
+			}
+		}
+	}
+	return nil
+}
+
+func (k *kubeRuntime) ListWorkers(pipeline string) (*v1.ReplicationControllerList, error) {
+	selector := "suite=pachyderm," + pipelineNameLabel
+	if pipeline != "" {
+		selector = pipelineSelector(pipeline)
+	}
+	return k.kubeClient().CoreV1().ReplicationControllers(k.a.namespace).List(
+		metav1.ListOptions{LabelSelector: selector})
+}
+
+func (k *kubeRuntime) Pods(rcName string) ([]v1.Pod, error) {
+	podList, err := k.kubeClient().CoreV1().Pods(k.a.namespace).List(metav1.ListOptions{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ListOptions",
+			APIVersion: "v1",
+		},
+		LabelSelector: metav1.FormatLabelSelector(metav1.SetAsLabelSelector(map[string]string{"app": rcName})),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return podList.Items, nil
+}
+
+func (k *kubeRuntime) Logs(pod string, opts *v1.PodLogOptions) (io.ReadCloser, error) {
+	return k.kubeClient().CoreV1().Pods(k.a.namespace).GetLogs(pod, opts).Timeout(10 * time.Second).Stream()
+}
+
+// WatchStatus creates a kubernetes watch, and for each event:
+//   1) Checks if the event concerns a Pod
+//   2) Checks if the Pod belongs to a pipeline (pipelineName annotation is set)
+//   3) Checks if the Pod is failing
+// If all three conditions are met, then it calls onCrash for the pipeline (in
+// 'pipelineName' and, outside the default project, 'pipelineProject')
+func (k *kubeRuntime) WatchStatus(ctx context.Context, onCrash func(pipeline, reason string) error) error {
+	kubePipelineWatch, err := k.kubeClient().CoreV1().Pods(k.a.namespace).Watch(
+		metav1.ListOptions{
+			LabelSelector: metav1.FormatLabelSelector(metav1.SetAsLabelSelector(
+				map[string]string{
+					"component": "worker",
+				})),
+			Watch: true,
+		})
+	if err != nil {
+		return errors.Wrap(err, "failed to watch kubernetes pods")
+	}
+	defer kubePipelineWatch.Stop()
+	for {
+		var event kube_watch.Event
+		var ok bool
+		select {
+		case event, ok = <-kubePipelineWatch.ResultChan():
+			if !ok {
+				return nil
+			}
+		case <-ctx.Done():
+			return errors.EnsureStack(ctx.Err())
+		}
+		// if we get an error we restart the watch
+		if event.Type == kube_watch.Error {
+			return errors.Wrap(kube_err.FromObject(event.Object), "error while watching kubernetes pods")
+		} else if event.Type == "" {
+			// k8s watches seem to sometimes get stuck in a loop returning events
+			// with Type = "". We treat these as errors as otherwise we get an
+			// endless stream of them and can't do anything.
+			return errors.New("error while watching kubernetes pods: empty event type")
+		}
+		pod, ok := event.Object.(*v1.Pod)
+		if !ok {
+			continue // irrelevant event
+		}
+		if pod.Status.Phase == v1.PodFailed {
+			log.Errorf("pod failed because: %s", pod.Status.Message)
+		}
+		pipelineName := pipelineFromLabels(pod.ObjectMeta.Annotations)
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.State.Waiting != nil && failures[status.State.Waiting.Reason] {
+				if err := onCrash(pipelineName, status.State.Waiting.Message); err != nil {
+					return err
+				}
+			}
+		}
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == v1.PodScheduled &&
+				condition.Status != v1.ConditionTrue && failures[condition.Reason] {
+				if err := onCrash(pipelineName, condition.Message); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,329 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+const (
+	// localWorkerRestartDelay is how long localRuntime waits before restarting
+	// a worker process that exited unexpectedly (the equivalent of a pod's
+	// 'Always' restart policy).
+	localWorkerRestartDelay = 2 * time.Second
+	// localCrashBuffer is the number of worker crashes that localRuntime
+	// buffers while nothing is watching its status
+	localCrashBuffer = 64
+)
+
+// localRuntime is a WorkerRuntime that runs pipeline workers as processes on
+// the same host as pachd. Workers have no pod spec, services or secrets; the
+// user's code runs directly on pachd's host.
+type localRuntime struct {
+	// binary is the worker binary run for each worker
+	binary string
+	// dir holds each worker's root directory and log file
+	dir string
+	// baseEnv is passed to every worker process, ahead of per-pipeline and
+	// per-worker variables
+	baseEnv []string
+	// crashes receives an event whenever a worker process exits without
+	// being stopped
+	crashes chan localCrash
+
+	mu  sync.Mutex
+	rcs map[string]*localRC // keyed by RC name
+}
+
+type localRC struct {
+	rc      v1.ReplicationController
+	env     []string
+	workers []*localWorker
+}
+
+type localWorker struct {
+	name   string
+	cancel func()
+	done   chan struct{}
+}
+
+type localCrash struct {
+	pipeline, reason string
+}
+
+func newLocalRuntime(config *serviceenv.Configuration, etcdPrefix string) (*localRuntime, error) {
+	dir := filepath.Join(config.StorageRoot, "workers")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	binary, err := exec.LookPath(config.LocalWorkerBinary)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not find local worker binary %q", config.LocalWorkerBinary)
+	}
+	return &localRuntime{
+		binary: binary,
+		dir:    dir,
+		baseEnv: append(os.Environ(),
+			"ETCD_SERVICE_HOST="+config.EtcdHost,
+			"ETCD_SERVICE_PORT="+config.EtcdPort,
+			"POSTGRES_SERVICE_HOST="+config.PostgresServiceHost,
+			"POSTGRES_SERVICE_PORT="+strconv.Itoa(config.PostgresServicePort),
+			"POSTGRES_SERVICE_SSL="+config.PostgresServiceSSL,
+			"POSTGRES_DATABASE_NAME="+config.PostgresDBName,
+			"COORDINATION_BACKEND="+config.CoordinationBackend,
+			client.PeerPortEnv+"="+strconv.FormatUint(uint64(config.PeerPort), 10),
+			client.PPSEtcdPrefixEnv+"="+etcdPrefix,
+			client.PPSWorkerIPEnv+"=127.0.0.1",
+			"PPS_WORKER_ADVERTISE_PORT=true",
+			"PACH_IN_WORKER=true",
+		),
+		crashes: make(chan localCrash, localCrashBuffer),
+		rcs:     make(map[string]*localRC),
+	}, nil
+}
+
+// localWorkerEnv returns the per-pipeline environment of a local worker. Env
+// vars that kubernetes would resolve from secrets or the downward API aren't
+// available to local workers and are skipped.
+func localWorkerEnv(options *workerOptions) []string {
+	env := []string{
+		client.PPSSpecCommitEnv + "=" + options.specCommit,
+		client.PPSProjectNameEnv + "=" + options.project,
+	}
+	for _, e := range options.workerEnv {
+		if e.ValueFrom != nil {
+			log.Warnf("local workers can't set %q from a kubernetes source, skipping", e.Name)
+			continue
+		}
+		env = append(env, e.Name+"="+e.Value)
+	}
+	if options.s3GatewayPort != 0 {
+		env = append(env, "S3GATEWAY_PORT="+strconv.Itoa(int(options.s3GatewayPort)))
+	}
+	return env
+}
+
+func (r *localRuntime) CreateWorkers(ctx context.Context, ptr *pps.StoredPipelineInfo, pipelineInfo *pps.PipelineInfo, options *workerOptions) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.rcs[options.rcName]; ok {
+		return nil
+	}
+	lrc := &localRC{
+		rc: v1.ReplicationController{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        options.rcName,
+				Labels:      options.labels,
+				Annotations: options.annotations,
+			},
+			Spec: v1.ReplicationControllerSpec{
+				Replicas: &options.parallelism,
+			},
+		},
+		env: localWorkerEnv(options),
+	}
+	r.rcs[options.rcName] = lrc
+	return r.reconcile(lrc)
+}
+
+func (r *localRuntime) ListWorkers(pipeline string) (*v1.ReplicationControllerList, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	result := &v1.ReplicationControllerList{}
+	for _, lrc := range r.rcs {
+		if pipeline == "" || pipelineFromLabels(lrc.rc.Labels) == pipeline {
+			result.Items = append(result.Items, lrc.rc)
+		}
+	}
+	sort.Slice(result.Items, func(i, j int) bool {
+		return result.Items[i].Name < result.Items[j].Name
+	})
+	return result, nil
+}
+
+func (r *localRuntime) ScaleWorkers(rc *v1.ReplicationController, replicas int32) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	lrc, ok := r.rcs[rc.Name]
+	if !ok {
+		return errors.Errorf("local workers %q not found", rc.Name)
+	}
+	lrc.rc.Spec.Replicas = &replicas
+	return r.reconcile(lrc)
+}
+
+func (r *localRuntime) DeleteWorkers(pipeline string) error {
+	r.mu.Lock()
+	var stopping []*localWorker
+	for name, lrc := range r.rcs {
+		if pipelineFromLabels(lrc.rc.Labels) != pipeline {
+			continue
+		}
+		stopping = append(stopping, lrc.workers...)
+		delete(r.rcs, name)
+	}
+	r.mu.Unlock()
+	for _, w := range stopping {
+		w.cancel()
+		<-w.done
+	}
+	return nil
+}
+
+// Pods returns a stand-in pod for each running worker in 'rcName', so that
+// callers that look up worker pods (e.g. GetLogs) work with local workers.
+func (r *localRuntime) Pods(rcName string) ([]v1.Pod, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	lrc, ok := r.rcs[rcName]
+	if !ok {
+		return nil, nil
+	}
+	var result []v1.Pod
+	for _, w := range lrc.workers {
+		result = append(result, v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        w.name,
+				Labels:      lrc.rc.Labels,
+				Annotations: lrc.rc.Annotations,
+			},
+			Status: v1.PodStatus{Phase: v1.PodRunning},
+		})
+	}
+	return result, nil
+}
+
+// Logs opens the log file of the local worker 'pod'. Local workers' logs
+// are files, so options such as Follow and TailLines are ignored.
+func (r *localRuntime) Logs(pod string, opts *v1.PodLogOptions) (io.ReadCloser, error) {
+	f, err := os.Open(filepath.Join(r.dir, pod+".log"))
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return f, nil
+}
+
+func (r *localRuntime) WatchStatus(ctx context.Context, onCrash func(pipeline, reason string) error) error {
+	for {
+		select {
+		case crash := <-r.crashes:
+			if err := onCrash(crash.pipeline, crash.reason); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return errors.EnsureStack(ctx.Err())
+		}
+	}
+}
+
+// reconcile starts or stops workers until 'lrc' has as many as its RC's
+// replica count. r.mu must be held.
+func (r *localRuntime) reconcile(lrc *localRC) error {
+	var replicas int
+	if lrc.rc.Spec.Replicas != nil {
+		replicas = int(*lrc.rc.Spec.Replicas)
+	}
+	for len(lrc.workers) > replicas {
+		w := lrc.workers[len(lrc.workers)-1]
+		lrc.workers = lrc.workers[:len(lrc.workers)-1]
+		w.cancel()
+		<-w.done
+	}
+	for len(lrc.workers) < replicas {
+		w, err := r.startWorker(lrc, fmt.Sprintf("%s-%d", lrc.rc.Name, len(lrc.workers)))
+		if err != nil {
+			return err
+		}
+		lrc.workers = append(lrc.workers, w)
+	}
+	return nil
+}
+
+func (r *localRuntime) startWorker(lrc *localRC, name string) (*localWorker, error) {
+	root := filepath.Join(r.dir, name)
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	env := append(append([]string{}, r.baseEnv...), lrc.env...)
+	env = append(env,
+		client.PPSPodNameEnv+"="+name,
+		"PPS_WORKER_ROOT="+root,
+	)
+	pipeline := pipelineFromLabels(lrc.rc.Labels)
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &localWorker{name: name, cancel: cancel, done: make(chan struct{})}
+	go func() {
+		defer close(w.done)
+		for {
+			err := r.runWorker(ctx, name, env)
+			if ctx.Err() != nil {
+				return
+			}
+			reason := fmt.Sprintf("local worker %q exited: %v", name, err)
+			log.Errorf("PPS master: %s", reason)
+			select {
+			case r.crashes <- localCrash{pipeline: pipeline, reason: reason}:
+			default:
+				// WatchStatus has fallen behind. It's safe to drop the event, as
+				// the worker will be restarted and report again if it's still failing
+			}
+			select {
+			case <-time.After(localWorkerRestartDelay):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return w, nil
+}
+
+// runWorker runs one worker process until it exits or 'ctx' is cancelled.
+func (r *localRuntime) runWorker(ctx context.Context, name string, env []string) (retErr error) {
+	port, err := freePort()
+	if err != nil {
+		return err
+	}
+	logFile, err := os.OpenFile(filepath.Join(r.dir, name+".log"), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := logFile.Close(); err != nil && retErr == nil {
+			retErr = errors.EnsureStack(err)
+		}
+	}()
+	cmd := exec.CommandContext(ctx, r.binary)
+	cmd.Env = append(env, client.PPSWorkerPortEnv+"="+strconv.Itoa(port))
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	return errors.EnsureStack(cmd.Run())
+}
+
+// freePort asks the OS for an unused TCP port. There is a small window in
+// which another process can take the port before the worker binds it, in
+// which case the worker exits and is restarted with a new port.
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
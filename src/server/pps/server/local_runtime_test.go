@@ -0,0 +1,192 @@
+package server
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+)
+
+// newTestLocalRuntime returns a localRuntime whose workers run 'script'
+// (a shell script) instead of the worker binary.
+func newTestLocalRuntime(t *testing.T, script string) *localRuntime {
+	dir := t.TempDir()
+	binary := filepath.Join(dir, "worker.sh")
+	require.NoError(t, ioutil.WriteFile(binary, []byte("#!/bin/sh\n"+script+"\n"), 0755))
+	r, err := newLocalRuntime(&serviceenv.Configuration{
+		GlobalConfiguration: &serviceenv.GlobalConfiguration{
+			StorageRoot: filepath.Join(dir, "root"),
+		},
+		PachdSpecificConfiguration: &serviceenv.PachdSpecificConfiguration{
+			LocalWorkerBinary: binary,
+		},
+	}, "pachyderm_pps")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		rcs, err := r.ListWorkers("")
+		require.NoError(t, err)
+		for _, rc := range rcs.Items {
+			require.NoError(t, r.DeleteWorkers(pipelineFromLabels(rc.Labels)))
+		}
+	})
+	return r
+}
+
+func testWorkerOptions(pipeline string, parallelism int32) *workerOptions {
+	return &workerOptions{
+		rcName:      ppsutil.PipelineRcName(client.NewPipeline(pipeline), 1),
+		specCommit:  "abc123",
+		labels:      pipelineLabels(client.NewPipeline(pipeline)),
+		annotations: map[string]string{specCommitAnnotation: "abc123"},
+		parallelism: parallelism,
+	}
+}
+
+func TestLocalRuntimeCreateAndList(t *testing.T) {
+	r := newTestLocalRuntime(t, "exec sleep 60")
+	ctx := context.Background()
+
+	require.NoError(t, r.CreateWorkers(ctx, nil, nil, testWorkerOptions("a", 0)))
+	require.NoError(t, r.CreateWorkers(ctx, nil, nil, testWorkerOptions("b", 0)))
+	// creating existing workers is a no-op
+	require.NoError(t, r.CreateWorkers(ctx, nil, nil, testWorkerOptions("b", 1)))
+
+	rcs, err := r.ListWorkers("")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(rcs.Items))
+	require.Equal(t, ppsutil.PipelineRcName(client.NewPipeline("a"), 1), rcs.Items[0].Name)
+	require.Equal(t, ppsutil.PipelineRcName(client.NewPipeline("b"), 1), rcs.Items[1].Name)
+	require.Equal(t, "abc123", rcs.Items[0].Annotations[specCommitAnnotation])
+	require.Equal(t, int32(0), *rcs.Items[1].Spec.Replicas)
+
+	rcs, err = r.ListWorkers("b")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rcs.Items))
+	require.Equal(t, "b", rcs.Items[0].Labels[pipelineNameLabel])
+
+	require.NoError(t, r.DeleteWorkers("a"))
+	rcs, err = r.ListWorkers("")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rcs.Items))
+	rcs, err = r.ListWorkers("a")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(rcs.Items))
+}
+
+func TestLocalRuntimeProjects(t *testing.T) {
+	r := newTestLocalRuntime(t, "exec sleep 60")
+	ctx := context.Background()
+
+	// pipelines in different projects may share a name
+	require.NoError(t, r.CreateWorkers(ctx, nil, nil, testWorkerOptions("a", 0)))
+	require.NoError(t, r.CreateWorkers(ctx, nil, nil, testWorkerOptions("project/a", 0)))
+	rcs, err := r.ListWorkers("")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(rcs.Items))
+
+	rcs, err = r.ListWorkers("project/a")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rcs.Items))
+	require.Equal(t, ppsutil.PipelineRcName(client.NewPipeline("project/a"), 1), rcs.Items[0].Name)
+	require.Equal(t, "project", rcs.Items[0].Labels[pipelineProjectLabel])
+
+	require.NoError(t, r.DeleteWorkers("project/a"))
+	rcs, err = r.ListWorkers("")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rcs.Items))
+	require.Equal(t, "a", pipelineFromLabels(rcs.Items[0].Labels))
+}
+
+func TestLocalRuntimeScale(t *testing.T) {
+	r := newTestLocalRuntime(t, `echo "started $PPS_POD_NAME"; exec sleep 60`)
+	options := testWorkerOptions("pipeline", 0)
+	require.NoError(t, r.CreateWorkers(context.Background(), nil, nil, options))
+	rcs, err := r.ListWorkers("pipeline")
+	require.NoError(t, err)
+	rc := &rcs.Items[0]
+
+	pods, err := r.Pods(options.rcName)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(pods))
+
+	require.NoError(t, r.ScaleWorkers(rc, 2))
+	pods, err = r.Pods(options.rcName)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(pods))
+	rcs, err = r.ListWorkers("pipeline")
+	require.NoError(t, err)
+	require.Equal(t, int32(2), *rcs.Items[0].Spec.Replicas)
+
+	// each worker writes its output to its own log
+	for _, pod := range pods {
+		pod := pod
+		require.NoErrorWithinTRetry(t, 10*time.Second, func() error {
+			logs, err := r.Logs(pod.Name, nil)
+			if err != nil {
+				return err
+			}
+			defer logs.Close()
+			data, err := ioutil.ReadAll(logs)
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			if !strings.Contains(string(data), "started "+pod.Name) {
+				return errors.Errorf("worker %q hasn't logged yet", pod.Name)
+			}
+			return nil
+		})
+	}
+
+	require.NoError(t, r.ScaleWorkers(rc, 1))
+	pods, err = r.Pods(options.rcName)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(pods))
+
+	require.NoError(t, r.DeleteWorkers("pipeline"))
+	pods, err = r.Pods(options.rcName)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(pods))
+	require.YesError(t, r.ScaleWorkers(rc, 1))
+}
+
+func TestLocalRuntimeWatchStatus(t *testing.T) {
+	r := newTestLocalRuntime(t, "echo failing; exit 1")
+	require.NoError(t, r.CreateWorkers(context.Background(), nil, nil, testWorkerOptions("crashing", 1)))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	errCrashed := errors.New("crashed")
+	var crashedPipeline, crashReason string
+	err := r.WatchStatus(ctx, func(pipeline, reason string) error {
+		crashedPipeline, crashReason = pipeline, reason
+		return errCrashed
+	})
+	require.True(t, errors.Is(err, errCrashed), "unexpected error: %v", err)
+	require.Equal(t, "crashing", crashedPipeline)
+	require.True(t, strings.Contains(crashReason, "exit status 1"), crashReason)
+
+	// WatchStatus returns once its context is cancelled
+	cancel()
+	require.YesError(t, r.WatchStatus(ctx, func(string, string) error { return nil }))
+}
+
+func TestLocalRuntimeMissingBinary(t *testing.T) {
+	_, err := newLocalRuntime(&serviceenv.Configuration{
+		GlobalConfiguration: &serviceenv.GlobalConfiguration{
+			StorageRoot: t.TempDir(),
+		},
+		PachdSpecificConfiguration: &serviceenv.PachdSpecificConfiguration{
+			LocalWorkerBinary: filepath.Join(os.TempDir(), "no-such-worker-binary"),
+		},
+	}, "pachyderm_pps")
+	require.YesError(t, err)
+}
@@ -61,7 +61,7 @@ const crashingBackoff = time.Second * 15
 // and out of standby in response to new output commits appearing in that
 // pipeline's output repo.
 func (m *ppsMaster) startMonitor(pipelineInfo *pps.PipelineInfo, ptr *pps.StoredPipelineInfo) {
-	pipeline := pipelineInfo.Pipeline.Name
+	pipeline := pipelineInfo.Pipeline.QualifiedName()
 	m.monitorCancelsMu.Lock()
 	defer m.monitorCancelsMu.Unlock()
 	if _, ok := m.monitorCancels[pipeline]; !ok {
@@ -94,7 +94,7 @@ func (m *ppsMaster) cancelMonitor(pipeline string) {
 // monitorCrashingPipeline that checks to see if the issues have resolved
 // themselves and moves the pipeline out of crashing if they have.
 func (m *ppsMaster) startCrashingMonitor(parallelism uint64, pipelineInfo *pps.PipelineInfo) {
-	pipeline := pipelineInfo.Pipeline.Name
+	pipeline := pipelineInfo.Pipeline.QualifiedName()
 	m.monitorCancelsMu.Lock()
 	defer m.monitorCancelsMu.Unlock()
 	if _, ok := m.crashingMonitorCancels[pipeline]; !ok {
@@ -175,7 +175,7 @@ func (m *ppsMaster) startMonitorThread(name string, f func(ctx context.Context))
 }
 
 func (m *ppsMaster) monitorPipeline(ctx context.Context, pipelineInfo *pps.PipelineInfo) {
-	pipeline := pipelineInfo.Pipeline.Name
+	pipeline := pipelineInfo.Pipeline.QualifiedName()
 	log.Printf("PPS master: monitoring pipeline %q", pipeline)
 	var eg errgroup.Group
 	pps.VisitInput(pipelineInfo.Input, func(in *pps.Input) error {
@@ -197,7 +197,7 @@ func (m *ppsMaster) monitorPipeline(ctx context.Context, pipelineInfo *pps.Pipel
 			defer close(ciChan)
 			return backoff.RetryNotify(func() error {
 				pachClient := m.a.env.GetPachClient(ctx)
-				return pachClient.SubscribeCommit(pipeline, "",
+				return pachClient.SubscribeCommit(pipelineInfo.Pipeline.OutputRepoName(), "",
 					pipelineInfo.SpecCommit.NewProvenance(),
 					"", pfs.CommitState_READY, func(ci *pfs.CommitInfo) error {
 						ciChan <- ci
@@ -278,10 +278,10 @@ func (m *ppsMaster) monitorPipeline(ctx context.Context, pipelineInfo *pps.Pipel
 							// Wait for the commit to be finished before blocking on the
 							// job because the job may not exist yet.
 							pachClient := m.a.env.GetPachClient(ctx)
-							if _, err := pachClient.BlockCommit(ci.Commit.Branch.Repo.Name, ci.Commit.Branch.Name, ci.Commit.ID); err != nil {
+							if _, err := pachClient.BlockCommit(ci.Commit.Branch.Repo.QualifiedName(), ci.Commit.Branch.Name, ci.Commit.ID); err != nil {
 								return err
 							}
-							if _, err := pachClient.InspectPipelineJobOutputCommit(ci.Commit.Branch.Repo.Name, ci.Commit.Branch.Name, ci.Commit.ID, true); err != nil {
+							if _, err := pachClient.InspectPipelineJobOutputCommit(ci.Commit.Branch.Repo.QualifiedName(), ci.Commit.Branch.Name, ci.Commit.ID, true); err != nil {
 								return err
 							}
 
@@ -332,12 +332,12 @@ func (m *ppsMaster) monitorPipeline(ctx context.Context, pipelineInfo *pps.Pipel
 }
 
 func (m *ppsMaster) monitorCrashingPipeline(ctx context.Context, parallelism uint64, pipelineInfo *pps.PipelineInfo) {
-	pipeline := pipelineInfo.Pipeline.Name
+	pipeline := pipelineInfo.Pipeline.QualifiedName()
 	ctx, cancelInner := context.WithCancel(ctx)
 	if parallelism == 0 {
 		parallelism = 1
 	}
-	pipelineRCName := ppsutil.PipelineRcName(pipeline, pipelineInfo.Version)
+	pipelineRCName := ppsutil.PipelineRcName(pipelineInfo.Pipeline, pipelineInfo.Version)
 	if err := backoff.RetryUntilCancel(ctx, backoff.MustLoop(func() error {
 		workerStatus, err := workerserver.Status(ctx, pipelineRCName,
 			m.a.env.GetEtcdClient(), m.a.etcdPrefix, m.a.workerGrpcPort)
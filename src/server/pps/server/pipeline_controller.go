@@ -19,7 +19,6 @@ import (
 	opentracing "github.com/opentracing/opentracing-go"
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type rcExpectation byte
@@ -261,24 +260,19 @@ func (op *pipelineOp) getPipelineInfo() error {
 // caller shouldn't continue with other operations
 func (op *pipelineOp) getRC(expectation rcExpectation) (retErr error) {
 	span, _ := tracing.AddSpanToAnyExisting(op.ctx,
-		"/pps.Master/GetRC", "pipeline", op.ptr.Pipeline.Name)
+		"/pps.Master/GetRC", "pipeline", op.ptr.Pipeline.QualifiedName())
 	defer func(span opentracing.Span) {
 		tracing.TagAnySpan(span, "err", fmt.Sprintf("%v", retErr))
 		tracing.FinishAnySpan(span)
 	}(span)
 
-	kubeClient := op.m.a.env.GetKubeClient()
-	namespace := op.m.a.namespace
-	selector := fmt.Sprintf("%s=%s", pipelineNameLabel, op.ptr.Pipeline.Name)
-
 	// count error types separately, so that this only errors if the pipeline is
 	// stuck and not changing
 	var notFoundErrCount, unexpectedErrCount, staleErrCount, tooManyErrCount,
 		otherErrCount int
 	return backoff.RetryNotify(func() error {
 		// List all RCs, so stale RCs from old pipelines are noticed and deleted
-		rcs, err := kubeClient.CoreV1().ReplicationControllers(namespace).List(
-			metav1.ListOptions{LabelSelector: selector})
+		rcs, err := op.m.a.workerRuntime.ListWorkers(op.ptr.Pipeline.QualifiedName())
 		if err != nil && !isNotFoundErr(err) {
 			return err
 		}
@@ -331,7 +325,7 @@ func (op *pipelineOp) getRC(expectation rcExpectation) (retErr error) {
 			}
 			return err //return whatever the most recent error was
 		}
-		log.Errorf("PPS master: error retrieving RC for %q: %v; retrying in %v", op.ptr.Pipeline.Name, err, d)
+		log.Errorf("PPS master: error retrieving RC for %q: %v; retrying in %v", op.ptr.Pipeline.QualifiedName(), err, d)
 		return nil
 	})
 }
@@ -341,12 +335,12 @@ func (op *pipelineOp) getRC(expectation rcExpectation) (retErr error) {
 // RC is using e.g. an old spec commit or something.
 func (op *pipelineOp) rcIsFresh() bool {
 	if op.rc == nil {
-		log.Errorf("PPS master: RC for %q is nil", op.ptr.Pipeline.Name)
+		log.Errorf("PPS master: RC for %q is nil", op.ptr.Pipeline.QualifiedName())
 		return false
 	}
 	expectedName := ""
 	if op.pipelineInfo != nil {
-		expectedName = ppsutil.PipelineRcName(op.ptr.Pipeline.Name, op.pipelineInfo.Version)
+		expectedName = ppsutil.PipelineRcName(op.ptr.Pipeline, op.pipelineInfo.Version)
 	}
 
 	// establish current RC properties
@@ -357,19 +351,19 @@ func (op *pipelineOp) rcIsFresh() bool {
 	switch {
 	case rcAuthTokenHash != hashAuthToken(op.ptr.AuthToken):
 		log.Errorf("PPS master: auth token in %q is stale %s != %s",
-			op.ptr.Pipeline.Name, rcAuthTokenHash, hashAuthToken(op.ptr.AuthToken))
+			op.ptr.Pipeline.QualifiedName(), rcAuthTokenHash, hashAuthToken(op.ptr.AuthToken))
 		return false
 	case rcSpecCommit != op.ptr.SpecCommit.ID:
 		log.Errorf("PPS master: spec commit in %q looks stale %s != %s",
-			op.ptr.Pipeline.Name, rcSpecCommit, op.ptr.SpecCommit.ID)
+			op.ptr.Pipeline.QualifiedName(), rcSpecCommit, op.ptr.SpecCommit.ID)
 		return false
 	case rcPachVersion != version.PrettyVersion():
 		log.Errorf("PPS master: %q is using stale pachd v%s != current v%s",
-			op.ptr.Pipeline.Name, rcPachVersion, version.PrettyVersion())
+			op.ptr.Pipeline.QualifiedName(), rcPachVersion, version.PrettyVersion())
 		return false
 	case expectedName != "" && rcName != expectedName:
 		log.Errorf("PPS master: %q has an unexpected (likely stale) name %q != %q",
-			op.ptr.Pipeline.Name, rcName, expectedName)
+			op.ptr.Pipeline.QualifiedName(), rcName, expectedName)
 	}
 	return true
 }
@@ -378,7 +372,7 @@ func (op *pipelineOp) rcIsFresh() bool {
 // etcd watch event and cause step() to eventually run again.
 func (op *pipelineOp) setPipelineState(state pps.PipelineState, reason string) error {
 	if err := op.m.a.setPipelineState(op.ctx,
-		op.ptr.Pipeline.Name, state, reason); err != nil {
+		op.ptr.Pipeline.QualifiedName(), state, reason); err != nil {
 		// don't bother failing if we can't set the state
 		return stepError{
 			error: errors.Wrapf(err, "could not set pipeline state to %v"+
@@ -391,7 +385,7 @@ func (op *pipelineOp) setPipelineState(state pps.PipelineState, reason string) e
 
 // createPipelineResources creates the RC and any services for op's pipeline.
 func (op *pipelineOp) createPipelineResources() error {
-	log.Infof("PPS master: creating resources for pipeline %q", op.ptr.Pipeline.Name)
+	log.Infof("PPS master: creating resources for pipeline %q", op.ptr.Pipeline.QualifiedName())
 	if err := op.m.a.createWorkerSvcAndRc(op.ctx, op.ptr, op.pipelineInfo); err != nil {
 		if errors.As(err, &noValidOptionsErr{}) {
 			// these errors indicate invalid pipelineInfo, don't retry
@@ -419,11 +413,11 @@ func (op *pipelineOp) startCrashingPipelineMonitor() {
 }
 
 func (op *pipelineOp) stopPipelineMonitor() {
-	op.m.cancelMonitor(op.ptr.Pipeline.Name)
+	op.m.cancelMonitor(op.ptr.Pipeline.QualifiedName())
 }
 
 func (op *pipelineOp) stopCrashingPipelineMonitor() {
-	op.m.cancelCrashingMonitor(op.ptr.Pipeline.Name)
+	op.m.cancelCrashingMonitor(op.ptr.Pipeline.QualifiedName())
 }
 
 // finishPipelineOutputCommits finishes any output commits of
@@ -439,11 +433,11 @@ func (op *pipelineOp) stopCrashingPipelineMonitor() {
 // event. This pipeline's output commits will stay open until another watch
 // event arrives for the pipeline and finishPipelineOutputCommits is retried.
 func (op *pipelineOp) finishPipelineOutputCommits() (retErr error) {
-	log.Infof("PPS master: finishing output commits for pipeline %q", op.ptr.Pipeline.Name)
+	log.Infof("PPS master: finishing output commits for pipeline %q", op.ptr.Pipeline.QualifiedName())
 
 	pachClient := op.m.a.env.GetPachClient(op.ctx)
 	if span, _ctx := tracing.AddSpanToAnyExisting(op.ctx,
-		"/pps.Master/FinishPipelineOutputCommits", "pipeline", op.ptr.Pipeline.Name); span != nil {
+		"/pps.Master/FinishPipelineOutputCommits", "pipeline", op.ptr.Pipeline.QualifiedName()); span != nil {
 		pachClient = pachClient.WithCtx(_ctx) // copy span back into pachClient
 		defer func() {
 			tracing.TagAnySpan(span, "err", fmt.Sprintf("%v", retErr))
@@ -452,13 +446,13 @@ func (op *pipelineOp) finishPipelineOutputCommits() (retErr error) {
 	}
 	pachClient.SetAuthToken(op.ptr.AuthToken)
 
-	if err := pachClient.ListCommitF(op.ptr.Pipeline.Name, op.pipelineInfo.OutputBranch, "", "", "", 0, false, func(commitInfo *pfs.CommitInfo) error {
-		return pachClient.StopPipelineJobOutputCommit(commitInfo.Commit.Branch.Repo.Name, commitInfo.Commit.Branch.Name, commitInfo.Commit.ID)
+	if err := pachClient.ListCommitF(op.ptr.Pipeline.OutputRepoName(), op.pipelineInfo.OutputBranch, "", "", "", 0, false, func(commitInfo *pfs.CommitInfo) error {
+		return pachClient.StopPipelineJobOutputCommit(commitInfo.Commit.Branch.Repo.QualifiedName(), commitInfo.Commit.Branch.Name, commitInfo.Commit.ID)
 	}); err != nil {
 		if isNotFoundErr(err) {
 			return nil // already deleted
 		}
-		return errors.Wrapf(err, "could not finish output commits of pipeline %q", op.ptr.Pipeline.Name)
+		return errors.Wrapf(err, "could not finish output commits of pipeline %q", op.ptr.Pipeline.QualifiedName())
 	}
 	return nil
 }
@@ -466,28 +460,16 @@ func (op *pipelineOp) finishPipelineOutputCommits() (retErr error) {
 // deletePipelineResources deletes the RC and services associated with op's
 // pipeline. It doesn't return a stepError, leaving retry behavior to the caller
 func (op *pipelineOp) deletePipelineResources() error {
-	if err := op.m.deletePipelineResources(op.ptr.Pipeline.Name); err != nil {
+	if err := op.m.deletePipelineResources(op.ptr.Pipeline.QualifiedName()); err != nil {
 		return err
 	}
 	return nil
 }
 
-// updateRC is a helper for {scaleUp,scaleDown}Pipeline. It includes all of the
-// logic for writing an updated RC spec to kubernetes, and updating/retrying if
-// k8s rejects the write. It presents a strange API, since the the RC being
-// updated is already available to the caller in op.rc, but update() may be
-// called muliple times if the k8s write fails. It may be helpful to think of
-// the rc passed to update() as mutable, while op.rc is immutable.
-func (op *pipelineOp) updateRC(update func(rc *v1.ReplicationController)) error {
-	kubeClient := op.m.a.env.GetKubeClient()
-	namespace := op.m.a.namespace
-	rc := kubeClient.CoreV1().ReplicationControllers(namespace)
-
-	newRC := *op.rc
-	// Apply op's update to rc
-	update(&newRC)
-	// write updated RC to k8s
-	if _, err := rc.Update(&newRC); err != nil {
+// scaleRC is a helper for {scaleUp,scaleDown}Pipeline. It sets the number of
+// workers in op.rc to 'replicas' through the master's WorkerRuntime.
+func (op *pipelineOp) scaleRC(replicas int32) error {
+	if err := op.m.a.workerRuntime.ScaleWorkers(op.rc, replicas); err != nil {
 		return newRetriableError(err, "error updating RC")
 	}
 	return nil
@@ -496,9 +478,9 @@ func (op *pipelineOp) updateRC(update func(rc *v1.ReplicationController)) error
 // scaleUpPipeline edits the RC associated with op's pipeline & spins up the
 // configured number of workers.
 func (op *pipelineOp) scaleUpPipeline() (retErr error) {
-	log.Infof("PPS master: scaling up workers for %q", op.ptr.Pipeline.Name)
+	log.Infof("PPS master: scaling up workers for %q", op.ptr.Pipeline.QualifiedName())
 	span, _ := tracing.AddSpanToAnyExisting(op.ctx,
-		"/pps.Master/ScaleUpPipeline", "pipeline", op.ptr.Pipeline.Name)
+		"/pps.Master/ScaleUpPipeline", "pipeline", op.ptr.Pipeline.QualifiedName())
 	defer func() {
 		if retErr != nil {
 			log.Errorf("PPS master: error scaling up: %v", retErr)
@@ -515,21 +497,15 @@ func (op *pipelineOp) scaleUpPipeline() (retErr error) {
 	}
 
 	// update pipeline RC
-	return op.updateRC(func(rc *v1.ReplicationController) {
-		if rc.Spec.Replicas != nil && *op.rc.Spec.Replicas == int32(parallelism) {
-			return // prior attempt succeeded
-		}
-		rc.Spec.Replicas = new(int32)
-		*rc.Spec.Replicas = int32(parallelism)
-	})
+	return op.scaleRC(int32(parallelism))
 }
 
 // scaleDownPipeline edits the RC associated with op's pipeline & spins down the
 // configured number of workers.
 func (op *pipelineOp) scaleDownPipeline() (retErr error) {
-	log.Infof("PPS master: scaling down workers for %q", op.ptr.Pipeline.Name)
+	log.Infof("PPS master: scaling down workers for %q", op.ptr.Pipeline.QualifiedName())
 	span, _ := tracing.AddSpanToAnyExisting(op.ctx,
-		"/pps.Master/ScaleDownPipeline", "pipeline", op.ptr.Pipeline.Name)
+		"/pps.Master/ScaleDownPipeline", "pipeline", op.ptr.Pipeline.QualifiedName())
 	defer func() {
 		if retErr != nil {
 			log.Errorf("PPS master: error scaling down: %v", retErr)
@@ -538,12 +514,7 @@ func (op *pipelineOp) scaleDownPipeline() (retErr error) {
 		tracing.FinishAnySpan(span)
 	}()
 
-	return op.updateRC(func(rc *v1.ReplicationController) {
-		if rc.Spec.Replicas != nil && *op.rc.Spec.Replicas == 0 {
-			return // prior attempt succeeded
-		}
-		rc.Spec.Replicas = &zero
-	})
+	return op.scaleRC(0)
 }
 
 // restartPipeline updates the RC/service associated with op's pipeline, and
@@ -575,5 +546,5 @@ func (op *pipelineOp) restartPipeline(reason string) error {
 		return errors.Wrap(err, "error restarting pipeline")
 	}
 
-	return errors.Errorf("restarting pipeline %q: %s", op.ptr.Pipeline.Name, reason)
+	return errors.Errorf("restarting pipeline %q: %s", op.ptr.Pipeline.QualifiedName(), reason)
 }
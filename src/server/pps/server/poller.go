@@ -5,10 +5,6 @@ import (
 	"time"
 
 	log "github.com/sirupsen/logrus"
-	v1 "k8s.io/api/core/v1"
-	kube_err "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	kube_watch "k8s.io/apimachinery/pkg/watch"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
@@ -89,10 +85,7 @@ func (m *ppsMaster) pollPipelines(ctx context.Context) {
 			// CreatePipeline(foo) were to run between querying etcd and querying k8s,
 			// then we might delete the RC for brand-new pipeline 'foo'). Even if we
 			// do delete a live pipeline's RC, it'll be fixed in the next cycle)
-			kc := m.a.env.GetKubeClient().CoreV1().ReplicationControllers(m.a.env.Config().Namespace)
-			rcs, err := kc.List(metav1.ListOptions{
-				LabelSelector: "suite=pachyderm,pipelineName",
-			})
+			rcs, err := m.a.workerRuntime.ListWorkers("")
 			if err != nil {
 				// No sensible error recovery here (e.g .if we can't reach k8s). We'll
 				// keep going, and just won't delete any RCs this round.
@@ -103,7 +96,7 @@ func (m *ppsMaster) pollPipelines(ctx context.Context) {
 			// etcd. Note that there may be zero, and etcdPipelines may be empty
 			if err := m.a.listPipelinePtr(ctx, nil, 0,
 				func(ptr *pps.StoredPipelineInfo) error {
-					etcdPipelines[ptr.Pipeline.Name] = true
+					etcdPipelines[ptr.Pipeline.QualifiedName()] = true
 					return nil
 				}); err != nil {
 				// listPipelinePtr results (etcdPipelines) are used by all remaining
@@ -115,10 +108,10 @@ func (m *ppsMaster) pollPipelines(ctx context.Context) {
 			// 3. Generate a delete event for orphaned RCs
 			if rcs != nil {
 				for _, rc := range rcs.Items {
-					pipeline, ok := rc.Labels["pipelineName"]
-					if !ok {
+					if _, ok := rc.Labels[pipelineNameLabel]; !ok {
 						return errors.New("'pipelineName' label missing from rc " + rc.Name)
 					}
+					pipeline := pipelineFromLabels(rc.Labels)
 					if !etcdPipelines[pipeline] {
 						m.eventCh <- &pipelineEvent{eventType: deleteEv, pipeline: pipeline}
 					}
@@ -164,59 +157,17 @@ func (m *ppsMaster) pollPipelines(ctx context.Context) {
 	}
 }
 
-// pollPipelinePods creates a kubernetes watch, and for each event:
-//   1) Checks if the event concerns a Pod
-//   2) Checks if the Pod belongs to a pipeline (pipelineName annotation is set)
-//   3) Checks if the Pod is failing
-// If all three conditions are met, then the pipline (in 'pipelineName') is set
-// to CRASHING
+// pollPipelinePods watches the status of pipeline workers through the master's
+// WorkerRuntime, and moves any pipeline whose workers are failing to CRASHING
 func (m *ppsMaster) pollPipelinePods(ctx context.Context) {
 	if err := backoff.RetryUntilCancel(ctx, backoff.MustLoop(func() error {
-		kubePipelineWatch, err := m.a.env.GetKubeClient().CoreV1().Pods(m.a.namespace).Watch(
-			metav1.ListOptions{
-				LabelSelector: metav1.FormatLabelSelector(metav1.SetAsLabelSelector(
-					map[string]string{
-						"component": "worker",
-					})),
-				Watch: true,
-			})
-		if err != nil {
-			return errors.Wrap(err, "failed to watch kubernetes pods")
-		}
-		defer kubePipelineWatch.Stop()
-		for event := range kubePipelineWatch.ResultChan() {
-			// if we get an error we restart the watch
-			if event.Type == kube_watch.Error {
-				return errors.Wrap(kube_err.FromObject(event.Object), "error while watching kubernetes pods")
-			} else if event.Type == "" {
-				// k8s watches seem to sometimes get stuck in a loop returning events
-				// with Type = "". We treat these as errors as otherwise we get an
-				// endless stream of them and can't do anything.
-				return errors.New("error while watching kubernetes pods: empty event type")
-			}
-			pod, ok := event.Object.(*v1.Pod)
-			if !ok {
-				continue // irrelevant event
-			}
-			if pod.Status.Phase == v1.PodFailed {
-				log.Errorf("pod failed because: %s", pod.Status.Message)
-			}
-			pipelineName := pod.ObjectMeta.Annotations["pipelineName"]
-			for _, status := range pod.Status.ContainerStatuses {
-				if status.State.Waiting != nil && failures[status.State.Waiting.Reason] {
-					if err := m.a.setPipelineCrashing(ctx, pipelineName, status.State.Waiting.Message); err != nil {
-						return errors.Wrap(err, "error moving pipeline to CRASHING")
-					}
-				}
-			}
-			for _, condition := range pod.Status.Conditions {
-				if condition.Type == v1.PodScheduled &&
-					condition.Status != v1.ConditionTrue && failures[condition.Reason] {
-					if err := m.a.setPipelineCrashing(ctx, pipelineName, condition.Message); err != nil {
-						return errors.Wrap(err, "error moving pipeline to CRASHING")
-					}
-				}
+		if err := m.a.workerRuntime.WatchStatus(ctx, func(pipeline, reason string) error {
+			if err := m.a.setPipelineCrashing(ctx, pipeline, reason); err != nil {
+				return errors.Wrap(err, "error moving pipeline to CRASHING")
 			}
+			return nil
+		}); err != nil {
+			return err
 		}
 		return backoff.ErrContinue // keep polling until cancelled (RetryUntilCancel)
 	}), backoff.NewInfiniteBackOff(), backoff.NotifyContinue("pollPipelinePods"),
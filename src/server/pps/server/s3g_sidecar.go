@@ -15,14 +15,16 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
-	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsconsts"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/watch"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 	"github.com/pachyderm/pachyderm/v2/src/server/pfs/s3"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/datum"
 	logrus "github.com/sirupsen/logrus"
 
 	v1 "k8s.io/api/core/v1"
@@ -62,7 +64,7 @@ func (a *apiServer) ServeSidecarS3G() {
 		defer retryCancel()
 		if err := a.sudo(retryCtx, func(superUserClient *client.APIClient) error {
 			buf := bytes.Buffer{}
-			if err := superUserClient.GetFile(client.NewCommit(ppsconsts.SpecRepo, "", specCommit), ppsconsts.SpecFile, &buf); err != nil {
+			if err := superUserClient.GetFile(client.NewCommit(ppsutil.SpecRepo(a.env.Config().PPSProjectName), "", specCommit), ppsconsts.SpecFile, &buf); err != nil {
 				return errors.Wrapf(err, "could not read existing PipelineInfo from PFS")
 			}
 			if err := proto.Unmarshal(buf.Bytes(), s.pipelineInfo); err != nil {
@@ -78,9 +80,8 @@ func (a *apiServer) ServeSidecarS3G() {
 
 		// Set auth token for s.pachClient (pipelinePtr.AuthToken will be empty if
 		// auth is off)
-		pipelineName := s.pipelineInfo.Pipeline.Name
 		pipelinePtr := &pps.StoredPipelineInfo{}
-		err := a.pipelines.ReadOnly(retryCtx).Get(pipelineName, pipelinePtr)
+		err := a.pipelines.ReadOnly(retryCtx).Get(s.pipelineInfo.Pipeline.QualifiedName(), pipelinePtr)
 		if err != nil {
 			return errors.Wrapf(err, "could not get auth token from etcdPipelineInfo")
 		}
@@ -136,10 +137,10 @@ func (s *sidecarS3G) createK8sServices() {
 	// createK8sServices goes through master election so that only one k8s service
 	// is created per pachyderm job running sidecar s3 gateway
 	backoff.RetryNotify(func() error {
-		masterLock := dlock.NewDLock(s.apiServer.env.GetEtcdClient(),
+		masterLock := serviceenv.NewDLock(s.apiServer.env,
 			path.Join(s.apiServer.etcdPrefix,
 				s3gSidecarLockPath,
-				s.pipelineInfo.Pipeline.Name,
+				s.pipelineInfo.Pipeline.QualifiedName(),
 				s.pipelineInfo.Salt))
 		ctx, err := masterLock.Lock(s.pachClient.Ctx())
 		if err != nil {
@@ -187,7 +188,9 @@ func (s *s3InstanceCreatingJobHandler) OnCreate(ctx context.Context, pipelineJob
 	// Initialize new S3 gateway
 	var inputBuckets []*s3.Bucket
 	pps.VisitInput(pipelineJobInfo.Input, func(in *pps.Input) error {
-		if in.Pfs != nil && in.Pfs.S3 {
+		// S3 inputs with any other glob (i.e. in a join, group or union) are
+		// served as per-datum buckets (see datumBuckets)
+		if in.Pfs != nil && in.Pfs.S3 && in.Pfs.Glob == "/" {
 			inputBuckets = append(inputBuckets, &s3.Bucket{
 				Repo:   in.Pfs.Repo,
 				Branch: in.Pfs.Branch,
@@ -200,13 +203,14 @@ func (s *s3InstanceCreatingJobHandler) OnCreate(ctx context.Context, pipelineJob
 	var outputBucket *s3.Bucket
 	if s.s.pipelineInfo.S3Out {
 		outputBucket = &s3.Bucket{
-			Repo:   pipelineJobInfo.OutputCommit.Branch.Repo.Name,
+			Repo:   pipelineJobInfo.OutputCommit.Branch.Repo.QualifiedName(),
 			Branch: pipelineJobInfo.OutputCommit.Branch.Name,
 			Commit: pipelineJobInfo.OutputCommit.ID,
 			Name:   "out",
 		}
 	}
-	driver := s3.NewWorkerDriver(inputBuckets, outputBucket)
+	datumBuckets := &datumBuckets{input: pipelineJobInfo.Input}
+	driver := s3.NewWorkerDriver(inputBuckets, outputBucket).WithDatumBuckets(datumBuckets.resolve)
 	// TODO(msteffen) always serve on the same port for now (there shouldn't be
 	// more than one job in s.servers). When parallel jobs are implemented, the
 	// servers in s.servers won't actually serve anymore, and instead parent
@@ -276,6 +280,69 @@ func (s *s3InstanceCreatingJobHandler) OnTerminate(jobCtx context.Context, pipel
 	delete(s.s.servers, pipelineJobID) // remove server from map no matter what
 }
 
+// datumBuckets serves the per-datum buckets of a job (see
+// ppsutil.DatumS3Bucket), which expose the files of an s3 input that belong to
+// a single datum. The job's datums are only computed the first time a
+// per-datum bucket is requested.
+type datumBuckets struct {
+	input *pps.Input
+
+	mu sync.Mutex
+	// buckets maps the names of per-datum buckets to the buckets
+	buckets map[string]*s3.Bucket
+}
+
+func (db *datumBuckets) resolve(pc *client.APIClient, name string) (*s3.Bucket, error) {
+	if _, _, ok := ppsutil.ParseDatumS3Bucket(name); !ok {
+		return nil, nil
+	}
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if db.buckets == nil {
+		buckets, err := db.index(pc)
+		if err != nil {
+			return nil, err
+		}
+		db.buckets = buckets
+	}
+	return db.buckets[name], nil
+}
+
+// index iterates over the job's datums (the same datums that the worker
+// processes) and builds the per-datum bucket for each s3 input in each datum.
+func (db *datumBuckets) index(pc *client.APIClient) (map[string]*s3.Bucket, error) {
+	dit, err := datum.NewIterator(pc, db.input)
+	if err != nil {
+		return nil, err
+	}
+	buckets := make(map[string]*s3.Bucket)
+	if err := dit.Iterate(func(meta *datum.Meta) error {
+		datumID := common.DatumID(meta.Inputs)
+		for _, input := range meta.Inputs {
+			if !input.S3 || input.FileInfo.File.Path == "/" {
+				continue
+			}
+			name := ppsutil.DatumS3Bucket(datumID, input.Name)
+			bucket, ok := buckets[name]
+			if !ok {
+				commit := input.FileInfo.File.Commit
+				bucket = &s3.Bucket{
+					Repo:   commit.Branch.Repo.QualifiedName(),
+					Branch: commit.Branch.Name,
+					Commit: commit.ID,
+					Name:   name,
+				}
+				buckets[name] = bucket
+			}
+			bucket.Paths = append(bucket.Paths, input.FileInfo.File.Path)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return buckets, nil
+}
+
 type k8sServiceCreatingJobHandler struct {
 	s *sidecarS3G
 }
@@ -287,7 +354,7 @@ func (s *k8sServiceCreatingJobHandler) S3G() *sidecarS3G {
 func (s *k8sServiceCreatingJobHandler) OnCreate(ctx context.Context, pipelineJobInfo *pps.PipelineJobInfo) {
 	// Create kubernetes service for the current job ('jobInfo')
 	labels := map[string]string{
-		"app":       ppsutil.PipelineRcName(pipelineJobInfo.Pipeline.Name, pipelineJobInfo.PipelineVersion),
+		"app":       ppsutil.PipelineRcName(pipelineJobInfo.Pipeline, pipelineJobInfo.PipelineVersion),
 		"suite":     "pachyderm",
 		"component": "worker",
 	}
@@ -366,7 +433,7 @@ func (h *handleJobsCtx) start() {
 		backoff.Retry(func() error {
 			var err error
 			watcher, err = h.s.apiServer.pipelineJobs.ReadOnly(context.Background()).WatchByIndex(
-				ppsdb.PipelineJobsPipelineIndex, h.s.pipelineInfo.Pipeline.Name)
+				ppsdb.PipelineJobsPipelineIndex, h.s.pipelineInfo.Pipeline.QualifiedName())
 			if err != nil {
 				return errors.Wrapf(err, "error creating watch")
 			}
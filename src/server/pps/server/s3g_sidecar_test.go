@@ -61,10 +61,11 @@ func TestS3PipelineErrors(t *testing.T) {
 
 	c, _ := initPachClient(t)
 
-	repo1, repo2 := tu.UniqueString(t.Name()+"_data"), tu.UniqueString(t.Name()+"_data")
-	require.NoError(t, c.CreateRepo(repo1))
-	require.NoError(t, c.CreateRepo(repo2))
+	repo := tu.UniqueString(t.Name() + "_data")
+	require.NoError(t, c.CreateRepo(repo))
 
+	// S3 inputs outside of join, group and union inputs can only expose a
+	// whole commit
 	pipeline := tu.UniqueString("Pipeline")
 	err := c.CreatePipeline(
 		pipeline,
@@ -77,55 +78,90 @@ func TestS3PipelineErrors(t *testing.T) {
 			Constant: 1,
 		},
 		&pps.Input{
-			Union: []*pps.Input{
-				{Pfs: &pps.PFSInput{
-					Repo:   repo1,
-					Branch: "master",
-					S3:     true,
-					Glob:   "/",
-				}},
-				{Pfs: &pps.PFSInput{
-					Repo:   repo2,
-					Branch: "master",
-					Glob:   "/*",
-				}},
+			Pfs: &pps.PFSInput{
+				Repo:   repo,
+				Branch: "master",
+				S3:     true,
+				Glob:   "/*",
 			},
 		},
 		"",
 		false,
 	)
 	require.YesError(t, err)
-	require.Matches(t, "union", err.Error())
-	err = c.CreatePipeline(
-		pipeline,
-		"",
-		[]string{"bash"},
-		[]string{
-			"ls -R /pfs >/pfs/out/files",
-		},
-		&pps.ParallelismSpec{
-			Constant: 1,
+	require.Matches(t, "glob", err.Error())
+}
+
+func TestS3InputInJoin(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c, userToken := initPachClient(t)
+
+	repo1, repo2 := tu.UniqueString(t.Name()+"_data"), tu.UniqueString(t.Name()+"_data")
+	require.NoError(t, c.CreateRepo(repo1))
+	require.NoError(t, c.CreateRepo(repo2))
+	for _, key := range []string{"a", "b"} {
+		require.NoError(t, c.PutFile(client.NewCommit(repo1, "master", ""), key+"1", strings.NewReader(key)))
+		require.NoError(t, c.PutFile(client.NewCommit(repo2, "master", ""), key+"2", strings.NewReader(key)))
+	}
+
+	// Each datum joins one file from each repo. The s3 input's datum bucket
+	// should contain only the file in the datum.
+	pipeline := tu.UniqueString("Pipeline")
+	pipelineCommit := client.NewCommit(pipeline, "master", "")
+	_, err := c.PpsAPIClient.CreatePipeline(c.Ctx(), &pps.CreatePipelineRequest{
+		Pipeline: client.NewPipeline(pipeline),
+		Transform: &pps.Transform{
+			Image: "pachyderm/ubuntu-with-s3-clients:v0.0.1",
+			Cmd:   []string{"bash", "-x"},
+			Stdin: []string{
+				"aws --endpoint=${S3_ENDPOINT} s3 ls s3://${in1_BUCKET} >/pfs/out/$(ls /pfs/in2)",
+			},
+			Env: map[string]string{
+				"AWS_ACCESS_KEY_ID":     userToken,
+				"AWS_SECRET_ACCESS_KEY": userToken,
+			},
 		},
-		&pps.Input{
+		ParallelismSpec: &pps.ParallelismSpec{Constant: 1},
+		Input: &pps.Input{
 			Join: []*pps.Input{
 				{Pfs: &pps.PFSInput{
+					Name:   "in1",
 					Repo:   repo1,
 					Branch: "master",
 					S3:     true,
-					Glob:   "/",
+					Glob:   "/(*)1",
+					JoinOn: "$1",
 				}},
 				{Pfs: &pps.PFSInput{
+					Name:   "in2",
 					Repo:   repo2,
 					Branch: "master",
-					Glob:   "/*",
+					Glob:   "/(*)2",
+					JoinOn: "$1",
 				}},
 			},
 		},
-		"",
-		false,
-	)
-	require.YesError(t, err)
-	require.Matches(t, "join", err.Error())
+	})
+	require.NoError(t, err)
+
+	pjis, err := c.FlushPipelineJobAll([]*pfs.Commit{client.NewCommit(repo1, "master", "")}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(pjis))
+	require.Equal(t, "JOB_SUCCESS", pjis[0].State.String())
+
+	for _, key := range []string{"a", "b"} {
+		var buf bytes.Buffer
+		require.NoError(t, c.GetFile(pipelineCommit, key+"2", &buf))
+		require.Matches(t, key+"1", buf.String())
+		for _, other := range []string{"a", "b"} {
+			if other != key {
+				require.False(t, strings.Contains(buf.String(), other+"1"), "unexpected %s1 in %q", other, buf.String())
+			}
+		}
+	}
 }
 
 func TestS3Input(t *testing.T) {
@@ -43,7 +43,10 @@ func NewAPIServer(
 		peerPort:              env.Config().PeerPort,
 		gcPercent:             env.Config().GCPercent,
 	}
-	apiServer.validateKube()
+	var err error
+	if apiServer.workerRuntime, err = newWorkerRuntime(apiServer, env.Config()); err != nil {
+		return nil, err
+	}
 	go apiServer.master()
 	return apiServer, nil
 }
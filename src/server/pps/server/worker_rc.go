@@ -5,32 +5,31 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"os"
 	"strconv"
 
 	jsonpatch "github.com/evanphx/json-patch"
 	client "github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/enterprise"
-	"github.com/pachyderm/pachyderm/v2/src/internal/config"
 	"github.com/pachyderm/pachyderm/v2/src/internal/deploy/assets"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
-	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
-	workerstats "github.com/pachyderm/pachyderm/v2/src/server/worker/stats"
 	"github.com/pachyderm/pachyderm/v2/src/version"
 
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/intstr"
 	kube "k8s.io/client-go/kubernetes"
 )
 
 const (
 	pipelineNameLabel         = "pipelineName"
+	pipelineProjectLabel      = "pipelineProject"
 	pachVersionAnnotation     = "version"
 	specCommitAnnotation      = "specCommit"
 	hashedAuthTokenAnnotation = "authTokenHash"
@@ -41,6 +40,7 @@ const (
 type workerOptions struct {
 	rcName        string // Name of the replication controller managing workers
 	specCommit    string // Pipeline spec commit ID (needed for s3 inputs)
+	project       string // Pipeline's project, whose spec repo holds specCommit
 	s3GatewayPort int32  // s3 gateway port (if any s3 pipeline inputs)
 
 	userImage             string              // The user's pipeline/job image
@@ -64,20 +64,56 @@ type workerOptions struct {
 	service          *pps.Service
 }
 
+// pipelineLabels returns the labels that identify pipeline's k8s resources.
+// Pipelines in the default project aren't labeled with a project, so that
+// their resources look the same as before projects existed.
+func pipelineLabels(pipeline *pps.Pipeline) map[string]string {
+	labels := map[string]string{pipelineNameLabel: pipeline.Name}
+	if project := pipeline.GetProject().GetName(); !pfs.IsDefaultProject(project) {
+		labels[pipelineProjectLabel] = project
+	}
+	return labels
+}
+
+// pipelineFromLabels returns the qualified name of the pipeline whose k8s
+// resource has labels (or annotations), undoing pipelineLabels.
+func pipelineFromLabels(labels map[string]string) string {
+	return pfs.QualifyName(labels[pipelineProjectLabel], labels[pipelineNameLabel])
+}
+
+// pipelineSelector returns a label selector matching the k8s resources of the
+// pipeline with the qualified name pipeline.
+func pipelineSelector(pipeline string) string {
+	project, name := pfs.SplitProject(pipeline)
+	if project == "" {
+		return fmt.Sprintf("%s=%s,!%s", pipelineNameLabel, name, pipelineProjectLabel)
+	}
+	return fmt.Sprintf("%s=%s,%s=%s", pipelineNameLabel, name, pipelineProjectLabel, project)
+}
+
+// spoutSecretName returns the name of the secret holding a spout pipeline's
+// pachctl config.
+func spoutSecretName(pipeline *pps.Pipeline) string {
+	if project := pipeline.GetProject().GetName(); !pfs.IsDefaultProject(project) {
+		return "spout-pachctl-secret-" + project + "-" + pipeline.Name
+	}
+	return "spout-pachctl-secret-" + pipeline.Name
+}
+
 // getPachctlSecretVolumeAndMount returns a Volume and
 // VolumeMount object configured for the pachctl secret (currently used in spout pipelines).
 func getPachctlSecretVolumeAndMount(secret string) (v1.Volume, v1.VolumeMount) {
 	return v1.Volume{
-			Name: client.PachctlSecretName,
-			VolumeSource: v1.VolumeSource{
-				Secret: &v1.SecretVolumeSource{
-					SecretName: secret,
-				},
+		Name: client.PachctlSecretName,
+		VolumeSource: v1.VolumeSource{
+			Secret: &v1.SecretVolumeSource{
+				SecretName: secret,
 			},
-		}, v1.VolumeMount{
-			Name:      client.PachctlSecretName,
-			MountPath: "/pachctl",
-		}
+		},
+	}, v1.VolumeMount{
+		Name:      client.PachctlSecretName,
+		MountPath: "/pachctl",
+	}
 }
 
 func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.PipelineInfo) (v1.PodSpec, error) {
@@ -108,6 +144,9 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 	}, {
 		Name:  client.PPSSpecCommitEnv,
 		Value: options.specCommit,
+	}, {
+		Name:  client.PPSProjectNameEnv,
+		Value: options.project,
 	}, {
 		Name: "PACHD_POD_NAME",
 		ValueFrom: &v1.EnvVarSource{
@@ -171,6 +210,10 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 			Name:  client.PPSSpecCommitEnv,
 			Value: options.specCommit,
 		},
+		{
+			Name:  client.PPSProjectNameEnv,
+			Value: options.project,
+		},
 		{
 			Name:  client.PPSWorkerPortEnv,
 			Value: strconv.FormatUint(uint64(a.workerGrpcPort), 10),
@@ -193,6 +236,9 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 			Value: strconv.FormatUint(uint64(options.s3GatewayPort), 10),
 		})
 	}
+	// Workers and sidecars must coordinate through the same backend as pachd
+	sidecarEnv = append(sidecarEnv, v1.EnvVar{Name: "COORDINATION_BACKEND", Value: a.env.Config().CoordinationBackend})
+	workerEnv = append(workerEnv, v1.EnvVar{Name: "COORDINATION_BACKEND", Value: a.env.Config().CoordinationBackend})
 	// Propagate feature flags to worker and sidecar
 	if a.env.Config().DisableCommitProgressCounter {
 		sidecarEnv = append(sidecarEnv, v1.EnvVar{Name: "DISABLE_COMMIT_PROGRESS_COUNTER", Value: "true"})
@@ -202,6 +248,15 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 		sidecarEnv = append(sidecarEnv, v1.EnvVar{Name: "LOKI_LOGGING", Value: "true"})
 		workerEnv = append(workerEnv, v1.EnvVar{Name: "LOKI_LOGGING", Value: "true"})
 	}
+	// Propagate the continuous profiling configuration to the worker
+	profiling := a.env.Config().ProfilingConfiguration
+	workerEnv = append(workerEnv, []v1.EnvVar{
+		{Name: "PROFILING_INTERVAL", Value: profiling.ProfilingInterval},
+		{Name: "PROFILING_HEAP_THRESHOLD", Value: strconv.FormatInt(profiling.ProfilingHeapThreshold, 10)},
+		{Name: "PROFILING_SLOW_DATUMS", Value: strconv.FormatBool(profiling.ProfilingSlowDatums)},
+		{Name: "PROFILING_RETENTION", Value: profiling.ProfilingRetention},
+		{Name: "PROFILING_CPU_DURATION", Value: profiling.ProfilingCPUDuration},
+	}...)
 
 	// This only happens in local deployment.  We want the workers to be
 	// able to read from/write to the hostpath volume as well.
@@ -247,7 +302,7 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 
 	// mount secret for spouts using pachctl
 	if pipelineInfo.Spout != nil {
-		pachctlSecretVolume, pachctlSecretMount := getPachctlSecretVolumeAndMount("spout-pachctl-secret-" + pipelineInfo.Pipeline.Name)
+		pachctlSecretVolume, pachctlSecretMount := getPachctlSecretVolumeAndMount(spoutSecretName(pipelineInfo.Pipeline))
 		options.volumes = append(options.volumes, pachctlSecretVolume)
 		sidecarVolumeMounts = append(sidecarVolumeMounts, pachctlSecretMount)
 		userVolumeMounts = append(userVolumeMounts, pachctlSecretMount)
@@ -427,8 +482,23 @@ func (a *apiServer) getStorageEnvVars(pipelineInfo *pps.PipelineInfo) []v1.EnvVa
 	vars := []v1.EnvVar{
 		{Name: assets.UploadConcurrencyLimitEnvVar, Value: strconv.Itoa(a.env.Config().StorageUploadConcurrencyLimit)},
 	}
+	// sidecars read chunks from whichever tier they're in
+	if url := a.env.Config().StorageColdTierURL; url != "" {
+		vars = append(vars, v1.EnvVar{Name: assets.ColdTierURLEnvVar, Value: url})
+	}
+	// sidecars write chunks, so they mirror them like pachd
+	if url := a.env.Config().StorageMirrorURL; url != "" {
+		vars = append(vars,
+			v1.EnvVar{Name: assets.MirrorURLEnvVar, Value: url},
+			v1.EnvVar{Name: assets.MirrorModeEnvVar, Value: a.env.Config().StorageMirrorMode},
+		)
+	}
 	if pipelineInfo.Spout != nil {
-		vars = append(vars, v1.EnvVar{Name: "SPOUT_PIPELINE_NAME", Value: pipelineInfo.Pipeline.Name})
+		vars = append(vars, v1.EnvVar{Name: "SPOUT_PIPELINE_NAME", Value: pipelineInfo.Pipeline.QualifiedName()})
+	}
+	// cache_size was validated when the pipeline was created
+	if cacheBytes, err := ppsutil.CacheBytes(pipelineInfo); err == nil && cacheBytes > 0 {
+		vars = append(vars, v1.EnvVar{Name: assets.ChunkCacheBytesEnvVar, Value: strconv.FormatInt(cacheBytes, 10)})
 	}
 	return vars
 }
@@ -445,7 +515,6 @@ func hashAuthToken(token string) string {
 }
 
 func (a *apiServer) getWorkerOptions(ptr *pps.StoredPipelineInfo, pipelineInfo *pps.PipelineInfo) (*workerOptions, error) {
-	pipelineName := pipelineInfo.Pipeline.Name
 	pipelineVersion := pipelineInfo.Version
 	var resourceRequests *v1.ResourceList
 	var resourceLimits *v1.ResourceList
@@ -473,9 +542,11 @@ func (a *apiServer) getWorkerOptions(ptr *pps.StoredPipelineInfo, pipelineInfo *
 	}
 
 	transform := pipelineInfo.Transform
-	rcName := ppsutil.PipelineRcName(pipelineName, pipelineVersion)
+	rcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline, pipelineVersion)
 	labels := labels(rcName)
-	labels[pipelineNameLabel] = pipelineName
+	for k, v := range pipelineLabels(pipelineInfo.Pipeline) {
+		labels[k] = v
+	}
 	userImage := transform.Image
 	if userImage == "" {
 		userImage = DefaultUserImage
@@ -556,12 +627,10 @@ func (a *apiServer) getWorkerOptions(ptr *pps.StoredPipelineInfo, pipelineInfo *
 		imagePullSecrets = append(imagePullSecrets, v1.LocalObjectReference{Name: a.imagePullSecret})
 	}
 
-	annotations := map[string]string{
-		pipelineNameLabel:         pipelineName,
-		pachVersionAnnotation:     version.PrettyVersion(),
-		specCommitAnnotation:      ptr.SpecCommit.ID,
-		hashedAuthTokenAnnotation: hashAuthToken(ptr.AuthToken),
-	}
+	annotations := pipelineLabels(pipelineInfo.Pipeline)
+	annotations[pachVersionAnnotation] = version.PrettyVersion()
+	annotations[specCommitAnnotation] = ptr.SpecCommit.ID
+	annotations[hashedAuthTokenAnnotation] = hashAuthToken(ptr.AuthToken)
 	if a.iamRole != "" {
 		annotations["iam.amazonaws.com/role"] = a.iamRole
 	}
@@ -602,6 +671,7 @@ func (a *apiServer) getWorkerOptions(ptr *pps.StoredPipelineInfo, pipelineInfo *
 		rcName:                rcName,
 		s3GatewayPort:         s3GatewayPort,
 		specCommit:            ptr.SpecCommit.ID,
+		project:               pipelineInfo.Pipeline.ProjectName(),
 		labels:                labels,
 		annotations:           annotations,
 		parallelism:           int32(0), // pipelines start w/ 0 workers & are scaled up
@@ -621,49 +691,6 @@ func (a *apiServer) getWorkerOptions(ptr *pps.StoredPipelineInfo, pipelineInfo *
 	}, nil
 }
 
-func (a *apiServer) createWorkerPachctlSecret(ctx context.Context, ptr *pps.StoredPipelineInfo, pipelineInfo *pps.PipelineInfo) error {
-	var cfg config.Config
-	err := cfg.InitV2()
-	if err != nil {
-		return errors.Wrapf(err, "error initializing V2 for config")
-	}
-	_, context, err := cfg.ActiveContext(true)
-	if err != nil {
-		return errors.Wrapf(err, "error getting the active context")
-	}
-	context.SessionToken = ptr.AuthToken
-	context.PachdAddress = "localhost:653"
-
-	rawConfig, err := json.MarshalIndent(cfg, "", "  ")
-	if err != nil {
-		return errors.Wrapf(err, "error marshaling the config")
-	}
-	s := v1.Secret{
-		TypeMeta: metav1.TypeMeta{
-			Kind:       "Secret",
-			APIVersion: "v1",
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:   "spout-pachctl-secret-" + pipelineInfo.Pipeline.Name,
-			Labels: labels(pipelineInfo.Pipeline.Name),
-		},
-		Data: map[string][]byte{
-			"config.json": rawConfig,
-		},
-	}
-	labels := s.GetLabels()
-	labels["pipelineName"] = pipelineInfo.Pipeline.Name
-	s.SetLabels(labels)
-
-	// send RPC to k8s to create the secret there
-	if _, err := a.env.GetKubeClient().CoreV1().Secrets(a.namespace).Create(&s); err != nil {
-		if !isAlreadyExistsErr(err) {
-			return err
-		}
-	}
-	return nil
-}
-
 // noValidOptions error may be returned by createWorkerSvcAndRc to indicate that
 // getWorkerOptions returned an error to it (getWorkerOptions does not return
 // noValidOptions). This is a mechanism for createWorkerSvcAndRc to signal to
@@ -673,154 +700,19 @@ type noValidOptionsErr struct {
 }
 
 func (a *apiServer) createWorkerSvcAndRc(ctx context.Context, ptr *pps.StoredPipelineInfo, pipelineInfo *pps.PipelineInfo) (retErr error) {
-	log.Infof("PPS master: upserting workers for %q", pipelineInfo.Pipeline.Name)
-	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/pps.Master/CreateWorkerRC", // ctx never used, but we want the right one in scope for future uses
-		"pipeline", pipelineInfo.Pipeline.Name)
+	log.Infof("PPS master: upserting workers for %q", pipelineInfo.Pipeline.QualifiedName())
+	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/pps.Master/CreateWorkerRC",
+		"pipeline", pipelineInfo.Pipeline.QualifiedName())
 	defer func() {
 		tracing.TagAnySpan(span, "err", retErr)
 		tracing.FinishAnySpan(span)
 	}()
 
-	// create pachctl secret used in spouts
-	if pipelineInfo.Spout != nil {
-		if err := a.createWorkerPachctlSecret(ctx, ptr, pipelineInfo); err != nil {
-			return err
-		}
-	}
-
 	options, err := a.getWorkerOptions(ptr, pipelineInfo)
 	if err != nil {
 		return noValidOptionsErr{err}
 	}
-	podSpec, err := a.workerPodSpec(options, pipelineInfo)
-	if err != nil {
-		return err
-	}
-	rc := &v1.ReplicationController{
-		TypeMeta: metav1.TypeMeta{
-			Kind:       "ReplicationController",
-			APIVersion: "v1",
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:        options.rcName,
-			Labels:      options.labels,
-			Annotations: options.annotations,
-		},
-		Spec: v1.ReplicationControllerSpec{
-			Selector: options.labels,
-			Replicas: &options.parallelism,
-			Template: &v1.PodTemplateSpec{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:        options.rcName,
-					Labels:      options.labels,
-					Annotations: options.annotations,
-				},
-				Spec: podSpec,
-			},
-		},
-	}
-	if _, err := a.env.GetKubeClient().CoreV1().ReplicationControllers(a.namespace).Create(rc); err != nil {
-		if !isAlreadyExistsErr(err) {
-			return err
-		}
-	}
-	serviceAnnotations := map[string]string{
-		"prometheus.io/scrape": "true",
-		"prometheus.io/port":   strconv.Itoa(workerstats.PrometheusPort),
-	}
-
-	service := &v1.Service{
-		TypeMeta: metav1.TypeMeta{
-			Kind:       "Service",
-			APIVersion: "v1",
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:        options.rcName,
-			Labels:      options.labels,
-			Annotations: serviceAnnotations,
-		},
-		Spec: v1.ServiceSpec{
-			Selector: options.labels,
-			Ports: []v1.ServicePort{
-				{
-					Port: int32(a.workerGrpcPort),
-					Name: "grpc-port",
-				},
-				{
-					Port: workerstats.PrometheusPort,
-					Name: "prometheus-metrics",
-				},
-			},
-		},
-	}
-	if _, err := a.env.GetKubeClient().CoreV1().Services(a.namespace).Create(service); err != nil {
-		if !isAlreadyExistsErr(err) {
-			return err
-		}
-	}
-
-	if options.service != nil {
-		var servicePort = []v1.ServicePort{
-			{
-				Port:       options.service.ExternalPort,
-				TargetPort: intstr.FromInt(int(options.service.InternalPort)),
-				Name:       "user-port",
-			},
-		}
-		var serviceType = v1.ServiceType(options.service.Type)
-		if serviceType == v1.ServiceTypeNodePort {
-			servicePort[0].NodePort = options.service.ExternalPort
-		}
-		service := &v1.Service{
-			TypeMeta: metav1.TypeMeta{
-				Kind:       "Service",
-				APIVersion: "v1",
-			},
-			ObjectMeta: metav1.ObjectMeta{
-				Name:        options.rcName + "-user",
-				Labels:      options.labels,
-				Annotations: options.annotations,
-			},
-			Spec: v1.ServiceSpec{
-				Selector: options.labels,
-				Type:     serviceType,
-				Ports:    servicePort,
-			},
-		}
-		if _, err := a.env.GetKubeClient().CoreV1().Services(a.namespace).Create(service); err != nil {
-			if !isAlreadyExistsErr(err) {
-				return err
-			}
-		}
-	}
-
-	var hasGitInput bool
-	pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) error {
-		if input.Git != nil {
-			hasGitInput = true
-			return errutil.ErrBreak
-		}
-		return nil
-	})
-	if hasGitInput {
-		return a.checkOrDeployGithookService()
-	}
-	return nil
-}
-
-func (a *apiServer) checkOrDeployGithookService() error {
-	kubeClient := a.env.GetKubeClient()
-	_, err := getGithookService(kubeClient, a.namespace)
-	if err != nil {
-		if errors.As(err, &errGithookServiceNotFound{}) {
-			svc := assets.GithookService(a.namespace)
-			_, err = kubeClient.CoreV1().Services(a.namespace).Create(svc)
-			return err
-		}
-		return err
-	}
-	// service already exists
-	return nil
+	return a.workerRuntime.CreateWorkers(ctx, ptr, pipelineInfo, options)
 }
 
 func getGithookService(kubeClient *kube.Clientset, namespace string) (*v1.Service, error) {
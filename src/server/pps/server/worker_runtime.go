@@ -0,0 +1,68 @@
+package server
+
+import (
+	"context"
+	"io"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// kubeRuntimeName is the default value of WORKER_RUNTIME, which runs
+	// pipeline workers as kubernetes pods
+	kubeRuntimeName = "kubernetes"
+	// localRuntimeName is the value of WORKER_RUNTIME that runs pipeline
+	// workers as processes on the same host as pachd
+	localRuntimeName = "local"
+)
+
+// WorkerRuntime is the interface through which the PPS master creates, scales,
+// and deletes the workers that run pipelines' user code.
+//
+// Each set of workers (one per pipeline version) is described by a
+// v1.ReplicationController. Runtimes other than kubernetes only need to
+// preserve its name, labels, annotations and replica count, which is all that
+// the pipeline controller reads when deciding whether a pipeline's workers are
+// up to date. Pipelines are identified by their qualified names (see
+// pps.Pipeline.QualifiedName).
+type WorkerRuntime interface {
+	// CreateWorkers creates a set of workers named options.rcName (plus any
+	// resources that they need) starting with options.parallelism workers. It
+	// does nothing if the set already exists.
+	CreateWorkers(ctx context.Context, ptr *pps.StoredPipelineInfo, pipelineInfo *pps.PipelineInfo, options *workerOptions) error
+	// ScaleWorkers sets the number of workers in the set described by 'rc'.
+	ScaleWorkers(rc *v1.ReplicationController, replicas int32) error
+	// DeleteWorkers deletes every set of workers belonging to 'pipeline',
+	// along with their resources.
+	DeleteWorkers(pipeline string) error
+	// ListWorkers lists the sets of workers belonging to 'pipeline', or the
+	// sets of every pipeline if 'pipeline' is empty.
+	ListWorkers(pipeline string) (*v1.ReplicationControllerList, error)
+	// Pods returns a pod for each worker in the set 'rcName'.
+	Pods(rcName string) ([]v1.Pod, error)
+	// Logs returns the logs of the worker in 'pod'.
+	Logs(pod string, opts *v1.PodLogOptions) (io.ReadCloser, error)
+	// WatchStatus calls 'onCrash' with the affected pipeline whenever a worker
+	// fails, until 'ctx' is cancelled, an error occurs, or the watch ends (in
+	// which case it returns nil and may be called again).
+	WatchStatus(ctx context.Context, onCrash func(pipeline, reason string) error) error
+}
+
+// newWorkerRuntime returns the WorkerRuntime selected by pachd's
+// configuration.
+func newWorkerRuntime(a *apiServer, config *serviceenv.Configuration) (WorkerRuntime, error) {
+	switch config.WorkerRuntime {
+	case kubeRuntimeName, "":
+		k := newKubeRuntime(a)
+		k.validate()
+		return k, nil
+	case localRuntimeName:
+		return newLocalRuntime(config, a.etcdPrefix)
+	default:
+		return nil, errors.Errorf("unrecognized worker runtime %q", config.WorkerRuntime)
+	}
+}
@@ -3,8 +3,10 @@ package cmds
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
@@ -43,6 +45,9 @@ The following pachctl commands are supported in transactions:
   delete commit
   create branch
   delete branch
+  put file
+  copy file
+  delete file
   create pipeline
   update pipeline
 
@@ -85,9 +90,10 @@ transaction' or cancelled with 'delete transaction'.`,
 	listTransaction.Flags().AddFlagSet(fullTimestampsFlags)
 	commands = append(commands, cmdutil.CreateAlias(listTransaction, "list transaction"))
 
+	var ttl time.Duration
 	startTransaction := &cobra.Command{
 		Short: "Start a new transaction.",
-		Long:  "Start a new transaction.",
+		Long:  "Start a new transaction. The transaction is deleted if it isn't finished before its TTL elapses.",
 		Run: cmdutil.RunFixedArgs(0, func([]string) error {
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
@@ -102,7 +108,11 @@ transaction' or cancelled with 'delete transaction'.`,
 				return errors.Errorf("cannot start a new transaction, since transaction with ID %q already exists", txn.ID)
 			}
 
-			transaction, err := c.StartTransaction()
+			request := &transaction.StartTransactionRequest{}
+			if ttl != 0 {
+				request.Ttl = types.DurationProto(ttl)
+			}
+			transaction, err := c.TransactionAPIClient.StartTransaction(c.Ctx(), request)
 			if err != nil {
 				return grpcutil.ScrubGRPC(err)
 			}
@@ -116,6 +126,7 @@ transaction' or cancelled with 'delete transaction'.`,
 			return nil
 		}),
 	}
+	startTransaction.Flags().DurationVar(&ttl, "ttl", 0, "How long the transaction may stay open before it is deleted, e.g. '2h'. Defaults to the cluster's transaction TTL.")
 	commands = append(commands, cmdutil.CreateAlias(startTransaction, "start transaction"))
 
 	stopTransaction := &cobra.Command{
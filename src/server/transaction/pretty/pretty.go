@@ -15,7 +15,7 @@ import (
 
 const (
 	//TransactionHeader is the header for transactions.
-	TransactionHeader = "TRANSACTION\tSTARTED\tOPS\t\n"
+	TransactionHeader = "TRANSACTION\tOWNER\tAGE\tEXPIRES\tOPS\t\n"
 )
 
 // PrintableTransactionInfo wraps a transaction.TransactionInfo with the
@@ -29,10 +29,22 @@ type PrintableTransactionInfo struct {
 // device.
 func PrintTransactionInfo(w io.Writer, info *transaction.TransactionInfo, fullTimestamps bool) {
 	fmt.Fprintf(w, "%s\t", info.Transaction.ID)
+	owner := info.Owner
+	if owner == "" {
+		owner = "-"
+	}
+	fmt.Fprintf(w, "%s\t", owner)
 	if fullTimestamps {
 		fmt.Fprintf(w, "%s\t", info.Started.String())
 	} else {
-		fmt.Fprintf(w, "%s\t", pretty.Ago(info.Started))
+		fmt.Fprintf(w, "%s\t", pretty.Since(info.Started))
+	}
+	if info.Expires == nil {
+		fmt.Fprintf(w, "-\t")
+	} else if fullTimestamps {
+		fmt.Fprintf(w, "%s\t", info.Expires.String())
+	} else {
+		fmt.Fprintf(w, "%s\t", pretty.Until(info.Expires))
 	}
 	fmt.Fprintf(w, "%d\n", len(info.Requests))
 }
@@ -41,9 +53,12 @@ func PrintTransactionInfo(w io.Writer, info *transaction.TransactionInfo, fullTi
 // to stdout.
 func PrintDetailedTransactionInfo(info *PrintableTransactionInfo) error {
 	template, err := template.New("TransactionInfo").Funcs(funcMap).Parse(
-		`ID: {{.Transaction.ID}}{{if .FullTimestamps}}
-Started: {{.Started}}{{else}}
-Started: {{prettyAgo .Started}}{{end}}
+		`ID: {{.Transaction.ID}}{{if .Owner}}
+Owner: {{.Owner}}{{end}}{{if .FullTimestamps}}
+Started: {{.Started}}{{if .Expires}}
+Expires: {{.Expires}}{{end}}{{else}}
+Started: {{prettyAgo .Started}}{{if .Expires}}
+Expires: {{prettyUntil .Expires}}{{end}}{{end}}
 Requests:
 {{transactionRequests .Requests .Responses}}
 `)
@@ -106,6 +121,10 @@ func sprintDeleteBranch(request *pfs.DeleteBranchRequest) string {
 	return fmt.Sprintf("delete branch %s@%s%s", request.Branch.Repo.Name, request.Branch.Name, force)
 }
 
+func sprintAddFileset(request *pfs.AddFilesetRequest) string {
+	return fmt.Sprintf("add fileset %s to commit %s@%s", request.FilesetId, request.Commit.Branch.Repo.Name, request.Commit.ID)
+}
+
 func sprintUpdatePipelineJobState(request *pps.UpdatePipelineJobStateRequest) string {
 	state := func() string {
 		switch request.State {
@@ -137,6 +156,33 @@ func sprintCreatePipeline(request *pps.CreatePipelineRequest) string {
 	return fmt.Sprintf("%s pipeline %s", verb, request.Pipeline.Name)
 }
 
+// SprintTransactionRequest returns a one line description of a request in a
+// transaction. response may be nil if the request hasn't been run.
+func SprintTransactionRequest(request *transaction.TransactionRequest, response *transaction.TransactionResponse) string {
+	if request.CreateRepo != nil {
+		return sprintCreateRepo(request.CreateRepo)
+	} else if request.DeleteRepo != nil {
+		return sprintDeleteRepo(request.DeleteRepo)
+	} else if request.StartCommit != nil {
+		return sprintStartCommit(request.StartCommit, response)
+	} else if request.FinishCommit != nil {
+		return sprintFinishCommit(request.FinishCommit)
+		// } else if request.SquashCommit != nil {
+		// 	return sprintSquashCommit(request.SquashCommit)
+	} else if request.CreateBranch != nil {
+		return sprintCreateBranch(request.CreateBranch)
+	} else if request.DeleteBranch != nil {
+		return sprintDeleteBranch(request.DeleteBranch)
+	} else if request.AddFileset != nil {
+		return sprintAddFileset(request.AddFileset)
+	} else if request.UpdatePipelineJobState != nil {
+		return sprintUpdatePipelineJobState(request.UpdatePipelineJobState)
+	} else if request.CreatePipeline != nil {
+		return sprintCreatePipeline(request.CreatePipeline)
+	}
+	return "ERROR (unknown request type)"
+}
+
 func transactionRequests(
 	requests []*transaction.TransactionRequest,
 	responses []*transaction.TransactionResponse,
@@ -147,33 +193,11 @@ func transactionRequests(
 
 	lines := []string{}
 	for i, request := range requests {
-		var line string
-		if request.CreateRepo != nil {
-			line = sprintCreateRepo(request.CreateRepo)
-		} else if request.DeleteRepo != nil {
-			line = sprintDeleteRepo(request.DeleteRepo)
-		} else if request.StartCommit != nil {
-			if len(responses) > i {
-				line = sprintStartCommit(request.StartCommit, responses[i])
-			} else {
-				line = sprintStartCommit(request.StartCommit, nil)
-			}
-		} else if request.FinishCommit != nil {
-			line = sprintFinishCommit(request.FinishCommit)
-			// } else if request.SquashCommit != nil {
-			// 	line = sprintSquashCommit(request.SquashCommit)
-		} else if request.CreateBranch != nil {
-			line = sprintCreateBranch(request.CreateBranch)
-		} else if request.DeleteBranch != nil {
-			line = sprintDeleteBranch(request.DeleteBranch)
-		} else if request.UpdatePipelineJobState != nil {
-			line = sprintUpdatePipelineJobState(request.UpdatePipelineJobState)
-		} else if request.CreatePipeline != nil {
-			line = sprintCreatePipeline(request.CreatePipeline)
-		} else {
-			line = "ERROR (unknown request type)"
+		var response *transaction.TransactionResponse
+		if len(responses) > i {
+			response = responses[i]
 		}
-		lines = append(lines, fmt.Sprintf("  %s", line))
+		lines = append(lines, fmt.Sprintf("  %s", SprintTransactionRequest(request, response)))
 	}
 
 	return strings.Join(lines, "\n")
@@ -181,6 +205,7 @@ func transactionRequests(
 
 var funcMap = template.FuncMap{
 	"prettyAgo":           pretty.Ago,
+	"prettyUntil":         pretty.Until,
 	"prettySize":          pretty.Size,
 	"transactionRequests": transactionRequests,
 }
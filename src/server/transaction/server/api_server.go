@@ -32,6 +32,7 @@ func newAPIServer(
 		driver: d,
 	}
 	go func() { env.GetPachClient(context.Background()) }() // Begin dialing connection on startup
+	go d.deleteExpiredTransactions(env.Context())
 	return s, nil
 }
 
@@ -46,7 +47,7 @@ func (a *apiServer) StartTransaction(ctx context.Context, request *transaction.S
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
-	return a.driver.startTransaction(ctx)
+	return a.driver.startTransaction(ctx, request.Ttl)
 }
 
 func (a *apiServer) InspectTransaction(ctx context.Context, request *transaction.InspectTransactionRequest) (response *transaction.TransactionInfo, retErr error) {
@@ -2,39 +2,65 @@ package server
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/jmoiron/sqlx"
+	log "github.com/sirupsen/logrus"
 
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactiondb"
 	txnenv "github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/server/transaction/pretty"
 	"github.com/pachyderm/pachyderm/v2/src/transaction"
 )
 
+const (
+	// defaultTTL is how long a transaction may stay open if the server isn't
+	// configured with a TTL.
+	defaultTTL = 24 * time.Hour
+	// expiredInterval is how often expired transactions are deleted.
+	expiredInterval = time.Minute
+)
+
 type driver struct {
+	env serviceenv.ServiceEnv
 	// txnEnv stores references to other pachyderm APIServer instances so we can
 	// make calls within the same transaction without serializing through RPCs
 	txnEnv       *txnenv.TransactionEnv
 	db           *sqlx.DB
 	transactions col.PostgresCollection
+	ttl          time.Duration
 }
 
 func newDriver(
 	env serviceenv.ServiceEnv,
 	txnEnv *txnenv.TransactionEnv,
 ) (*driver, error) {
-
+	ttl := defaultTTL
+	if env.Config().TransactionTTL != "" {
+		var err error
+		if ttl, err = time.ParseDuration(env.Config().TransactionTTL); err != nil {
+			return nil, errors.Wrapf(err, "invalid transaction TTL")
+		}
+	}
 	return &driver{
+		env:          env,
 		txnEnv:       txnEnv,
 		db:           env.GetDBClient(),
 		transactions: transactiondb.Transactions(env.GetDBClient(), env.GetPostgresListener()),
+		ttl:          ttl,
 	}, nil
 }
 
@@ -51,15 +77,19 @@ func (d *driver) batchTransaction(ctx context.Context, req []*transaction.Transa
 	if err := d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
 		// Because we're building and running the entire transaction atomically here,
 		// there is no need to persist the TransactionInfo to the collection
+		owner, err := d.owner(ctx)
+		if err != nil {
+			return err
+		}
 		info := &transaction.TransactionInfo{
 			Transaction: &transaction.Transaction{
 				ID: uuid.New(),
 			},
 			Requests: req,
 			Started:  now(),
+			Owner:    owner,
 		}
 
-		var err error
 		result, err = d.runTransaction(txnCtx, info)
 		return err
 	}); err != nil {
@@ -69,13 +99,48 @@ func (d *driver) batchTransaction(ctx context.Context, req []*transaction.Transa
 	return result, nil
 }
 
-func (d *driver) startTransaction(ctx context.Context) (*transaction.Transaction, error) {
+// owner returns the user making the request, or "" if auth isn't active.
+func (d *driver) owner(ctx context.Context) (string, error) {
+	if d.env.AuthServer() == nil {
+		return "", nil
+	}
+	whoAmI, err := d.env.AuthServer().WhoAmI(ctx, &auth.WhoAmIRequest{})
+	if auth.IsErrNotActivated(err) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	return whoAmI.Username, nil
+}
+
+func (d *driver) startTransaction(ctx context.Context, ttlProto *types.Duration) (*transaction.Transaction, error) {
+	ttl := d.ttl
+	if ttlProto != nil {
+		var err error
+		if ttl, err = types.DurationFromProto(ttlProto); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		if ttl <= 0 {
+			return nil, errors.Errorf("transaction TTL must be positive, got %v", ttl)
+		}
+	}
+	owner, err := d.owner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	started := time.Now()
+	expires, err := types.TimestampProto(started.Add(ttl))
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
 	info := &transaction.TransactionInfo{
 		Transaction: &transaction.Transaction{
 			ID: uuid.New(),
 		},
 		Requests: []*transaction.TransactionRequest{},
 		Started:  now(),
+		Owner:    owner,
+		Expires:  expires,
 	}
 
 	if err := col.NewSQLTx(ctx, d.db, func(sqlTx *sqlx.Tx) error {
@@ -103,6 +168,52 @@ func (d *driver) deleteTransaction(ctx context.Context, txn *transaction.Transac
 	})
 }
 
+// checkExpired returns an error if the transaction has expired, since it may be
+// deleted at any time.
+func checkExpired(info *transaction.TransactionInfo) error {
+	if info.Expires == nil {
+		return nil
+	}
+	expires, err := types.TimestampFromProto(info.Expires)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	if time.Now().After(expires) {
+		return errors.Errorf("transaction %s expired at %s", info.Transaction.ID, expires.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// deleteExpiredTransactions deletes the transactions that have expired every
+// expiredInterval, until ctx is done. Every pachd does this, which is harmless
+// since each transaction is only deleted once.
+func (d *driver) deleteExpiredTransactions(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(expiredInterval):
+		}
+		txns, err := d.listTransaction(ctx)
+		if err != nil {
+			log.Errorf("error listing transactions to delete expired ones: %v", err)
+			continue
+		}
+		for _, info := range txns {
+			if checkExpired(info) == nil {
+				continue
+			}
+			if err := col.NewSQLTx(ctx, d.db, func(sqlTx *sqlx.Tx) error {
+				return d.transactions.ReadWrite(sqlTx).Delete(info.Transaction.ID)
+			}); err != nil && !col.IsErrNotFound(err) {
+				log.Errorf("error deleting expired transaction %s: %v", info.Transaction.ID, err)
+				continue
+			}
+			log.Infof("deleted expired transaction %s (owner %q, %d requests)", info.Transaction.ID, info.Owner, len(info.Requests))
+		}
+	}
+}
+
 func (d *driver) listTransaction(ctx context.Context) ([]*transaction.TransactionInfo, error) {
 	var result []*transaction.TransactionInfo
 	transactionInfo := &transaction.TransactionInfo{}
@@ -164,6 +275,8 @@ func (d *driver) runTransaction(txnCtx *txncontext.TransactionContext, info *tra
 			err = directTxn.CreateBranch(request.CreateBranch)
 		} else if request.DeleteBranch != nil {
 			err = directTxn.DeleteBranch(request.DeleteBranch)
+		} else if request.AddFileset != nil {
+			err = directTxn.AddFileset(request.AddFileset)
 		} else if request.UpdatePipelineJobState != nil {
 			err = directTxn.UpdatePipelineJobState(request.UpdatePipelineJobState)
 		} else if request.DeleteAll != nil {
@@ -189,7 +302,13 @@ func (d *driver) runTransaction(txnCtx *txncontext.TransactionContext, info *tra
 		}
 
 		if err != nil {
-			return result, errors.Wrapf(err, "error running request %d of %d", i+1, len(info.Requests))
+			return result, &transactionRequestError{
+				index:    i,
+				total:    len(info.Requests),
+				request:  request,
+				response: response,
+				err:      err,
+			}
 		}
 	}
 	return result, nil
@@ -197,27 +316,242 @@ func (d *driver) runTransaction(txnCtx *txncontext.TransactionContext, info *tra
 
 func (d *driver) finishTransaction(ctx context.Context, txn *transaction.Transaction) (*transaction.TransactionInfo, error) {
 	info := &transaction.TransactionInfo{}
+	var started *types.Timestamp
 	if err := d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		err := d.transactions.ReadOnly(ctx).Get(txn.ID, info)
+		err := d.transactions.ReadWrite(txnCtx.SqlTx).Get(txn.ID, info)
 		if err != nil {
 			return err
 		}
+		if err := checkExpired(info); err != nil {
+			return err
+		}
+		started = info.Started
 		info, err = d.runTransaction(txnCtx, info)
 		if err != nil {
-			return err
+			// Every request was valid when it was appended.
+			return markConflict(err, len(info.Requests))
 		}
 		return d.transactions.ReadWrite(txnCtx.SqlTx).Delete(txn.ID)
 	}); err != nil {
-		return nil, err
+		// The changes are read once the failed attempt has been rolled back.
+		return nil, d.describeConflict(ctx, err, started)
 	}
 	return info, nil
 }
 
-// Error to be returned when the transaction has been modified between our two sqlTx calls
-type transactionConflictError struct{}
+// transactionRequestError is returned when a request in a transaction fails.
+type transactionRequestError struct {
+	index, total int
+	request      *transaction.TransactionRequest
+	response     *transaction.TransactionResponse
+	err          error
+	// conflict is set if the request was valid when it was appended to the
+	// transaction, so it failed because of a change made outside of the
+	// transaction since then.
+	conflict bool
+	// changes describe the changes to the repos, branches and commits the
+	// request refers to that were made since the transaction started.
+	changes []string
+}
+
+func (e *transactionRequestError) Error() string {
+	msg := fmt.Sprintf("request %d of %d (%s) failed: %v", e.index+1, e.total, pretty.SprintTransactionRequest(e.request, e.response), e.err)
+	if e.conflict {
+		if len(e.changes) == 0 {
+			msg += "; the request was valid when it was added to the transaction, so it conflicts with a change made outside of the transaction since then"
+		} else {
+			msg += fmt.Sprintf("; the request was valid when it was added to the transaction, so it conflicts with a change made outside of the transaction since then: %s", strings.Join(e.changes, ", "))
+		}
+	}
+	return msg
+}
+
+func (e *transactionRequestError) Unwrap() error {
+	return e.err
+}
+
+// markConflict marks err as a conflict if it's a failure of one of the first
+// numValidated requests in the transaction, which had already been validated.
+func markConflict(err error, numValidated int) error {
+	requestErr := &transactionRequestError{}
+	if errors.As(err, &requestErr) && requestErr.index < numValidated {
+		requestErr.conflict = true
+	}
+	return err
+}
+
+// describeConflict names the changes made outside of the transaction since it
+// started, if err is a conflict.
+func (d *driver) describeConflict(ctx context.Context, err error, started *types.Timestamp) error {
+	requestErr := &transactionRequestError{}
+	if !errors.As(err, &requestErr) || !requestErr.conflict || started == nil {
+		return err
+	}
+	since, tsErr := types.TimestampFromProto(started)
+	if tsErr != nil {
+		return err
+	}
+	requestErr.changes = d.changesSince(ctx, requestErr.request, since)
+	return err
+}
+
+// changesSince describes the changes made since the given time to the repos,
+// branches and commits that request refers to. Objects that can't be read are
+// left out, since this only adds detail to an error.
+func (d *driver) changesSince(ctx context.Context, request *transaction.TransactionRequest, since time.Time) []string {
+	var repos []*pfs.Repo
+	var branches []*pfs.Branch
+	// created are branches the request may create, so they may not exist.
+	var created []*pfs.Branch
+	var commits []*pfs.Commit
+	switch {
+	case request.CreateRepo != nil:
+		repos = append(repos, request.CreateRepo.Repo)
+	case request.DeleteRepo != nil:
+		repos = append(repos, request.DeleteRepo.Repo)
+	case request.StartCommit != nil:
+		if request.StartCommit.Branch != nil {
+			repos = append(repos, request.StartCommit.Branch.Repo)
+			created = append(created, request.StartCommit.Branch)
+		}
+		commits = append(commits, request.StartCommit.Parent)
+	case request.FinishCommit != nil:
+		commits = append(commits, request.FinishCommit.Commit)
+	case request.SquashCommit != nil:
+		commits = append(commits, request.SquashCommit.Commit)
+	case request.CreateBranch != nil:
+		if request.CreateBranch.Branch != nil {
+			repos = append(repos, request.CreateBranch.Branch.Repo)
+			created = append(created, request.CreateBranch.Branch)
+		}
+		commits = append(commits, request.CreateBranch.Head)
+		branches = append(branches, request.CreateBranch.Provenance...)
+	case request.DeleteBranch != nil:
+		branches = append(branches, request.DeleteBranch.Branch)
+	case request.AddFileset != nil:
+		commits = append(commits, request.AddFileset.Commit)
+	}
+	for _, commit := range commits {
+		if commit == nil || commit.Branch == nil {
+			continue
+		}
+		if commit.ID == "" {
+			branches = append(branches, commit.Branch)
+			continue
+		}
+		repos = append(repos, commit.Branch.Repo)
+	}
+
+	repoInfos := pfsdb.Repos(d.db, d.env.GetPostgresListener()).ReadOnly(ctx)
+	branchInfos := pfsdb.Branches(d.db, d.env.GetPostgresListener()).ReadOnly(ctx)
+	commitInfos := pfsdb.Commits(d.db, d.env.GetPostgresListener()).ReadOnly(ctx)
+	var changes []string
+	seen := make(map[string]bool)
+	describe := func(change string) {
+		if !seen[change] {
+			seen[change] = true
+			changes = append(changes, change)
+		}
+	}
+	after := func(ts *types.Timestamp) (time.Time, bool) {
+		t, err := types.TimestampFromProto(ts)
+		return t, ts != nil && err == nil && t.After(since)
+	}
+	for _, repo := range repos {
+		if repo == nil {
+			continue
+		}
+		repo = withRepoType(repo)
+		repoInfo := &pfs.RepoInfo{}
+		if err := repoInfos.Get(pfsdb.RepoKey(repo), repoInfo); err != nil {
+			if col.IsErrNotFound(err) && request.CreateRepo == nil {
+				describe(fmt.Sprintf("repo %s was deleted", repo.QualifiedName()))
+			}
+			continue
+		}
+		if t, ok := after(repoInfo.Created); ok {
+			describe(fmt.Sprintf("repo %s was created at %s", repo.QualifiedName(), t.Format(time.RFC3339)))
+		}
+	}
+	describeBranch := func(branch *pfs.Branch, mayNotExist bool) {
+		if branch == nil || branch.Repo == nil {
+			return
+		}
+		branch = &pfs.Branch{Repo: withRepoType(branch.Repo), Name: branch.Name}
+		name := branch.Repo.QualifiedName() + "@" + branch.Name
+		branchInfo := &pfs.BranchInfo{}
+		if err := branchInfos.Get(pfsdb.BranchKey(branch), branchInfo); err != nil {
+			if col.IsErrNotFound(err) && !mayNotExist {
+				describe(fmt.Sprintf("branch %s was deleted", name))
+			}
+			return
+		}
+		if branchInfo.Head == nil {
+			return
+		}
+		headInfo := &pfs.CommitInfo{}
+		if err := commitInfos.Get(pfsdb.CommitKey(branchInfo.Head), headInfo); err != nil {
+			return
+		}
+		if t, ok := after(headInfo.Started); ok {
+			describe(fmt.Sprintf("branch %s moved to commit %s at %s", name, branchInfo.Head.ID, t.Format(time.RFC3339)))
+		}
+	}
+	for _, branch := range branches {
+		describeBranch(branch, false)
+	}
+	for _, branch := range created {
+		describeBranch(branch, true)
+	}
+	for _, commit := range commits {
+		if commit == nil || commit.Branch == nil || commit.Branch.Repo == nil || commit.ID == "" {
+			continue
+		}
+		// Commits named relative to another commit can't be looked up directly.
+		if _, ancestors, err := ancestry.Parse(commit.ID); err != nil || ancestors != 0 {
+			continue
+		}
+		commit = &pfs.Commit{Branch: &pfs.Branch{Repo: withRepoType(commit.Branch.Repo), Name: commit.Branch.Name}, ID: commit.ID}
+		name := commit.Branch.Repo.QualifiedName() + "@" + commit.ID
+		commitInfo := &pfs.CommitInfo{}
+		if err := commitInfos.Get(pfsdb.CommitKey(commit), commitInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				describe(fmt.Sprintf("commit %s was deleted", name))
+			}
+			continue
+		}
+		if t, ok := after(commitInfo.Finished); ok {
+			describe(fmt.Sprintf("commit %s was finished at %s", name, t.Format(time.RFC3339)))
+		}
+	}
+	return changes
+}
+
+// withRepoType returns repo with the default type filled in, as PFS does.
+func withRepoType(repo *pfs.Repo) *pfs.Repo {
+	if repo.Type != "" {
+		return repo
+	}
+	return &pfs.Repo{Name: repo.Name, Type: pfs.UserRepoType, Project: repo.Project}
+}
+
+// transactionConflictError is returned when the transaction has been modified
+// between our two sqlTx calls.
+type transactionConflictError struct {
+	txnID string
+	// concurrent are the requests that were appended concurrently.
+	concurrent []*transaction.TransactionRequest
+}
 
 func (e *transactionConflictError) Error() string {
-	return "transaction could not be modified due to concurrent modifications"
+	if len(e.concurrent) == 0 {
+		return fmt.Sprintf("transaction %s could not be modified due to concurrent modifications", e.txnID)
+	}
+	var requests []string
+	for _, request := range e.concurrent {
+		requests = append(requests, pretty.SprintTransactionRequest(request, nil))
+	}
+	return fmt.Sprintf("transaction %s could not be modified because %d request(s) were appended to it concurrently: %s", e.txnID, len(e.concurrent), strings.Join(requests, ", "))
 }
 
 func (d *driver) appendTransaction(
@@ -226,12 +560,14 @@ func (d *driver) appendTransaction(
 	items []*transaction.TransactionRequest,
 ) (*transaction.TransactionInfo, error) {
 	// Run this thing in a loop in case we get a conflict, time out after some tries
+	conflictErr := &transactionConflictError{txnID: txn.ID}
 	for i := 0; i < 10; i++ {
 		// We first do a dryrun of the transaction to
 		// 1. make sure the appended request is valid
 		// 2. Capture the result of the request to be returned
 		var numRequests, numResponses int
 		var newResponses []*transaction.TransactionResponse
+		var started *types.Timestamp
 
 		if err := d.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
 			// Get the existing transaction and append the new requests
@@ -240,6 +576,9 @@ func (d *driver) appendTransaction(
 			if err != nil {
 				return err
 			}
+			if err := checkExpired(info); err != nil {
+				return err
+			}
 
 			// Save the length so that we can check that nothing else modifies the
 			// transaction in the meantime
@@ -254,11 +593,12 @@ func (d *driver) appendTransaction(
 			// case we want to save the generated responses and reattempt.  The saved
 			// responses will contain any references to objects generated outside of
 			// the transaction and need to be reused on the next attempt.
+			started = info.Started
 			info, err = d.runTransaction(txnCtx, info)
 			newResponses = info.Responses
-			return err
+			return markConflict(err, numRequests)
 		}); err != nil {
-			return nil, err
+			return nil, d.describeConflict(ctx, err, started)
 		}
 
 		info := &transaction.TransactionInfo{}
@@ -267,7 +607,11 @@ func (d *driver) appendTransaction(
 			return d.transactions.ReadWrite(sqlTx).Update(txn.ID, info, func() error {
 				if len(info.Requests) != numRequests || len(info.Responses) != numResponses {
 					// Someone else modified the transaction while we did the dry run
-					return &transactionConflictError{}
+					conflictErr = &transactionConflictError{txnID: txn.ID}
+					if len(info.Requests) > numRequests {
+						conflictErr.concurrent = info.Requests[numRequests:]
+					}
+					return conflictErr
 				}
 
 				info.Requests = append(info.Requests, items...)
@@ -276,9 +620,9 @@ func (d *driver) appendTransaction(
 			})
 		}); err == nil {
 			return info, nil
-		} else if !errors.As(err, &transactionConflictError{}) {
+		} else if !errors.As(err, &conflictErr) {
 			return nil, err
 		}
 	}
-	return nil, &transactionConflictError{}
+	return nil, conflictErr
 }
@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
@@ -109,6 +112,84 @@ func TestTransactions(suite *testing.T) {
 		require.YesError(t, err)
 	})
 
+	suite.Run("TestInvalidatedTransactionError", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, testutil.NewTestDBConfig(t))
+
+		txn, err := env.PachClient.StartTransaction()
+		require.NoError(t, err)
+		txnClient := env.PachClient.WithTransaction(txn)
+		require.NoError(t, txnClient.CreateRepo("foo"))
+		require.NoError(t, txnClient.CreateRepo("bar"))
+
+		require.NoError(t, env.PachClient.CreateRepo("bar"))
+
+		// The error names the request that failed, and the change made outside
+		// of the transaction that it conflicts with
+		_, err = env.PachClient.FinishTransaction(txn)
+		require.YesError(t, err)
+		require.Matches(t, "request 2 of 2 \\(create repo bar\\) failed", err.Error())
+		require.Matches(t, "bar already exists", err.Error())
+		require.Matches(t, "conflicts with a change made outside of the transaction", err.Error())
+		require.Matches(t, "repo bar was created at", err.Error())
+
+		// Appending to the transaction reports the same conflict
+		_, err = txnClient.PfsAPIClient.CreateRepo(txnClient.Ctx(), &pfs.CreateRepoRequest{Repo: client.NewRepo("baz")})
+		require.YesError(t, err)
+		require.Matches(t, "request 2 of 3 \\(create repo bar\\) failed", err.Error())
+		require.Matches(t, "conflicts with a change made outside of the transaction", err.Error())
+		require.Matches(t, "repo bar was created at", err.Error())
+	})
+
+	suite.Run("TestInvalidatedTransactionErrorNamesCommit", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, testutil.NewTestDBConfig(t))
+
+		require.NoError(t, env.PachClient.CreateRepo("data"))
+		commit, err := env.PachClient.StartCommit("data", "master")
+		require.NoError(t, err)
+
+		txn, err := env.PachClient.StartTransaction()
+		require.NoError(t, err)
+		txnClient := env.PachClient.WithTransaction(txn)
+		require.NoError(t, txnClient.FinishCommit("data", "master", commit.ID))
+
+		require.NoError(t, env.PachClient.FinishCommit("data", "master", commit.ID))
+
+		_, err = env.PachClient.FinishTransaction(txn)
+		require.YesError(t, err)
+		require.Matches(t, "commit data@"+commit.ID+" was finished at", err.Error())
+	})
+
+	suite.Run("TestTransactionTTL", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, testutil.NewTestDBConfig(t))
+
+		txn, err := env.PachClient.TransactionAPIClient.StartTransaction(env.Context, &transaction.StartTransactionRequest{
+			Ttl: types.DurationProto(time.Second),
+		})
+		require.NoError(t, err)
+
+		info, err := env.PachClient.InspectTransaction(txn)
+		require.NoError(t, err)
+		require.NotNil(t, info.Expires)
+		require.Equal(t, "", info.Owner)
+
+		txnClient := env.PachClient.WithTransaction(txn)
+		require.NoError(t, txnClient.CreateRepo("foo"))
+		time.Sleep(2 * time.Second)
+
+		// The transaction can't be modified or finished once it has expired
+		err = txnClient.CreateRepo("bar")
+		require.YesError(t, err)
+		require.Matches(t, "expired", err.Error())
+		_, err = env.PachClient.FinishTransaction(txn)
+		require.YesError(t, err)
+		require.Matches(t, "expired", err.Error())
+		_, err = env.PachClient.InspectRepo("foo")
+		require.YesError(t, err)
+	})
+
 	suite.Run("TestFailedAppend", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, testutil.NewTestDBConfig(t))
@@ -543,6 +624,65 @@ func TestTransactions(suite *testing.T) {
 			}
 		}
 	})
+
+	suite.Run("TestModifyFileTransaction", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, testutil.NewTestDBConfig(t))
+
+		txn, err := env.PachClient.StartTransaction()
+		require.NoError(t, err)
+		txnClient := env.PachClient.WithTransaction(txn)
+
+		require.NoError(t, txnClient.CreateRepo("foo"))
+		commit, err := txnClient.StartCommit("foo", "master")
+		require.NoError(t, err)
+		require.NoError(t, txnClient.PutFile(commit, "file", strings.NewReader("foo")))
+		require.NoError(t, txnClient.PutFile(commit, "file", strings.NewReader("bar"), client.WithAppendPutFile()))
+		require.NoError(t, txnClient.FinishCommit("foo", "master", commit.ID))
+		require.NoError(t, txnClient.CreateBranch("foo", "other", "master", "", nil))
+
+		info, err := env.PachClient.InspectTransaction(txn)
+		require.NoError(t, err)
+		require.Equal(t, 6, len(info.Requests))
+		require.NotNil(t, info.Requests[2].AddFileset)
+
+		// Nothing happens until the transaction is finished
+		_, err = env.PachClient.InspectRepo("foo")
+		require.YesError(t, err)
+
+		_, err = env.PachClient.FinishTransaction(txn)
+		require.NoError(t, err)
+
+		for _, branch := range []string{"master", "other"} {
+			var buf bytes.Buffer
+			require.NoError(t, env.PachClient.GetFile(client.NewCommit("foo", branch, ""), "file", &buf))
+			require.Equal(t, "foobar", buf.String())
+		}
+	})
+
+	suite.Run("TestFailedModifyFileTransaction", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, testutil.NewTestDBConfig(t))
+
+		txn, err := env.PachClient.StartTransaction()
+		require.NoError(t, err)
+		txnClient := env.PachClient.WithTransaction(txn)
+
+		require.NoError(t, txnClient.CreateRepo("foo"))
+		commit, err := txnClient.StartCommit("foo", "master")
+		require.NoError(t, err)
+		require.NoError(t, txnClient.PutFile(commit, "file", strings.NewReader("foo")))
+
+		// Create the same repo outside of the transaction, so it can't run
+		require.NoError(t, env.PachClient.CreateRepo("foo"))
+		_, err = env.PachClient.FinishTransaction(txn)
+		require.YesError(t, err)
+
+		// The file wasn't written to any commit
+		commitInfos, err := env.PachClient.ListCommit("foo", "", "", "", "", 0)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(commitInfos))
+	})
 }
 
 func TestCreatePipelineTransaction(t *testing.T) {
@@ -12,6 +12,13 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 )
 
+const (
+	// MetaPrefix is the prefix for datum meta paths in a meta commit.
+	MetaPrefix = "meta"
+	// MetaFileName is the name of a datum's meta file.
+	MetaFileName = "meta"
+)
+
 // IsDone returns true if the given context has been canceled, or false otherwise
 func IsDone(ctx context.Context) bool {
 	select {
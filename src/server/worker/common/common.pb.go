@@ -8,6 +8,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	pfs "github.com/pachyderm/pachyderm/v2/src/pfs"
+	pps "github.com/pachyderm/pachyderm/v2/src/pps"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -24,6 +25,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type DatumState int32
+
+const (
+	DatumState_PROCESSED DatumState = 0
+	DatumState_FAILED    DatumState = 1
+	DatumState_RECOVERED DatumState = 2
+	DatumState_SKIPPED   DatumState = 3
+)
+
+var DatumState_name = map[int32]string{
+	0: "PROCESSED",
+	1: "FAILED",
+	2: "RECOVERED",
+	3: "SKIPPED",
+}
+
+var DatumState_value = map[string]int32{
+	"PROCESSED": 0,
+	"FAILED":    1,
+	"RECOVERED": 2,
+	"SKIPPED":   3,
+}
+
+func (x DatumState) String() string {
+	return proto.EnumName(DatumState_name, int32(x))
+}
+
+func (DatumState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_91fb6c79ddd9db74, []int{0}
+}
+
 type Input struct {
 	FileInfo             *pfs.FileInfo `protobuf:"bytes,1,opt,name=file_info,json=fileInfo,proto3" json:"file_info,omitempty"`
 	ParentCommit         *pfs.Commit   `protobuf:"bytes,2,opt,name=parent_commit,json=parentCommit,proto3" json:"parent_commit,omitempty"`
@@ -151,38 +183,158 @@ func (m *Input) GetS3() bool {
 	return false
 }
 
+// DatumMeta is written to a datum's meta file in the meta commit. It's shared
+// by the worker, which writes it, and PFS, which reads it for file lineage.
+type DatumMeta struct {
+	PipelineJobID        string            `protobuf:"bytes,1,opt,name=pipeline_job_id,json=pipelineJobId,proto3" json:"pipeline_job_id,omitempty"`
+	Inputs               []*Input          `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Hash                 string            `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	State                DatumState        `protobuf:"varint,4,opt,name=state,proto3,enum=common.DatumState" json:"state,omitempty"`
+	Reason               string            `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Stats                *pps.ProcessStats `protobuf:"bytes,6,opt,name=stats,proto3" json:"stats,omitempty"`
+	Index                int64             `protobuf:"varint,7,opt,name=index,proto3" json:"index,omitempty"`
+	Retries              []*pps.DatumRetry `protobuf:"bytes,8,rep,name=retries,proto3" json:"retries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DatumMeta) Reset()         { *m = DatumMeta{} }
+func (m *DatumMeta) String() string { return proto.CompactTextString(m) }
+func (*DatumMeta) ProtoMessage()    {}
+func (*DatumMeta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91fb6c79ddd9db74, []int{1}
+}
+func (m *DatumMeta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DatumMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DatumMeta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DatumMeta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatumMeta.Merge(m, src)
+}
+func (m *DatumMeta) XXX_Size() int {
+	return m.Size()
+}
+func (m *DatumMeta) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatumMeta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatumMeta proto.InternalMessageInfo
+
+func (m *DatumMeta) GetPipelineJobID() string {
+	if m != nil {
+		return m.PipelineJobID
+	}
+	return ""
+}
+
+func (m *DatumMeta) GetInputs() []*Input {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *DatumMeta) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *DatumMeta) GetState() DatumState {
+	if m != nil {
+		return m.State
+	}
+	return DatumState_PROCESSED
+}
+
+func (m *DatumMeta) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *DatumMeta) GetStats() *pps.ProcessStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+func (m *DatumMeta) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *DatumMeta) GetRetries() []*pps.DatumRetry {
+	if m != nil {
+		return m.Retries
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("common.DatumState", DatumState_name, DatumState_value)
 	proto.RegisterType((*Input)(nil), "common.Input")
+	proto.RegisterType((*DatumMeta)(nil), "common.DatumMeta")
 }
 
 func init() { proto.RegisterFile("server/worker/common/common.proto", fileDescriptor_91fb6c79ddd9db74) }
 
 var fileDescriptor_91fb6c79ddd9db74 = []byte{
-	// 369 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x6b, 0xdb, 0x30,
-	0x14, 0xc7, 0xb1, 0x97, 0xf8, 0xc7, 0xf3, 0xb2, 0x83, 0x18, 0x9b, 0x16, 0x58, 0x92, 0x6d, 0x97,
-	0xb0, 0x43, 0x3c, 0x92, 0xdb, 0x8e, 0x19, 0x6c, 0x4b, 0x29, 0x14, 0x0c, 0xb9, 0xf4, 0x62, 0x6c,
-	0x57, 0x76, 0xd4, 0xda, 0x92, 0x90, 0xe4, 0x14, 0xf7, 0x2f, 0xec, 0xa9, 0xf4, 0x2f, 0x28, 0xc5,
-	0x7f, 0x49, 0x91, 0x9c, 0x43, 0x0f, 0x3d, 0xf9, 0xfb, 0xf9, 0xf8, 0x3d, 0x3d, 0xa4, 0x07, 0xdf,
-	0x14, 0x91, 0x47, 0x22, 0xe3, 0x5b, 0x2e, 0x6f, 0x88, 0x8c, 0x0b, 0xde, 0x34, 0x9c, 0x9d, 0x3e,
-	0x2b, 0x21, 0xb9, 0xe6, 0xc8, 0x1b, 0x68, 0x3a, 0x11, 0xa5, 0x8a, 0x45, 0xa9, 0x06, 0x3d, 0xfd,
-	0x58, 0xf1, 0x8a, 0xdb, 0x18, 0x9b, 0x34, 0xd8, 0xef, 0x0f, 0x2e, 0x8c, 0x77, 0x4c, 0xb4, 0x1a,
-	0xfd, 0x84, 0xb0, 0xa4, 0x35, 0x49, 0x29, 0x2b, 0x39, 0x76, 0x16, 0xce, 0x32, 0x5a, 0x4f, 0x56,
-	0xa6, 0xfd, 0x2f, 0xad, 0xc9, 0x8e, 0x95, 0x3c, 0x09, 0xca, 0x53, 0x42, 0xbf, 0x60, 0x22, 0x32,
-	0x49, 0x98, 0x4e, 0xcd, 0x2c, 0xaa, 0xb1, 0x6b, 0xeb, 0x23, 0x5b, 0xff, 0xc7, 0xaa, 0xe4, 0xfd,
-	0x50, 0x31, 0x10, 0x42, 0x30, 0x62, 0x59, 0x43, 0xf0, 0xbb, 0x85, 0xb3, 0x0c, 0x13, 0x9b, 0xd1,
-	0x67, 0xf0, 0xaf, 0x39, 0x65, 0x29, 0x67, 0x78, 0x64, 0xb5, 0x67, 0xf0, 0x82, 0xa1, 0xaf, 0x00,
-	0xbc, 0xd5, 0x44, 0xa6, 0x86, 0xf1, 0x78, 0xe1, 0x2c, 0x83, 0x24, 0xb4, 0xe6, 0x8c, 0x53, 0x86,
-	0xbe, 0x40, 0x50, 0x49, 0xde, 0x8a, 0x34, 0xef, 0xb0, 0x67, 0x1b, 0x7d, 0xcb, 0xdb, 0xce, 0x8c,
-	0xa9, 0xb3, 0xbb, 0x0e, 0xfb, 0xb6, 0xc7, 0x66, 0xf4, 0x09, 0xbc, 0x5c, 0x66, 0xac, 0x38, 0xe0,
-	0x60, 0x98, 0x32, 0x10, 0xfa, 0x01, 0x7e, 0x45, 0x75, 0xda, 0xca, 0x1a, 0x87, 0xe6, 0xc7, 0x16,
-	0xfa, 0xa7, 0xb9, 0xf7, 0x8f, 0xea, 0x7d, 0x72, 0x9e, 0x78, 0x15, 0xd5, 0x7b, 0x59, 0xa3, 0x39,
-	0x44, 0xa4, 0x11, 0xba, 0x4b, 0xcd, 0xdd, 0x15, 0x06, 0x7b, 0x2e, 0x58, 0x65, 0xde, 0x45, 0xa1,
-	0x0f, 0xe0, 0xaa, 0x0d, 0x8e, 0xac, 0x77, 0xd5, 0x66, 0xfb, 0xff, 0xbe, 0x9f, 0x39, 0x8f, 0xfd,
-	0xcc, 0x79, 0xee, 0x67, 0xce, 0xe5, 0xef, 0x8a, 0xea, 0x43, 0x9b, 0xaf, 0x0a, 0xde, 0xc4, 0x22,
-	0x2b, 0x0e, 0xdd, 0x15, 0x91, 0xaf, 0xd3, 0x71, 0x1d, 0x2b, 0x59, 0xc4, 0x6f, 0x2d, 0x35, 0xf7,
-	0xec, 0x86, 0x36, 0x2f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x14, 0x7a, 0x34, 0x38, 0xf3, 0x01, 0x00,
-	0x00,
+	// 588 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xc1, 0x6e, 0x13, 0x3d,
+	0x10, 0xfe, 0x77, 0xd3, 0x6c, 0xb2, 0x93, 0x7f, 0xdb, 0xd4, 0xaa, 0xc0, 0x54, 0xa2, 0x0d, 0x45,
+	0x88, 0xd0, 0x43, 0x17, 0xa5, 0x27, 0xb8, 0x91, 0x64, 0x0b, 0x29, 0x45, 0x89, 0x1c, 0x95, 0x03,
+	0x97, 0xd5, 0x26, 0x71, 0x12, 0x97, 0xc4, 0xb6, 0x6c, 0xa7, 0x10, 0xde, 0x89, 0xf7, 0xe0, 0x84,
+	0x78, 0x82, 0x0a, 0xe5, 0x49, 0x90, 0xbd, 0x1b, 0xd1, 0x03, 0xa7, 0xfd, 0xbe, 0x6f, 0xc6, 0x33,
+	0x9e, 0x6f, 0xd6, 0xf0, 0x44, 0x53, 0x75, 0x4b, 0x55, 0xfc, 0x45, 0xa8, 0xcf, 0x54, 0xc5, 0x63,
+	0xb1, 0x5c, 0x0a, 0x5e, 0x7c, 0xce, 0xa4, 0x12, 0x46, 0xa0, 0x20, 0x67, 0x87, 0x91, 0x9c, 0xea,
+	0x58, 0x4e, 0x75, 0x2e, 0x1f, 0x46, 0x52, 0xea, 0x58, 0xca, 0x2d, 0x3d, 0x98, 0x89, 0x99, 0x70,
+	0x30, 0xb6, 0x28, 0x57, 0x4f, 0x7e, 0xfa, 0x50, 0xee, 0x71, 0xb9, 0x32, 0xe8, 0x14, 0xc2, 0x29,
+	0x5b, 0xd0, 0x94, 0xf1, 0xa9, 0xc0, 0x5e, 0xc3, 0x6b, 0xd6, 0x5a, 0xd1, 0x99, 0xad, 0x76, 0xc1,
+	0x16, 0xb4, 0xc7, 0xa7, 0x82, 0x54, 0xa7, 0x05, 0x42, 0x2f, 0x21, 0x92, 0x99, 0xa2, 0xdc, 0xa4,
+	0xb6, 0x35, 0x33, 0xd8, 0x77, 0xf9, 0x35, 0x97, 0xdf, 0x71, 0x12, 0xf9, 0x3f, 0xcf, 0xc8, 0x19,
+	0x42, 0xb0, 0xc3, 0xb3, 0x25, 0xc5, 0xa5, 0x86, 0xd7, 0x0c, 0x89, 0xc3, 0xe8, 0x21, 0x54, 0x6e,
+	0x04, 0xe3, 0xa9, 0xe0, 0x78, 0xc7, 0xc9, 0x81, 0xa5, 0x7d, 0x8e, 0x1e, 0x03, 0x88, 0x95, 0xa1,
+	0x2a, 0xb5, 0x1c, 0x97, 0x1b, 0x5e, 0xb3, 0x4a, 0x42, 0xa7, 0x5c, 0x0a, 0xc6, 0xd1, 0x23, 0xa8,
+	0xce, 0x94, 0x58, 0xc9, 0x74, 0xb4, 0xc6, 0x81, 0x3b, 0x58, 0x71, 0xbc, 0xbd, 0xb6, 0x6d, 0x16,
+	0xd9, 0xb7, 0x35, 0xae, 0xb8, 0x33, 0x0e, 0xa3, 0x07, 0x10, 0x8c, 0x54, 0xc6, 0xc7, 0x73, 0x5c,
+	0xcd, 0xbb, 0xe4, 0x0c, 0x3d, 0x85, 0xca, 0x8c, 0x99, 0x74, 0xa5, 0x16, 0x38, 0xb4, 0x81, 0x36,
+	0x6c, 0xee, 0x8e, 0x83, 0xb7, 0xcc, 0x5c, 0x93, 0x2b, 0x12, 0xcc, 0x98, 0xb9, 0x56, 0x0b, 0x74,
+	0x0c, 0x35, 0xba, 0x94, 0x66, 0x9d, 0xda, 0xd9, 0x35, 0x06, 0x57, 0x17, 0x9c, 0x64, 0x7d, 0xd1,
+	0x68, 0x17, 0x7c, 0x7d, 0x8e, 0x6b, 0x4e, 0xf7, 0xf5, 0xf9, 0xc9, 0x77, 0x1f, 0xc2, 0x6e, 0x66,
+	0x56, 0xcb, 0x0f, 0xd4, 0x64, 0xe8, 0x15, 0xec, 0x49, 0x26, 0xe9, 0x82, 0x71, 0x9a, 0xde, 0x88,
+	0x51, 0xca, 0x26, 0xce, 0xda, 0xb0, 0xbd, 0xbf, 0xb9, 0x3b, 0x8e, 0x06, 0x45, 0xe8, 0x52, 0x8c,
+	0x7a, 0x5d, 0x12, 0xc9, 0x7b, 0x74, 0x82, 0x9e, 0x41, 0xc0, 0xec, 0x62, 0x34, 0xf6, 0x1b, 0x25,
+	0xb7, 0x8c, 0x62, 0xe9, 0x6e, 0x5d, 0xa4, 0x08, 0xda, 0x89, 0xe7, 0x99, 0x9e, 0x6f, 0x8d, 0xb5,
+	0x18, 0x35, 0xa1, 0xac, 0x4d, 0x66, 0xa8, 0xb3, 0x75, 0xb7, 0x85, 0xb6, 0x27, 0xdd, 0xbd, 0x86,
+	0x36, 0x42, 0xf2, 0x04, 0xeb, 0x8d, 0xa2, 0x99, 0x16, 0xb9, 0xcb, 0x21, 0x29, 0x18, 0x7a, 0x9e,
+	0x57, 0xd0, 0xce, 0xdf, 0x5a, 0x6b, 0xff, 0xcc, 0xfe, 0x47, 0x03, 0x25, 0xc6, 0x54, 0x6b, 0x5b,
+	0x40, 0xe7, 0x05, 0x34, 0x3a, 0x80, 0x32, 0xe3, 0x13, 0xfa, 0xd5, 0x39, 0x5e, 0x22, 0x39, 0x41,
+	0x2f, 0xa0, 0xa2, 0xa8, 0x51, 0x8c, 0x6a, 0x5c, 0x75, 0x97, 0xdf, 0x73, 0x05, 0x5c, 0x7f, 0x42,
+	0x8d, 0x5a, 0x93, 0x6d, 0xfc, 0xb4, 0x03, 0xf0, 0xf7, 0x5a, 0x28, 0x82, 0x70, 0x40, 0xfa, 0x9d,
+	0x64, 0x38, 0x4c, 0xba, 0xf5, 0xff, 0x10, 0x40, 0x70, 0xf1, 0xa6, 0x77, 0x95, 0x74, 0xeb, 0x9e,
+	0x0d, 0x91, 0xa4, 0xd3, 0xff, 0x98, 0x90, 0xa4, 0x5b, 0xf7, 0x51, 0x0d, 0x2a, 0xc3, 0xf7, 0xbd,
+	0xc1, 0x20, 0xe9, 0xd6, 0x4b, 0xed, 0x77, 0x3f, 0x36, 0x47, 0xde, 0xaf, 0xcd, 0x91, 0xf7, 0x7b,
+	0x73, 0xe4, 0x7d, 0x7a, 0x3d, 0x63, 0x66, 0xbe, 0x1a, 0xd9, 0xa9, 0x63, 0x99, 0x8d, 0xe7, 0xeb,
+	0x09, 0x55, 0xf7, 0xd1, 0x6d, 0x2b, 0xd6, 0x6a, 0x1c, 0xff, 0xeb, 0x61, 0x8d, 0x02, 0xf7, 0x2c,
+	0xce, 0xff, 0x0c, 0x00, 0x5e, 0x56, 0x3e, 0x03, 0x77, 0x03, 0x00, 0x00,
 }
 
 func (m *Input) Marshal() (dAtA []byte, err error) {
@@ -311,6 +463,104 @@ func (m *Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DatumMeta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DatumMeta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DatumMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Retries) > 0 {
+		for iNdEx := len(m.Retries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Retries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Index != 0 {
+		i = encodeVarintCommon(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Stats != nil {
+		{
+			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.State != 0 {
+		i = encodeVarintCommon(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Inputs) > 0 {
+		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PipelineJobID) > 0 {
+		i -= len(m.PipelineJobID)
+		copy(dAtA[i:], m.PipelineJobID)
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.PipelineJobID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCommon(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCommon(v)
 	base := offset
@@ -374,6 +624,52 @@ func (m *Input) Size() (n int) {
 	return n
 }
 
+func (m *DatumMeta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PipelineJobID)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovCommon(uint64(l))
+		}
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovCommon(uint64(m.State))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovCommon(uint64(m.Index))
+	}
+	if len(m.Retries) > 0 {
+		for _, e := range m.Retries {
+			l = e.Size()
+			n += 1 + l + sovCommon(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovCommon(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -743,6 +1039,295 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DatumMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatumMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatumMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineJobID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PipelineJobID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inputs = append(m.Inputs, &Input{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= DatumState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &pps.ProcessStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Retries = append(m.Retries, &pps.DatumRetry{})
+			if err := m.Retries[len(m.Retries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCommon(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
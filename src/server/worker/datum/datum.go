@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path"
@@ -27,9 +28,12 @@ import (
 
 const (
 	// MetaPrefix is the prefix for the meta path.
-	MetaPrefix = "meta"
+	MetaPrefix = common.MetaPrefix
 	// MetaFileName is the name of the meta file.
-	MetaFileName = "meta"
+	MetaFileName = common.MetaFileName
+	// ErrCmdOutputFileName is the name of the file with the output of the
+	// error handling code.
+	ErrCmdOutputFileName = "err_cmd_output"
 	// PFSPrefix is the prefix for the pfs path.
 	PFSPrefix = "pfs"
 	// OutputPrefix is the prefix for the output path.
@@ -39,6 +43,23 @@ const (
 	defaultNumRetries = 3
 )
 
+// Meta and State are defined in the common package, so that PFS can read
+// datum meta files without depending on this package.
+type (
+	// Meta is the meta of a datum.
+	Meta = common.DatumMeta
+	// State is the state of a processed datum.
+	State = common.DatumState
+)
+
+// The states of a processed datum.
+const (
+	State_PROCESSED = common.DatumState_PROCESSED
+	State_FAILED    = common.DatumState_FAILED
+	State_RECOVERED = common.DatumState_RECOVERED
+	State_SKIPPED   = common.DatumState_SKIPPED
+)
+
 // SetSpec specifies criteria for creating datum sets.
 type SetSpec struct {
 	Number    int64
@@ -117,6 +138,7 @@ type Set struct {
 	storageRoot                       string
 	metaOutputClient, pfsOutputClient client.ModifyFile
 	stats                             *Stats
+	downloaderOpts                    []pfssync.DownloaderOption
 }
 
 // WithSet provides a scoped environment for a datum set.
@@ -150,22 +172,27 @@ func (s *Set) UploadMeta(meta *Meta, opts ...Option) error {
 // TODO: Handle datum concurrency here, and potentially move symlinking here.
 func (s *Set) WithDatum(ctx context.Context, meta *Meta, cb func(*Datum) error, opts ...Option) error {
 	d := newDatum(s, meta, opts...)
+	b, err := d.backOff()
+	if err != nil {
+		return err
+	}
 	cancelCtx, cancel := context.WithCancel(ctx)
 	attemptsLeft := d.numRetries + 1
 	return backoff.RetryUntilCancel(cancelCtx, func() error {
 		return d.withData(func() (retErr error) {
 			defer func() {
 				attemptsLeft--
-				if retErr == nil || attemptsLeft == 0 {
+				if retErr == nil || attemptsLeft == 0 || !d.shouldRetry(retErr) {
 					retErr = d.finish(retErr)
 					cancel()
 				}
 			}()
 			return cb(d)
 		})
-	}, &backoff.ZeroBackOff{}, func(err error, _ time.Duration) error {
+	}, b, func(err error, backoff time.Duration) error {
 		// TODO: Tagged logger here?
 		fmt.Println("withDatum:", err)
+		d.recordRetry(err, backoff)
 		return nil
 	})
 }
@@ -177,8 +204,11 @@ type Datum struct {
 	meta             *Meta
 	storageRoot      string
 	numRetries       int
-	recoveryCallback func(context.Context) error
+	recoveryCallback func(context.Context, io.Writer) error
+	recoveryOutput   *bytes.Buffer
 	timeout          time.Duration
+	retrySpec        *pps.RetrySpec
+	retryBudget      *RetryBudget
 }
 
 func newDatum(set *Set, meta *Meta, opts ...Option) *Datum {
@@ -214,6 +244,12 @@ func (d *Datum) finish(err error) (retErr error) {
 		}
 	}()
 	if err != nil {
+		if d.classify(err) == actionSkip {
+			d.meta.State = State_SKIPPED
+			d.meta.Reason = err.Error()
+			d.set.stats.SkippedByExitCode++
+			return d.uploadMetaOutput()
+		}
 		d.handleFailed(err)
 		return d.uploadMetaOutput()
 	}
@@ -234,6 +270,15 @@ func (d *Datum) handleFailed(err error) {
 	}
 }
 
+// shouldRetry returns true if the datum should be retried after an attempt
+// failed with err, which takes a retry from the retry budget.
+func (d *Datum) shouldRetry(err error) bool {
+	if d.classify(err) != actionRetry {
+		return false
+	}
+	return d.retryBudget == nil || d.retryBudget.take()
+}
+
 func (d *Datum) withData(cb func() error) (retErr error) {
 	// Setup and defer cleanup of pfs directory.
 	if err := os.MkdirAll(path.Join(d.PFSStorageRoot(), OutputPrefix), 0777); err != nil {
@@ -250,7 +295,7 @@ func (d *Datum) withData(cb func() error) (retErr error) {
 			return err
 		}
 		return cb()
-	})
+	}, d.set.downloaderOpts...)
 }
 
 func (d *Datum) downloadData(downloader pfssync.Downloader) error {
@@ -261,6 +306,10 @@ func (d *Datum) downloadData(downloader pfssync.Downloader) error {
 	d.meta.Stats.DownloadBytes = 0
 	var mu sync.Mutex
 	for _, input := range d.meta.Inputs {
+		if input.S3 {
+			// S3 inputs are served by the sidecar s3 gateway
+			continue
+		}
 		// TODO: Need some validation to catch lazy & empty since they are incompatible.
 		// Probably should catch this at the input validation during pipeline creation?
 		opts := []pfssync.DownloadOption{
@@ -303,7 +352,8 @@ func (d *Datum) run(ctx context.Context, cb func(ctx context.Context) error) (re
 		if retErr != nil {
 			if d.recoveryCallback != nil {
 				// TODO: Set error based on recovery or original? Going with original for now.
-				err := d.recoveryCallback(ctx)
+				d.recoveryOutput = &bytes.Buffer{}
+				err := d.recoveryCallback(ctx, d.recoveryOutput)
 				if err == nil {
 					d.meta.State = State_RECOVERED
 				}
@@ -333,6 +383,12 @@ func (d *Datum) uploadMetaOutput() (retErr error) {
 		if err := ioutil.WriteFile(fullPath, buf.Bytes(), 0700); err != nil {
 			return err
 		}
+		if d.recoveryOutput != nil && d.recoveryOutput.Len() > 0 {
+			fullPath := path.Join(d.MetaStorageRoot(), ErrCmdOutputFileName)
+			if err := ioutil.WriteFile(fullPath, d.recoveryOutput.Bytes(), 0700); err != nil {
+				return err
+			}
+		}
 		return d.upload(d.set.metaOutputClient, d.storageRoot)
 	}
 	return nil
@@ -353,6 +409,9 @@ func (d *Datum) uploadOutput() error {
 	return d.uploadMetaOutput()
 }
 
+// upload uploads the files under storageRoot, tagged with the datum's ID. The
+// tag records which datum wrote each output path, which is what deleting a
+// datum's output and PFS's file lineage rely on.
 func (d *Datum) upload(mf client.ModifyFile, storageRoot string, cb ...func(*tar.Header) error) error {
 	// TODO: Might make more sense to convert to tar on the fly.
 	f, err := os.Create(path.Join(d.set.storageRoot, TmpFileName))
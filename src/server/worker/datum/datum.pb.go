@@ -8,7 +8,6 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	pps "github.com/pachyderm/pachyderm/v2/src/pps"
-	common "github.com/pachyderm/pachyderm/v2/src/server/worker/common"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -25,146 +24,26 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-type State int32
-
-const (
-	State_PROCESSED State = 0
-	State_FAILED    State = 1
-	State_RECOVERED State = 2
-)
-
-var State_name = map[int32]string{
-	0: "PROCESSED",
-	1: "FAILED",
-	2: "RECOVERED",
-}
-
-var State_value = map[string]int32{
-	"PROCESSED": 0,
-	"FAILED":    1,
-	"RECOVERED": 2,
-}
-
-func (x State) String() string {
-	return proto.EnumName(State_name, int32(x))
-}
-
-func (State) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_96ec7427544ac634, []int{0}
-}
-
-type Meta struct {
-	PipelineJobID        string            `protobuf:"bytes,1,opt,name=pipeline_job_id,json=pipelineJobId,proto3" json:"pipeline_job_id,omitempty"`
-	Inputs               []*common.Input   `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
-	Hash                 string            `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
-	State                State             `protobuf:"varint,4,opt,name=state,proto3,enum=datum.State" json:"state,omitempty"`
-	Reason               string            `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
-	Stats                *pps.ProcessStats `protobuf:"bytes,6,opt,name=stats,proto3" json:"stats,omitempty"`
-	Index                int64             `protobuf:"varint,7,opt,name=index,proto3" json:"index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
-}
-
-func (m *Meta) Reset()         { *m = Meta{} }
-func (m *Meta) String() string { return proto.CompactTextString(m) }
-func (*Meta) ProtoMessage()    {}
-func (*Meta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96ec7427544ac634, []int{0}
-}
-func (m *Meta) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *Meta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Meta.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *Meta) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Meta.Merge(m, src)
-}
-func (m *Meta) XXX_Size() int {
-	return m.Size()
-}
-func (m *Meta) XXX_DiscardUnknown() {
-	xxx_messageInfo_Meta.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Meta proto.InternalMessageInfo
-
-func (m *Meta) GetPipelineJobID() string {
-	if m != nil {
-		return m.PipelineJobID
-	}
-	return ""
-}
-
-func (m *Meta) GetInputs() []*common.Input {
-	if m != nil {
-		return m.Inputs
-	}
-	return nil
-}
-
-func (m *Meta) GetHash() string {
-	if m != nil {
-		return m.Hash
-	}
-	return ""
-}
-
-func (m *Meta) GetState() State {
-	if m != nil {
-		return m.State
-	}
-	return State_PROCESSED
-}
-
-func (m *Meta) GetReason() string {
-	if m != nil {
-		return m.Reason
-	}
-	return ""
-}
-
-func (m *Meta) GetStats() *pps.ProcessStats {
-	if m != nil {
-		return m.Stats
-	}
-	return nil
-}
-
-func (m *Meta) GetIndex() int64 {
-	if m != nil {
-		return m.Index
-	}
-	return 0
-}
-
 type Stats struct {
-	ProcessStats         *pps.ProcessStats `protobuf:"bytes,1,opt,name=process_stats,json=processStats,proto3" json:"process_stats,omitempty"`
-	Processed            int64             `protobuf:"varint,2,opt,name=processed,proto3" json:"processed,omitempty"`
-	Skipped              int64             `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
-	Failed               int64             `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
-	Recovered            int64             `protobuf:"varint,5,opt,name=recovered,proto3" json:"recovered,omitempty"`
-	FailedID             string            `protobuf:"bytes,6,opt,name=failed_id,json=failedId,proto3" json:"failed_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	ProcessStats *pps.ProcessStats `protobuf:"bytes,1,opt,name=process_stats,json=processStats,proto3" json:"process_stats,omitempty"`
+	Processed    int64             `protobuf:"varint,2,opt,name=processed,proto3" json:"processed,omitempty"`
+	Skipped      int64             `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Failed       int64             `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	Recovered    int64             `protobuf:"varint,5,opt,name=recovered,proto3" json:"recovered,omitempty"`
+	FailedID     string            `protobuf:"bytes,6,opt,name=failed_id,json=failedId,proto3" json:"failed_id,omitempty"`
+	// skipped_by_exit_code counts the datums skipped by the retry spec's
+	// skip_exit_codes, skipped counts the datums processed by an earlier job.
+	SkippedByExitCode    int64    `protobuf:"varint,7,opt,name=skipped_by_exit_code,json=skippedByExitCode,proto3" json:"skipped_by_exit_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Stats) Reset()         { *m = Stats{} }
 func (m *Stats) String() string { return proto.CompactTextString(m) }
 func (*Stats) ProtoMessage()    {}
 func (*Stats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96ec7427544ac634, []int{1}
+	return fileDescriptor_96ec7427544ac634, []int{0}
 }
 func (m *Stats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,129 +114,40 @@ func (m *Stats) GetFailedID() string {
 	return ""
 }
 
+func (m *Stats) GetSkippedByExitCode() int64 {
+	if m != nil {
+		return m.SkippedByExitCode
+	}
+	return 0
+}
+
 func init() {
-	proto.RegisterEnum("datum.State", State_name, State_value)
-	proto.RegisterType((*Meta)(nil), "datum.Meta")
 	proto.RegisterType((*Stats)(nil), "datum.Stats")
 }
 
 func init() { proto.RegisterFile("server/worker/datum/datum.proto", fileDescriptor_96ec7427544ac634) }
 
 var fileDescriptor_96ec7427544ac634 = []byte{
-	// 458 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xc1, 0x8b, 0xd3, 0x4e,
-	0x14, 0xfe, 0x4d, 0xd3, 0x64, 0xb7, 0xb3, 0xcd, 0xcf, 0xee, 0xb0, 0x48, 0x58, 0xa4, 0x8d, 0x05,
-	0x31, 0x7a, 0x68, 0xb0, 0x82, 0xb0, 0x47, 0x77, 0x93, 0x95, 0x88, 0xb2, 0x65, 0x0a, 0x1e, 0xbc,
-	0x94, 0x34, 0xf3, 0x6c, 0xe3, 0x6e, 0x33, 0xc3, 0x4c, 0x5a, 0xf5, 0x3f, 0xf4, 0xe8, 0xd1, 0xd3,
-	0x22, 0xf9, 0x27, 0xbc, 0xca, 0xcc, 0x44, 0xb6, 0x82, 0x5e, 0x92, 0xf7, 0x7d, 0xdf, 0x7b, 0xdf,
-	0xf0, 0xf1, 0x1e, 0x1e, 0x29, 0x90, 0x3b, 0x90, 0xf1, 0x27, 0x2e, 0xaf, 0x41, 0xc6, 0x2c, 0xaf,
-	0xb7, 0x1b, 0xfb, 0x9d, 0x08, 0xc9, 0x6b, 0x4e, 0x5c, 0x03, 0x4e, 0x4f, 0x56, 0x7c, 0xc5, 0x0d,
-	0x13, 0xeb, 0xca, 0x8a, 0xa7, 0xbe, 0x10, 0x2a, 0x16, 0x42, 0xb5, 0xf0, 0xe1, 0x9f, 0x66, 0x05,
-	0xdf, 0x6c, 0x78, 0xd5, 0xfe, 0x6c, 0xcb, 0xf8, 0x27, 0xc2, 0xdd, 0xb7, 0x50, 0xe7, 0xe4, 0x0c,
-	0xdf, 0x13, 0xa5, 0x80, 0x9b, 0xb2, 0x82, 0xc5, 0x47, 0xbe, 0x5c, 0x94, 0x2c, 0x40, 0x21, 0x8a,
-	0x7a, 0xe7, 0xc7, 0xcd, 0xed, 0xc8, 0x9f, 0xb5, 0xd2, 0x6b, 0xbe, 0xcc, 0x12, 0xea, 0x8b, 0x3d,
-	0xc8, 0xc8, 0x23, 0xec, 0x95, 0x95, 0xd8, 0xd6, 0x2a, 0xe8, 0x84, 0x4e, 0x74, 0x34, 0xf5, 0x27,
-	0xed, 0x13, 0x99, 0x66, 0x69, 0x2b, 0x12, 0x82, 0xbb, 0xeb, 0x5c, 0xad, 0x03, 0x47, 0xdb, 0x52,
-	0x53, 0x93, 0x31, 0x76, 0x55, 0x9d, 0xd7, 0x10, 0x74, 0x43, 0x14, 0xfd, 0x3f, 0xed, 0x4f, 0x6c,
-	0xd4, 0xb9, 0xe6, 0xa8, 0x95, 0xc8, 0x7d, 0xec, 0x49, 0xc8, 0x15, 0xaf, 0x02, 0xd7, 0x4c, 0xb6,
-	0x88, 0x3c, 0xb6, 0xb3, 0x2a, 0xf0, 0x42, 0x14, 0x1d, 0x4d, 0x8f, 0x27, 0x3a, 0xf8, 0x4c, 0xf2,
-	0x02, 0x94, 0xd2, 0x06, 0xca, 0x1a, 0x28, 0x72, 0x82, 0xdd, 0xb2, 0x62, 0xf0, 0x39, 0x38, 0x08,
-	0x51, 0xe4, 0x50, 0x0b, 0xc6, 0xdf, 0x11, 0x76, 0x4d, 0x1b, 0x79, 0x81, 0x7d, 0x61, 0xc7, 0x16,
-	0xd6, 0x10, 0xfd, 0xcb, 0xb0, 0x2f, 0xf6, 0x10, 0x79, 0x80, 0x7b, 0x2d, 0x06, 0x16, 0x74, 0x8c,
-	0xf7, 0x1d, 0x41, 0x02, 0x7c, 0xa0, 0xae, 0x4b, 0x21, 0x80, 0x99, 0xc4, 0x0e, 0xfd, 0x0d, 0x75,
-	0xa0, 0x0f, 0x79, 0x79, 0x03, 0xcc, 0xa4, 0x76, 0x68, 0x8b, 0xb4, 0x9f, 0x84, 0x82, 0xef, 0x40,
-	0x02, 0x33, 0x59, 0x1d, 0x7a, 0x47, 0x90, 0x27, 0xb8, 0x67, 0xfb, 0xf4, 0x6a, 0x3c, 0xb3, 0x9a,
-	0x7e, 0x73, 0x3b, 0x3a, 0xbc, 0x34, 0x64, 0x96, 0xd0, 0x43, 0x2b, 0x67, 0xec, 0xe9, 0x33, 0x9b,
-	0x0c, 0x88, 0x8f, 0x7b, 0x33, 0x7a, 0x75, 0x91, 0xce, 0xe7, 0x69, 0x32, 0xf8, 0x8f, 0x60, 0xec,
-	0x5d, 0xbe, 0xcc, 0xde, 0xa4, 0xc9, 0x00, 0x69, 0x89, 0xa6, 0x17, 0x57, 0xef, 0x52, 0x9a, 0x26,
-	0x83, 0xce, 0xf9, 0xab, 0xaf, 0xcd, 0x10, 0x7d, 0x6b, 0x86, 0xe8, 0x47, 0x33, 0x44, 0xef, 0xcf,
-	0x56, 0x65, 0xbd, 0xde, 0x2e, 0xf5, 0x1e, 0x63, 0x91, 0x17, 0xeb, 0x2f, 0x0c, 0xe4, 0x7e, 0xb5,
-	0x9b, 0xc6, 0x4a, 0x16, 0xf1, 0x5f, 0x6e, 0x75, 0xe9, 0x99, 0xbb, 0x7a, 0xfe, 0x2b, 0x00, 0x00,
-	0xff, 0xff, 0x7f, 0xd2, 0x48, 0xa7, 0xc9, 0x02, 0x00, 0x00,
-}
-
-func (m *Meta) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Meta) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Meta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Index != 0 {
-		i = encodeVarintDatum(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Stats != nil {
-		{
-			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDatum(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintDatum(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.State != 0 {
-		i = encodeVarintDatum(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintDatum(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Inputs) > 0 {
-		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDatum(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.PipelineJobID) > 0 {
-		i -= len(m.PipelineJobID)
-		copy(dAtA[i:], m.PipelineJobID)
-		i = encodeVarintDatum(dAtA, i, uint64(len(m.PipelineJobID)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	// 299 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcb, 0x4a, 0x03, 0x31,
+	0x14, 0x86, 0x49, 0x6b, 0x6f, 0xb1, 0x5d, 0x34, 0x14, 0x09, 0x45, 0xda, 0xe2, 0xaa, 0x6e, 0x1a,
+	0xa8, 0x20, 0xb8, 0xad, 0x37, 0xba, 0x93, 0xba, 0x73, 0x33, 0xcc, 0x24, 0xc7, 0x69, 0xa8, 0x25,
+	0x21, 0x49, 0xc7, 0xce, 0x23, 0xf8, 0x66, 0x2e, 0x7d, 0x02, 0x91, 0x79, 0x12, 0x99, 0x64, 0xd4,
+	0x2e, 0xdc, 0x84, 0xf3, 0xfd, 0xdf, 0xe1, 0x0f, 0x24, 0x78, 0x6c, 0xc1, 0x64, 0x60, 0xd8, 0xab,
+	0x32, 0x1b, 0x30, 0x4c, 0xc4, 0x6e, 0xb7, 0x0d, 0xe7, 0x4c, 0x1b, 0xe5, 0x14, 0x69, 0x78, 0x18,
+	0x0e, 0x52, 0x95, 0x2a, 0x9f, 0xb0, 0x72, 0x0a, 0x72, 0xd8, 0xd3, 0xda, 0x32, 0xad, 0x6d, 0xc0,
+	0xb3, 0xb7, 0x1a, 0x6e, 0x3c, 0xba, 0xd8, 0x59, 0x72, 0x89, 0x7b, 0xda, 0x28, 0x0e, 0xd6, 0x46,
+	0xb6, 0x0c, 0x28, 0x9a, 0xa0, 0xe9, 0xf1, 0xbc, 0x3f, 0x2b, 0x97, 0x1f, 0x82, 0xf1, 0x9b, 0xab,
+	0xae, 0x3e, 0x20, 0x72, 0x8a, 0x3b, 0x15, 0x83, 0xa0, 0xb5, 0x09, 0x9a, 0xd6, 0x57, 0x7f, 0x01,
+	0xa1, 0xb8, 0x65, 0x37, 0x52, 0x6b, 0x10, 0xb4, 0xee, 0xdd, 0x0f, 0x92, 0x13, 0xdc, 0x7c, 0x8e,
+	0xe5, 0x0b, 0x08, 0x7a, 0xe4, 0x45, 0x45, 0x65, 0x9f, 0x01, 0xae, 0x32, 0x30, 0x20, 0x68, 0x23,
+	0xf4, 0xfd, 0x06, 0xe4, 0x1c, 0x77, 0xc2, 0x5e, 0x24, 0x05, 0x6d, 0x4e, 0xd0, 0xb4, 0xb3, 0xe8,
+	0x16, 0x9f, 0xe3, 0xf6, 0x9d, 0x0f, 0x97, 0x37, 0xab, 0x76, 0xd0, 0x4b, 0x41, 0x18, 0x1e, 0x54,
+	0x77, 0x45, 0x49, 0x1e, 0xc1, 0x5e, 0xba, 0x88, 0x2b, 0x01, 0xb4, 0xe5, 0x3b, 0xfb, 0x95, 0x5b,
+	0xe4, 0xb7, 0x7b, 0xe9, 0xae, 0x95, 0x80, 0xc5, 0xfd, 0x7b, 0x31, 0x42, 0x1f, 0xc5, 0x08, 0x7d,
+	0x15, 0x23, 0xf4, 0x74, 0x95, 0x4a, 0xb7, 0xde, 0x25, 0x33, 0xae, 0xb6, 0x4c, 0xc7, 0x7c, 0x9d,
+	0x0b, 0x30, 0x87, 0x53, 0x36, 0x67, 0xd6, 0x70, 0xf6, 0xcf, 0x67, 0x24, 0x4d, 0xff, 0xb6, 0x17,
+	0xdf, 0x03, 0x00, 0x80, 0x09, 0x26, 0x3b, 0xaa, 0x01, 0x00, 0x00,
 }
 
 func (m *Stats) Marshal() (dAtA []byte, err error) {
@@ -384,6 +174,11 @@ func (m *Stats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.SkippedByExitCode != 0 {
+		i = encodeVarintDatum(dAtA, i, uint64(m.SkippedByExitCode))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.FailedID) > 0 {
 		i -= len(m.FailedID)
 		copy(dAtA[i:], m.FailedID)
@@ -437,46 +232,6 @@ func encodeVarintDatum(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
-func (m *Meta) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PipelineJobID)
-	if l > 0 {
-		n += 1 + l + sovDatum(uint64(l))
-	}
-	if len(m.Inputs) > 0 {
-		for _, e := range m.Inputs {
-			l = e.Size()
-			n += 1 + l + sovDatum(uint64(l))
-		}
-	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovDatum(uint64(l))
-	}
-	if m.State != 0 {
-		n += 1 + sovDatum(uint64(m.State))
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovDatum(uint64(l))
-	}
-	if m.Stats != nil {
-		l = m.Stats.Size()
-		n += 1 + l + sovDatum(uint64(l))
-	}
-	if m.Index != 0 {
-		n += 1 + sovDatum(uint64(m.Index))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
 func (m *Stats) Size() (n int) {
 	if m == nil {
 		return 0
@@ -503,6 +258,9 @@ func (m *Stats) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDatum(uint64(l))
 	}
+	if m.SkippedByExitCode != 0 {
+		n += 1 + sovDatum(uint64(m.SkippedByExitCode))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -515,261 +273,6 @@ func sovDatum(x uint64) (n int) {
 func sozDatum(x uint64) (n int) {
 	return sovDatum(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Meta) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDatum
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Meta: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Meta: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineJobID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDatum
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDatum
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDatum
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PipelineJobID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDatum
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDatum
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDatum
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Inputs = append(m.Inputs, &common.Input{})
-			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDatum
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDatum
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDatum
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDatum
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= State(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDatum
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDatum
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDatum
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDatum
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDatum
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDatum
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Stats == nil {
-				m.Stats = &pps.ProcessStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-			}
-			m.Index = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDatum
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Index |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDatum(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDatum
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
 func (m *Stats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -943,6 +446,25 @@ func (m *Stats) Unmarshal(dAtA []byte) error {
 			}
 			m.FailedID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedByExitCode", wireType)
+			}
+			m.SkippedByExitCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SkippedByExitCode |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDatum(dAtA[iNdEx:])
@@ -148,17 +148,17 @@ type Hasher interface {
 }
 
 type pipelineJobIterator struct {
-	iterator Iterator
-	pipelineJobID    string
-	hasher   Hasher
+	iterator      Iterator
+	pipelineJobID string
+	hasher        Hasher
 }
 
 // NewPipelineJobIterator creates a new job iterator.
 func NewPipelineJobIterator(iterator Iterator, pipelineJobID string, hasher Hasher) Iterator {
 	return &pipelineJobIterator{
-		iterator: iterator,
-		pipelineJobID:    pipelineJobID,
-		hasher:   hasher,
+		iterator:      iterator,
+		pipelineJobID: pipelineJobID,
+		hasher:        hasher,
 	}
 }
 
@@ -173,30 +173,43 @@ func (ji *pipelineJobIterator) Iterate(cb func(*Meta) error) error {
 type fileSetIterator struct {
 	pachClient           *client.APIClient
 	repo, branch, commit string
+	after                string
 }
 
 // NewCommitIterator creates an iterator for the specified commit and repo.
-func NewCommitIterator(pachClient *client.APIClient, repo, branch, commit string) Iterator {
-	return &fileSetIterator{
+func NewCommitIterator(pachClient *client.APIClient, repo, branch, commit string, opts ...IteratorOption) Iterator {
+	fsi := &fileSetIterator{
 		pachClient: pachClient,
 		repo:       repo,
 		branch:     branch,
 		commit:     commit,
 	}
+	for _, opt := range opts {
+		opt(fsi)
+	}
+	return fsi
 }
 
 // NewFileSetIterator creates a new fileset iterator.
-func NewFileSetIterator(pachClient *client.APIClient, fsID string) Iterator {
-	return &fileSetIterator{
+func NewFileSetIterator(pachClient *client.APIClient, fsID string, opts ...IteratorOption) Iterator {
+	fsi := &fileSetIterator{
 		pachClient: pachClient,
 		repo:       client.FileSetsRepoName,
 		branch:     "",
 		commit:     fsID,
 	}
+	for _, opt := range opts {
+		opt(fsi)
+	}
+	return fsi
 }
 
 func (fsi *fileSetIterator) Iterate(cb func(*Meta) error) error {
-	r, err := fsi.pachClient.GetFileTar(client.NewCommit(fsi.repo, "", fsi.commit), path.Join("/", MetaPrefix, "*", MetaFileName))
+	var after string
+	if fsi.after != "" {
+		after = path.Join("/", MetaPrefix, fsi.after, MetaFileName)
+	}
+	r, err := fsi.pachClient.GetFileTarAfter(client.NewCommit(fsi.repo, "", fsi.commit), path.Join("/", MetaPrefix, "*", MetaFileName), after)
 	if err != nil {
 		return err
 	}
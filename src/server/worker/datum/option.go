@@ -3,10 +3,13 @@ package datum
 import (
 	"context"
 	"fmt"
+	"io"
 	"path"
 	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfssync"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
 )
 
 // SetOption configures a set.
@@ -33,6 +36,24 @@ func WithStats(stats *Stats) SetOption {
 	}
 }
 
+// WithDownloaderOptions sets the options for the downloader that downloads
+// each datum's input data.
+func WithDownloaderOptions(opts ...pfssync.DownloaderOption) SetOption {
+	return func(s *Set) {
+		s.downloaderOpts = opts
+	}
+}
+
+// IteratorOption configures a datum iterator.
+type IteratorOption func(*fileSetIterator)
+
+// WithDatumsAfter makes the iterator skip the datums with IDs at or before id.
+func WithDatumsAfter(id string) IteratorOption {
+	return func(fsi *fileSetIterator) {
+		fsi.after = id
+	}
+}
+
 // Option configures a datum.
 type Option func(*Datum)
 
@@ -43,13 +64,29 @@ func WithRetry(numRetries int) Option {
 	}
 }
 
-// WithRecoveryCallback sets the recovery callback.
-func WithRecoveryCallback(cb func(context.Context) error) Option {
+// WithRecoveryCallback sets the recovery callback. The callback's output is
+// stored with the datum's meta if the datum fails.
+func WithRecoveryCallback(cb func(context.Context, io.Writer) error) Option {
 	return func(d *Datum) {
 		d.recoveryCallback = cb
 	}
 }
 
+// WithRetrySpec sets the backoff between retries and which failures are
+// retried.
+func WithRetrySpec(spec *pps.RetrySpec) Option {
+	return func(d *Datum) {
+		d.retrySpec = spec
+	}
+}
+
+// WithRetryBudget sets a retry budget shared with other datums.
+func WithRetryBudget(budget *RetryBudget) Option {
+	return func(d *Datum) {
+		d.retryBudget = budget
+	}
+}
+
 // WithTimeout sets the timeout.
 func WithTimeout(timeout time.Duration) Option {
 	return func(d *Datum) {
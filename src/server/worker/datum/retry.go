@@ -0,0 +1,137 @@
+package datum
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/exec"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+const (
+	defaultRetryMultiplier = 2
+	defaultMaxRetryBackoff = time.Hour
+)
+
+// failureAction is what is done with a datum after an attempt to process it
+// fails.
+type failureAction int
+
+const (
+	actionRetry failureAction = iota
+	actionFail
+	actionSkip
+)
+
+// RetryBudget limits the number of retries for many datums. It is safe for
+// concurrent use.
+type RetryBudget struct {
+	// take takes a retry from the budget, returning false if there are none
+	// left.
+	take func() bool
+}
+
+// NewRetryBudget creates a retry budget that allows the given number of
+// retries.
+func NewRetryBudget(retries int64) *RetryBudget {
+	var mu sync.Mutex
+	return NewSharedRetryBudget(func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		if retries <= 0 {
+			return false
+		}
+		retries--
+		return true
+	})
+}
+
+// NewSharedRetryBudget creates a retry budget that is kept elsewhere, so that
+// it can be shared with other workers. take takes a retry from it, returning
+// false if there are none left.
+func NewSharedRetryBudget(take func() bool) *RetryBudget {
+	return &RetryBudget{take: take}
+}
+
+// exitCode returns the exit code of the process that caused err, if any.
+func exitCode(err error) (int64, bool) {
+	exitErr := &exec.ExitError{}
+	if !errors.As(err, &exitErr) {
+		return 0, false
+	}
+	return int64(exitErr.ExitCode()), true
+}
+
+func containsCode(codes []int64, code int64) bool {
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// classify decides what to do with the datum after an attempt fails with err,
+// based on the retry spec.
+func (d *Datum) classify(err error) failureAction {
+	if d.retrySpec == nil {
+		return actionRetry
+	}
+	code, ok := exitCode(err)
+	if !ok {
+		return actionRetry
+	}
+	switch {
+	case containsCode(d.retrySpec.SkipExitCodes, code):
+		return actionSkip
+	case containsCode(d.retrySpec.FailExitCodes, code):
+		return actionFail
+	case len(d.retrySpec.RetryExitCodes) > 0 && !containsCode(d.retrySpec.RetryExitCodes, code):
+		return actionFail
+	default:
+		return actionRetry
+	}
+}
+
+// backOff returns the backoff between attempts to process the datum.
+func (d *Datum) backOff() (backoff.BackOff, error) {
+	if d.retrySpec == nil || d.retrySpec.InitialBackoff == nil {
+		return &backoff.ZeroBackOff{}, nil
+	}
+	initial, err := types.DurationFromProto(d.retrySpec.InitialBackoff)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	b := backoff.NewInfiniteBackOff()
+	b.InitialInterval = initial
+	b.RandomizationFactor = d.retrySpec.Jitter
+	b.Multiplier = defaultRetryMultiplier
+	if d.retrySpec.Multiplier > 0 {
+		b.Multiplier = d.retrySpec.Multiplier
+	}
+	b.MaxInterval = defaultMaxRetryBackoff
+	if d.retrySpec.MaxBackoff != nil {
+		if b.MaxInterval, err = types.DurationFromProto(d.retrySpec.MaxBackoff); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+	}
+	b.Reset()
+	return b, nil
+}
+
+// recordRetry records a failed attempt to process the datum, which is about to
+// be retried after the backoff.
+func (d *Datum) recordRetry(err error, backoff time.Duration) {
+	retry := &pps.DatumRetry{
+		Failed:  types.TimestampNow(),
+		Backoff: types.DurationProto(backoff),
+		Reason:  err.Error(),
+	}
+	if code, ok := exitCode(err); ok {
+		retry.ExitCode = code
+	}
+	d.meta.Retries = append(d.meta.Retries, retry)
+}
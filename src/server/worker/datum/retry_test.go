@@ -0,0 +1,98 @@
+// +build !windows
+
+package datum
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/exec"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+func exitWith(code int) error {
+	return exec.Command("sh", "-c", fmt.Sprintf("exit %d", code)).Run()
+}
+
+// runDatum processes an empty datum with cb and returns its meta and the
+// number of attempts.
+func runDatum(t *testing.T, s *Set, cb func(attempt int) error, opts ...Option) (*Meta, int) {
+	meta := &Meta{}
+	var attempts int
+	require.NoError(t, s.WithDatum(context.Background(), meta, func(*Datum) error {
+		attempts++
+		return cb(attempts)
+	}, opts...))
+	return meta, attempts
+}
+
+func TestRetrySpec(t *testing.T) {
+	stats := &Stats{ProcessStats: &pps.ProcessStats{}}
+	require.NoError(t, WithSet(nil, t.TempDir(), func(s *Set) error {
+		spec := &pps.RetrySpec{
+			InitialBackoff: types.DurationProto(10 * time.Millisecond),
+			FailExitCodes:  []int64{4},
+			SkipExitCodes:  []int64{3},
+		}
+		// Skipped datums aren't retried.
+		meta, attempts := runDatum(t, s, func(int) error { return exitWith(3) }, WithRetrySpec(spec))
+		require.Equal(t, 1, attempts)
+		require.Equal(t, State_SKIPPED, meta.State)
+		require.Equal(t, int64(1), stats.SkippedByExitCode)
+		// Failed datums aren't retried.
+		meta, attempts = runDatum(t, s, func(int) error { return exitWith(4) }, WithRetrySpec(spec))
+		require.Equal(t, 1, attempts)
+		require.Equal(t, State_FAILED, meta.State)
+		require.Equal(t, int64(1), stats.Failed)
+		// Other failures are retried after a backoff, and each retry is recorded.
+		start := time.Now()
+		meta, attempts = runDatum(t, s, func(attempt int) error {
+			if attempt < 3 {
+				return exitWith(5)
+			}
+			return nil
+		}, WithRetrySpec(spec))
+		require.True(t, time.Since(start) >= 30*time.Millisecond)
+		require.Equal(t, 3, attempts)
+		require.Equal(t, State_PROCESSED, meta.State)
+		require.Equal(t, 2, len(meta.Retries))
+		for _, retry := range meta.Retries {
+			require.Equal(t, int64(5), retry.ExitCode)
+		}
+		backoff, err := types.DurationFromProto(meta.Retries[1].Backoff)
+		require.NoError(t, err)
+		require.Equal(t, 20*time.Millisecond, backoff)
+		return nil
+	}, WithStats(stats)))
+}
+
+func TestRetryExitCodes(t *testing.T) {
+	require.NoError(t, WithSet(nil, t.TempDir(), func(s *Set) error {
+		spec := &pps.RetrySpec{RetryExitCodes: []int64{5}}
+		_, attempts := runDatum(t, s, func(int) error { return exitWith(5) }, WithRetrySpec(spec))
+		require.Equal(t, defaultNumRetries+1, attempts)
+		_, attempts = runDatum(t, s, func(int) error { return exitWith(6) }, WithRetrySpec(spec))
+		require.Equal(t, 1, attempts)
+		// Failures that aren't an exit of the user code are retried.
+		_, attempts = runDatum(t, s, func(int) error { return errors.New("download failed") }, WithRetrySpec(spec))
+		require.Equal(t, defaultNumRetries+1, attempts)
+		return nil
+	}))
+}
+
+func TestRetryBudget(t *testing.T) {
+	require.NoError(t, WithSet(nil, t.TempDir(), func(s *Set) error {
+		budget := NewRetryBudget(2)
+		_, attempts := runDatum(t, s, func(int) error { return exitWith(1) }, WithRetryBudget(budget))
+		require.Equal(t, 3, attempts)
+		// The budget is spent, so the next datum isn't retried.
+		_, attempts = runDatum(t, s, func(int) error { return exitWith(1) }, WithRetryBudget(budget))
+		require.Equal(t, 1, attempts)
+		return nil
+	}))
+}
@@ -14,6 +14,7 @@ func MergeStats(x, y *Stats) error {
 	}
 	x.Processed += y.Processed
 	x.Skipped += y.Skipped
+	x.SkippedByExitCode += y.SkippedByExitCode
 	x.Failed += y.Failed
 	x.Recovered += y.Recovered
 	if x.FailedID == "" {
@@ -0,0 +1,214 @@
+package driver
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/exec"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/logs"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/stats"
+)
+
+const (
+	// BatchOK is the result user code reports for a datum it processed
+	// successfully. Any other result is the reason the datum failed.
+	BatchOK = "ok"
+)
+
+// userCodeBatch is a long-lived user code process that processes datums one
+// at a time, for pipelines with datum batching.
+//
+// The process reads the ID of the next datum, one per line, from file
+// descriptor 3, once the datum's data has been linked into /pfs. When it has
+// processed the datum, it writes BatchOK, or the reason the datum failed, on
+// a line to file descriptor 4. The worker closes file descriptor 3 when
+// there are no more datums, after which the process should exit.
+//
+// If the process exits, or is killed because a datum timed out or was
+// cancelled, the datum fails and a new process is started for the next one.
+type userCodeBatch struct {
+	d       *driver
+	logger  logs.TaggedLogger
+	environ []string
+
+	cmd     *exec.Cmd
+	next    *os.File
+	results *bufio.Reader
+	// exited is closed when the process exits, after which waitErr is set.
+	exited  chan struct{}
+	waitErr error
+}
+
+// WithUserCodeBatch starts the configured user process lazily and calls cb
+// with a function that hands the process a datum and waits for its result.
+// The process is stopped when cb returns, and killed if ctx is cancelled
+// while it's stopping.
+func (d *driver) WithUserCodeBatch(
+	ctx context.Context,
+	logger logs.TaggedLogger,
+	environ []string,
+	cb func(process func(context.Context, logs.TaggedLogger, string) error) error,
+) (retErr error) {
+	if len(d.pipelineInfo.Transform.Cmd) == 0 {
+		return errors.New("invalid pipeline transform, no command specified")
+	}
+	b := &userCodeBatch{
+		d:       d,
+		logger:  logger,
+		environ: environ,
+	}
+	defer func() {
+		if err := b.close(ctx); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	return cb(b.process)
+}
+
+func (b *userCodeBatch) start() (retErr error) {
+	b.logger.Logf("beginning to run batched user code")
+	transform := b.d.pipelineInfo.Transform
+	cmd := exec.Command(transform.Cmd[0], transform.Cmd[1:]...)
+	if transform.Stdin != nil {
+		cmd.Stdin = strings.NewReader(strings.Join(transform.Stdin, "\n") + "\n")
+	}
+	cmd.Stdout = b.logger.WithUserCode()
+	cmd.Stderr = b.logger.WithUserCode()
+	cmd.Env = b.environ
+	if b.d.uid != nil && b.d.gid != nil {
+		cmd.SysProcAttr = makeCmdCredentials(*b.d.uid, *b.d.gid)
+	}
+	cmd.Dir = filepath.Join(b.d.rootDir, transform.WorkingDir)
+	nextR, nextW, err := os.Pipe()
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	resultsR, resultsW, err := os.Pipe()
+	if err != nil {
+		nextR.Close()
+		nextW.Close()
+		return errors.EnsureStack(err)
+	}
+	// The child's ends of the pipes are only needed by the child.
+	defer nextR.Close()
+	defer resultsW.Close()
+	defer func() {
+		if retErr != nil {
+			nextW.Close()
+			resultsR.Close()
+		}
+	}()
+	cmd.ExtraFiles = []*os.File{nextR, resultsW}
+	if err := cmd.Start(); err != nil {
+		return errors.EnsureStack(err)
+	}
+	b.cmd = cmd
+	b.next = nextW
+	b.results = bufio.NewReader(resultsR)
+	b.exited = make(chan struct{})
+	go func(exited chan struct{}) {
+		defer close(exited)
+		defer resultsR.Close()
+		b.waitErr = cmd.Wait()
+	}(b.exited)
+	return nil
+}
+
+// process hands the datum with ID datumID to the user process and waits for
+// it to report the result.
+func (b *userCodeBatch) process(ctx context.Context, logger logs.TaggedLogger, datumID string) (retErr error) {
+	logger.Logf("beginning to process datum with batched user code")
+	defer stats.DatumDurations.Start()()
+	defer func(start time.Time) {
+		if retErr != nil {
+			logger.Logf("errored processing datum with batched user code after %v: %v", time.Since(start), retErr)
+		} else {
+			logger.Logf("finished processing datum with batched user code after %v", time.Since(start))
+		}
+	}(time.Now())
+	if b.cmd == nil {
+		if err := b.start(); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintln(b.next, datumID); err != nil {
+		return b.exitErr(errors.Wrap(err, "could not send datum to user code"))
+	}
+	type result struct {
+		line string
+		err  error
+	}
+	results := b.results
+	resultChan := make(chan result, 1)
+	go func() {
+		line, err := results.ReadString('\n')
+		resultChan <- result{line, err}
+	}()
+	select {
+	case <-ctx.Done():
+		// The process may be in any state, so it's replaced for the next datum.
+		b.kill()
+		return errors.EnsureStack(ctx.Err())
+	case r := <-resultChan:
+		if r.err != nil {
+			return b.exitErr(errors.Wrap(r.err, "user code did not report the datum's result"))
+		}
+		line := strings.TrimSpace(r.line)
+		if line != BatchOK {
+			return errors.Errorf("user code failed to process datum: %s", line)
+		}
+		return nil
+	}
+}
+
+// exitErr waits for the process, which failed to communicate, to exit and
+// returns err annotated with the exit status.
+func (b *userCodeBatch) exitErr(err error) error {
+	b.kill()
+	if b.waitErr != nil {
+		return errors.Wrapf(err, "user code exited (%v)", b.waitErr)
+	}
+	return errors.Wrap(err, "user code exited")
+}
+
+func (b *userCodeBatch) kill() {
+	if b.cmd == nil {
+		return
+	}
+	b.next.Close()
+	// Kill errors if the process has already exited, which is fine.
+	b.cmd.Process.Kill()
+	<-b.exited
+	b.cmd = nil
+}
+
+// close tells the process that there are no more datums and waits for it to
+// exit.
+func (b *userCodeBatch) close(ctx context.Context) error {
+	if b.cmd == nil {
+		return nil
+	}
+	if err := b.next.Close(); err != nil {
+		return errors.EnsureStack(err)
+	}
+	select {
+	case <-b.exited:
+		b.cmd = nil
+	case <-ctx.Done():
+		b.kill()
+		return errors.EnsureStack(ctx.Err())
+	}
+	if b.waitErr != nil {
+		// Every datum has reported its result, so this doesn't fail any of them.
+		b.logger.Logf("batched user code exited with error: %v", b.waitErr)
+		return nil
+	}
+	b.logger.Logf("finished running batched user code")
+	return nil
+}
@@ -0,0 +1,75 @@
+// +build !windows
+
+package driver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/logs"
+)
+
+// batchScript reports "ok" for datums whose ID starts with "ok", hangs on
+// datums whose ID starts with "hang", exits on datums whose ID starts with
+// "exit", and fails any other datum.
+const batchScript = `
+while read -u 3 datum; do
+  case "$datum" in
+    ok*) echo ok >&4 ;;
+    hang*) sleep 60 ;;
+    exit*) exit 1 ;;
+    *) echo "bad datum $datum" >&4 ;;
+  esac
+done
+`
+
+func newBatchTestDriver() *driver {
+	return &driver{
+		pipelineInfo: &pps.PipelineInfo{
+			Transform: &pps.Transform{
+				Cmd:           []string{"bash", "-c", batchScript},
+				DatumBatching: true,
+			},
+		},
+		rootDir: "/",
+	}
+}
+
+func TestUserCodeBatch(t *testing.T) {
+	d := newBatchTestDriver()
+	logger := logs.NewMockLogger()
+	ctx := context.Background()
+	require.NoError(t, d.WithUserCodeBatch(ctx, logger, nil, func(process func(context.Context, logs.TaggedLogger, string) error) error {
+		require.NoError(t, process(ctx, logger, "ok1"))
+		require.NoError(t, process(ctx, logger, "ok2"))
+		err := process(ctx, logger, "bad")
+		require.YesError(t, err)
+		require.Matches(t, "bad datum bad", err.Error())
+		// The process keeps running after a datum fails.
+		require.NoError(t, process(ctx, logger, "ok3"))
+		return nil
+	}))
+}
+
+func TestUserCodeBatchRestart(t *testing.T) {
+	d := newBatchTestDriver()
+	logger := logs.NewMockLogger()
+	ctx := context.Background()
+	require.NoError(t, d.WithUserCodeBatch(ctx, logger, nil, func(process func(context.Context, logs.TaggedLogger, string) error) error {
+		require.NoError(t, process(ctx, logger, "ok1"))
+		// The datum fails if the process exits, and the next datum gets a new
+		// process.
+		require.YesError(t, process(ctx, logger, "exit"))
+		require.NoError(t, process(ctx, logger, "ok2"))
+		// The datum fails if it times out, and the next datum gets a new
+		// process.
+		timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer cancel()
+		require.YesError(t, process(timeoutCtx, logger, "hang"))
+		require.NoError(t, process(ctx, logger, "ok3"))
+		return nil
+	}))
+}
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"os"
@@ -24,11 +25,13 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/work"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/logs"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/stats"
 )
 
 // TODO(2.0 optional):
@@ -38,7 +41,7 @@ import (
 // tests to see what can be reused.
 
 func workNamespace(pipelineInfo *pps.PipelineInfo) string {
-	return fmt.Sprintf("/pipeline-%s/v%d", pipelineInfo.Pipeline.Name, pipelineInfo.Version)
+	return fmt.Sprintf("/pipeline-%s/v%d", pipelineInfo.Pipeline.QualifiedName(), pipelineInfo.Version)
 }
 
 // Driver provides an interface for common functions needed by worker code, and
@@ -62,6 +65,11 @@ type Driver interface {
 	// Returns the path that will contain the input filesets for the job
 	InputDir() string
 
+	// Returns the cache of input file contents shared by this worker's datums
+	// (or nil if the pipeline has no cache_size), along with the size of the
+	// largest file that should be added to it
+	InputCache() (kv.GetPut, int64)
+
 	// Returns the pachd API client for the driver
 	PachClient() *client.APIClient
 
@@ -84,7 +92,14 @@ type Driver interface {
 
 	RunUserCode(context.Context, logs.TaggedLogger, []string) error
 
-	RunUserErrorHandlingCode(context.Context, logs.TaggedLogger, []string) error
+	// RunUserErrorHandlingCode runs the configured error handling process,
+	// copying its output to the writer as well as the logs.
+	RunUserErrorHandlingCode(context.Context, logs.TaggedLogger, []string, io.Writer) error
+
+	// WithUserCodeBatch runs the configured user process for many datums, for
+	// pipelines with datum batching. It calls the callback with a function that
+	// processes one datum, whose data must be active, and returns its result.
+	WithUserCodeBatch(context.Context, logs.TaggedLogger, []string, func(func(context.Context, logs.TaggedLogger, string) error) error) error
 
 	// TODO: provide a more generic interface for modifying pipeline jobs, and
 	// some quality-of-life functions for common operations.
@@ -117,6 +132,10 @@ type driver struct {
 	// The directory to store input data - this is typically static but can be
 	// overridden by tests.
 	inputDir string
+
+	// The cache of input file contents, sized by the pipeline's cache_size.
+	inputCache      kv.GetPut
+	inputCacheBytes int64
 }
 
 // NewDriver constructs a Driver object using the given clients and pipeline
@@ -146,6 +165,20 @@ func NewDriver(
 		rootDir:         rootPath,
 		inputDir:        pfsPath,
 	}
+	cacheBytes, err := ppsutil.CacheBytes(pipelineInfo)
+	if err != nil {
+		return nil, err
+	}
+	if cacheBytes > 0 {
+		// The cache is outside of the input directory, so that it isn't
+		// visible to user code. Its path is stable across restarts, so that
+		// its contents are reused (the files are keyed by their hashes).
+		result.inputCache, err = kv.NewFileCache(filepath.Join(os.TempDir(), "pach-cache", env.Config().PodName), cacheBytes)
+		if err != nil {
+			return nil, err
+		}
+		result.inputCacheBytes = cacheBytes
+	}
 	if pipelineInfo.Transform.User != "" {
 		user, err := lookupDockerUser(pipelineInfo.Transform.User)
 		if err != nil && !errors.Is(err, os.ErrNotExist) {
@@ -263,16 +296,16 @@ func (d *driver) Pipelines() col.PostgresCollection {
 }
 
 func (d *driver) NewTaskWorker() *work.Worker {
-	return work.NewWorker(d.env.GetEtcdClient(), d.env.Config().PPSEtcdPrefix, workNamespace(d.pipelineInfo))
+	return serviceenv.NewTaskWorker(d.env, d.env.Config().PPSEtcdPrefix, workNamespace(d.pipelineInfo))
 }
 
 func (d *driver) NewTaskQueue() (*work.TaskQueue, error) {
-	return work.NewTaskQueue(d.ctx, d.env.GetEtcdClient(), d.env.Config().PPSEtcdPrefix, workNamespace(d.pipelineInfo))
+	return serviceenv.NewTaskQueue(d.ctx, d.env, d.env.Config().PPSEtcdPrefix, workNamespace(d.pipelineInfo))
 }
 
 func (d *driver) ExpectedNumWorkers() (int64, error) {
 	pipelinePtr := &pps.StoredPipelineInfo{}
-	if err := d.Pipelines().ReadOnly(d.ctx).Get(d.PipelineInfo().Pipeline.Name, pipelinePtr); err != nil {
+	if err := d.Pipelines().ReadOnly(d.ctx).Get(d.PipelineInfo().Pipeline.QualifiedName(), pipelinePtr); err != nil {
 		return 0, errors.EnsureStack(err)
 	}
 	numWorkers := pipelinePtr.Parallelism
@@ -294,6 +327,10 @@ func (d *driver) InputDir() string {
 	return d.inputDir
 }
 
+func (d *driver) InputCache() (kv.GetPut, int64) {
+	return d.inputCache, d.inputCacheBytes
+}
+
 func (d *driver) PachClient() *client.APIClient {
 	return d.pachClient
 }
@@ -308,6 +345,7 @@ func (d *driver) RunUserCode(
 	environ []string,
 ) (retErr error) {
 	logger.Logf("beginning to run user code")
+	defer stats.DatumDurations.Start()()
 	defer func(start time.Time) {
 		if retErr != nil {
 			logger.Logf("errored running user code after %v: %v", time.Since(start), retErr)
@@ -378,6 +416,7 @@ func (d *driver) RunUserErrorHandlingCode(
 	ctx context.Context,
 	logger logs.TaggedLogger,
 	environ []string,
+	output io.Writer,
 ) (retErr error) {
 	logger.Logf("beginning to run user error handling code")
 	defer func(start time.Time) {
@@ -392,8 +431,13 @@ func (d *driver) RunUserErrorHandlingCode(
 	if d.pipelineInfo.Transform.ErrStdin != nil {
 		cmd.Stdin = strings.NewReader(strings.Join(d.pipelineInfo.Transform.ErrStdin, "\n") + "\n")
 	}
-	cmd.Stdout = logger.WithUserCode()
-	cmd.Stderr = logger.WithUserCode()
+	var stdout, stderr io.Writer = logger.WithUserCode(), logger.WithUserCode()
+	if output != nil {
+		stdout = io.MultiWriter(stdout, output)
+		stderr = io.MultiWriter(stderr, output)
+	}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
 	cmd.Env = environ
 	if d.uid != nil && d.gid != nil {
 		cmd.SysProcAttr = makeCmdCredentials(*d.uid, *d.gid)
@@ -457,7 +501,7 @@ func (d *driver) UpdatePipelineJobState(pipelineJobID string, state pps.Pipeline
 // deleted.
 func (d *driver) DeletePipelineJob(sqlTx *sqlx.Tx, pipelineJobPtr *pps.StoredPipelineJobInfo) error {
 	pipelinePtr := &pps.StoredPipelineInfo{}
-	if err := d.Pipelines().ReadWrite(sqlTx).Update(pipelineJobPtr.Pipeline.Name, pipelinePtr, func() error {
+	if err := d.Pipelines().ReadWrite(sqlTx).Update(pipelineJobPtr.Pipeline.QualifiedName(), pipelinePtr, func() error {
 		if pipelinePtr.JobCounts == nil {
 			pipelinePtr.JobCounts = make(map[int32]int32)
 		}
@@ -497,6 +541,16 @@ func (d *driver) UserCodeEnv(
 	for _, input := range inputs {
 		result = append(result, fmt.Sprintf("%s=%s", input.Name, filepath.Join(d.InputDir(), input.Name, input.FileInfo.File.Path)))
 		result = append(result, fmt.Sprintf("%s_COMMIT=%s", input.Name, input.FileInfo.File.Commit.ID))
+		if input.S3 {
+			// S3 inputs that expose a whole commit are served as a bucket named
+			// after the input. Otherwise (e.g. s3 inputs in a join), the files
+			// in this datum are served as a per-datum bucket.
+			bucket := input.Name
+			if input.FileInfo.File.Path != "/" {
+				bucket = ppsutil.DatumS3Bucket(common.DatumID(inputs), input.Name)
+			}
+			result = append(result, fmt.Sprintf("%s_BUCKET=%s", input.Name, bucket))
+		}
 	}
 
 	if pipelineJobID != "" {
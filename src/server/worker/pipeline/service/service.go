@@ -27,7 +27,7 @@ func Run(driver driver.Driver, logger logs.TaggedLogger) error {
 	pipelineInfo := driver.PipelineInfo()
 	return forEachCommit(pachClient, pipelineInfo, logger, func(ctx context.Context, commitInfo *pfs.CommitInfo) (retErr error) {
 		driver := driver.WithContext(ctx)
-		pipelineJobInfo, err := ensurePipelineJob(pachClient, pipelineInfo.Pipeline.Name, commitInfo.Commit, logger)
+		pipelineJobInfo, err := ensurePipelineJob(pachClient, pipelineInfo.Pipeline.QualifiedName(), commitInfo.Commit, logger)
 		if err != nil {
 			return err
 		}
@@ -85,7 +85,7 @@ func forEachCommit(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo,
 	// TODO: Readd subscribe on spec commit provenance. Current code simplifies correctness in terms
 	// of commits being closed / pipeline jobs being finished.
 	return pachClient.SubscribeCommit(
-		pipelineInfo.Pipeline.Name,
+		pipelineInfo.Pipeline.OutputRepoName(),
 		"",
 		nil,
 		"",
@@ -115,7 +115,7 @@ func ensurePipelineJob(pachClient *client.APIClient, pipeline string, commit *pf
 		return nil, err
 	}
 	if len(pipelineJobInfos) > 1 {
-		return nil, errors.Errorf("multiple pipeline jobs found for commit: %s@%s", commit.Branch.Repo.Name, commit.ID)
+		return nil, errors.Errorf("multiple pipeline jobs found for commit: %s@%s", commit.Branch.Repo.QualifiedName(), commit.ID)
 	} else if len(pipelineJobInfos) < 1 {
 		pipelineJob, err := pachClient.CreatePipelineJob(pipeline, commit, nil)
 		if err != nil {
@@ -222,8 +222,9 @@ func (jdi *JobDatumIterator) skippableDatum(meta1, meta2 *datum.Meta) bool {
 	if jdi.jc.noSkip {
 		return false
 	}
-	// If the hashes are equal and the second datum was processed, then skip it.
-	return meta1.Hash == meta2.Hash && meta2.State == datum.State_PROCESSED
+	// If the hashes are equal and the second datum was processed (or skipped by
+	// the user code), then skip it.
+	return meta1.Hash == meta2.Hash && (meta2.State == datum.State_PROCESSED || meta2.State == datum.State_SKIPPED)
 }
 
 // Stats returns the stats for the most recent iteration.
@@ -2,6 +2,7 @@ package transform
 
 import (
 	"context"
+	"io"
 	"os"
 	"path/filepath"
 	"testing"
@@ -13,6 +14,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsconsts"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
 	"github.com/pachyderm/pachyderm/v2/src/internal/work"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
@@ -87,6 +89,9 @@ func (td *testDriver) Namespace() string {
 func (td *testDriver) InputDir() string {
 	return td.inner.InputDir()
 }
+func (td *testDriver) InputCache() (kv.GetPut, int64) {
+	return td.inner.InputCache()
+}
 func (td *testDriver) PachClient() *client.APIClient {
 	return td.inner.PachClient()
 }
@@ -105,8 +110,11 @@ func (td *testDriver) UserCodeEnv(pipelineJobID string, commit *pfs.Commit, inpu
 func (td *testDriver) RunUserCode(ctx context.Context, logger logs.TaggedLogger, env []string) error {
 	return td.inner.RunUserCode(ctx, logger, env)
 }
-func (td *testDriver) RunUserErrorHandlingCode(ctx context.Context, logger logs.TaggedLogger, env []string) error {
-	return td.inner.RunUserErrorHandlingCode(ctx, logger, env)
+func (td *testDriver) RunUserErrorHandlingCode(ctx context.Context, logger logs.TaggedLogger, env []string, output io.Writer) error {
+	return td.inner.RunUserErrorHandlingCode(ctx, logger, env, output)
+}
+func (td *testDriver) WithUserCodeBatch(ctx context.Context, logger logs.TaggedLogger, env []string, cb func(func(context.Context, logs.TaggedLogger, string) error) error) error {
+	return td.inner.WithUserCodeBatch(ctx, logger, env, cb)
 }
 func (td *testDriver) DeletePipelineJob(sqlTx *sqlx.Tx, pji *pps.StoredPipelineJobInfo) error {
 	return td.inner.DeletePipelineJob(sqlTx, pji)
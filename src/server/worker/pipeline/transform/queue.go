@@ -0,0 +1,205 @@
+package transform
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+const (
+	coalescedReason = "coalesced into a later input commit (max_queue_size reached)"
+	droppedReason   = "dropped (max_queue_size reached)"
+)
+
+// jobQueue bounds the number of outstanding jobs for a pipeline to the
+// pipeline's max_queue_size, and applies the pipeline's QueueOverflowPolicy to
+// input commits that arrive while the queue is full.
+type jobQueue struct {
+	ctx    context.Context
+	policy pps.QueueOverflowPolicy
+	// start starts a job for an input commit. Once start has been called,
+	// done must be called exactly once, when the job has finished (or failed
+	// to start).
+	start func(*pfs.CommitInfo) error
+	// skip kills the job for an input commit that won't be processed.
+	skip func(*pfs.CommitInfo, string) error
+	// report is called with the queue's state after it changes. It's called
+	// from a single goroutine, without holding any of the queue's locks, and
+	// may miss intermediate states if the state changes faster than it
+	// returns.
+	report func(*pps.PipelineQueueState)
+	// logf logs errors from jobs started asynchronously.
+	logf func(string, ...interface{})
+
+	// slots is nil if the queue is unbounded.
+	slots chan struct{}
+	// startMu serializes calls to start, so that jobs are started in the
+	// order of their input commits.
+	startMu sync.Mutex
+	// mu protects held.
+	mu   sync.Mutex
+	held *pfs.CommitInfo
+
+	stateMu sync.Mutex
+	state   pps.PipelineQueueState
+	// changed is signalled when state changes, to wake up reportState.
+	changed chan struct{}
+}
+
+func newJobQueue(ctx context.Context, maxQueueSize int64, policy pps.QueueOverflowPolicy) *jobQueue {
+	q := &jobQueue{
+		ctx:     ctx,
+		policy:  policy,
+		report:  func(*pps.PipelineQueueState) {},
+		logf:    func(string, ...interface{}) {},
+		changed: make(chan struct{}, 1),
+	}
+	if maxQueueSize > 0 {
+		q.slots = make(chan struct{}, maxQueueSize)
+	}
+	go q.reportState()
+	return q
+}
+
+// push is called with each new input commit, in order. Depending on the
+// overflow policy, it may block until a running job finishes.
+func (q *jobQueue) push(commitInfo *pfs.CommitInfo) error {
+	if q.slots == nil {
+		return q.run(commitInfo)
+	}
+	switch q.policy {
+	case pps.QueueOverflowPolicy_QUEUE_COALESCE:
+		q.mu.Lock()
+		if q.held == nil && q.tryAcquire() {
+			q.mu.Unlock()
+			return q.run(commitInfo)
+		}
+		prev := q.held
+		q.held = commitInfo
+		q.mu.Unlock()
+		q.updateState(func(state *pps.PipelineQueueState) {
+			state.HeldCommits = 1
+			if prev != nil {
+				state.CoalescedCommits++
+			}
+		})
+		if prev != nil {
+			return q.skip(prev, coalescedReason)
+		}
+		return nil
+	case pps.QueueOverflowPolicy_QUEUE_DROP:
+		if q.tryAcquire() {
+			return q.run(commitInfo)
+		}
+		q.updateState(func(state *pps.PipelineQueueState) {
+			state.DroppedCommits++
+		})
+		return q.skip(commitInfo, droppedReason)
+	default:
+		if q.tryAcquire() {
+			return q.run(commitInfo)
+		}
+		// Block upstream: subsequent input commits aren't delivered until this
+		// one has been started.
+		q.updateState(func(state *pps.PipelineQueueState) {
+			state.HeldCommits = 1
+		})
+		select {
+		case q.slots <- struct{}{}:
+		case <-q.ctx.Done():
+			return q.ctx.Err()
+		}
+		q.updateState(func(state *pps.PipelineQueueState) {
+			state.HeldCommits = 0
+		})
+		return q.run(commitInfo)
+	}
+}
+
+// done releases the slot held by a job that has finished.
+func (q *jobQueue) done() {
+	if q.slots != nil {
+		<-q.slots
+	}
+	q.updateState(func(state *pps.PipelineQueueState) {
+		state.RunningJobs--
+	})
+	if q.policy == pps.QueueOverflowPolicy_QUEUE_COALESCE {
+		// done may be called while start is running, so start the held commit
+		// asynchronously.
+		go q.startHeld()
+	}
+}
+
+func (q *jobQueue) startHeld() {
+	q.mu.Lock()
+	if q.held == nil || !q.tryAcquire() {
+		q.mu.Unlock()
+		return
+	}
+	commitInfo := q.held
+	q.held = nil
+	// Take startMu before releasing mu, so that a commit pushed after the held
+	// commit can't be started before it.
+	q.startMu.Lock()
+	q.mu.Unlock()
+	defer q.startMu.Unlock()
+	q.updateState(func(state *pps.PipelineQueueState) {
+		state.HeldCommits = 0
+	})
+	if err := q.startLocked(commitInfo); err != nil {
+		q.logf("error starting held pipeline job for commit %q: %v", commitInfo.Commit.ID, err)
+	}
+}
+
+func (q *jobQueue) tryAcquire() bool {
+	select {
+	case q.slots <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+func (q *jobQueue) run(commitInfo *pfs.CommitInfo) error {
+	q.startMu.Lock()
+	defer q.startMu.Unlock()
+	return q.startLocked(commitInfo)
+}
+
+func (q *jobQueue) startLocked(commitInfo *pfs.CommitInfo) error {
+	q.updateState(func(state *pps.PipelineQueueState) {
+		state.RunningJobs++
+	})
+	return q.start(commitInfo)
+}
+
+func (q *jobQueue) updateState(f func(*pps.PipelineQueueState)) {
+	q.stateMu.Lock()
+	f(&q.state)
+	q.stateMu.Unlock()
+	select {
+	case q.changed <- struct{}{}:
+	default:
+	}
+}
+
+// reportState reports a snapshot of the queue's state each time it changes,
+// until the queue's context is done.
+func (q *jobQueue) reportState() {
+	for {
+		select {
+		case <-q.changed:
+		case <-q.ctx.Done():
+			return
+		}
+		q.stateMu.Lock()
+		state := proto.Clone(&q.state).(*pps.PipelineQueueState)
+		q.stateMu.Unlock()
+		q.report(state)
+	}
+}
@@ -0,0 +1,111 @@
+package transform
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+type testQueue struct {
+	*jobQueue
+	mu      sync.Mutex
+	started []string
+	skipped map[string]string
+}
+
+func newTestQueue(maxQueueSize int64, policy pps.QueueOverflowPolicy) *testQueue {
+	tq := &testQueue{
+		jobQueue: newJobQueue(context.Background(), maxQueueSize, policy),
+		skipped:  make(map[string]string),
+	}
+	tq.start = func(ci *pfs.CommitInfo) error {
+		tq.mu.Lock()
+		defer tq.mu.Unlock()
+		tq.started = append(tq.started, ci.Commit.ID)
+		return nil
+	}
+	tq.skip = func(ci *pfs.CommitInfo, reason string) error {
+		tq.mu.Lock()
+		defer tq.mu.Unlock()
+		tq.skipped[ci.Commit.ID] = reason
+		return nil
+	}
+	return tq
+}
+
+func (tq *testQueue) startedIDs() []string {
+	tq.mu.Lock()
+	defer tq.mu.Unlock()
+	return append([]string{}, tq.started...)
+}
+
+func commitInfo(id string) *pfs.CommitInfo {
+	return &pfs.CommitInfo{Commit: client.NewCommit("repo", "master", id)}
+}
+
+func TestJobQueueUnbounded(t *testing.T) {
+	q := newTestQueue(0, pps.QueueOverflowPolicy_QUEUE_DROP)
+	for _, id := range []string{"a", "b", "c"} {
+		require.NoError(t, q.push(commitInfo(id)))
+	}
+	require.Equal(t, []string{"a", "b", "c"}, q.startedIDs())
+	require.Equal(t, int64(3), q.state.RunningJobs)
+}
+
+func TestJobQueueDrop(t *testing.T) {
+	q := newTestQueue(1, pps.QueueOverflowPolicy_QUEUE_DROP)
+	require.NoError(t, q.push(commitInfo("a")))
+	require.NoError(t, q.push(commitInfo("b")))
+	require.Equal(t, droppedReason, q.skipped["b"])
+	q.done()
+	require.NoError(t, q.push(commitInfo("c")))
+	require.Equal(t, []string{"a", "c"}, q.startedIDs())
+	require.Equal(t, int64(1), q.state.DroppedCommits)
+}
+
+func TestJobQueueCoalesce(t *testing.T) {
+	q := newTestQueue(1, pps.QueueOverflowPolicy_QUEUE_COALESCE)
+	require.NoError(t, q.push(commitInfo("a")))
+	require.NoError(t, q.push(commitInfo("b")))
+	require.NoError(t, q.push(commitInfo("c")))
+	require.Equal(t, coalescedReason, q.skipped["b"])
+	require.Equal(t, []string{"a"}, q.startedIDs())
+	// finishing "a" starts the latest held commit
+	q.done()
+	require.NoErrorWithinTRetry(t, 10*time.Second, func() error {
+		if len(q.startedIDs()) != 2 {
+			return errors.New("held commit not started")
+		}
+		return nil
+	})
+	require.Equal(t, []string{"a", "c"}, q.startedIDs())
+	q.stateMu.Lock()
+	defer q.stateMu.Unlock()
+	require.Equal(t, int64(0), q.state.HeldCommits)
+	require.Equal(t, int64(1), q.state.CoalescedCommits)
+}
+
+func TestJobQueueBlock(t *testing.T) {
+	q := newTestQueue(1, pps.QueueOverflowPolicy_QUEUE_BLOCK)
+	require.NoError(t, q.push(commitInfo("a")))
+	pushed := make(chan error)
+	go func() {
+		pushed <- q.push(commitInfo("b"))
+	}()
+	select {
+	case <-pushed:
+		t.Fatal("push should block while the queue is full")
+	case <-time.After(100 * time.Millisecond):
+	}
+	q.done()
+	require.NoError(t, <-pushed)
+	require.Equal(t, []string{"a", "b"}, q.startedIDs())
+	require.Equal(t, 0, len(q.skipped))
+}
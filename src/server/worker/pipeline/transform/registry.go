@@ -67,9 +67,14 @@ func (ppj *pendingPipelineJob) saveJobStats(stats *datum.Stats) {
 	datum.MergeProcessStats(ppj.pji.Stats, stats.ProcessStats)
 	ppj.pji.DataProcessed += stats.Processed
 	ppj.pji.DataSkipped += stats.Skipped
-	ppj.pji.DataFailed += stats.Failed
+	ppj.pji.DataSkippedByExitCode += stats.SkippedByExitCode
+	if ppj.driver.PipelineInfo().DatumFailurePolicy == pps.DatumFailurePolicy_QUARANTINE {
+		ppj.pji.DataQuarantined += stats.Failed
+	} else {
+		ppj.pji.DataFailed += stats.Failed
+	}
 	ppj.pji.DataRecovered += stats.Recovered
-	ppj.pji.DataTotal += stats.Processed + stats.Skipped + stats.Failed + stats.Recovered
+	ppj.pji.DataTotal += stats.Processed + stats.Skipped + stats.SkippedByExitCode + stats.Failed + stats.Recovered
 }
 
 func (ppj *pendingPipelineJob) withDeleter(pachClient *client.APIClient, cb func() error) error {
@@ -105,6 +110,7 @@ type registry struct {
 	taskQueue   *work.TaskQueue
 	concurrency int64
 	limiter     limit.ConcurrencyLimiter
+	queue       *jobQueue
 	jobChain    *chain.JobChain
 }
 
@@ -121,13 +127,20 @@ func newRegistry(driver driver.Driver, logger logs.TaggedLogger) (*registry, err
 	if err != nil {
 		return nil, err
 	}
-	return &registry{
+	reg := &registry{
 		driver:      driver,
 		logger:      logger,
 		taskQueue:   taskQueue,
 		concurrency: concurrency,
 		limiter:     limit.New(int(concurrency)),
-	}, nil
+	}
+	pipelineInfo := driver.PipelineInfo()
+	reg.queue = newJobQueue(driver.PachClient().Ctx(), pipelineInfo.MaxQueueSize, pipelineInfo.QueueOverflowPolicy)
+	reg.queue.start = reg.startPipelineJob
+	reg.queue.skip = reg.skipPipelineJob
+	reg.queue.report = reg.writeQueueState
+	reg.queue.logf = logger.Logf
+	return reg, nil
 }
 
 func (reg *registry) succeedPipelineJob(ppj *pendingPipelineJob) error {
@@ -158,6 +171,38 @@ func (reg *registry) killPipelineJob(ppj *pendingPipelineJob, reason string) err
 	return err
 }
 
+// skipPipelineJob kills the job for an input commit that the job queue won't
+// process.
+func (reg *registry) skipPipelineJob(commitInfo *pfs.CommitInfo, reason string) error {
+	pipelineJobInfo, err := reg.ensurePipelineJob(commitInfo)
+	if err != nil {
+		return err
+	}
+	reg.logger.Logf("skipping pipeline job %q with reason: %s", pipelineJobInfo.PipelineJob.ID, reason)
+	_, err = reg.driver.PachClient().PpsAPIClient.StopPipelineJob(
+		reg.driver.PachClient().Ctx(),
+		&pps.StopPipelineJobRequest{
+			PipelineJob: pipelineJobInfo.PipelineJob,
+			Reason:      reason,
+		},
+	)
+	return err
+}
+
+// writeQueueState records the state of the job queue in the pipeline's
+// StoredPipelineInfo, so that it's reported by InspectPipeline.
+func (reg *registry) writeQueueState(state *pps.PipelineQueueState) {
+	if err := reg.driver.NewSQLTx(func(sqlTx *sqlx.Tx) error {
+		pipelinePtr := &pps.StoredPipelineInfo{}
+		return reg.driver.Pipelines().ReadWrite(sqlTx).Update(reg.driver.PipelineInfo().Pipeline.QualifiedName(), pipelinePtr, func() error {
+			pipelinePtr.QueueState = state
+			return nil
+		})
+	}); err != nil {
+		reg.logger.Logf("error writing pipeline queue state: %v", err)
+	}
+}
+
 func (reg *registry) initializeJobChain(metaCommitInfo *pfs.CommitInfo) error {
 	if reg.jobChain == nil {
 		pi := reg.driver.PipelineInfo()
@@ -186,7 +231,7 @@ func (reg *registry) initializeJobChain(metaCommitInfo *pfs.CommitInfo) error {
 		reg.jobChain = chain.NewJobChain(
 			pachClient,
 			hasher,
-			append(opts, chain.WithBase(datum.NewCommitIterator(pachClient, commit.Branch.Repo.Name, commit.Branch.Name, commit.ID)))...,
+			append(opts, chain.WithBase(datum.NewCommitIterator(pachClient, commit.Branch.Repo.QualifiedName(), commit.Branch.Name, commit.ID)))...,
 		)
 	}
 	return nil
@@ -195,11 +240,11 @@ func (reg *registry) initializeJobChain(metaCommitInfo *pfs.CommitInfo) error {
 func (reg *registry) ensurePipelineJob(commitInfo *pfs.CommitInfo) (*pps.PipelineJobInfo, error) {
 	pachClient := reg.driver.PachClient()
 	pipelineInfo := reg.driver.PipelineInfo()
-	pipelineJobInfo, err := pachClient.InspectPipelineJobOutputCommit(pipelineInfo.Pipeline.Name, commitInfo.Commit.Branch.Name, commitInfo.Commit.ID, false)
+	pipelineJobInfo, err := pachClient.InspectPipelineJobOutputCommit(pipelineInfo.Pipeline.OutputRepoName(), commitInfo.Commit.Branch.Name, commitInfo.Commit.ID, false)
 	if err != nil {
 		// TODO: It would be better for this to be a structured error.
 		if strings.Contains(err.Error(), "not found") {
-			pipelineJob, err := pachClient.CreatePipelineJob(pipelineInfo.Pipeline.Name, commitInfo.Commit, ppsutil.GetStatsCommit(commitInfo))
+			pipelineJob, err := pachClient.CreatePipelineJob(pipelineInfo.Pipeline.QualifiedName(), commitInfo.Commit, ppsutil.GetStatsCommit(commitInfo))
 			if err != nil {
 				return nil, err
 			}
@@ -222,14 +267,16 @@ func (reg *registry) startPipelineJob(commitInfo *pfs.CommitInfo) error {
 	defer func() {
 		if asyncEg == nil {
 			// The async errgroup never got started, so give up the limiter lock
+			// and the job's slot in the queue
 			reg.limiter.Release()
+			reg.queue.done()
 		}
 	}()
 	pipelineJobInfo, err := reg.ensurePipelineJob(commitInfo)
 	if err != nil {
 		return err
 	}
-	metaCommitInfo, err := reg.driver.PachClient().InspectCommit(pipelineJobInfo.StatsCommit.Branch.Repo.Name, pipelineJobInfo.StatsCommit.Branch.Name, pipelineJobInfo.StatsCommit.ID)
+	metaCommitInfo, err := reg.driver.PachClient().InspectCommit(pipelineJobInfo.StatsCommit.Branch.Repo.QualifiedName(), pipelineJobInfo.StatsCommit.Branch.Name, pipelineJobInfo.StatsCommit.ID)
 	if err != nil {
 		return err
 	}
@@ -263,7 +310,7 @@ func (reg *registry) startPipelineJob(commitInfo *pfs.CommitInfo) error {
 	if err != nil {
 		return err
 	}
-	outputDit := datum.NewCommitIterator(pachClient, ppj.metaCommitInfo.Commit.Branch.Repo.Name, ppj.metaCommitInfo.Commit.Branch.Name, ppj.metaCommitInfo.Commit.ID)
+	outputDit := datum.NewCommitIterator(pachClient, ppj.metaCommitInfo.Commit.Branch.Repo.QualifiedName(), ppj.metaCommitInfo.Commit.Branch.Name, ppj.metaCommitInfo.Commit.ID)
 	ppj.jdit = reg.jobChain.CreateJob(ppj.driver.PachClient().Ctx(), ppj.pji.PipelineJob.ID, dit, outputDit)
 	var afterTime time.Duration
 	if ppj.pji.JobTimeout != nil {
@@ -331,18 +378,18 @@ func (reg *registry) startPipelineJob(commitInfo *pfs.CommitInfo) error {
 					ppj.logger.Logf("error incrementing restart count for job (%s): %v", ppj.pji.PipelineJob.ID, err)
 				}
 				// Reload the job's commitInfo(s) as they may have changed and clear the state of the commit(s).
-				ppj.commitInfo, err = reg.driver.PachClient().InspectCommit(ppj.commitInfo.Commit.Branch.Repo.Name, ppj.commitInfo.Commit.Branch.Name, ppj.commitInfo.Commit.ID)
+				ppj.commitInfo, err = reg.driver.PachClient().InspectCommit(ppj.commitInfo.Commit.Branch.Repo.QualifiedName(), ppj.commitInfo.Commit.Branch.Name, ppj.commitInfo.Commit.ID)
 				if err != nil {
 					return err
 				}
-				if err := ppj.driver.PachClient().ClearCommit(ppj.commitInfo.Commit.Branch.Repo.Name, ppj.commitInfo.Commit.Branch.Name, ppj.commitInfo.Commit.ID); err != nil {
+				if err := ppj.driver.PachClient().ClearCommit(ppj.commitInfo.Commit.Branch.Repo.QualifiedName(), ppj.commitInfo.Commit.Branch.Name, ppj.commitInfo.Commit.ID); err != nil {
 					return err
 				}
-				ppj.metaCommitInfo, err = reg.driver.PachClient().InspectCommit(ppj.metaCommitInfo.Commit.Branch.Repo.Name, ppj.metaCommitInfo.Commit.Branch.Name, ppj.metaCommitInfo.Commit.ID)
+				ppj.metaCommitInfo, err = reg.driver.PachClient().InspectCommit(ppj.metaCommitInfo.Commit.Branch.Repo.QualifiedName(), ppj.metaCommitInfo.Commit.Branch.Name, ppj.metaCommitInfo.Commit.ID)
 				if err != nil {
 					return err
 				}
-				return ppj.driver.PachClient().ClearCommit(ppj.metaCommitInfo.Commit.Branch.Repo.Name, ppj.metaCommitInfo.Commit.Branch.Name, ppj.metaCommitInfo.Commit.ID)
+				return ppj.driver.PachClient().ClearCommit(ppj.metaCommitInfo.Commit.Branch.Repo.QualifiedName(), ppj.metaCommitInfo.Commit.Branch.Name, ppj.metaCommitInfo.Commit.ID)
 			})
 			ppj.logger.Logf("master done running processJobs")
 		}); err != nil {
@@ -353,6 +400,7 @@ func (reg *registry) startPipelineJob(commitInfo *pfs.CommitInfo) error {
 		return nil
 	})
 	go func() {
+		defer reg.queue.done()
 		defer reg.limiter.Release()
 		// Make sure the job has been removed from the job chain.
 		defer ppj.jdit.Finish()
@@ -508,13 +556,13 @@ func (reg *registry) processJobRunning(ppj *pendingPipelineJob) error {
 							return err
 						}
 						renewer.Remove(data.FilesetId)
-						repo := ppj.commitInfo.Commit.Branch.Repo.Name
+						repo := ppj.commitInfo.Commit.Branch.Repo.QualifiedName()
 						branch := ppj.commitInfo.Commit.Branch.Name
 						commit := ppj.commitInfo.Commit.ID
 						if err := pachClient.AddFileset(repo, branch, commit, data.OutputFilesetId); err != nil {
 							return err
 						}
-						repo = ppj.metaCommitInfo.Commit.Branch.Repo.Name
+						repo = ppj.metaCommitInfo.Commit.Branch.Repo.QualifiedName()
 						branch = ppj.metaCommitInfo.Commit.Branch.Name
 						commit = ppj.metaCommitInfo.Commit.ID
 						if err := pachClient.AddFileset(repo, branch, commit, data.MetaFilesetId); err != nil {
@@ -538,7 +586,13 @@ func (reg *registry) processJobRunning(ppj *pendingPipelineJob) error {
 	ppj.saveJobStats(ppj.jdit.Stats())
 	ppj.saveJobStats(stats)
 	if stats.FailedID != "" {
-		return reg.failPipelineJob(ppj, fmt.Sprintf("datum %v failed", stats.FailedID))
+		if ppj.driver.PipelineInfo().DatumFailurePolicy != pps.DatumFailurePolicy_QUARANTINE {
+			return reg.failPipelineJob(ppj, fmt.Sprintf("datum %v failed", stats.FailedID))
+		}
+		// The failed datums are left out of the output, and their meta (inputs,
+		// reason and error handling output) stays in the meta commit so they
+		// can be re-driven later.
+		ppj.logger.Logf("quarantined %v failed datums, first failed datum: %v", stats.Failed, stats.FailedID)
 	}
 	if ppj.pji.Egress != nil {
 		ppj.pji.State = pps.PipelineJobState_JOB_EGRESSING
@@ -606,7 +660,7 @@ func failedInputs(pachClient *client.APIClient, pipelineJobInfo *pps.PipelineJob
 			})
 		if err != nil {
 			return errors.Wrapf(err, "error blocking on commit %s@%s",
-				commit.Branch.Repo.Name, commit.Branch.Name, commit.ID)
+				commit.Branch.Repo.QualifiedName(), commit.Branch.Name, commit.ID)
 		}
 		if strings.Contains(ci.Description, pfs.EmptyStr) {
 			failed = append(failed, name)
@@ -15,14 +15,14 @@ func Run(driver driver.Driver, logger logs.TaggedLogger) error {
 		return err
 	}
 	logger.Logf("transform spawner started")
-	return forEachCommit(driver, reg.startPipelineJob)
+	return forEachCommit(driver, reg.queue.push)
 }
 
 func forEachCommit(driver driver.Driver, cb func(*pfs.CommitInfo) error) error {
 	pachClient := driver.PachClient()
 	pipelineInfo := driver.PipelineInfo()
 	return pachClient.SubscribeCommit(
-		pipelineInfo.Pipeline.Name,
+		pipelineInfo.Pipeline.OutputRepoName(),
 		"",
 		pipelineInfo.SpecCommit.NewProvenance(),
 		"",
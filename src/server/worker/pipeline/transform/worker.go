@@ -3,14 +3,18 @@ package transform
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"path/filepath"
 	"time"
 
 	"github.com/gogo/protobuf/types"
+	"github.com/jmoiron/sqlx"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfssync"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	"github.com/pachyderm/pachyderm/v2/src/internal/work"
@@ -83,43 +87,23 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 				datum.WithPFSOutput(mfPFS),
 				datum.WithStats(datumSet.Stats),
 			}
+			if cache, maxBytes := driver.InputCache(); cache != nil {
+				opts = append(opts, datum.WithDownloaderOptions(pfssync.WithCache(cache, maxBytes)))
+			}
 			// Setup datum set for processing.
 			return datum.WithSet(pachClient, storageRoot, func(s *datum.Set) error {
-				di := datum.NewFileSetIterator(pachClient, datumSet.FilesetId)
-				// Process each datum in the assigned datum set.
-				return di.Iterate(func(meta *datum.Meta) error {
-					ctx := pachClient.Ctx()
-					inputs := meta.Inputs
-					logger = logger.WithData(inputs)
-					env := driver.UserCodeEnv(logger.PipelineJobID(), datumSet.OutputCommit, inputs)
-					var opts []datum.Option
-					if driver.PipelineInfo().DatumTimeout != nil {
-						timeout, err := types.DurationFromProto(driver.PipelineInfo().DatumTimeout)
-						if err != nil {
-							return err
-						}
-						opts = append(opts, datum.WithTimeout(timeout))
-					}
-					if driver.PipelineInfo().DatumTries > 0 {
-						opts = append(opts, datum.WithRetry(int(driver.PipelineInfo().DatumTries)-1))
-					}
-					if driver.PipelineInfo().Transform.ErrCmd != nil {
-						opts = append(opts, datum.WithRecoveryCallback(func(runCtx context.Context) error {
-							return driver.RunUserErrorHandlingCode(runCtx, logger, env)
-						}))
-					}
-					return s.WithDatum(ctx, meta, func(d *datum.Datum) error {
-						cancelCtx, cancel := context.WithCancel(ctx)
-						defer cancel()
-						return status.withDatum(inputs, cancel, func() error {
-							return driver.WithActiveData(inputs, d.PFSStorageRoot(), func() error {
-								return d.Run(cancelCtx, func(runCtx context.Context) error {
-									return driver.RunUserCode(runCtx, logger, env)
-								})
-							})
-						})
-					}, opts...)
-
+				if !driver.PipelineInfo().Transform.DatumBatching {
+					return handleDatums(driver, logger, datumSet, status, s, func(runCtx context.Context, logger logs.TaggedLogger, env []string, _ *datum.Datum) error {
+						return driver.RunUserCode(runCtx, logger, env)
+					})
+				}
+				// The user process outlives each datum, so its environment only
+				// describes the job.
+				env := driver.UserCodeEnv(logger.PipelineJobID(), datumSet.OutputCommit, nil)
+				return driver.WithUserCodeBatch(pachClient.Ctx(), logger, env, func(process func(context.Context, logs.TaggedLogger, string) error) error {
+					return handleDatums(driver, logger, datumSet, status, s, func(runCtx context.Context, logger logs.TaggedLogger, _ []string, d *datum.Datum) error {
+						return process(runCtx, logger, d.ID)
+					})
 				})
 			}, opts...)
 		})
@@ -135,3 +119,80 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 	datumSet.MetaFilesetId = resp.FilesetId
 	return nil
 }
+
+// jobRetryBudget returns the retry budget for the datums of the logger's job,
+// which allows the given number of retries. The budget is shared by all of
+// the job's workers: the retries taken from it are counted in the job's
+// record.
+func jobRetryBudget(driver driver.Driver, logger logs.TaggedLogger, retries int64) *datum.RetryBudget {
+	pipelineJobID := logger.PipelineJobID()
+	return datum.NewSharedRetryBudget(func() bool {
+		var ok bool
+		if err := backoff.RetryUntilCancel(driver.PachClient().Ctx(), func() error {
+			return driver.NewSQLTx(func(sqlTx *sqlx.Tx) error {
+				pipelineJobPtr := &pps.StoredPipelineJobInfo{}
+				return errors.EnsureStack(driver.PipelineJobs().ReadWrite(sqlTx).Update(pipelineJobID, pipelineJobPtr, func() error {
+					ok = pipelineJobPtr.DatumRetries < retries
+					if ok {
+						pipelineJobPtr.DatumRetries++
+					}
+					return nil
+				}))
+			})
+		}, backoff.NewExponentialBackOff(), func(err error, d time.Duration) error {
+			logger.Logf("error taking a retry from the job's retry budget: %v; retrying in %v", err, d)
+			return nil
+		}); err != nil {
+			logger.Logf("could not take a retry from the job's retry budget: %v", err)
+			return false
+		}
+		return ok
+	})
+}
+
+// handleDatums processes each datum in the datum set, running the user code
+// for each with runUserCode.
+func handleDatums(driver driver.Driver, logger logs.TaggedLogger, datumSet *DatumSet, status *Status, s *datum.Set, runUserCode func(context.Context, logs.TaggedLogger, []string, *datum.Datum) error) error {
+	pachClient := driver.PachClient()
+	di := datum.NewFileSetIterator(pachClient, datumSet.FilesetId)
+	// Process each datum in the assigned datum set.
+	return di.Iterate(func(meta *datum.Meta) error {
+		ctx := pachClient.Ctx()
+		inputs := meta.Inputs
+		logger = logger.WithData(inputs)
+		env := driver.UserCodeEnv(logger.PipelineJobID(), datumSet.OutputCommit, inputs)
+		var opts []datum.Option
+		if driver.PipelineInfo().DatumTimeout != nil {
+			timeout, err := types.DurationFromProto(driver.PipelineInfo().DatumTimeout)
+			if err != nil {
+				return err
+			}
+			opts = append(opts, datum.WithTimeout(timeout))
+		}
+		if driver.PipelineInfo().DatumTries > 0 {
+			opts = append(opts, datum.WithRetry(int(driver.PipelineInfo().DatumTries)-1))
+		}
+		if retrySpec := driver.PipelineInfo().Transform.RetrySpec; retrySpec != nil {
+			opts = append(opts, datum.WithRetrySpec(retrySpec))
+			if retrySpec.JobRetryBudget > 0 {
+				opts = append(opts, datum.WithRetryBudget(jobRetryBudget(driver, logger, retrySpec.JobRetryBudget)))
+			}
+		}
+		if driver.PipelineInfo().Transform.ErrCmd != nil {
+			opts = append(opts, datum.WithRecoveryCallback(func(runCtx context.Context, output io.Writer) error {
+				return driver.RunUserErrorHandlingCode(runCtx, logger, env, output)
+			}))
+		}
+		return s.WithDatum(ctx, meta, func(d *datum.Datum) error {
+			cancelCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			return status.withDatum(inputs, cancel, func() error {
+				return driver.WithActiveData(inputs, d.PFSStorageRoot(), func() error {
+					return d.Run(cancelCtx, func(runCtx context.Context) error {
+						return runUserCode(runCtx, logger, env, d)
+					})
+				})
+			})
+		}, opts...)
+	})
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"net"
 	"os"
 	"path"
 	"strconv"
@@ -94,7 +95,12 @@ func Conns(ctx context.Context, pipelineRcName string, etcdClient *etcd.Client,
 		}
 		ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
 		defer cancel()
-		conn, err := grpc.DialContext(ctx, fmt.Sprintf("%s:%d", wIP, workerGrpcPort),
+		// Workers that share an IP register themselves as IP:port
+		addr := fmt.Sprintf("%s:%d", wIP, workerGrpcPort)
+		if _, _, err := net.SplitHostPort(wIP); err == nil {
+			addr = wIP
+		}
+		conn, err := grpc.DialContext(ctx, addr,
 			append(client.DefaultDialOptions(), grpc.WithInsecure())...)
 		if err != nil {
 			return nil, err
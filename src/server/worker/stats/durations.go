@@ -0,0 +1,91 @@
+package stats
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// DatumDurations tracks how long this worker's datums spend in user code. The
+// continuous profiler uses it to notice datums that are running unusually
+// long.
+var DatumDurations = NewDurationTracker(100)
+
+// DurationTracker tracks the durations of a window of recently finished
+// operations, along with the operations that are still running.
+type DurationTracker struct {
+	mu       sync.Mutex
+	window   []time.Duration
+	next     int
+	running  map[int64]time.Time
+	nextID   int64
+	capacity int
+}
+
+// NewDurationTracker returns a DurationTracker that remembers the durations of
+// the last 'capacity' operations.
+func NewDurationTracker(capacity int) *DurationTracker {
+	return &DurationTracker{
+		running:  make(map[int64]time.Time),
+		capacity: capacity,
+	}
+}
+
+// Start records the start of an operation. The returned function must be
+// called when the operation finishes.
+func (t *DurationTracker) Start() func() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	id := t.nextID
+	t.nextID++
+	start := time.Now()
+	t.running[id] = start
+	return func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		delete(t.running, id)
+		t.record(time.Since(start))
+	}
+}
+
+func (t *DurationTracker) record(d time.Duration) {
+	if len(t.window) < t.capacity {
+		t.window = append(t.window, d)
+		return
+	}
+	t.window[t.next] = d
+	t.next = (t.next + 1) % t.capacity
+}
+
+// Percentile returns the p'th percentile (0 < p <= 1) of the recorded
+// durations, along with the number of durations it's based on.
+func (t *DurationTracker) Percentile(p float64) (time.Duration, int) {
+	t.mu.Lock()
+	sorted := append([]time.Duration{}, t.window...)
+	t.mu.Unlock()
+	if len(sorted) == 0 {
+		return 0, 0
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	i := int(float64(len(sorted))*p+0.5) - 1
+	if i < 0 {
+		i = 0
+	} else if i >= len(sorted) {
+		i = len(sorted) - 1
+	}
+	return sorted[i], len(sorted)
+}
+
+// Longest returns how long the longest-running operation that hasn't
+// finished has been running (or 0 if none are running).
+func (t *DurationTracker) Longest() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var longest time.Duration
+	for _, start := range t.running {
+		if d := time.Since(start); d > longest {
+			longest = d
+		}
+	}
+	return longest
+}
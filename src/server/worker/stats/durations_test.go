@@ -0,0 +1,35 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestDurationTrackerPercentile(t *testing.T) {
+	tracker := NewDurationTracker(10)
+	_, n := tracker.Percentile(0.95)
+	require.Equal(t, 0, n)
+	// only the last 10 durations are kept
+	for i := 1; i <= 20; i++ {
+		tracker.record(time.Duration(i) * time.Second)
+	}
+	p50, n := tracker.Percentile(0.5)
+	require.Equal(t, 10, n)
+	require.Equal(t, 15*time.Second, p50)
+	p95, _ := tracker.Percentile(0.95)
+	require.Equal(t, 20*time.Second, p95)
+}
+
+func TestDurationTrackerLongest(t *testing.T) {
+	tracker := NewDurationTracker(10)
+	require.Equal(t, time.Duration(0), tracker.Longest())
+	done := tracker.Start()
+	time.Sleep(10 * time.Millisecond)
+	require.True(t, tracker.Longest() >= 10*time.Millisecond)
+	done()
+	require.Equal(t, time.Duration(0), tracker.Longest())
+	_, n := tracker.Percentile(0.95)
+	require.Equal(t, 1, n)
+}
@@ -14,7 +14,6 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
-	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
@@ -86,7 +85,7 @@ func NewWorker(
 		if pipelineInfo.Transform.Cmd == nil {
 			if len(image.Config.Entrypoint) == 0 {
 				ppsutil.FailPipeline(env.Context(), env.GetDBClient(), driver.Pipelines(),
-					pipelineInfo.Pipeline.Name,
+					pipelineInfo.Pipeline.QualifiedName(),
 					"nothing to run: no transform.cmd and no entrypoint")
 			}
 			pipelineInfo.Transform.Cmd = image.Config.Entrypoint
@@ -138,8 +137,8 @@ func (w *Worker) worker() {
 func (w *Worker) master(env serviceenv.ServiceEnv) {
 	pipelineInfo := w.driver.PipelineInfo()
 	logger := logs.NewMasterLogger(pipelineInfo)
-	lockPath := path.Join(env.Config().PPSEtcdPrefix, masterLockPath, pipelineInfo.Pipeline.Name, pipelineInfo.Salt)
-	masterLock := dlock.NewDLock(env.GetEtcdClient(), lockPath)
+	lockPath := path.Join(env.Config().PPSEtcdPrefix, masterLockPath, pipelineInfo.Pipeline.QualifiedName(), pipelineInfo.Salt)
+	masterLock := serviceenv.NewDLock(env, lockPath)
 
 	b := backoff.NewInfiniteBackOff()
 	// Setting a high backoff so that when this master fails, the other
@@ -170,7 +169,7 @@ func (w *Worker) master(env serviceenv.ServiceEnv) {
 				w.driver.PachClient().Ctx(),
 				env.GetDBClient(),
 				w.driver.Pipelines(),
-				pipelineInfo.Pipeline.Name,
+				pipelineInfo.Pipeline.QualifiedName(),
 				"worker master could not access output repo to watch for new commits",
 			)
 		}
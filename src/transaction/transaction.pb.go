@@ -82,6 +82,7 @@ type TransactionRequest struct {
 	CreatePipeline         *pps.CreatePipelineRequest         `protobuf:"bytes,9,opt,name=create_pipeline,json=createPipeline,proto3" json:"create_pipeline,omitempty"`
 	StopPipelineJob        *pps.StopPipelineJobRequest        `protobuf:"bytes,10,opt,name=stop_pipeline_job,json=stopPipelineJob,proto3" json:"stop_pipeline_job,omitempty"`
 	DeleteAll              *DeleteAllRequest                  `protobuf:"bytes,11,opt,name=delete_all,json=deleteAll,proto3" json:"delete_all,omitempty"`
+	AddFileset             *pfs.AddFilesetRequest             `protobuf:"bytes,12,opt,name=add_fileset,json=addFileset,proto3" json:"add_fileset,omitempty"`
 	XXX_NoUnkeyedLiteral   struct{}                           `json:"-"`
 	XXX_unrecognized       []byte                             `json:"-"`
 	XXX_sizecache          int32                              `json:"-"`
@@ -197,6 +198,13 @@ func (m *TransactionRequest) GetDeleteAll() *DeleteAllRequest {
 	return nil
 }
 
+func (m *TransactionRequest) GetAddFileset() *pfs.AddFilesetRequest {
+	if m != nil {
+		return m.AddFileset
+	}
+	return nil
+}
+
 type TransactionResponse struct {
 	// At most, one of these fields should be set (most responses are empty)
 	Commit                 *pfs.Commit                        `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
@@ -356,13 +364,17 @@ func (m *Transaction) GetID() string {
 }
 
 type TransactionInfo struct {
-	Transaction          *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
-	Requests             []*TransactionRequest  `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
-	Responses            []*TransactionResponse `protobuf:"bytes,3,rep,name=responses,proto3" json:"responses,omitempty"`
-	Started              *types.Timestamp       `protobuf:"bytes,4,opt,name=started,proto3" json:"started,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+	Transaction *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Requests    []*TransactionRequest  `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	Responses   []*TransactionResponse `protobuf:"bytes,3,rep,name=responses,proto3" json:"responses,omitempty"`
+	Started     *types.Timestamp       `protobuf:"bytes,4,opt,name=started,proto3" json:"started,omitempty"`
+	// The user that started the transaction, if auth is active
+	Owner string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The transaction is deleted if it hasn't been finished by this time
+	Expires              *types.Timestamp `protobuf:"bytes,6,opt,name=expires,proto3" json:"expires,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *TransactionInfo) Reset()         { *m = TransactionInfo{} }
@@ -426,6 +438,20 @@ func (m *TransactionInfo) GetStarted() *types.Timestamp {
 	return nil
 }
 
+func (m *TransactionInfo) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TransactionInfo) GetExpires() *types.Timestamp {
+	if m != nil {
+		return m.Expires
+	}
+	return nil
+}
+
 type TransactionInfos struct {
 	TransactionInfo      []*TransactionInfo `protobuf:"bytes,1,rep,name=transaction_info,json=transactionInfo,proto3" json:"transaction_info,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
@@ -521,9 +547,12 @@ func (m *BatchTransactionRequest) GetRequests() []*TransactionRequest {
 }
 
 type StartTransactionRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// How long the transaction may stay open before it is deleted, the server's
+	// default is used if this is unset
+	Ttl                  *types.Duration `protobuf:"bytes,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *StartTransactionRequest) Reset()         { *m = StartTransactionRequest{} }
@@ -559,6 +588,13 @@ func (m *StartTransactionRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StartTransactionRequest proto.InternalMessageInfo
 
+func (m *StartTransactionRequest) GetTtl() *types.Duration {
+	if m != nil {
+		return m.Ttl
+	}
+	return nil
+}
+
 type InspectTransactionRequest struct {
 	Transaction          *Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
@@ -758,63 +794,68 @@ func init() {
 func init() { proto.RegisterFile("transaction/transaction.proto", fileDescriptor_284c03442be38d9f) }
 
 var fileDescriptor_284c03442be38d9f = []byte{
-	// 895 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x8e, 0xdb, 0x44,
-	0x14, 0xde, 0x64, 0xdb, 0x6d, 0x73, 0xd2, 0x36, 0xd9, 0x01, 0x65, 0xbd, 0x29, 0xfb, 0xc3, 0x94,
-	0xa2, 0x5e, 0x39, 0x62, 0x01, 0x21, 0xf1, 0xab, 0xee, 0x2e, 0x54, 0x41, 0x5c, 0x54, 0xce, 0xb2,
-	0x45, 0x05, 0x64, 0x39, 0xf6, 0x38, 0x31, 0x72, 0x3c, 0x53, 0xcf, 0xa4, 0xd2, 0xde, 0xf1, 0x30,
-	0x3c, 0x0c, 0x97, 0x3c, 0x01, 0x42, 0x2b, 0x6e, 0x78, 0x0b, 0x34, 0x3f, 0xce, 0x8e, 0x1d, 0x7b,
-	0x0b, 0x6a, 0xef, 0xec, 0xef, 0x9c, 0xef, 0x9b, 0x33, 0xe7, 0x3b, 0x3e, 0x09, 0xec, 0x89, 0x3c,
-	0xc8, 0x78, 0x10, 0x8a, 0x84, 0x66, 0x23, 0xeb, 0xd9, 0x65, 0x39, 0x15, 0x14, 0x75, 0x2d, 0x68,
-	0x78, 0x7f, 0x46, 0xe9, 0x2c, 0x25, 0x23, 0x15, 0x9a, 0x2e, 0xe3, 0x11, 0x59, 0x30, 0x71, 0xa1,
-	0x33, 0x87, 0x07, 0xd5, 0xa0, 0x48, 0x16, 0x84, 0x8b, 0x60, 0xc1, 0x4c, 0xc2, 0xdb, 0x33, 0x3a,
-	0xa3, 0xea, 0x71, 0x24, 0x9f, 0x0c, 0x7a, 0x97, 0xc5, 0x7c, 0xc4, 0x62, 0xbe, 0x7a, 0x65, 0x7c,
-	0xc4, 0x98, 0x79, 0xc5, 0x08, 0xfa, 0xa7, 0x24, 0x25, 0x82, 0x3c, 0x4e, 0x53, 0x8f, 0xbc, 0x58,
-	0x12, 0x2e, 0xf0, 0x3f, 0x37, 0x01, 0x9d, 0x5d, 0x55, 0x65, 0x60, 0xf4, 0x09, 0x74, 0xc3, 0x9c,
-	0x04, 0x82, 0xf8, 0x39, 0x61, 0xd4, 0x69, 0x1d, 0xb6, 0x1e, 0x75, 0x8f, 0x06, 0xae, 0x94, 0x3e,
-	0x51, 0xb8, 0x47, 0x18, 0x35, 0xc9, 0x1e, 0x84, 0x2b, 0x48, 0x12, 0x23, 0x75, 0x86, 0x26, 0xb6,
-	0x2d, 0xa2, 0x3e, 0xbb, 0x44, 0x8c, 0x56, 0x10, 0xfa, 0x14, 0xee, 0x70, 0x11, 0xe4, 0xc2, 0x0f,
-	0xe9, 0x62, 0x91, 0x08, 0x67, 0x53, 0x31, 0x77, 0x14, 0x73, 0x22, 0x03, 0x27, 0x0a, 0x2f, 0xa8,
-	0x5d, 0x7e, 0x85, 0xa1, 0x2f, 0xe0, 0x6e, 0x9c, 0x64, 0x09, 0x9f, 0x17, 0xe4, 0x1b, 0x8a, 0xec,
-	0x28, 0xf2, 0x37, 0x2a, 0x52, 0x66, 0xdf, 0x89, 0x2d, 0x50, 0xd2, 0xf9, 0x8b, 0x65, 0x70, 0x45,
-	0xbf, 0x69, 0xd1, 0x27, 0x2a, 0x52, 0xa1, 0x73, 0x0b, 0x94, 0x74, 0xd3, 0xab, 0x69, 0x1e, 0x64,
-	0xe1, 0xdc, 0xd9, 0xb2, 0xe8, 0xba, 0x5b, 0xc7, 0x2a, 0xb0, 0xa2, 0x87, 0x16, 0x28, 0xe9, 0xa6,
-	0x63, 0x86, 0x7e, 0xcb, 0xa2, 0xeb, 0x9e, 0x55, 0xe8, 0x91, 0x05, 0xa2, 0x9f, 0x61, 0x77, 0xc9,
-	0x22, 0x79, 0x3a, 0x4b, 0x18, 0x49, 0x93, 0x8c, 0xf8, 0xbf, 0xd0, 0xa9, 0xcf, 0x45, 0x20, 0x88,
-	0x73, 0x5b, 0x49, 0x61, 0x57, 0xce, 0xc0, 0xf7, 0x2a, 0xeb, 0xa9, 0x49, 0xfa, 0x96, 0x4e, 0x27,
-	0x42, 0x99, 0xa6, 0x45, 0x07, 0xcb, 0xda, 0x30, 0x3a, 0x81, 0x9e, 0xb9, 0x5c, 0x21, 0xef, 0x74,
-	0x94, 0xe8, 0x50, 0x89, 0xea, 0xeb, 0x15, 0xac, 0x42, 0xec, 0x5e, 0x58, 0x82, 0xd1, 0x13, 0xd8,
-	0xe6, 0x82, 0xb2, 0x52, 0x85, 0x0e, 0x28, 0x99, 0xfb, 0x4a, 0x66, 0x22, 0x28, 0xb3, 0x8e, 0x2e,
-	0x74, 0x7a, 0xbc, 0x8c, 0xa3, 0xcf, 0xc1, 0x8c, 0x8c, 0x1f, 0xa4, 0xa9, 0xd3, 0x55, 0x0a, 0x7b,
-	0xae, 0xfd, 0xa1, 0x55, 0x07, 0xdc, 0xeb, 0x44, 0x05, 0x82, 0x7f, 0x6b, 0xc1, 0x5b, 0xa5, 0x59,
-	0xe7, 0x8c, 0x66, 0x9c, 0xa0, 0x07, 0xb0, 0x65, 0x8c, 0xd7, 0x73, 0xde, 0xd5, 0xce, 0x69, 0xcb,
-	0x4d, 0x08, 0xcd, 0xc1, 0xa9, 0x34, 0xc2, 0xcf, 0x8d, 0x80, 0x99, 0x72, 0xb7, 0x54, 0x48, 0xb9,
-	0x33, 0x35, 0xc7, 0x7a, 0x83, 0xb0, 0xd2, 0x3c, 0x8d, 0xe3, 0x0b, 0x78, 0xf7, 0x95, 0x64, 0xb4,
-	0x07, 0x10, 0x27, 0x29, 0xe1, 0x44, 0xf8, 0x49, 0xa4, 0xea, 0xee, 0x78, 0x1d, 0x83, 0x8c, 0x23,
-	0xf4, 0x31, 0xf4, 0x59, 0x4e, 0x5e, 0xfa, 0x9c, 0x91, 0xb0, 0x98, 0xea, 0xf6, 0xfa, 0xe5, 0xee,
-	0xc9, 0xa4, 0x09, 0x23, 0xa1, 0x7e, 0xc7, 0x0f, 0xa1, 0x6b, 0x1d, 0x86, 0x06, 0xd0, 0x2e, 0xc4,
-	0x8f, 0xb7, 0x2e, 0xff, 0x3c, 0x68, 0x8f, 0x4f, 0xbd, 0x76, 0x12, 0xe1, 0x5f, 0xdb, 0xd0, 0xb3,
-	0xf2, 0xc6, 0x59, 0x2c, 0xbf, 0x5f, 0x7b, 0xbb, 0x99, 0x4e, 0x3a, 0xa5, 0x96, 0xd8, 0xf7, 0xb0,
-	0x93, 0xd1, 0x67, 0x70, 0x3b, 0xd7, 0x76, 0x71, 0xa7, 0x7d, 0xb8, 0xf9, 0xa8, 0x7b, 0x74, 0xd0,
-	0x48, 0x34, 0xb6, 0xae, 0x08, 0xe8, 0x4b, 0xe8, 0x14, 0x46, 0x70, 0x67, 0x53, 0xb1, 0x0f, 0x9b,
-	0xd9, 0xa6, 0xf7, 0x57, 0x14, 0xf4, 0x11, 0xdc, 0x52, 0xbb, 0x84, 0x44, 0x66, 0x6d, 0x0c, 0x5d,
-	0xbd, 0x7c, 0xdd, 0x62, 0xf9, 0xba, 0x67, 0xc5, 0xf2, 0xf5, 0x8a, 0x54, 0xfc, 0x23, 0xf4, 0x2b,
-	0x1d, 0xe0, 0xe8, 0x09, 0xf4, 0xad, 0x73, 0xfd, 0x24, 0x8b, 0xe5, 0xe6, 0x94, 0x05, 0xbd, 0xd3,
-	0x54, 0x90, 0x24, 0x7a, 0x3d, 0x51, 0x06, 0xf0, 0x39, 0xec, 0x1c, 0x07, 0x22, 0x9c, 0xd7, 0x2c,
-	0x66, 0xbb, 0x55, 0xad, 0xff, 0xd9, 0x2a, 0xbc, 0x0b, 0x3b, 0x6a, 0x95, 0xae, 0x27, 0xe1, 0x67,
-	0xb0, 0x3b, 0xce, 0xe4, 0xb4, 0xd4, 0x04, 0x5f, 0xc7, 0x5b, 0x7c, 0x0e, 0x8e, 0xfe, 0x26, 0xdf,
-	0xb0, 0xae, 0x03, 0x83, 0xef, 0x12, 0x5e, 0x77, 0x95, 0x73, 0x70, 0xf4, 0xce, 0x7f, 0xb3, 0x27,
-	0x1e, 0xfd, 0x7d, 0x03, 0x36, 0x1f, 0x3f, 0x1d, 0xa3, 0x1f, 0xa0, 0x5f, 0x75, 0x07, 0xbd, 0x57,
-	0x92, 0x68, 0x30, 0x6f, 0x78, 0xed, 0x18, 0xe0, 0x0d, 0x74, 0x06, 0xfd, 0xaa, 0x3f, 0x15, 0xe5,
-	0x06, 0xfb, 0x86, 0x8d, 0x57, 0xc0, 0x1b, 0xe8, 0x27, 0x40, 0xeb, 0xd6, 0xa2, 0xf7, 0x4b, 0x8c,
-	0x46, 0xef, 0xff, 0x43, 0xcd, 0xdb, 0x6b, 0xfe, 0xa2, 0x87, 0x35, 0x3b, 0xb9, 0x46, 0x7b, 0xb0,
-	0xf6, 0xa5, 0x7d, 0x2d, 0xff, 0x03, 0xe1, 0x0d, 0xf4, 0x0c, 0x7a, 0x15, 0x77, 0xd1, 0x83, 0x92,
-	0x66, 0xbd, 0xf7, 0xc3, 0xbd, 0xeb, 0xaa, 0xe5, 0x78, 0x03, 0x3d, 0x87, 0xed, 0xb5, 0xe1, 0xa8,
-	0x94, 0xdb, 0x34, 0x3c, 0xaf, 0x6c, 0xc5, 0x29, 0x74, 0x56, 0x3f, 0x3f, 0xe8, 0xfa, 0x9f, 0xa5,
-	0xe6, 0xab, 0x1f, 0x7f, 0xf5, 0xfb, 0xe5, 0x7e, 0xeb, 0x8f, 0xcb, 0xfd, 0xd6, 0x5f, 0x97, 0xfb,
-	0xad, 0xe7, 0x1f, 0xcc, 0x12, 0x31, 0x5f, 0x4e, 0xdd, 0x90, 0x2e, 0x46, 0x2c, 0x08, 0xe7, 0x17,
-	0x11, 0xc9, 0xed, 0xa7, 0x97, 0x47, 0x23, 0x9e, 0x87, 0xf6, 0x7f, 0xcd, 0xe9, 0x96, 0x92, 0xfc,
-	0xf0, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xba, 0x50, 0x4b, 0x2a, 0x8d, 0x0a, 0x00, 0x00,
+	// 961 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xeb, 0x8e, 0xdb, 0x44,
+	0x14, 0xde, 0x24, 0x6d, 0xda, 0x9c, 0x6c, 0x9b, 0xec, 0x50, 0x65, 0xbd, 0x29, 0x7b, 0xc1, 0xa5,
+	0xa8, 0x12, 0x92, 0x23, 0x16, 0x10, 0x12, 0x57, 0xed, 0x85, 0xad, 0x82, 0xf8, 0x51, 0x39, 0x4b,
+	0x8b, 0x0a, 0xc8, 0x72, 0xec, 0x49, 0x62, 0xe4, 0x78, 0xa6, 0x9e, 0x49, 0x61, 0x5f, 0x07, 0xf1,
+	0x30, 0xfc, 0xe0, 0x07, 0x4f, 0x80, 0xd0, 0x8a, 0x07, 0x41, 0x73, 0x71, 0x32, 0x76, 0xec, 0x5d,
+	0x10, 0xfb, 0xcf, 0xf3, 0x9d, 0xf3, 0x7d, 0x33, 0xe7, 0x32, 0x67, 0x0c, 0xbb, 0x3c, 0xf5, 0x13,
+	0xe6, 0x07, 0x3c, 0x22, 0xc9, 0xc0, 0xf8, 0x76, 0x68, 0x4a, 0x38, 0x41, 0x6d, 0x03, 0xea, 0xef,
+	0x4d, 0x09, 0x99, 0xc6, 0x78, 0x20, 0x4d, 0xe3, 0xc5, 0x64, 0x10, 0x2e, 0x52, 0x7f, 0xe5, 0xdc,
+	0x7f, 0x58, 0xb4, 0xe3, 0x39, 0xe5, 0x17, 0xda, 0xb8, 0x5f, 0x34, 0xf2, 0x68, 0x8e, 0x19, 0xf7,
+	0xe7, 0x54, 0x3b, 0x3c, 0x98, 0x92, 0x29, 0x91, 0x9f, 0x03, 0xf1, 0xa5, 0xd1, 0x7b, 0x74, 0xc2,
+	0x06, 0x74, 0xc2, 0x96, 0x4b, 0xca, 0x06, 0x94, 0xea, 0xa5, 0x8d, 0xa0, 0x7b, 0x8a, 0x63, 0xcc,
+	0xf1, 0x51, 0x1c, 0xbb, 0xf8, 0xd5, 0x02, 0x33, 0x6e, 0xff, 0xd2, 0x04, 0x74, 0xbe, 0x3a, 0xb5,
+	0x86, 0xd1, 0x47, 0xd0, 0x0e, 0x52, 0xec, 0x73, 0xec, 0xa5, 0x98, 0x12, 0xab, 0x76, 0x50, 0x7b,
+	0xd2, 0x3e, 0xec, 0x39, 0x42, 0xfa, 0x44, 0xe2, 0x2e, 0xa6, 0x44, 0x3b, 0xbb, 0x10, 0x2c, 0x21,
+	0x41, 0x0c, 0xe5, 0x1e, 0x8a, 0x58, 0x37, 0x88, 0x6a, 0xef, 0x1c, 0x31, 0x5c, 0x42, 0xe8, 0x63,
+	0xd8, 0x64, 0xdc, 0x4f, 0xb9, 0x17, 0x90, 0xf9, 0x3c, 0xe2, 0x56, 0x43, 0x32, 0xb7, 0x25, 0x73,
+	0x24, 0x0c, 0x27, 0x12, 0xcf, 0xa8, 0x6d, 0xb6, 0xc2, 0xd0, 0x67, 0x70, 0x6f, 0x12, 0x25, 0x11,
+	0x9b, 0x65, 0xe4, 0x5b, 0x92, 0x6c, 0x49, 0xf2, 0x99, 0xb4, 0xe4, 0xd9, 0x9b, 0x13, 0x03, 0x14,
+	0x74, 0xf6, 0x6a, 0xe1, 0xaf, 0xe8, 0xb7, 0x0d, 0xfa, 0x48, 0x5a, 0x0a, 0x74, 0x66, 0x80, 0x82,
+	0xae, 0x73, 0x35, 0x4e, 0xfd, 0x24, 0x98, 0x59, 0x4d, 0x83, 0xae, 0xb2, 0x75, 0x2c, 0x0d, 0x4b,
+	0x7a, 0x60, 0x80, 0x82, 0xae, 0x33, 0xa6, 0xe9, 0x77, 0x0c, 0xba, 0xca, 0x59, 0x81, 0x1e, 0x1a,
+	0x20, 0xfa, 0x01, 0x76, 0x16, 0x34, 0x14, 0xbb, 0xd3, 0x88, 0xe2, 0x38, 0x4a, 0xb0, 0xf7, 0x23,
+	0x19, 0x7b, 0x8c, 0xfb, 0x1c, 0x5b, 0x77, 0xa5, 0x94, 0xed, 0x88, 0x1e, 0xf8, 0x46, 0x7a, 0x3d,
+	0xd3, 0x4e, 0x5f, 0x91, 0xf1, 0x88, 0xcb, 0xa2, 0x29, 0xd1, 0xde, 0xa2, 0xd4, 0x8c, 0x4e, 0xa0,
+	0xa3, 0x83, 0xcb, 0xe4, 0xad, 0x96, 0x14, 0xed, 0x4b, 0x51, 0x15, 0x5e, 0xc6, 0xca, 0xc4, 0xee,
+	0x07, 0x39, 0x18, 0x3d, 0x85, 0x2d, 0xc6, 0x09, 0xcd, 0x9d, 0xd0, 0x02, 0x29, 0xf3, 0x50, 0xca,
+	0x8c, 0x38, 0xa1, 0xc6, 0xd6, 0x99, 0x4e, 0x87, 0xe5, 0x71, 0xf4, 0x29, 0xe8, 0x96, 0xf1, 0xfc,
+	0x38, 0xb6, 0xda, 0x52, 0x61, 0xd7, 0x31, 0x2f, 0x62, 0xb1, 0xc1, 0xdd, 0x56, 0x98, 0x21, 0xa2,
+	0x37, 0xfd, 0x30, 0xf4, 0x26, 0x51, 0x8c, 0x19, 0xe6, 0xd6, 0xa6, 0xd1, 0x9b, 0x47, 0x61, 0x78,
+	0xa6, 0xe0, 0x65, 0x6f, 0xfa, 0x4b, 0xc8, 0xfe, 0xb5, 0x06, 0x6f, 0xe4, 0x2e, 0x09, 0xa3, 0x24,
+	0x61, 0x18, 0x3d, 0x82, 0xa6, 0xee, 0x18, 0x75, 0x41, 0xda, 0xaa, 0xe4, 0x12, 0x72, 0xb5, 0x09,
+	0xcd, 0xc0, 0x2a, 0x64, 0xd0, 0x4b, 0xb5, 0x80, 0xbe, 0x1e, 0x4e, 0x2e, 0x82, 0x7c, 0x4a, 0x4b,
+	0xb6, 0x75, 0x7b, 0x41, 0x21, 0xeb, 0x0a, 0xb7, 0x2f, 0xe0, 0xad, 0x6b, 0xc9, 0x68, 0x17, 0x40,
+	0x27, 0xc0, 0x8b, 0x42, 0x79, 0xee, 0x96, 0xdb, 0xd2, 0xc8, 0x30, 0x44, 0x1f, 0x42, 0x97, 0xa6,
+	0xf8, 0xb5, 0xc7, 0x28, 0x0e, 0xb2, 0xeb, 0x50, 0x5f, 0x0f, 0xee, 0xbe, 0x70, 0x1a, 0x51, 0x1c,
+	0xa8, 0xb5, 0xfd, 0x18, 0xda, 0xc6, 0x66, 0xa8, 0x07, 0xf5, 0x4c, 0xfc, 0xb8, 0x79, 0xf9, 0xe7,
+	0x7e, 0x7d, 0x78, 0xea, 0xd6, 0xa3, 0xd0, 0xfe, 0xbd, 0x0e, 0x1d, 0xc3, 0x6f, 0x98, 0x4c, 0xc4,
+	0xc5, 0x37, 0xc7, 0xa6, 0xce, 0xa4, 0x95, 0x4b, 0x89, 0x19, 0x87, 0xe9, 0x8c, 0x3e, 0x81, 0xbb,
+	0xa9, 0xaa, 0x17, 0xb3, 0xea, 0x07, 0x8d, 0x27, 0xed, 0xc3, 0xfd, 0x4a, 0xa2, 0xae, 0xeb, 0x92,
+	0x80, 0x3e, 0x87, 0x56, 0x56, 0x08, 0x66, 0x35, 0x24, 0xfb, 0xa0, 0x9a, 0xad, 0x73, 0xbf, 0xa2,
+	0xa0, 0x0f, 0xe0, 0x8e, 0x1c, 0x42, 0x38, 0xd4, 0xf3, 0xa6, 0xef, 0xa8, 0xa9, 0xed, 0x64, 0x53,
+	0xdb, 0x39, 0xcf, 0xa6, 0xb6, 0x9b, 0xb9, 0xa2, 0x07, 0x70, 0x9b, 0xfc, 0x94, 0xe0, 0x54, 0x0e,
+	0x99, 0x96, 0xab, 0x16, 0x42, 0x0b, 0xff, 0x4c, 0xa3, 0x14, 0x33, 0xab, 0x79, 0xbd, 0x96, 0x76,
+	0xb5, 0xbf, 0x83, 0x6e, 0x21, 0x9b, 0x0c, 0x3d, 0x85, 0xae, 0x11, 0x83, 0x17, 0x25, 0x13, 0x31,
+	0xbe, 0x45, 0x70, 0x6f, 0x56, 0x05, 0x27, 0x88, 0x6e, 0x87, 0xe7, 0x01, 0xfb, 0x39, 0x6c, 0x1f,
+	0xfb, 0x3c, 0x98, 0x95, 0xbc, 0x0e, 0x66, 0xda, 0x6b, 0xff, 0x31, 0xed, 0xf6, 0x19, 0x6c, 0xcb,
+	0x79, 0x5e, 0xa2, 0xfb, 0x2e, 0x34, 0x38, 0x8f, 0x75, 0x0b, 0xec, 0xac, 0x65, 0xe0, 0x54, 0x3f,
+	0xa0, 0xae, 0xf0, 0xb2, 0x5f, 0xc0, 0xce, 0x30, 0x11, 0x6d, 0x5a, 0xa6, 0xf4, 0x3f, 0x9a, 0xca,
+	0x7e, 0x0e, 0x96, 0x9a, 0x22, 0x37, 0xac, 0x6b, 0x41, 0xef, 0xeb, 0x88, 0x95, 0x9c, 0x56, 0xec,
+	0xa8, 0x5e, 0xa9, 0x9b, 0xdd, 0xf1, 0xf0, 0xef, 0x5b, 0xd0, 0x38, 0x7a, 0x36, 0x44, 0xdf, 0x42,
+	0xb7, 0x58, 0x4a, 0xf4, 0x76, 0x4e, 0xa2, 0xa2, 0xd2, 0xfd, 0x2b, 0x7b, 0xc6, 0xde, 0x40, 0xe7,
+	0xd0, 0x2d, 0x16, 0xb3, 0xa0, 0x5c, 0x51, 0xeb, 0x7e, 0x65, 0x08, 0xf6, 0x06, 0xfa, 0x1e, 0xd0,
+	0x7a, 0x69, 0xd1, 0x3b, 0x39, 0x46, 0x65, 0xed, 0xff, 0xc5, 0x99, 0xb7, 0xd6, 0xea, 0x8b, 0x1e,
+	0x97, 0xbc, 0x22, 0x25, 0xda, 0xbd, 0xb5, 0xa6, 0xfc, 0x52, 0xfc, 0xb5, 0xd9, 0x1b, 0xe8, 0x05,
+	0x74, 0x0a, 0xd5, 0x45, 0x8f, 0x72, 0x9a, 0xe5, 0xb5, 0xef, 0xef, 0x5e, 0x75, 0x5a, 0x66, 0x6f,
+	0xa0, 0x97, 0xb0, 0xb5, 0xd6, 0x1c, 0x85, 0xe3, 0x56, 0x35, 0xcf, 0xb5, 0xa9, 0x38, 0x85, 0xd6,
+	0xf2, 0xc1, 0x44, 0x57, 0x3f, 0xa4, 0xd5, 0xa1, 0x1f, 0x7f, 0xf1, 0xdb, 0xe5, 0x5e, 0xed, 0x8f,
+	0xcb, 0xbd, 0xda, 0x5f, 0x97, 0x7b, 0xb5, 0x97, 0xef, 0x4d, 0x23, 0x3e, 0x5b, 0x8c, 0x9d, 0x80,
+	0xcc, 0x07, 0xd4, 0x0f, 0x66, 0x17, 0x21, 0x4e, 0xcd, 0xaf, 0xd7, 0x87, 0x03, 0x96, 0x06, 0xe6,
+	0xdf, 0xf3, 0xb8, 0x29, 0x25, 0xdf, 0xff, 0x67, 0x00, 0x57, 0x92, 0xdc, 0x65, 0x5f, 0x0b, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1166,6 +1207,18 @@ func (m *TransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AddFileset != nil {
+		{
+			size, err := m.AddFileset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.DeleteAll != nil {
 		{
 			size, err := m.DeleteAll.MarshalToSizedBuffer(dAtA[:i])
@@ -1456,6 +1509,25 @@ func (m *TransactionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Expires != nil {
+		{
+			size, err := m.Expires.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Started != nil {
 		{
 			size, err := m.Started.MarshalToSizedBuffer(dAtA[:i])
@@ -1617,6 +1689,18 @@ func (m *StartTransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Ttl != nil {
+		{
+			size, err := m.Ttl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1837,6 +1921,10 @@ func (m *TransactionRequest) Size() (n int) {
 		l = m.DeleteAll.Size()
 		n += 1 + l + sovTransaction(uint64(l))
 	}
+	if m.AddFileset != nil {
+		l = m.AddFileset.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1925,6 +2013,14 @@ func (m *TransactionInfo) Size() (n int) {
 		l = m.Started.Size()
 		n += 1 + l + sovTransaction(uint64(l))
 	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Expires != nil {
+		l = m.Expires.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1973,6 +2069,10 @@ func (m *StartTransactionRequest) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.Ttl != nil {
+		l = m.Ttl.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2521,6 +2621,42 @@ func (m *TransactionRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddFileset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AddFileset == nil {
+				m.AddFileset = &pfs.AddFilesetRequest{}
+			}
+			if err := m.AddFileset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransaction(dAtA[iNdEx:])
@@ -3037,6 +3173,74 @@ func (m *TransactionInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expires == nil {
+				m.Expires = &types.Timestamp{}
+			}
+			if err := m.Expires.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransaction(dAtA[iNdEx:])
@@ -3258,6 +3462,42 @@ func (m *StartTransactionRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: StartTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ttl == nil {
+				m.Ttl = &types.Duration{}
+			}
+			if err := m.Ttl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransaction(dAtA[iNdEx:])